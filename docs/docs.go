@@ -58,48 +58,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/ping": {
-            "get": {
-                "description": "ping",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "ping",
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/version": {
-            "get": {
-                "description": "version Description",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "version Summary",
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/workspace": {
+        "/admin/event": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "list workspaces",
+                "description": "list events of event bus by type, status and payload, the latest created first",
                 "consumes": [
                     "application/json"
                 ],
@@ -107,44 +73,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workspace"
+                    "admin"
                 ],
-                "summary": "use to list workspaces",
+                "summary": "use to list events of event bus",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "query page",
+                        "description": "page number",
                         "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "query size",
+                        "description": "page size",
                         "name": "size",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "query order, just like field1,field2:desc",
-                        "name": "orderBy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "query searchWord",
-                        "name": "searchWord",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "event types",
+                        "name": "types",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "query exact",
-                        "name": "exact",
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "pending",
+                                "dequeue",
+                                "running",
+                                "completed",
+                                "failed",
+                                "deadletter"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "event status",
+                        "name": "status",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "query ids, split by comma",
-                        "name": "ids",
+                        "description": "substring of payload",
+                        "name": "payload",
                         "in": "query"
                     }
                 ],
@@ -152,7 +128,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ListWorkspacesResponse"
+                            "$ref": "#/definitions/handlers.listEventsResponse"
                         }
                     },
                     "400": {
@@ -181,112 +157,47 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "import workspace",
+                "description": "delete completed events updated before the time permanently",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "workspace"
+                    "admin"
                 ],
-                "summary": "use to import workspace",
+                "summary": "use to purge completed events",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "file",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "workspace mount path",
-                        "name": "mountType",
+                        "type": "integer",
+                        "description": "unix time, events completed before it are deleted",
+                        "name": "before",
                         "in": "query",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "workspace mount type, only support nfs",
-                        "name": "mountPath",
-                        "in": "query",
-                        "required": true
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "event types",
+                        "name": "types",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ImportWorkspaceResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "invalid param",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
-                    },
-                    "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
-                    },
-                    "403": {
-                        "description": "forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
-                    },
-                    "500": {
-                        "description": "internal system error",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "basicAuth": []
-                    }
-                ],
-                "description": "create workspace",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "workspace"
-                ],
-                "summary": "use to create workspace",
-                "parameters": [
-                    {
-                        "description": "create workspace request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateWorkspaceRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateWorkspaceResponse"
+                            "$ref": "#/definitions/handlers.purgeEventsResponse"
                         }
                     },
                     "400": {
@@ -316,14 +227,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{id}": {
+        "/admin/event/{id}": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "get workspace",
+                "description": "get event of event bus with retry count and the reason of current status",
                 "consumes": [
                     "application/json"
                 ],
@@ -331,13 +242,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workspace"
+                    "admin"
                 ],
-                "summary": "use to get workspace by id",
+                "summary": "use to get event of event bus",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "get workspace id",
+                        "description": "event id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -347,7 +258,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.GetWorkspaceByIdResponse"
+                            "$ref": "#/definitions/handlers.eventItem"
                         }
                     },
                     "400": {
@@ -381,14 +292,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/event/{id}/replay": {
+            "post": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "delete workspace",
+                "description": "requeue failed or dead lettered event with retry count reset, so that it will be handled again",
                 "consumes": [
                     "application/json"
                 ],
@@ -396,13 +309,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workspace"
+                    "admin"
                 ],
-                "summary": "use to delete workspace",
+                "summary": "use to replay failed or dead lettered event",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "delete workspace id",
+                        "description": "event id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -443,14 +356,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/admin/run/reconcile": {
+            "post": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "update workspace",
+                "description": "re-attach orphaned engine runs, abort duplicated engine runs and mark runs whose engine run vanished as failed",
                 "consumes": [
                     "application/json"
                 ],
@@ -458,30 +373,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workspace"
+                    "admin"
                 ],
-                "summary": "use to update workspace",
+                "summary": "use to reconcile active runs with the runs of wes backends",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "update workspace id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "update workspace request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateWorkspaceRequest"
-                        }
+                        "type": "boolean",
+                        "description": "only report what would be done",
+                        "name": "dryRun",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ReconcileRunsResponse"
+                        }
                     },
                     "400": {
                         "description": "invalid param",
@@ -501,12 +409,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.AppError"
                         }
                     },
-                    "404": {
-                        "description": "not found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
-                    },
                     "500": {
                         "description": "internal system error",
                         "schema": {
@@ -516,66 +418,62 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace-id}/notebook": {
+        "/metrics": {
             "get": {
-                "security": [
-                    {
-                        "basicAuth": []
-                    }
+                "description": "prometheus metrics",
+                "produces": [
+                    "text/plain"
                 ],
-                "description": "list notebook of workspace",
+                "summary": "metrics",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/ping": {
+            "get": {
+                "description": "ping",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "tags": [
-                    "notebook"
+                "summary": "ping",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "version Description",
+                "consumes": [
+                    "application/json"
                 ],
-                "summary": "use to list notebook of workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "version Summary",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.listNotebooksResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "invalid param",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
-                    },
-                    "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
-                    },
-                    "403": {
-                        "description": "forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
-                    },
-                    "500": {
-                        "description": "internal system error",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/workspace/{workspace-id}/notebook/{name}": {
+        "/workspace": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "get notebook content",
+                "description": "list workspaces",
                 "consumes": [
                     "application/json"
                 ],
@@ -583,10 +481,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notebook"
+                    "workspace"
+                ],
+                "summary": "use to list workspaces",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "query page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "query size",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "query order, just like field1,field2:desc",
+                        "name": "orderBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "query searchWord",
+                        "name": "searchWord",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "query exact",
+                        "name": "exact",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "query ids, split by comma",
+                        "name": "ids",
+                        "in": "query"
+                    }
                 ],
-                "summary": "get notebook content",
                 "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListWorkspacesResponse"
+                        }
+                    },
                     "400": {
                         "description": "invalid param",
                         "schema": {
@@ -619,29 +561,47 @@ const docTemplate = `{
                         "basicAuth": []
                     }
                 ],
-                "description": "create notebook, update if name exist, set ipynb content in http body",
+                "description": "import workspace",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notebook"
+                    "workspace"
                 ],
-                "summary": "use to create or update notebook",
+                "summary": "use to import workspace",
                 "parameters": [
                     {
-                        "description": "ipynb content",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/notebook.IPythonNotebook"
-                        }
+                        "type": "file",
+                        "description": "file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "workspace mount path",
+                        "name": "mountType",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "workspace mount type, only support nfs",
+                        "name": "mountPath",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ImportWorkspaceResponse"
+                        }
+                    },
                     "400": {
                         "description": "invalid param",
                         "schema": {
@@ -668,13 +628,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "delete notebook",
+                "description": "create workspace",
                 "consumes": [
                     "application/json"
                 ],
@@ -682,12 +642,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notebook"
+                    "workspace"
+                ],
+                "summary": "use to create workspace",
+                "parameters": [
+                    {
+                        "description": "create workspace request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateWorkspaceRequest"
+                        }
+                    }
                 ],
-                "summary": "use to delete notebook",
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateWorkspaceResponse"
+                        }
                     },
                     "400": {
                         "description": "invalid param",
@@ -716,26 +690,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace-id}/notebookserver": {
+        "/workspace/import/{id}": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "list notebook server",
+                "description": "get import job",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notebook server"
+                    "workspace"
                 ],
-                "summary": "use to list notebook server",
+                "summary": "use to get the progress of importing workspace",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "workspace id ",
-                        "name": "workspace-id",
+                        "description": "import job id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -744,10 +721,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/hertz.listResponseItem"
-                            }
+                            "$ref": "#/definitions/handlers.GetImportJobResponse"
                         }
                     },
                     "400": {
@@ -768,6 +742,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.AppError"
                         }
                     },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
                     "500": {
                         "description": "internal system error",
                         "schema": {
@@ -775,44 +755,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/workspace/{id}": {
+            "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "create notebook server",
+                "description": "get workspace",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notebook server"
+                    "workspace"
                 ],
-                "summary": "use to create notebook server",
+                "summary": "use to get workspace by id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "workspace id ",
-                        "name": "workspace-id",
+                        "description": "get workspace id",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "notebook server settings",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/hertz.createRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/hertz.createResponse"
+                            "$ref": "#/definitions/handlers.GetWorkspaceByIdResponse"
                         }
                     },
                     "400": {
@@ -833,6 +809,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.AppError"
                         }
                     },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
                     "500": {
                         "description": "internal system error",
                         "schema": {
@@ -840,51 +822,36 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/workspace/{workspace-id}/notebookserver/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "get notebook server",
+                "description": "delete workspace",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notebook server"
+                    "workspace"
                 ],
-                "summary": "use to get notebook server",
+                "summary": "use to delete workspace",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "workspace id ",
-                        "name": "workspace-id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "notebook server id",
+                        "description": "delete workspace id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "notebook object to edit",
-                        "name": "notebook",
-                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/hertz.getResponse"
-                        }
+                    "202": {
+                        "description": "Accepted"
                     },
                     "400": {
                         "description": "invalid param",
@@ -904,6 +871,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.AppError"
                         }
                     },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
                     "500": {
                         "description": "internal system error",
                         "schema": {
@@ -912,48 +885,44 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "patch": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "update notebook server settings",
+                "description": "update workspace",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notebook server"
+                    "workspace"
                 ],
-                "summary": "use to update notebook server settings",
+                "summary": "use to update workspace",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "workspace id ",
-                        "name": "workspace-id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "notebook server id",
+                        "description": "update workspace id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "notebook server settings",
+                        "description": "update workspace request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/hertz.updateSettingsRequest"
+                            "$ref": "#/definitions/handlers.UpdateWorkspaceRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted"
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "invalid param",
@@ -973,6 +942,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.AppError"
                         }
                     },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
                     "500": {
                         "description": "internal system error",
                         "schema": {
@@ -980,52 +955,50 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/workspace/{id}/clone": {
             "post": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "turn notebook server on or off",
+                "description": "clone the workflows, data models, notebooks and optionally the files on storage of workspace into a new workspace asynchronously, the progress is tracked by the returned job",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notebook server"
+                    "workspace"
                 ],
-                "summary": "use to turn notebook server on or off",
+                "summary": "use to clone workspace",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "workspace id ",
-                        "name": "workspace-id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "notebook server id",
+                        "description": "source workspace id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "turn on notebook server",
-                        "name": "on",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "turn off notebook server",
-                        "name": "off",
-                        "in": "query"
+                        "description": "clone workspace request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CloneWorkspaceRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CloneWorkspaceResponse"
+                        }
                     },
                     "400": {
                         "description": "invalid param",
@@ -1045,62 +1018,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.AppError"
                         }
                     },
-                    "500": {
-                        "description": "internal system error",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "basicAuth": []
-                    }
-                ],
-                "description": "delete notebook server",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "notebook server"
-                ],
-                "summary": "use to delete notebook server",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "workspace id ",
-                        "name": "workspace-id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "notebook server id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "202": {
-                        "description": "Accepted"
-                    },
-                    "400": {
-                        "description": "invalid param",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
-                    },
-                    "401": {
-                        "description": "unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errors.AppError"
-                        }
-                    },
-                    "403": {
-                        "description": "forbidden",
+                    "404": {
+                        "description": "not found",
                         "schema": {
                             "$ref": "#/definitions/errors.AppError"
                         }
@@ -1114,66 +1033,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace-id}/workflow": {
+        "/workspace/{id}/export": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "list workflow of workspace",
+                "description": "export workspace as a zip which can be imported, the submissions with the inputs and outputs of their runs are included on request",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/zip"
                 ],
                 "tags": [
-                    "workflow"
+                    "workspace"
                 ],
-                "summary": "use to list workflows of workspace",
+                "summary": "use to export workspace",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "workspace id",
-                        "name": "workspace-id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "integer",
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "support order field: name/createdAt, support order: asc/desc, seperated by comma, eg: createdAt:desc,name:asc",
-                        "name": "orderBy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "workflow name",
-                        "name": "searchWord",
-                        "in": "query"
-                    },
                     {
                         "type": "boolean",
-                        "description": "exact",
-                        "name": "exact",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "workspace ids seperated by comma",
-                        "name": "ids",
+                        "description": "export submissions too",
+                        "name": "includeSubmissions",
                         "in": "query"
                     }
                 ],
@@ -1181,7 +1070,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.listWorkflowsResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -1202,6 +1091,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.AppError"
                         }
                     },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
                     "500": {
                         "description": "internal system error",
                         "schema": {
@@ -1209,14 +1104,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/workspace/{id}/member": {
+            "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "create workflow, add workflow version if id is given",
+                "description": "list workspace members",
                 "consumes": [
                     "application/json"
                 ],
@@ -1224,32 +1121,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workflow"
+                    "workspace"
                 ],
-                "summary": "use to create or update workflow",
+                "summary": "use to list the members of workspace",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "workspace id",
-                        "name": "workspace-id",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "create workflow request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.createWorkflowRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.createWorkflowResponse"
+                            "$ref": "#/definitions/handlers.ListWorkspaceMembersResponse"
                         }
                     },
                     "400": {
@@ -1270,6 +1158,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.AppError"
                         }
                     },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
                     "500": {
                         "description": "internal system error",
                         "schema": {
@@ -1277,16 +1171,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/workspace/{workspace-id}/workflow/{id}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "get workflow",
+                "description": "add workspace member with role owner, writer or reader",
                 "consumes": [
                     "application/json"
                 ],
@@ -1294,31 +1186,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workflow"
+                    "workspace"
                 ],
-                "summary": "get workflow",
+                "summary": "use to add a member to workspace",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "workflow id",
+                        "description": "workspace id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "workspace id",
-                        "name": "workspace-id",
-                        "in": "path",
-                        "required": true
+                        "description": "add workspace member request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddWorkspaceMemberRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.getWorkflowResponse"
-                        }
+                    "201": {
+                        "description": "Created"
                     },
                     "400": {
                         "description": "invalid param",
@@ -1338,6 +1229,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.AppError"
                         }
                     },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
                     "500": {
                         "description": "internal system error",
                         "schema": {
@@ -1345,14 +1242,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/workspace/{id}/member/{name}": {
             "delete": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "delete workflow",
+                "description": "delete workspace member, the last owner can not be removed",
                 "consumes": [
                     "application/json"
                 ],
@@ -1360,28 +1259,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workflow"
+                    "workspace"
                 ],
-                "summary": "use to delete workflow",
+                "summary": "use to remove a member from workspace",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "delete workspace id",
-                        "name": "workspace-id",
+                        "description": "workspace id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "delete workflow id",
-                        "name": "id",
+                        "description": "user name of member",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted"
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "invalid param",
@@ -1421,7 +1320,7 @@ const docTemplate = `{
                         "basicAuth": []
                     }
                 ],
-                "description": "update workspace",
+                "description": "update workspace member, the last owner can not be changed",
                 "consumes": [
                     "application/json"
                 ],
@@ -1429,31 +1328,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workflow"
+                    "workspace"
                 ],
-                "summary": "use to update workflow",
+                "summary": "use to change the role of workspace member",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "update workspace id",
-                        "name": "workspace-id",
+                        "description": "workspace id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "update workflow id",
-                        "name": "id",
+                        "description": "user name of member",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "update workflow request",
+                        "description": "update workspace member request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.updateWorkflowRequest"
+                            "$ref": "#/definitions/handlers.UpdateWorkspaceMemberRequest"
                         }
                     }
                 ],
@@ -1494,14 +1393,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace-id}/workflow/{workflow-id}/file": {
+        "/workspace/{workspace-id}/notebook": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "list workflow of workspace",
+                "description": "list notebook of workspace",
                 "consumes": [
                     "application/json"
                 ],
@@ -1509,75 +1408,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workflow"
-                ],
-                "summary": "use to list workflow files",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "workspace id",
-                        "name": "workspace-id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "workflow id",
-                        "name": "workflow-id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "list workflow files",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.listWorkflowFilesRequest"
-                        }
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "support order field: version/path, support order: asc/desc, seperated by comma, eg: version:desc,path:asc",
-                        "name": "orderBy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "workflow name",
-                        "name": "searchWord",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "workspace file ids seperated by comma",
-                        "name": "ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "workspace version id",
-                        "name": "workflowVersionID",
-                        "in": "query"
-                    }
+                    "notebook"
                 ],
+                "summary": "use to list notebook of workspace",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.listWorkflowFilesResponse"
+                            "$ref": "#/definitions/handlers.listNotebooksResponse"
                         }
                     },
                     "400": {
@@ -1607,14 +1445,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace-id}/workflow/{workflow-id}/file/{id}": {
+        "/workspace/{workspace-id}/notebook/{name}": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "get workflow file",
+                "description": "get notebook content",
                 "consumes": [
                     "application/json"
                 ],
@@ -1622,39 +1460,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workflow"
-                ],
-                "summary": "get workflow file",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "workflow file id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "workspace id",
-                        "name": "workspace-id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "workflow id",
-                        "name": "workflow-id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "notebook"
                 ],
+                "summary": "get notebook content",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.getWorkflowFileResponse"
-                        }
-                    },
                     "400": {
                         "description": "invalid param",
                         "schema": {
@@ -1680,16 +1489,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/workspace/{workspace-id}/workflow/{workflow-id}/version/{id}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "get workflow version",
+                "description": "create notebook, update if name exist, set ipynb content in http body",
                 "consumes": [
                     "application/json"
                 ],
@@ -1697,39 +1504,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workflow"
+                    "notebook"
                 ],
-                "summary": "get workflow version",
+                "summary": "use to create or update notebook",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "workflow version id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "workspace id",
-                        "name": "workspace-id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "workflow id",
-                        "name": "workflow-id",
-                        "in": "path",
-                        "required": true
+                        "description": "ipynb content",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notebook.IPythonNotebook"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.getWorkflowVersionResponse"
-                        }
-                    },
                     "400": {
                         "description": "invalid param",
                         "schema": {
@@ -1755,16 +1544,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/workspace/{workspace-id}/workflow/{workflow-id}/versions": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "list workflow verions of workspace",
+                "description": "delete notebook",
                 "consumes": [
                     "application/json"
                 ],
@@ -1772,55 +1559,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workflow"
-                ],
-                "summary": "use to list workflow versions",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "workspace id",
-                        "name": "workspace-id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "workflow id",
-                        "name": "workflow-id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "support order field: source/language/status, support order: asc/desc, seperated by comma, eg: status:desc,language:asc",
-                        "name": "orderBy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "workspace version ids seperated by comma",
-                        "name": "ids",
-                        "in": "query"
-                    }
+                    "notebook"
                 ],
+                "summary": "use to delete notebook",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.listWorkflowVersionsResponse"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "invalid param",
@@ -1849,64 +1593,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace_id}/data_model": {
+        "/workspace/{workspace-id}/notebookserver": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "list data models",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "list notebook server",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "datamodel"
+                    "notebook server"
                 ],
-                "summary": "use to list data models",
+                "summary": "use to list notebook server",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "get workspace id",
-                        "name": "workspace_id",
+                        "description": "workspace id ",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "data model types",
-                        "name": "types",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "query searchWord",
-                        "name": "searchWord",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "data model ids",
-                        "name": "ids",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ListDataModelsResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/hertz.listResponseItem"
+                            }
                         }
                     },
                     "400": {
@@ -1935,31 +1653,35 @@ const docTemplate = `{
                     }
                 }
             },
-            "patch": {
+            "post": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "patch data model",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "create notebook server",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "datamodel"
+                    "notebook server"
                 ],
-                "summary": "use to patch data model",
+                "summary": "use to create notebook server",
                 "parameters": [
                     {
-                        "description": "patch data model request",
+                        "type": "string",
+                        "description": "workspace id ",
+                        "name": "workspace-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "notebook server settings",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.PatchDataModelRequest"
+                            "$ref": "#/definitions/hertz.createRequest"
                         }
                     }
                 ],
@@ -1967,7 +1689,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handlers.PatchDataModelResponse"
+                            "$ref": "#/definitions/hertz.createResponse"
                         }
                     },
                     "400": {
@@ -1997,45 +1719,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace_id}/data_model/{id}": {
+        "/workspace/{workspace-id}/notebookserver/{id}": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "get data model",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "get notebook server",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "datamodel"
+                    "notebook server"
                 ],
-                "summary": "use to get data model",
+                "summary": "use to get notebook server",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "get workspace id",
-                        "name": "workspace_id",
+                        "description": "workspace id ",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "get data model id",
+                        "description": "notebook server id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "notebook object to edit",
+                        "name": "notebook",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.GetDataModelResponse"
+                            "$ref": "#/definitions/hertz.getResponse"
                         }
                     },
                     "400": {
@@ -2064,57 +1789,43 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "delete data model",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "update notebook server settings",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "datamodel"
+                    "notebook server"
                 ],
-                "summary": "use to delete data model,support delete with data model name/row ids/headers",
+                "summary": "use to update notebook server settings",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "get workspace id",
-                        "name": "workspace_id",
+                        "description": "workspace id ",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "get data model id",
+                        "description": "notebook server id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "the data model headers should delete",
-                        "name": "headers",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "the data model row ids should delete",
-                        "name": "rowIDs",
-                        "in": "query"
+                        "description": "notebook server settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/hertz.updateSettingsRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2146,92 +1857,52 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/workspace/{workspace_id}/data_model/{id}/rows": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "list data model rows",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "turn notebook server on or off",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "datamodel"
+                    "notebook server"
                 ],
-                "summary": "use to list data model rows",
+                "summary": "use to turn notebook server on or off",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "get workspace id",
-                        "name": "workspace_id",
+                        "description": "workspace id ",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "get data model id",
+                        "description": "notebook server id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "query page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "query size",
-                        "name": "size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "query order, just like field1,field2:desc",
-                        "name": "orderBy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "data model entity set reffed entity row ids",
-                        "name": "inSetIDs",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "query searchWord",
-                        "name": "searchWord",
+                        "type": "boolean",
+                        "description": "turn on notebook server",
+                        "name": "on",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "data model row ids",
-                        "name": "rowIDs",
+                        "type": "boolean",
+                        "description": "turn off notebook server",
+                        "name": "off",
                         "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.ListDataModelRowsResponse"
-                        }
+                    "202": {
+                        "description": "Accepted"
                     },
                     "400": {
                         "description": "invalid param",
@@ -2258,48 +1929,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/workspace/{workspace_id}/data_model/{id}/rows/ids": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "list all data model row ids",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "delete notebook server",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "datamodel"
+                    "notebook server"
                 ],
-                "summary": "use to list all data model row ids",
+                "summary": "use to delete notebook server",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "get workspace id",
-                        "name": "workspace_id",
+                        "description": "workspace id ",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "get data model id",
+                        "description": "notebook server id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.ListAllDataModelRowIDsResponse"
-                        }
+                    "202": {
+                        "description": "Accepted"
                     },
                     "400": {
                         "description": "invalid param",
@@ -2328,14 +1991,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace_id}/submission": {
+        "/workspace/{workspace-id}/workflow": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "list submissions",
+                "description": "list workflow of workspace",
                 "consumes": [
                     "application/json"
                 ],
@@ -2343,71 +2006,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "submission"
+                    "workflow"
                 ],
-                "summary": "use to list submissions",
+                "summary": "use to list workflows of workspace",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "workspace id",
-                        "name": "workspace_id",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "integer",
-                        "description": "query page",
+                        "description": "page number",
                         "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "query size",
+                        "description": "page size",
                         "name": "size",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "query order, just like field1,field2:desc",
+                        "description": "support order field: name/createdAt, support order: asc/desc, seperated by comma, eg: createdAt:desc,name:asc",
                         "name": "orderBy",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "query searchWord",
+                        "description": "workflow name",
                         "name": "searchWord",
                         "in": "query"
                     },
                     {
                         "type": "boolean",
-                        "description": "query exact",
+                        "description": "exact",
                         "name": "exact",
                         "in": "query"
                     },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "query ids",
-                        "name": "ids",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "workflow id",
-                        "name": "workflowID",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "query status",
-                        "name": "status",
+                        "description": "workspace ids seperated by comma",
+                        "name": "ids",
                         "in": "query"
                     }
                 ],
@@ -2415,7 +2058,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ListSubmissionsResponse"
+                            "$ref": "#/definitions/handlers.listWorkflowsResponse"
                         }
                     },
                     "400": {
@@ -2450,7 +2093,7 @@ const docTemplate = `{
                         "basicAuth": []
                     }
                 ],
-                "description": "create submission",
+                "description": "create workflow, add workflow version if id is given",
                 "consumes": [
                     "application/json"
                 ],
@@ -2458,32 +2101,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "submission"
+                    "workflow"
                 ],
-                "summary": "use to create submission",
+                "summary": "use to create or update workflow",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "workspace id",
-                        "name": "workspace_id",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "create submission request",
+                        "description": "create workflow request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateSubmissionRequest"
+                            "$ref": "#/definitions/handlers.createWorkflowRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateSubmissionResponse"
+                            "$ref": "#/definitions/handlers.createWorkflowResponse"
                         }
                     },
                     "400": {
@@ -2513,14 +2156,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace_id}/submission/{id}": {
-            "delete": {
+        "/workspace/{workspace-id}/workflow/{id}": {
+            "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "delete submission",
+                "description": "get workflow",
                 "consumes": [
                     "application/json"
                 ],
@@ -2528,28 +2171,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "submission"
+                    "workflow"
                 ],
-                "summary": "use to delete submission",
+                "summary": "get workflow",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "workspace id",
-                        "name": "workspace_id",
+                        "description": "workflow id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "submission id",
-                        "name": "id",
+                        "description": "workspace id",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.getWorkflowResponse"
+                        }
                     },
                     "400": {
                         "description": "invalid param",
@@ -2576,16 +2222,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/workspace/{workspace_id}/submission/{id}/cancel": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "cancel submission",
+                "description": "delete workflow",
                 "consumes": [
                     "application/json"
                 ],
@@ -2593,20 +2237,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "submission"
+                    "workflow"
                 ],
-                "summary": "use to cancel submission",
+                "summary": "use to delete workflow",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "workspace id",
-                        "name": "workspace_id",
+                        "description": "delete workspace id",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "submission id",
+                        "description": "delete workflow id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2634,6 +2278,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.AppError"
                         }
                     },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
                     "500": {
                         "description": "internal system error",
                         "schema": {
@@ -2641,16 +2291,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/workspace/{workspace_id}/submission/{name}": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "check submission name unique",
+                "description": "update workspace",
                 "consumes": [
                     "application/json"
                 ],
@@ -2658,28 +2306,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "submission"
+                    "workflow"
                 ],
-                "summary": "use to check submission name unique",
+                "summary": "use to update workflow",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "workspace id",
-                        "name": "workspace_id",
+                        "description": "update workspace id",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "submission name",
-                        "name": "name",
+                        "description": "update workflow id",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "update workflow request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.updateWorkflowRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted"
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "invalid param",
@@ -2699,6 +2356,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.AppError"
                         }
                     },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
                     "500": {
                         "description": "internal system error",
                         "schema": {
@@ -2708,14 +2371,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace_id}/submission/{submission_id}/run": {
+        "/workspace/{workspace-id}/workflow/{workflow-id}/file": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "list runs",
+                "description": "list workflow of workspace",
                 "consumes": [
                     "application/json"
                 ],
@@ -2723,66 +2386,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "submission"
+                    "workflow"
                 ],
-                "summary": "use to list runs",
+                "summary": "use to list workflow files",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "workspace id",
-                        "name": "workspace_id",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "submission id",
-                        "name": "submission_id",
+                        "description": "workflow id",
+                        "name": "workflow-id",
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "description": "list workflow files",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.listWorkflowFilesRequest"
+                        }
+                    },
                     {
                         "type": "integer",
-                        "description": "query page",
+                        "description": "page number",
                         "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "query size",
+                        "description": "page size",
                         "name": "size",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "query order, just like field1,field2:desc",
+                        "description": "support order field: version/path, support order: asc/desc, seperated by comma, eg: version:desc,path:asc",
                         "name": "orderBy",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "query searchWord",
+                        "description": "workflow name",
                         "name": "searchWord",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "query ids",
+                        "type": "string",
+                        "description": "workspace file ids seperated by comma",
                         "name": "ids",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "query status",
-                        "name": "status",
+                        "type": "string",
+                        "description": "workspace version id",
+                        "name": "workflowVersionID",
                         "in": "query"
                     }
                 ],
@@ -2790,7 +2454,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ListRunsResponse"
+                            "$ref": "#/definitions/handlers.listWorkflowFilesResponse"
                         }
                     },
                     "400": {
@@ -2820,14 +2484,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace_id}/submission/{submission_id}/run/{id}/cancel": {
-            "post": {
+        "/workspace/{workspace-id}/workflow/{workflow-id}/file/{id}": {
+            "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "cancel run",
+                "description": "get workflow file",
                 "consumes": [
                     "application/json"
                 ],
@@ -2835,35 +2499,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "submission"
+                    "workflow"
                 ],
-                "summary": "use to cancel run",
+                "summary": "get workflow file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "workspace id",
-                        "name": "workspace_id",
+                        "description": "workflow file id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "submission id",
-                        "name": "submission_id",
+                        "description": "workspace id",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "run id",
-                        "name": "id",
+                        "description": "workflow id",
+                        "name": "workflow-id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.getWorkflowFileResponse"
+                        }
                     },
                     "400": {
                         "description": "invalid param",
@@ -2892,14 +2559,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspace_id}/submission/{submission_id}/run/{run_id}/task": {
+        "/workspace/{workspace-id}/workflow/{workflow-id}/version/{id}": {
             "get": {
                 "security": [
                     {
                         "basicAuth": []
                     }
                 ],
-                "description": "list tasks",
+                "description": "get workflow version",
                 "consumes": [
                     "application/json"
                 ],
@@ -2907,55 +2574,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "submission"
+                    "workflow"
                 ],
-                "summary": "use to list tasks",
+                "summary": "get workflow version",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "workspace id",
-                        "name": "workspace_id",
+                        "description": "workflow version id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "submission id",
-                        "name": "submission_id",
+                        "description": "workspace id",
+                        "name": "workspace-id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "run id",
-                        "name": "run_id",
+                        "description": "workflow id",
+                        "name": "workflow-id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "query page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "query size",
-                        "name": "size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "query order, just like field1,field2:desc",
-                        "name": "orderBy",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ListTasksResponse"
+                            "$ref": "#/definitions/handlers.getWorkflowVersionResponse"
                         }
                     },
                     "400": {
@@ -2984,57 +2633,2136 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "apiserver.clientConfig": {
-            "type": "object",
-            "properties": {
-                "notebook": {
-                    "type": "object",
-                    "properties": {
-                        "officialImages": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/notebook.Image"
-                            }
-                        },
-                        "resourceOptions": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/notebook.ResourceSize"
-                            }
-                        }
-                    }
-                },
-                "storage": {
-                    "type": "object",
-                    "properties": {
-                        "fsPath": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "errors.AppError": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "integer"
-                },
-                "message": {
-                    "type": "string"
-                }
-            }
         },
-        "github_com_Bio-OS_bioos_internal_context_submission_interface_hertz_handlers.WorkflowVersion": {
-            "type": "object",
-            "properties": {
-                "id": {
+        "/workspace/{workspace-id}/workflow/{workflow-id}/versions": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "list workflow verions of workspace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflow"
+                ],
+                "summary": "use to list workflow versions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "workflow id",
+                        "name": "workflow-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "support order field: source/language/status, support order: asc/desc, seperated by comma, eg: status:desc,language:asc",
+                        "name": "orderBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "workspace version ids seperated by comma",
+                        "name": "ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.listWorkflowVersionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/data_model": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "list data models",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to list data models",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "get workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "data model types",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "query searchWord",
+                        "name": "searchWord",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "data model ids",
+                        "name": "ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListDataModelsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "patch data model",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to patch data model",
+                "parameters": [
+                    {
+                        "description": "patch data model request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PatchDataModelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PatchDataModelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/data_model/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "get data model",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to get data model",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "get workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "get data model id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetDataModelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "delete data model",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to delete data model,support delete with data model name/row ids/headers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "get workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "get data model id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "the data model headers should delete",
+                        "name": "headers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "the data model row ids should delete",
+                        "name": "rowIDs",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/data_model/{id}/export": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "export the rows of data model as csv or tsv file, which is streamed page by page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv",
+                    "text/tab-separated-values"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to export data model",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "data model id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "csv or tsv, csv by default",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "columns exported in order, all columns by default",
+                        "name": "columns",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter rows by conditions on columns, e.g. tissue = liver AND read_count \u003e 1e6",
+                        "name": "where",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/data_model/{id}/rows": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "list data model rows",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to list data model rows",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "get workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "get data model id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "query page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "query size",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "query order, just like field1,field2:desc",
+                        "name": "orderBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "data model entity set reffed entity row ids",
+                        "name": "inSetIDs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "query searchWord",
+                        "name": "searchWord",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "data model row ids",
+                        "name": "rowIDs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter rows by conditions on columns, e.g. tissue = liver AND read_count \u003e 1e6",
+                        "name": "where",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListDataModelRowsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/data_model/{id}/rows/ids": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "list all data model row ids",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to list all data model row ids",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "get workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "get data model id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListAllDataModelRowIDsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/data_model/{id}/snapshot": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "list the snapshots of data model, the latest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to list data model snapshots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "data model id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListDataModelSnapshotsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "save the current headers and rows of data model to an immutable snapshot",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to create data model snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "data model id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "create data model snapshot request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateDataModelSnapshotRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateDataModelSnapshotResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/data_model/{id}/snapshot/diff": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "compare the columns and rows of a snapshot with another snapshot or the current data model",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to diff data model snapshots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "data model id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "snapshot id compared from",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "snapshot id compared to, the current data model by default",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DiffDataModelSnapshotsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/data_model/{id}/snapshot/{snapshot_id}": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "get the data model snapshot with its rows",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to get data model snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "data model id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "snapshot id",
+                        "name": "snapshot_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "the rows returned, all rows by default",
+                        "name": "rowIDs",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetDataModelSnapshotResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/data_model/{id}/snapshot/{snapshot_id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "overwrite the data model with the snapshot, the data model is saved to a new snapshot before restoring",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "datamodel"
+                ],
+                "summary": "use to restore data model snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "data model id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "snapshot id",
+                        "name": "snapshot_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RestoreDataModelSnapshotResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/submission": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "list submissions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to list submissions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "query page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "query size",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "query order, just like field1,field2:desc",
+                        "name": "orderBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "query searchWord",
+                        "name": "searchWord",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "query exact",
+                        "name": "exact",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "query ids",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "workflow id",
+                        "name": "workflowID",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "query status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListSubmissionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "create submission",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to create submission",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "create submission request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateSubmissionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateSubmissionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/submission/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "delete submission",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to delete submission",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "submission id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/submission/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "cancel submission",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to cancel submission",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "submission id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/submission/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "create a new submission from the submission, which only contains runs in the specified status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to retry runs of submission",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "submission id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "retry submission request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RetrySubmissionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RetrySubmissionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/submission/{name}": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "check submission name unique",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to check submission name unique",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "submission name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/submission/{submission_id}/run": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "list runs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to list runs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "submission id",
+                        "name": "submission_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "query page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "query size",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "query order, just like field1,field2:desc",
+                        "name": "orderBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "query searchWord",
+                        "name": "searchWord",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "query ids",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "query status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListRunsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/submission/{submission_id}/run/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "cancel run",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to cancel run",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "submission id",
+                        "name": "submission_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "run id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/submission/{submission_id}/run/{id}/rerun": {
+            "post": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "rerun finished run with call caching, previous execution is kept in attempts of run",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to rerun finished run with call caching",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "submission id",
+                        "name": "submission_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "run id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/submission/{submission_id}/run/{run_id}/task": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "list tasks",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to list tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "submission id",
+                        "name": "submission_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "run id",
+                        "name": "run_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "query page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "query size",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "query order, just like field1,field2:desc",
+                        "name": "orderBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ListTasksResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/submission/{submission_id}/run/{run_id}/task/{name}/log": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "stream the stdout or stderr of task on the storage of workspace, keep streaming the appended log until the task finished if follow",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to stream the log of task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "submission id",
+                        "name": "submission_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "run id",
+                        "name": "run_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "task name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "stdout or stderr, default stdout",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "only output the last lines",
+                        "name": "tail",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "keep streaming until the task finished",
+                        "name": "follow",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspace_id}/usage": {
+            "get": {
+                "security": [
+                    {
+                        "basicAuth": []
+                    }
+                ],
+                "description": "sum the cpu, memory and wall-clock of tasks started in the time range by submission, by run if submissionID is specified",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submission"
+                ],
+                "summary": "use to get the resource usage of workspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "workspace id",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "submission id",
+                        "name": "submissionID",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "unix seconds, tasks started from it are counted",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "unix seconds, tasks started before it are counted",
+                        "name": "endTime",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GetUsageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid param",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "403": {
+                        "description": "forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal system error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.AppError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apiserver.clientConfig": {
+            "type": "object",
+            "properties": {
+                "notebook": {
+                    "type": "object",
+                    "properties": {
+                        "officialImages": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/notebook.Image"
+                            }
+                        },
+                        "resourceOptions": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/notebook.ResourceSize"
+                            }
+                        }
+                    }
+                },
+                "storage": {
+                    "type": "object",
+                    "properties": {
+                        "fsPath": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "errors.AppError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Bio-OS_bioos_internal_context_submission_interface_hertz_handlers.WorkflowVersion": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string"
                 },
                 "versionID": {
@@ -3101,12 +4829,93 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.AddWorkspaceMemberRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "writer",
+                        "reader"
+                    ]
+                },
+                "userName": {
+                    "type": "string"
+                },
+                "workspaceID": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CloneWorkspaceRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "includeStorage": {
+                    "description": "IncludeStorage also copies the files on the storage of source workspace",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "storage": {
+                    "$ref": "#/definitions/handlers.WorkspaceStorage"
+                }
+            }
+        },
+        "handlers.CloneWorkspaceResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "jobID": {
+                    "description": "JobID is the clone job tracking the progress",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateDataModelSnapshotRequest": {
+            "type": "object",
+            "properties": {
+                "dataModelID": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "workspaceID": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateDataModelSnapshotResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
         "handlers.CreateSubmissionRequest": {
             "type": "object",
             "properties": {
                 "description": {
                     "type": "string"
                 },
+                "engineBackend": {
+                    "description": "EngineBackend overrides the default WES backend of workspace",
+                    "type": "string"
+                },
                 "entity": {
                     "$ref": "#/definitions/handlers.Entity"
                 },
@@ -3116,6 +4925,10 @@ const docTemplate = `{
                 "inOutMaterial": {
                     "$ref": "#/definitions/handlers.InOutMaterial"
                 },
+                "maxConcurrentRuns": {
+                    "description": "MaxConcurrentRuns limits the runs submitted at the same time, 0 means using the default of server",
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -3125,6 +4938,9 @@ const docTemplate = `{
                 "workflowID": {
                     "type": "string"
                 },
+                "workflowVersionID": {
+                    "type": "string"
+                },
                 "workspaceID": {
                     "type": "string"
                 }
@@ -3144,6 +4960,10 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "engineBackend": {
+                    "description": "EngineBackend is the default WES backend of workspace, empty means the default of server",
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -3172,8 +4992,110 @@ const docTemplate = `{
                 "rowCount": {
                     "type": "integer"
                 },
-                "type": {
-                    "type": "string"
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.DataModelGridDiff": {
+            "type": "object",
+            "properties": {
+                "column": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.DataModelRowDiff": {
+            "type": "object",
+            "properties": {
+                "grids": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.DataModelGridDiff"
+                    }
+                },
+                "rowID": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.DataModelSnapshot": {
+            "type": "object",
+            "properties": {
+                "auto": {
+                    "description": "Auto marks the snapshot taken automatically before the data model is overwritten",
+                    "type": "boolean"
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "dataModelID": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "headerTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "headers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rowCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.DiffDataModelSnapshotsResponse": {
+            "type": "object",
+            "properties": {
+                "addedColumns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "addedRowIDs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "changedRows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.DataModelRowDiff"
+                    }
+                },
+                "removedColumns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "removedRowIDs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -3189,6 +5111,10 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "dataModelSnapshotID": {
+                    "description": "DataModelSnapshotID is the snapshot of data model the inputs are read from, ignored on creating",
+                    "type": "string"
+                },
                 "inputsTemplate": {
                     "description": "* 输入配置，json 序列化后的 string\n\t  采用 json 序列化原因基于以下两点考虑：\n\t  - thrift/接口设计层面不允许 ` + "`" + `Value` + "`" + ` 类型不确定\n\t  - 在 inputs/outputs 层级进行序列化可使得 ` + "`" + `bioos-server` + "`" + ` 不处理 ` + "`" + `Inputs` + "`" + `/` + "`" + `Outputs` + "`" + `(非 ` + "`" + `this.xxx` + "`" + ` 索引的输入) 就入库/提交给计算引擎，达到透传效果",
                     "type": "string"
@@ -3221,6 +5147,95 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.GetDataModelSnapshotResponse": {
+            "type": "object",
+            "properties": {
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "snapshot": {
+                    "$ref": "#/definitions/handlers.DataModelSnapshot"
+                }
+            }
+        },
+        "handlers.GetImportJobResponse": {
+            "type": "object",
+            "properties": {
+                "components": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ImportJobComponent"
+                    }
+                },
+                "createTime": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "finishTime": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "sourceWorkspaceID": {
+                    "description": "SourceWorkspaceID is the workspace cloned from",
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string",
+                    "enum": [
+                        "Pending",
+                        "Running",
+                        "Succeeded",
+                        "Failed"
+                    ]
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "Import",
+                        "Clone"
+                    ]
+                },
+                "updateTime": {
+                    "type": "integer"
+                },
+                "workspaceID": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.GetUsageResponse": {
+            "type": "object",
+            "properties": {
+                "endTime": {
+                    "type": "integer"
+                },
+                "startTime": {
+                    "type": "integer"
+                },
+                "submissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.SubmissionUsage"
+                    }
+                },
+                "usage": {
+                    "$ref": "#/definitions/handlers.Usage"
+                },
+                "workspaceID": {
+                    "type": "string"
+                }
+            }
+        },
         "handlers.GetWorkspaceByIdResponse": {
             "type": "object",
             "properties": {
@@ -3230,12 +5245,19 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "engineBackend": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
+                "owner": {
+                    "description": "Owner is the user created the workspace, empty means visible to everyone",
+                    "type": "string"
+                },
                 "storage": {
                     "$ref": "#/definitions/handlers.WorkspaceStorage"
                 },
@@ -3244,11 +5266,42 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.ImportJobComponent": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "enum": [
+                        "notebooks",
+                        "workflows",
+                        "dataModels",
+                        "notebookServers",
+                        "storage"
+                    ]
+                },
+                "state": {
+                    "type": "string",
+                    "enum": [
+                        "Pending",
+                        "Running",
+                        "Succeeded",
+                        "Failed"
+                    ]
+                }
+            }
+        },
         "handlers.ImportWorkspaceResponse": {
             "type": "object",
             "properties": {
                 "id": {
                     "type": "string"
+                },
+                "jobID": {
+                    "description": "JobID is the import job tracking the progress",
+                    "type": "string"
                 }
             }
         },
@@ -3277,6 +5330,12 @@ const docTemplate = `{
         "handlers.ListDataModelRowsResponse": {
             "type": "object",
             "properties": {
+                "headerTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "headers": {
                     "type": "array",
                     "items": {
@@ -3303,6 +5362,17 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.ListDataModelSnapshotsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.DataModelSnapshot"
+                    }
+                }
+            }
+        },
         "handlers.ListDataModelsResponse": {
             "type": "object",
             "properties": {
@@ -3349,81 +5419,185 @@ const docTemplate = `{
                 "size": {
                     "type": "integer"
                 },
-                "total": {
-                    "type": "integer"
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.ListTasksResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TaskItem"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.ListWorkspaceMembersResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.WorkspaceMember"
+                    }
+                }
+            }
+        },
+        "handlers.ListWorkspacesResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.WorkspaceItem"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.NFSWorkspaceStorage": {
+            "type": "object",
+            "properties": {
+                "mountPath": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PatchDataModelRequest": {
+            "type": "object",
+            "properties": {
+                "async": {
+                    "type": "boolean"
+                },
+                "columnTypes": {
+                    "description": "ColumnTypes declares the types of entity columns keyed by header, types of the others are inferred",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "headers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "workspaceID": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PatchDataModelResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ReconcileItem": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action is one of Reattach, AbortDuplicate, MarkVanished and Error",
+                    "type": "string"
+                },
+                "engineBackend": {
+                    "type": "string"
+                },
+                "engineRunID": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "runID": {
+                    "type": "string"
+                },
+                "submissionID": {
+                    "type": "string"
                 }
             }
         },
-        "handlers.ListTasksResponse": {
+        "handlers.ReconcileRunsResponse": {
             "type": "object",
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handlers.TaskItem"
-                    }
-                },
-                "page": {
+                "checked": {
                     "type": "integer"
                 },
-                "size": {
-                    "type": "integer"
+                "dryRun": {
+                    "type": "boolean"
                 },
-                "total": {
+                "finishTime": {
                     "type": "integer"
-                }
-            }
-        },
-        "handlers.ListWorkspacesResponse": {
-            "type": "object",
-            "properties": {
+                },
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handlers.WorkspaceItem"
+                        "$ref": "#/definitions/handlers.ReconcileItem"
                     }
                 },
-                "page": {
-                    "type": "integer"
-                },
-                "size": {
-                    "type": "integer"
-                },
-                "total": {
+                "startTime": {
                     "type": "integer"
                 }
             }
         },
-        "handlers.NFSWorkspaceStorage": {
+        "handlers.RestoreDataModelSnapshotResponse": {
             "type": "object",
             "properties": {
-                "mountPath": {
+                "backupSnapshotID": {
+                    "description": "BackupSnapshotID is the snapshot of data model taken before restoring",
                     "type": "string"
                 }
             }
         },
-        "handlers.PatchDataModelRequest": {
+        "handlers.RetrySubmissionRequest": {
             "type": "object",
             "properties": {
-                "async": {
-                    "type": "boolean"
-                },
-                "headers": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "string"
                 },
                 "name": {
+                    "description": "Name of the new submission, generated from the retried one if empty",
                     "type": "string"
                 },
-                "rows": {
+                "status": {
+                    "description": "Status of runs to retry, one or more of Failed and Cancelled, Failed if empty",
                     "type": "array",
                     "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        }
+                        "type": "string"
                     }
                 },
                 "workspaceID": {
@@ -3431,7 +5605,7 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.PatchDataModelResponse": {
+        "handlers.RetrySubmissionResponse": {
             "type": "object",
             "properties": {
                 "id": {
@@ -3439,12 +5613,46 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.RunAttempt": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "integer"
+                },
+                "engineRunID": {
+                    "type": "string"
+                },
+                "finishTime": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
         "handlers.RunItem": {
             "type": "object",
             "properties": {
+                "attempts": {
+                    "description": "Attempts are previous executions of the run, from oldest",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.RunAttempt"
+                    }
+                },
                 "duration": {
                     "type": "integer"
                 },
+                "engineBackend": {
+                    "description": "EngineBackend is the name of WES backend the run is submitted to",
+                    "type": "string"
+                },
                 "engineRunID": {
                     "type": "string"
                 },
@@ -3480,6 +5688,20 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.RunUsage": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "runID": {
+                    "type": "string"
+                },
+                "usage": {
+                    "$ref": "#/definitions/handlers.Usage"
+                }
+            }
+        },
         "handlers.Status": {
             "type": "object",
             "properties": {
@@ -3521,6 +5743,10 @@ const docTemplate = `{
                 "duration": {
                     "type": "integer"
                 },
+                "engineBackend": {
+                    "description": "EngineBackend is the name of WES backend runs are submitted to",
+                    "type": "string"
+                },
                 "entity": {
                     "$ref": "#/definitions/handlers.Entity"
                 },
@@ -3536,9 +5762,17 @@ const docTemplate = `{
                 "inOutMaterial": {
                     "$ref": "#/definitions/handlers.InOutMaterial"
                 },
+                "maxConcurrentRuns": {
+                    "description": "MaxConcurrentRuns limits the runs submitted at the same time, 0 means unlimited",
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
+                "parentSubmissionID": {
+                    "description": "ParentSubmissionID is the id of submission retried by this one",
+                    "type": "string"
+                },
                 "runStatus": {
                     "$ref": "#/definitions/handlers.Status"
                 },
@@ -3556,6 +5790,27 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.SubmissionUsage": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "runs": {
+                    "description": "Runs is only reported for the usage of one submission",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.RunUsage"
+                    }
+                },
+                "submissionID": {
+                    "type": "string"
+                },
+                "usage": {
+                    "$ref": "#/definitions/handlers.Usage"
+                }
+            }
+        },
         "handlers.TaskItem": {
             "type": "object",
             "properties": {
@@ -3568,6 +5823,14 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "resources": {
+                    "description": "Resources are requested by the task, zero value means not reported by engine",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handlers.TaskResources"
+                        }
+                    ]
+                },
                 "runID": {
                     "type": "string"
                 },
@@ -3585,12 +5848,53 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.TaskResources": {
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "type": "number"
+                },
+                "machineType": {
+                    "type": "string"
+                },
+                "memory": {
+                    "description": "Memory in bytes",
+                    "type": "integer"
+                },
+                "preemptible": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handlers.UpdateWorkspaceMemberRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "writer",
+                        "reader"
+                    ]
+                },
+                "userName": {
+                    "type": "string"
+                },
+                "workspaceID": {
+                    "type": "string"
+                }
+            }
+        },
         "handlers.UpdateWorkspaceRequest": {
             "type": "object",
             "properties": {
                 "description": {
                     "type": "string"
                 },
+                "engineBackend": {
+                    "description": "EngineBackend set to empty string means using the default of server",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -3599,6 +5903,29 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.Usage": {
+            "type": "object",
+            "properties": {
+                "cpuSeconds": {
+                    "description": "CPUSeconds is the sum of cpu multiplied by task duration",
+                    "type": "number"
+                },
+                "memoryGBSeconds": {
+                    "description": "MemoryGBSeconds is the sum of memory in GB multiplied by task duration",
+                    "type": "number"
+                },
+                "preemptibleTaskCount": {
+                    "type": "integer"
+                },
+                "taskCount": {
+                    "type": "integer"
+                },
+                "wallClock": {
+                    "description": "WallClock is the sum of task duration in seconds",
+                    "type": "integer"
+                }
+            }
+        },
         "handlers.WorkflowFile": {
             "type": "object",
             "properties": {
@@ -3682,12 +6009,19 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "engineBackend": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
+                "owner": {
+                    "description": "Owner is the user created the workspace, empty means visible to everyone",
+                    "type": "string"
+                },
                 "storage": {
                     "$ref": "#/definitions/handlers.WorkspaceStorage"
                 },
@@ -3696,6 +6030,25 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.WorkspaceMember": {
+            "type": "object",
+            "properties": {
+                "createTime": {
+                    "type": "integer"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "writer",
+                        "reader"
+                    ]
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
         "handlers.WorkspaceStorage": {
             "type": "object",
             "properties": {
@@ -3724,7 +6077,9 @@ const docTemplate = `{
                 "language": {
                     "type": "string",
                     "enum": [
-                        "WDL"
+                        "WDL",
+                        "Nextflow",
+                        "CWL"
                     ]
                 },
                 "mainWorkflowPath": {
@@ -3761,6 +6116,87 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.eventAttempt": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "description": "Duration in milliseconds",
+                    "type": "integer"
+                },
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.eventHandlerFailure"
+                    }
+                },
+                "startTime": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.eventHandlerFailure": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "handler": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.eventItem": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "Attempts are the latest attempts to handle the event, from oldest",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.eventAttempt"
+                    }
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastError": {
+                    "description": "LastError is the error of the last failed attempt",
+                    "type": "string"
+                },
+                "leaseExpireAt": {
+                    "description": "LeaseExpireAt is when the running event can be taken over by other event bus, 0 if never running",
+                    "type": "integer"
+                },
+                "owner": {
+                    "description": "Owner is the event bus handling or last handled the event",
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Reason is why the event is in current status, e.g. the error of the last handling",
+                    "type": "string"
+                },
+                "retryCount": {
+                    "type": "integer"
+                },
+                "scheduledAt": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "integer"
+                }
+            }
+        },
         "handlers.getWorkflowFileResponse": {
             "type": "object",
             "properties": {
@@ -3785,6 +6221,23 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.listEventsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.eventItem"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
         "handlers.listNotebooksResponse": {
             "type": "object",
             "properties": {
@@ -3908,6 +6361,14 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.purgeEventsResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
         "handlers.updateWorkflowRequest": {
             "type": "object",
             "required": [
@@ -3924,7 +6385,9 @@ const docTemplate = `{
                 "language": {
                     "type": "string",
                     "enum": [
-                        "WDL"
+                        "WDL",
+                        "Nextflow",
+                        "CWL"
                     ]
                 },
                 "mainWorkflowPath": {
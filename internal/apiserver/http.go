@@ -11,12 +11,14 @@ import (
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/adaptor"
 	"github.com/cloudwego/hertz/pkg/common/config"
 	"github.com/google/uuid"
 	"github.com/hertz-contrib/cors"
 	"github.com/hertz-contrib/http2/factory"
 	"github.com/hertz-contrib/requestid"
 	"github.com/hertz-contrib/swagger"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
 	"golang.org/x/net/http2"
 
@@ -107,6 +109,7 @@ func setupMiddlewares(h *server.Hertz) {
 func setupRouter(h *server.Hertz, opts *options.Options) {
 	h.GET("/ping", PingHandler)
 	h.GET("/version", VersionHandler)
+	h.GET("/metrics", MetricsHandler)
 	url := swagger.URL("/swagger/doc.json") // The url pointing to API definition
 	h.GET("/swagger/*any", swagger.WrapHandler(swaggerFiles.Handler, url))
 	h.GET("/.well-known/configuration", clientConfigHandler(opts))
@@ -176,3 +179,19 @@ func PingHandler(_ context.Context, ctx *app.RequestContext) {
 func VersionHandler(_ context.Context, ctx *app.RequestContext) {
 	ctx.JSON(http.StatusOK, version.Get())
 }
+
+// MetricsHandler metrics handler
+//
+//	@Summary		metrics
+//	@Description	prometheus metrics
+//	@Produce		text/plain
+//	@Router			/metrics [get]
+//	@Success		200
+func MetricsHandler(_ context.Context, ctx *app.RequestContext) {
+	req, err := adaptor.GetCompatRequest(&ctx.Request)
+	if err != nil {
+		utils.WriteHertzErrorResponse(ctx, apperrors.NewInternalError(err))
+		return
+	}
+	promhttp.Handler().ServeHTTP(adaptor.GetCompatResponseWriter(&ctx.Response), req)
+}
@@ -24,6 +24,7 @@ import (
 	submissionproto "github.com/Bio-OS/bioos/internal/context/submission/interface/grpc/proto"
 	submissionhertz "github.com/Bio-OS/bioos/internal/context/submission/interface/hertz"
 	workspaceapp "github.com/Bio-OS/bioos/internal/context/workspace/application"
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
 	workspacegrpc "github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc"
 	workspaceproto "github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
 	workspacehertz "github.com/Bio-OS/bioos/internal/context/workspace/interface/hertz"
@@ -91,6 +92,19 @@ func run(ctx context.Context, opts *options.Options) error {
 	defer func() {
 		_ = workspaceService.Close(ctx)
 	}()
+	middlewares.RegisterServiceUser(opts.Client.Username)
+	middlewares.RegisterWorkspaceAuthorizer(middlewares.WorkspaceAuthorizerFunc(
+		func(ctx context.Context, user, workspaceID, act string) (bool, bool, error) {
+			authorization, err := workspaceService.WorkspaceQueries.AuthorizeWorkspace.Handle(ctx, &workspacequery.AuthorizeWorkspaceQuery{
+				WorkspaceID: workspaceID,
+				UserName:    user,
+				Action:      act,
+			})
+			if err != nil {
+				return false, false, err
+			}
+			return authorization.Allowed, authorization.Managed, nil
+		}))
 
 	workspaceGRPCService := workspacegrpc.NewServer(workspaceService)
 	// TODO invoke workspace API by grpc service client after solved backend token
@@ -118,6 +132,7 @@ func run(ctx context.Context, opts *options.Options) error {
 	workflowGRPCService := workspacegrpc.NewWorkflowServer(workspaceService)
 	datamodelGRPCService := workspacegrpc.NewDataModelServer(workspaceService)
 	notebookGRPCService := workspacegrpc.NewNotebookServer(workspaceService)
+	eventGRPCService := workspacegrpc.NewEventServer(workspaceService)
 	submissionGRPCService := submissiongrpc.NewSubmissionServer(submissionService)
 	versionGRPCService := workspacegrpc.NewVersionServer()
 	notebookserverGRPCService := notebookservergrpc.NewServer(notebookserverService)
@@ -127,6 +142,7 @@ func run(ctx context.Context, opts *options.Options) error {
 		server.GetGRPCRegister(workspaceproto.RegisterWorkflowServiceServer, workflowGRPCService),
 		server.GetGRPCRegister(workspaceproto.RegisterDataModelServiceServer, datamodelGRPCService),
 		server.GetGRPCRegister(workspaceproto.RegisterNotebookServiceServer, notebookGRPCService),
+		server.GetGRPCRegister(workspaceproto.RegisterEventServiceServer, eventGRPCService),
 		server.GetGRPCRegister(submissionproto.RegisterSubmissionServiceServer, submissionGRPCService),
 		server.GetGRPCRegister(workspaceproto.RegisterVersionServiceServer, versionGRPCService),
 		server.GetGRPCRegister(notebookserverproto.RegisterNotebookServerServiceServer, notebookserverGRPCService),
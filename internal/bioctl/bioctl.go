@@ -13,6 +13,7 @@ import (
 	cliflag "k8s.io/component-base/cli/flag"
 
 	internalcmd "github.com/Bio-OS/bioos/internal/bioctl/cmd"
+	cliadmin "github.com/Bio-OS/bioos/internal/bioctl/cmd/admin"
 	clidatamodel "github.com/Bio-OS/bioos/internal/bioctl/cmd/data-model"
 	clisubmission "github.com/Bio-OS/bioos/internal/bioctl/cmd/submission"
 	cliversion "github.com/Bio-OS/bioos/internal/bioctl/cmd/version"
@@ -42,6 +43,7 @@ func NewBIOCtlCommandWithArgs(in io.Reader, out, err io.Writer) *cobra.Command {
 	command.AddCommand(cliworkflow.NewCmdWorkflow(&opt))
 	command.AddCommand(clidatamodel.NewCmdDataModel(&opt))
 	command.AddCommand(clisubmission.NewCmdSubmission(&opt))
+	command.AddCommand(cliadmin.NewCmdAdmin(&opt))
 	addExample(command)
 
 	// version doesn't need Example text
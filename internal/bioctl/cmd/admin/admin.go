@@ -0,0 +1,21 @@
+package admin
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/cmd/admin/event"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+func NewCmdAdmin(opt *clioptions.GlobalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "admin command",
+		Long:  `admin command, requires the admin permission`,
+		Args:  cobra.NoArgs,
+		Run:   prompt.SelectSubCommand,
+	}
+	cmd.AddCommand(event.NewCmdEvent(opt))
+	return cmd
+}
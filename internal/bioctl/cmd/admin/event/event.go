@@ -0,0 +1,24 @@
+package event
+
+import (
+	"github.com/spf13/cobra"
+
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+func NewCmdEvent(opt *clioptions.GlobalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "events",
+		Aliases: []string{"event"},
+		Short:   "event bus command",
+		Long:    `inspect, replay and purge the events of event bus`,
+		Args:    cobra.NoArgs,
+		Run:     prompt.SelectSubCommand,
+	}
+	cmd.AddCommand(NewCmdList(opt))
+	cmd.AddCommand(NewCmdGet(opt))
+	cmd.AddCommand(NewCmdReplay(opt))
+	cmd.AddCommand(NewCmdPurge(opt))
+	return cmd
+}
@@ -0,0 +1,99 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+// GetOptions is an options to get an event.
+type GetOptions struct {
+	eventClient factory.EventClient
+	formatter   formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewGetOptions returns a reference to a GetOptions
+func NewGetOptions(opt *clioptions.GlobalOptions) *GetOptions {
+	return &GetOptions{
+		options: opt,
+	}
+}
+
+func NewCmdGet(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewGetOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "get <event_id>",
+		Short: "get an event",
+		Long:  "get an event of event bus with its payload, retry count and the reason of current status",
+		Args:  cobra.ExactArgs(1),
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *GetOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.eventClient, err = f.EventClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the get options
+func (o *GetOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Run run the get event command
+func (o *GetOptions) Run(args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+
+	resp, err := o.eventClient.GetEvent(ctx, &convert.GetEventRequest{
+		ID: args[0],
+	})
+	if err != nil {
+		return err
+	}
+	o.formatter.Write(resp)
+
+	return nil
+}
+
+func (o *GetOptions) GetPromptArgs() ([]string, error) {
+	eventID, err := prompt.PromptRequiredString("Event ID")
+	if err != nil {
+		return []string{}, err
+	}
+	return []string{eventID}, nil
+}
+
+func (o *GetOptions) GetPromptOptions() error {
+	return nil
+}
+
+func (o *GetOptions) GetDefaultFormat() formatter.Format {
+	return formatter.YamlFormat
+}
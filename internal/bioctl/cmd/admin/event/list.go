@@ -0,0 +1,134 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+)
+
+// ListOptions is an options to list events.
+type ListOptions struct {
+	Types   []string
+	Status  []string
+	Payload string
+	Page    int32
+	Size    int32
+
+	eventClient factory.EventClient
+	formatter   formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewListOptions returns a reference to a ListOptions
+func NewListOptions(opt *clioptions.GlobalOptions) *ListOptions {
+	return &ListOptions{
+		options: opt,
+	}
+}
+
+func NewCmdList(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewListOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list events",
+		Long:  "list events of event bus, the latest created first",
+		Args:  cobra.NoArgs,
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	cmd.Flags().StringSliceVar(&o.Types, "type", o.Types, "The event types")
+	cmd.Flags().StringSliceVar(&o.Status, "status", o.Status, "The event status")
+	cmd.Flags().StringVar(&o.Payload, "payload", o.Payload, "The substring of event payload")
+	cmd.Flags().Int32VarP(&o.Page, "page", "p", 1, "The page number")
+	cmd.Flags().Int32VarP(&o.Size, "size", "s", 10, "The page size")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *ListOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.eventClient, err = f.EventClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the list options
+func (o *ListOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Run run the list events command
+func (o *ListOptions) Run(args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+
+	resp, err := o.eventClient.ListEvents(ctx, &convert.ListEventsRequest{
+		Page:    int(o.Page),
+		Size:    int(o.Size),
+		Types:   o.Types,
+		Status:  o.Status,
+		Payload: o.Payload,
+	})
+	if err != nil {
+		return err
+	}
+	o.formatter.Write(resp)
+
+	return nil
+}
+
+func (o *ListOptions) GetPromptArgs() ([]string, error) {
+	return nil, nil
+}
+
+func (o *ListOptions) GetPromptOptions() error {
+	var err error
+	o.Types, err = prompt.PromptStringSlice("Types")
+	if err != nil {
+		return err
+	}
+	o.Status, err = prompt.PromptStringMultiSelect("Status", 5, []string{eventbus.EventStatusPending,
+		eventbus.EventStatusDequeue, eventbus.EventStatusRunning, eventbus.EventStatusCompleted, eventbus.EventStatusFailed})
+	if err != nil {
+		return err
+	}
+	o.Payload, err = prompt.PromptOptionalString("Payload")
+	if err != nil {
+		return err
+	}
+	o.Page, err = prompt.PromptRequiredInt32("Page")
+	if err != nil {
+		return err
+	}
+	o.Size, err = prompt.PromptRequiredInt32("Size")
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TableFormat
+}
@@ -0,0 +1,119 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+// PurgeOptions is an options to purge completed events.
+type PurgeOptions struct {
+	// OlderThan is the age of completed events to purge
+	OlderThan time.Duration
+	Types     []string
+
+	eventClient factory.EventClient
+	formatter   formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewPurgeOptions returns a reference to a PurgeOptions
+func NewPurgeOptions(opt *clioptions.GlobalOptions) *PurgeOptions {
+	return &PurgeOptions{
+		options: opt,
+	}
+}
+
+func NewCmdPurge(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewPurgeOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "purge",
+		Short: "purge completed events",
+		Long:  "delete the events completed before the cutoff permanently",
+		Args:  cobra.NoArgs,
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	cmd.Flags().DurationVar(&o.OlderThan, "older-than", o.OlderThan, "Purge the events completed longer than the duration ago, e.g. 168h")
+	cmd.Flags().StringSliceVar(&o.Types, "type", o.Types, "The event types, all types if not specified")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *PurgeOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.eventClient, err = f.EventClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the purge options
+func (o *PurgeOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+	if o.OlderThan <= 0 {
+		return fmt.Errorf("need to specify a positive duration by --older-than")
+	}
+	return nil
+}
+
+// Run run the purge events command
+func (o *PurgeOptions) Run(args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+
+	resp, err := o.eventClient.PurgeEvents(ctx, &convert.PurgeEventsRequest{
+		Before: time.Now().Add(-o.OlderThan).Unix(),
+		Types:  o.Types,
+	})
+	if err != nil {
+		return err
+	}
+	o.formatter.Write(fmt.Sprintf("%d events purged", resp.Count))
+
+	return nil
+}
+
+func (o *PurgeOptions) GetPromptArgs() ([]string, error) {
+	return nil, nil
+}
+
+func (o *PurgeOptions) GetPromptOptions() error {
+	olderThan, err := prompt.PromptRequiredString("Older than (e.g. 168h)")
+	if err != nil {
+		return err
+	}
+	o.OlderThan, err = time.ParseDuration(olderThan)
+	if err != nil {
+		return err
+	}
+	o.Types, err = prompt.PromptStringSlice("Types")
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *PurgeOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TextFormat
+}
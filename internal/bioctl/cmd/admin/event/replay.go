@@ -0,0 +1,102 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+// ReplayOptions is an options to replay a failed or dead lettered event.
+type ReplayOptions struct {
+	eventClient factory.EventClient
+	formatter   formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewReplayOptions returns a reference to a ReplayOptions
+func NewReplayOptions(opt *clioptions.GlobalOptions) *ReplayOptions {
+	return &ReplayOptions{
+		options: opt,
+	}
+}
+
+func NewCmdReplay(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewReplayOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "replay <event_id>",
+		Short: "replay a failed or dead lettered event",
+		Long:  "requeue a failed or dead lettered event with retry count reset, so that it will be handled again",
+		Args:  cobra.ExactArgs(1),
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *ReplayOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.eventClient, err = f.EventClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the replay options
+func (o *ReplayOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Run run the replay event command
+func (o *ReplayOptions) Run(args []string) error {
+	eventID := args[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+
+	_, err := o.eventClient.ReplayEvent(ctx, &convert.ReplayEventRequest{
+		ID: eventID,
+	})
+	if err != nil {
+		return err
+	}
+	o.formatter.Write(fmt.Sprintf("event [%s] will be replayed soon", eventID))
+
+	return nil
+}
+
+func (o *ReplayOptions) GetPromptArgs() ([]string, error) {
+	eventID, err := prompt.PromptRequiredString("Event ID")
+	if err != nil {
+		return []string{}, err
+	}
+	return []string{eventID}, nil
+}
+
+func (o *ReplayOptions) GetPromptOptions() error {
+	return nil
+}
+
+func (o *ReplayOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TextFormat
+}
@@ -18,5 +18,6 @@ func NewCmdDataModel(opt *clioptions.GlobalOptions) *cobra.Command {
 	cmd.AddCommand(NewCmdImport(opt))
 	cmd.AddCommand(NewCmdList(opt))
 	cmd.AddCommand(NewCmdDelete(opt))
+	cmd.AddCommand(NewCmdExport(opt))
 	return cmd
 }
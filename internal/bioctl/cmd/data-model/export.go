@@ -0,0 +1,170 @@
+package data_model
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/cmd"
+	cliworkspace "github.com/Bio-OS/bioos/internal/bioctl/cmd/workspace"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+	"github.com/Bio-OS/bioos/pkg/consts"
+)
+
+// ExportOptions is an options to export a data-model.
+type ExportOptions struct {
+	WorkspaceName string
+	Name          string
+	OutputFile    string
+	Format        string
+	Columns       []string
+	Where         string
+
+	workspaceClient factory.WorkspaceClient
+	dataModelClient factory.DataModelClient
+	formatter       formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewExportOptions returns a reference to a ExportOptions
+func NewExportOptions(opt *clioptions.GlobalOptions) *ExportOptions {
+	return &ExportOptions{
+		options: opt,
+	}
+}
+
+func NewCmdExport(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewExportOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export a data-model",
+		Long:  "export the rows of a data-model to a csv or tsv file, which is streamed from server",
+		Args:  cobra.NoArgs,
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	cmd.Flags().StringVarP(&o.WorkspaceName, "workspace", "w", o.WorkspaceName, "The workspace name")
+	cmd.Flags().StringVarP(&o.Name, "name", "n", o.Name, "The data-model name to export")
+	cmd.Flags().StringVar(&o.OutputFile, "output-file", o.OutputFile, "The file to export to, <name>.<format> by default")
+	cmd.Flags().StringVar(&o.Format, "format", o.Format, "The format of exported file: csv or tsv, inferred from the extension of output file by default")
+	cmd.Flags().StringSliceVar(&o.Columns, "columns", o.Columns, "The columns to export in order, all columns by default")
+	cmd.Flags().StringVar(&o.Where, "where", o.Where, "filter rows to export, e.g. \"tissue = liver AND read_count > 1e6\"")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *ExportOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.workspaceClient, err = f.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	o.dataModelClient, err = f.DataModelClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the export options
+func (o *ExportOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+	if o.WorkspaceName == "" {
+		return fmt.Errorf("need to specify a workspace name")
+	}
+	if o.Name == "" {
+		return fmt.Errorf("need to specify a data-model name")
+	}
+	if o.Format != "" && o.Format != consts.DataModelExportFormatCSV && o.Format != consts.DataModelExportFormatTSV {
+		return fmt.Errorf("format %s not support, please use csv or tsv", o.Format)
+	}
+	return nil
+}
+
+// Run run the export data-model command
+func (o *ExportOptions) Run(args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+	workspaceID, err := cliworkspace.ConvertWorkspaceNameIntoID(ctx, o.workspaceClient, o.WorkspaceName)
+	if err != nil {
+		return err
+	}
+	dataModelID, err := ConvertDataModelNameIntoID(ctx, o.dataModelClient, workspaceID, o.Name)
+	if err != nil {
+		return err
+	}
+
+	if o.Format == "" {
+		o.Format = consts.DataModelExportFormatCSV
+		if strings.EqualFold(filepath.Ext(o.OutputFile), "."+consts.DataModelExportFormatTSV) {
+			o.Format = consts.DataModelExportFormatTSV
+		}
+	}
+	if o.OutputFile == "" {
+		o.OutputFile = fmt.Sprintf("%s.%s", o.Name, o.Format)
+	}
+	file, err := os.Create(filepath.Clean(o.OutputFile))
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	err = o.dataModelClient.ExportDataModel(ctx, &convert.ExportDataModelRequest{
+		WorkspaceID: workspaceID,
+		ID:          dataModelID,
+		Format:      o.Format,
+		Columns:     o.Columns,
+		Where:       o.Where,
+	}, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return fmt.Errorf("failed to export data-model: %w", err)
+	}
+	o.formatter.Write(o.OutputFile)
+
+	return nil
+}
+
+func (o *ExportOptions) GetPromptArgs() ([]string, error) {
+	return nil, nil
+}
+
+func (o *ExportOptions) GetPromptOptions() error {
+	var err error
+	o.WorkspaceName, err = cmd.GetWorkspaceName(o.options.Client.Timeout, o.workspaceClient)
+	if err != nil {
+		return err
+	}
+
+	o.Name, err = prompt.PromptRequiredString("Data Model Name", prompt.WithInputMessage("data-model name"))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ExportOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TextFormat
+}
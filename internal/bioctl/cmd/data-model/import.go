@@ -27,6 +27,7 @@ import (
 type ImportOptions struct {
 	WorkspaceName string
 	InputFile     string
+	ColumnTypes   map[string]string
 
 	workspaceClient factory.WorkspaceClient
 	dataModelClient factory.DataModelClient
@@ -55,6 +56,7 @@ func NewCmdImport(opt *clioptions.GlobalOptions) *cobra.Command {
 
 	cmd.Flags().StringVarP(&o.WorkspaceName, "workspace", "w", o.WorkspaceName, "The workspace name")
 	cmd.Flags().StringVarP(&o.InputFile, "input-file ", "i", o.InputFile, "the file (only support csv) to import")
+	cmd.Flags().StringToStringVar(&o.ColumnTypes, "column-type", o.ColumnTypes, "The types of columns, e.g. read_count=int,bam=file, types of other columns are inferred from values")
 
 	return cmd
 }
@@ -94,6 +96,11 @@ func (o *ImportOptions) Validate() error {
 	if err != nil {
 		return err
 	}
+	for column, typ := range o.ColumnTypes {
+		if !utils.ValidDataModelColumnType(typ) {
+			return fmt.Errorf("unknown type %s of column %s", typ, column)
+		}
+	}
 	return nil
 }
 
@@ -130,6 +137,7 @@ func (o *ImportOptions) Run(args []string) error {
 	req := &convert.PatchDataModelRequest{
 		WorkspaceID: workspaceID,
 		Name:        name,
+		ColumnTypes: o.ColumnTypes,
 	}
 
 	headers, rows, err := utils.ReadDataModelFromCSV(o.InputFile)
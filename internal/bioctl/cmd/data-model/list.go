@@ -24,6 +24,8 @@ type ListOptions struct {
 	WorkspaceName string
 	Types         []string
 	Name          string
+	Where         string
+	OrderBy       string
 
 	workspaceClient factory.WorkspaceClient
 	dataModelClient factory.DataModelClient
@@ -53,6 +55,8 @@ func NewCmdList(opt *clioptions.GlobalOptions) *cobra.Command {
 	cmd.Flags().StringVarP(&o.WorkspaceName, "workspace", "w", o.WorkspaceName, "The workspace name")
 	cmd.Flags().StringVarP(&o.Name, "name", "n", o.Name, "data-model names to List")
 	cmd.Flags().StringSliceVarP(&o.Types, "types", "t", o.Types, "data-model types to List")
+	cmd.Flags().StringVar(&o.Where, "where", o.Where, "filter rows of the data-model specified by name, e.g. \"tissue = liver AND read_count > 1e6\"")
+	cmd.Flags().StringVar(&o.OrderBy, "order-by", o.OrderBy, "sort rows of the data-model specified by name, e.g. read_count:desc")
 
 	return cmd
 }
@@ -86,6 +90,9 @@ func (o *ListOptions) Validate() error {
 	if o.WorkspaceName == "" {
 		return fmt.Errorf("need to specify a workspace name")
 	}
+	if o.Name == "" && (o.Where != "" || o.OrderBy != "") {
+		return fmt.Errorf("need to specify a data-model name to filter or sort rows")
+	}
 	for _, t := range o.Types {
 		if t != consts.DataModelTypeEntity && t != consts.DataModelTypeEntitySet && t != consts.DataModelTypeWorkspace {
 			return fmt.Errorf("data-model type %s not support", t)
@@ -108,7 +115,7 @@ func (o *ListOptions) Run(args []string) error {
 		if err != nil {
 			return err
 		}
-		headers, rows, err := cmd.ParseWholeDataModel(ctx, o.dataModelClient, workspaceID, dataModelID)
+		headers, rows, err := cmd.ParseDataModelRows(ctx, o.dataModelClient, workspaceID, dataModelID, o.Where, o.OrderBy)
 		if err != nil {
 			return err
 		}
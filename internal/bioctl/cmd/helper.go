@@ -48,12 +48,15 @@ func GetAllRuns(ctx context.Context, submissionClient factory.SubmissionClient,
 	return items, nil
 }
 
-func ParseWholeDataModel(ctx context.Context, dataModelClient factory.DataModelClient, workspaceID, dataModelID string) ([]string, [][]string, error) {
+// ParseDataModelRows collects all the rows of data model matching where, sorted by orderBy.
+func ParseDataModelRows(ctx context.Context, dataModelClient factory.DataModelClient, workspaceID, dataModelID, where, orderBy string) ([]string, [][]string, error) {
 	resp, err := dataModelClient.ListDataModelRows(ctx, &convert.ListDataModelRowsRequest{
 		Page:        1,
 		Size:        100,
 		WorkspaceID: workspaceID,
 		ID:          dataModelID,
+		Where:       where,
+		OrderBy:     orderBy,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -73,6 +76,8 @@ func ParseWholeDataModel(ctx context.Context, dataModelClient factory.DataModelC
 			Size:        100,
 			WorkspaceID: workspaceID,
 			ID:          dataModelID,
+			Where:       where,
+			OrderBy:     orderBy,
 		})
 		if err != nil {
 			return nil, nil, err
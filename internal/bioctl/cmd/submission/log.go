@@ -16,11 +16,19 @@ import (
 	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
 )
 
+const (
+	taskLogStdout = "stdout"
+	taskLogStderr = "stderr"
+)
+
 // LogOptions is an options to log a workspace.
 type LogOptions struct {
 	WorkspaceName string
 	RunID         string
 	TaskName      string
+	Stream        string
+	Tail          int
+	Follow        bool
 
 	submissionClient factory.SubmissionClient
 	workspaceClient  factory.WorkspaceClient
@@ -50,6 +58,9 @@ func NewCmdLog(opt *clioptions.GlobalOptions) *cobra.Command {
 	cmd.Flags().StringVarP(&o.WorkspaceName, "workspace", "w", o.WorkspaceName, "The workspace name")
 	cmd.Flags().StringVarP(&o.RunID, "run-id", "r", o.RunID, "The RunID of the submission.")
 	cmd.Flags().StringVarP(&o.TaskName, "task-name", "t", o.TaskName, "The TaskName of the submission")
+	cmd.Flags().StringVar(&o.Stream, "stream", o.Stream, "The stream of the task log, stdout or stderr. Both if not specified, stdout if follow.")
+	cmd.Flags().IntVar(&o.Tail, "tail", o.Tail, "Only output the last lines of the task log.")
+	cmd.Flags().BoolVarP(&o.Follow, "follow", "f", o.Follow, "Keep outputting the task log until the task finished.")
 
 	return cmd
 }
@@ -85,6 +96,15 @@ func (o *LogOptions) Validate() error {
 			return fmt.Errorf("must specify a run id before specifying a task name ")
 		}
 	}
+	if o.TaskName == "" && (o.Stream != "" || o.Tail != 0 || o.Follow) {
+		return fmt.Errorf("must specify a task name before specifying stream, tail or follow")
+	}
+	if o.Stream != "" && o.Stream != taskLogStdout && o.Stream != taskLogStderr {
+		return fmt.Errorf("stream must be %s or %s", taskLogStdout, taskLogStderr)
+	}
+	if o.Tail < 0 {
+		return fmt.Errorf("tail can not less than 0")
+	}
 	if o.WorkspaceName == "" {
 		return fmt.Errorf("need to specify a workspace name")
 	}
@@ -118,35 +138,7 @@ func (o *LogOptions) Run(args []string) error {
 		}
 
 		if o.TaskName != "" {
-			taskResp, err := o.submissionClient.ListTasks(ctx, &convert.ListTasksRequest{
-				WorkspaceID:  workspaceID,
-				SubmissionID: submissionID,
-				RunID:        o.RunID,
-			})
-			if err != nil {
-				return err
-			}
-
-			for _, item := range taskResp.Items {
-				if item.Name == o.TaskName {
-					if item.Stdout != "" {
-						o.formatter.Write(item.Stdout)
-					} else {
-						o.formatter.Write(fmt.Sprintf("the Stdout of task [%s] is unavailable now", o.TaskName))
-					}
-					if item.Stderr != "" {
-						o.formatter.Write(item.Stderr)
-					} else {
-						o.formatter.Write(fmt.Sprintf("the Stderr of task [%s] is unavailable now", o.TaskName))
-					}
-					return nil
-				}
-			}
-
-			if len(taskResp.Items) == 0 {
-				return fmt.Errorf("task: %s not found", o.TaskName)
-			}
-			return nil
+			return o.streamTaskLog(workspaceID, submissionID)
 		}
 
 		if runResp.Items[0].Log != nil {
@@ -179,6 +171,39 @@ func (o *LogOptions) Run(args []string) error {
 	return nil
 }
 
+// streamTaskLog outputs the task log from the storage of workspace, it is not limited by the client timeout if follow.
+func (o *LogOptions) streamTaskLog(workspaceID, submissionID string) error {
+	streams := []string{o.Stream}
+	if o.Stream == "" {
+		streams = []string{taskLogStdout, taskLogStderr}
+		if o.Follow {
+			streams = []string{taskLogStdout}
+		}
+	}
+
+	ctx := context.Background()
+	if !o.Follow {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Second*time.Duration(o.options.Client.Timeout))
+		defer cancel()
+	}
+	for _, stream := range streams {
+		err := o.submissionClient.StreamTaskLog(ctx, &convert.StreamTaskLogRequest{
+			WorkspaceID:  workspaceID,
+			SubmissionID: submissionID,
+			RunID:        o.RunID,
+			Name:         o.TaskName,
+			Stream:       stream,
+			Tail:         o.Tail,
+			Follow:       o.Follow,
+		}, o.options.Stream.Output)
+		if err != nil {
+			return fmt.Errorf("failed to get the %s of task [%s]: %w", stream, o.TaskName, err)
+		}
+	}
+	return nil
+}
+
 func (o *LogOptions) GetPromptArgs() ([]string, error) {
 	submissionID, err := prompt.PromptRequiredString("Submission ID")
 	if err != nil {
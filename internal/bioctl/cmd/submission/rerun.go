@@ -0,0 +1,138 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/cmd"
+	cliworkspace "github.com/Bio-OS/bioos/internal/bioctl/cmd/workspace"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+// RerunOptions is an options to rerun a run of submission.
+type RerunOptions struct {
+	WorkspaceName string
+	RunID         string
+
+	submissionClient factory.SubmissionClient
+	workspaceClient  factory.WorkspaceClient
+	formatter        formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewRerunOptions returns a reference to a RerunOptions
+func NewRerunOptions(opt *clioptions.GlobalOptions) *RerunOptions {
+	return &RerunOptions{
+		options: opt,
+	}
+}
+
+func NewCmdRerun(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewRerunOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "rerun <submission_id>",
+		Short: "rerun a finished run of the submission",
+		Long:  "rerun a finished run of the submission with call caching, outputs of tasks succeeded before will be reused",
+		Args:  cobra.ExactArgs(1),
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	cmd.Flags().StringVarP(&o.WorkspaceName, "workspace", "w", o.WorkspaceName, "The workspace name")
+	cmd.Flags().StringVarP(&o.RunID, "run-id", "r", o.RunID, "The ID of the run to rerun.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *RerunOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.workspaceClient, err = f.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	o.submissionClient, err = f.SubmissionClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the rerun options
+func (o *RerunOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+
+	if o.WorkspaceName == "" {
+		return fmt.Errorf("need to specify a workspace name")
+	}
+	if o.RunID == "" {
+		return fmt.Errorf("need to specify a run id")
+	}
+	return nil
+}
+
+// Run run the rerun command
+func (o *RerunOptions) Run(args []string) error {
+	submissionID := args[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+	workspaceID, err := cliworkspace.ConvertWorkspaceNameIntoID(ctx, o.workspaceClient, o.WorkspaceName)
+	if err != nil {
+		return err
+	}
+
+	_, err = o.submissionClient.RerunRun(ctx, &convert.RerunRunRequest{
+		WorkspaceID:  workspaceID,
+		SubmissionID: submissionID,
+		ID:           o.RunID,
+	})
+	if err != nil {
+		return err
+	}
+
+	o.formatter.Write(fmt.Sprintf("run [%s] will be rerun soon", o.RunID))
+
+	return nil
+}
+
+func (o *RerunOptions) GetPromptArgs() ([]string, error) {
+	submissionID, err := prompt.PromptRequiredString("Submission ID")
+	if err != nil {
+		return []string{}, err
+	}
+	return []string{submissionID}, nil
+}
+
+func (o *RerunOptions) GetPromptOptions() error {
+	var err error
+	o.WorkspaceName, err = cmd.GetWorkspaceName(o.options.Client.Timeout, o.workspaceClient)
+	if err != nil {
+		return err
+	}
+	o.RunID, err = prompt.PromptRequiredString("Run ID")
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *RerunOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TextFormat
+}
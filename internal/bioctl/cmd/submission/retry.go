@@ -0,0 +1,150 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/cmd"
+	cliworkspace "github.com/Bio-OS/bioos/internal/bioctl/cmd/workspace"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+var retryableRunStatuses = []string{consts.RunFailed, consts.RunCancelled}
+
+// RetryOptions is an options to retry a submission.
+type RetryOptions struct {
+	WorkspaceName string
+	Name          string
+	Status        []string
+
+	submissionClient factory.SubmissionClient
+	workspaceClient  factory.WorkspaceClient
+	formatter        formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewRetryOptions returns a reference to a RetryOptions
+func NewRetryOptions(opt *clioptions.GlobalOptions) *RetryOptions {
+	return &RetryOptions{
+		options: opt,
+	}
+}
+
+func NewCmdRetry(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewRetryOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "retry <submission_id>",
+		Short: "retry runs of the submission as a new submission",
+		Long:  "retry runs of the submission as a new submission, only runs in the specified status will be retried",
+		Args:  cobra.ExactArgs(1),
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	cmd.Flags().StringVarP(&o.WorkspaceName, "workspace", "w", o.WorkspaceName, "The workspace name")
+	cmd.Flags().StringVarP(&o.Name, "name", "n", o.Name, "The name of the new submission, generated from the retried one if not specified")
+	cmd.Flags().StringSliceVarP(&o.Status, "status", "s", []string{consts.RunFailed}, "The status of runs to retry, one or more of Failed and Cancelled")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *RetryOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.workspaceClient, err = f.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	o.submissionClient, err = f.SubmissionClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the retry options
+func (o *RetryOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+
+	if o.WorkspaceName == "" {
+		return fmt.Errorf("need to specify a workspace name")
+	}
+	for _, status := range o.Status {
+		if !utils.In(status, retryableRunStatuses) {
+			return fmt.Errorf("run status %s can not be retried, should be one of %v", status, retryableRunStatuses)
+		}
+	}
+	return nil
+}
+
+// Run run the retry submission command
+func (o *RetryOptions) Run(args []string) error {
+	submissionID := args[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+	workspaceID, err := cliworkspace.ConvertWorkspaceNameIntoID(ctx, o.workspaceClient, o.WorkspaceName)
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.submissionClient.RetrySubmission(ctx, &convert.RetrySubmissionRequest{
+		WorkspaceID: workspaceID,
+		ID:          submissionID,
+		Name:        o.Name,
+		Status:      o.Status,
+	})
+	if err != nil {
+		return err
+	}
+	o.formatter.Write(resp.ID)
+
+	return nil
+}
+
+func (o *RetryOptions) GetPromptArgs() ([]string, error) {
+	submissionID, err := prompt.PromptRequiredString("Submission ID")
+	if err != nil {
+		return []string{}, err
+	}
+	return []string{submissionID}, nil
+}
+
+func (o *RetryOptions) GetPromptOptions() error {
+	var err error
+	o.WorkspaceName, err = cmd.GetWorkspaceName(o.options.Client.Timeout, o.workspaceClient)
+	if err != nil {
+		return err
+	}
+	o.Name, err = prompt.PromptOptionalString("Name")
+	if err != nil {
+		return err
+	}
+	o.Status, err = prompt.PromptStringMultiSelect("Status", len(retryableRunStatuses), retryableRunStatuses)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *RetryOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TextFormat
+}
@@ -19,8 +19,11 @@ func NewCmdSubmission(opt *clioptions.GlobalOptions) *cobra.Command {
 	cmd.AddCommand(NewCmdQuery(opt))
 	cmd.AddCommand(NewCmdDelete(opt))
 	cmd.AddCommand(NewCmdStop(opt))
+	cmd.AddCommand(NewCmdRetry(opt))
+	cmd.AddCommand(NewCmdRerun(opt))
 	cmd.AddCommand(NewCmdLog(opt))
 	cmd.AddCommand(NewCmdList(opt))
 	cmd.AddCommand(NewCmdOutput(opt))
+	cmd.AddCommand(NewCmdUsage(opt))
 	return cmd
 }
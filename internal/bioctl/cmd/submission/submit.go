@@ -35,12 +35,17 @@ const emptyJsonStr = "null"
 // SubmitOptions is an options to submit a workspace.
 type SubmitOptions struct {
 	WorkspaceName   string
+	WorkflowVersion string
 	Description     string
 	Type            string
 	DataModelName   string
 	DataModelRowIDs []string
 	File            string
 	ReadFromCache   bool
+	// MaxConcurrentRuns 0 means using the default of server
+	MaxConcurrentRuns int
+	// EngineBackend overrides the default WES backend of workspace
+	EngineBackend string
 
 	InputsTemplate  string
 	OutputsTemplate string
@@ -75,12 +80,15 @@ func NewCmdSubmit(opt *clioptions.GlobalOptions) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&o.WorkspaceName, "workspace", "w", o.WorkspaceName, "The workspace name")
+	cmd.Flags().StringVar(&o.WorkflowVersion, "workflow-version", o.WorkflowVersion, "The workflow version ID to submit, the latest version will be used if not specified.")
 	cmd.Flags().StringVarP(&o.Description, "description", "d", o.Description, "The description of the submission.")
 	cmd.Flags().StringVarP(&o.Type, "type", "t", o.Type, "The Type of the submission.")
 	cmd.Flags().StringVarP(&o.DataModelName, "data-model", "m", o.DataModelName, "The name of the data-model this submission will use.")
 	cmd.Flags().StringSliceVar(&o.DataModelRowIDs, "data-model-rows", o.DataModelRowIDs, "The rows of the data-model this submission will use.")
 	cmd.Flags().StringVarP(&o.File, "file", "f", o.File, "The file path of Inputs/Outputs.")
 	cmd.Flags().BoolVar(&o.ReadFromCache, "call-caching", true, "use previous cache of the submission or not.")
+	cmd.Flags().StringVar(&o.EngineBackend, "engine-backend", o.EngineBackend, "The WES backend to run the submission, the default backend of workspace will be used if not specified.")
+	cmd.Flags().IntVar(&o.MaxConcurrentRuns, "max-concurrent-runs", o.MaxConcurrentRuns, "The max number of runs submitted at the same time, the default of server will be used if not specified.")
 
 	return cmd
 }
@@ -131,6 +139,10 @@ func (o *SubmitOptions) Validate() error {
 		return fmt.Errorf("need to specify a file to declare inputs and outputs")
 	}
 
+	if o.MaxConcurrentRuns < 0 {
+		return fmt.Errorf("max concurrent runs cannot be negative")
+	}
+
 	err := o.parseInputsAndOutputsFile()
 	if err != nil {
 		return err
@@ -171,13 +183,16 @@ func (o *SubmitOptions) Run(args []string) error {
 	}
 
 	req := &convert.CreateSubmissionRequest{
-		WorkspaceID: workspaceID,
-		Name:        fmt.Sprintf("%s-history-%s", workflowName, time.Now().Format("2006-01-02-15-04-05")),
-		WorkflowID:  workflowID,
-		Type:        o.Type,
+		WorkspaceID:       workspaceID,
+		Name:              fmt.Sprintf("%s-history-%s", workflowName, time.Now().Format("2006-01-02-15-04-05")),
+		WorkflowID:        workflowID,
+		WorkflowVersionID: o.WorkflowVersion,
+		Type:              o.Type,
 		ExposedOptions: convert.ExposedOptions{
 			ReadFromCache: o.ReadFromCache,
 		},
+		MaxConcurrentRuns: o.MaxConcurrentRuns,
+		EngineBackend:     o.EngineBackend,
 	}
 
 	if o.Type == consts.DataModelTypeSubmission {
@@ -238,6 +253,11 @@ func (o *SubmitOptions) GetPromptOptions() error {
 		return err
 	}
 
+	o.WorkflowVersion, err = prompt.PromptOptionalString("Workflow Version")
+	if err != nil {
+		return err
+	}
+
 	o.Description, err = prompt.PromptOptionalString("Description")
 	if err != nil {
 		return err
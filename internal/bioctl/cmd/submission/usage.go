@@ -0,0 +1,156 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/cmd"
+	cliworkspace "github.com/Bio-OS/bioos/internal/bioctl/cmd/workspace"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+const usageMonthLayout = "2006-01"
+
+// UsageOptions is an options to report the resource usage of submissions.
+type UsageOptions struct {
+	WorkspaceName string
+	// Month is in the format of YYYY-MM, all the usage is reported if empty
+	Month string
+
+	startTime int64
+	endTime   int64
+
+	submissionClient factory.SubmissionClient
+	workspaceClient  factory.WorkspaceClient
+	formatter        formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewUsageOptions returns a reference to a UsageOptions
+func NewUsageOptions(opt *clioptions.GlobalOptions) *UsageOptions {
+	return &UsageOptions{
+		options: opt,
+	}
+}
+
+func NewCmdUsage(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewUsageOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "usage [<submission_id>]",
+		Short: "report the resource usage of submissions",
+		Long:  "report the resource usage of submissions in the workspace, or of runs in the submission if submission id is specified",
+		Args:  cobra.MaximumNArgs(1),
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	cmd.Flags().StringVarP(&o.WorkspaceName, "workspace", "w", o.WorkspaceName, "The workspace name")
+	cmd.Flags().StringVarP(&o.Month, "month", "m", o.Month, "Only report the usage of tasks started in the month, in the format of YYYY-MM")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *UsageOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.workspaceClient, err = f.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	o.submissionClient, err = f.SubmissionClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the usage options
+func (o *UsageOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+
+	if o.WorkspaceName == "" {
+		return fmt.Errorf("need to specify a workspace name")
+	}
+	if o.Month != "" {
+		month, err := time.ParseInLocation(usageMonthLayout, o.Month, time.Local)
+		if err != nil {
+			return fmt.Errorf("month %s is not in the format of YYYY-MM", o.Month)
+		}
+		o.startTime = month.Unix()
+		o.endTime = month.AddDate(0, 1, 0).Unix()
+	}
+	return nil
+}
+
+// Run run the usage command
+func (o *UsageOptions) Run(args []string) error {
+	var submissionID string
+	if len(args) > 0 {
+		submissionID = args[0]
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+	workspaceID, err := cliworkspace.ConvertWorkspaceNameIntoID(ctx, o.workspaceClient, o.WorkspaceName)
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.submissionClient.GetUsage(ctx, &convert.GetUsageRequest{
+		WorkspaceID:  workspaceID,
+		SubmissionID: submissionID,
+		StartTime:    o.startTime,
+		EndTime:      o.endTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	o.formatter.Write(resp)
+
+	return nil
+}
+
+func (o *UsageOptions) GetPromptArgs() ([]string, error) {
+	submissionID, err := prompt.PromptOptionalString("Submission ID")
+	if err != nil {
+		return []string{}, err
+	}
+	if submissionID == "" {
+		return []string{}, nil
+	}
+	return []string{submissionID}, nil
+}
+
+func (o *UsageOptions) GetPromptOptions() error {
+	var err error
+	o.WorkspaceName, err = cmd.GetWorkspaceName(o.options.Client.Timeout, o.workspaceClient)
+	if err != nil {
+		return err
+	}
+	o.Month, err = prompt.PromptOptionalString("Month (YYYY-MM)")
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *UsageOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TextFormat
+}
@@ -54,7 +54,7 @@ func (o *CreateOptions) GetPromptOptions() error {
 		return err
 	}
 
-	o.Language, err = prompt.PromptStringSelect("Language", 10, []string{workflow.Language})
+	o.Language, err = prompt.PromptStringSelect("Language", 10, workflow.SupportedLanguages)
 	if err != nil {
 		return err
 	}
@@ -107,7 +107,7 @@ func NewCmdCreate(opt *clioptions.GlobalOptions) *cobra.Command {
 
 	cmd.Flags().StringVarP(&o.WorkspaceName, "workspace", "w", o.WorkspaceName, "The name of the workspace.")
 	cmd.Flags().StringVarP(&o.Description, "description", "d", o.Description, "The description of the workflow.")
-	cmd.Flags().StringVarP(&o.Language, "language", "l", o.Language, "The language of the workflow.")
+	cmd.Flags().StringVarP(&o.Language, "language", "l", o.Language, "The language of the workflow, one of WDL, Nextflow and CWL.")
 	cmd.Flags().StringVar(&o.Source, "source", o.Source, "The source of the workflow.")
 	cmd.Flags().StringVar(&o.URL, "url", o.URL, "The url of the workflow.")
 	cmd.Flags().StringVarP(&o.Tag, "tag", o.Tag, "t", "The tag or branch of the workflow.")
@@ -141,7 +141,7 @@ func (o *CreateOptions) Validate() error {
 	if err := o.options.Validate(); err != nil {
 		return err
 	}
-	if o.Language != workflow.Language {
+	if !workflow.IsSupportedLanguage(o.Language) {
 		return fmt.Errorf("unspport language: %s", o.Language)
 	}
 	if o.Source != workflow.WorkflowSourceGit && o.Source != workflow.WorkflowSourceFile {
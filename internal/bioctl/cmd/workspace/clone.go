@@ -0,0 +1,174 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+// CloneOptions is an options to clone a workspace.
+type CloneOptions struct {
+	WorkspaceID string
+	Description string
+	MountType   string
+	MountPath   string
+	// IncludeStorage also copies the files on the storage of source workspace
+	IncludeStorage bool
+	// Wait for the clone job finished
+	Wait        bool
+	WaitTimeout time.Duration
+
+	workspaceClient factory.WorkspaceClient
+	formatter       formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewCloneOptions returns a reference to a CloneOptions
+func NewCloneOptions(opt *clioptions.GlobalOptions) *CloneOptions {
+	return &CloneOptions{
+		options: opt,
+	}
+}
+
+func NewCmdClone(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewCloneOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "clone <workspace_name>",
+		Short: "clone a workspace",
+		Long:  "clone the workflows, data models, notebooks and optionally the storage files of a workspace into a new workspace",
+		Args:  cobra.ExactArgs(1),
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	cmd.Flags().StringVarP(&o.WorkspaceID, "workspaceID", "w", o.WorkspaceID, "The id of the workspace to clone.")
+	cmd.Flags().StringVarP(&o.Description, "description", "d", o.Description, "The description of the new workspace.")
+	cmd.Flags().StringVarP(&o.MountType, "mount-type", "t", o.MountType, "The mount type of the new workspace Storage.")
+	cmd.Flags().StringVarP(&o.MountPath, "mount-path", "p", o.MountPath, "The mount path of the new workspace Storage.")
+	cmd.Flags().BoolVar(&o.IncludeStorage, "include-storage", o.IncludeStorage, "Copy the files on the storage of the workspace too, the mount path must not be the same or nested with it.")
+	cmd.Flags().BoolVar(&o.Wait, "wait", o.Wait, "Wait until the workspace is cloned and output the clone job.")
+	cmd.Flags().DurationVar(&o.WaitTimeout, "wait-timeout", 30*time.Minute, "The max time to wait for the clone job.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *CloneOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.workspaceClient, err = f.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the clone options
+func (o *CloneOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+	if len(o.WorkspaceID) == 0 {
+		return fmt.Errorf("workspace id [%s] should not be empty", o.WorkspaceID)
+	}
+	if o.MountType != "nfs" {
+		return fmt.Errorf("workspace storage [%s] not support", o.MountType)
+	}
+	if o.IncludeStorage && o.MountPath == "" {
+		return fmt.Errorf("mount path is required to clone the storage files")
+	}
+	if o.Wait && o.WaitTimeout <= 0 {
+		return fmt.Errorf("wait timeout must be positive")
+	}
+	return nil
+}
+
+// Run run the clone workspace command
+func (o *CloneOptions) Run(args []string) error {
+	workspaceName := args[0]
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+	req := &convert.CloneWorkspaceRequest{
+		ID:             o.WorkspaceID,
+		Name:           workspaceName,
+		Description:    o.Description,
+		IncludeStorage: o.IncludeStorage,
+	}
+	if o.MountPath != "" {
+		req.Storage = &convert.WorkspaceStorage{
+			NFS: &convert.NFSWorkspaceStorage{
+				MountPath: o.MountPath,
+			},
+		}
+	}
+
+	resp, err := o.workspaceClient.CloneWorkspace(ctx, req)
+	if err != nil {
+		return err
+	}
+	if !o.Wait {
+		o.formatter.Write(resp.Id)
+		return nil
+	}
+
+	job, err := waitImportJob(o.workspaceClient, o.options.Client.Timeout, resp.JobID, o.WaitTimeout)
+	if err != nil {
+		return err
+	}
+	o.formatter.Write(job)
+	if job.State != "Succeeded" {
+		return fmt.Errorf("failed to clone workspace [%s]: %s", o.WorkspaceID, job.Error)
+	}
+	return nil
+}
+
+func (o *CloneOptions) GetPromptArgs() ([]string, error) {
+	workspaceName, err := prompt.PromptRequiredString("Name")
+	if err != nil {
+		return []string{}, err
+	}
+	return []string{workspaceName}, nil
+}
+
+func (o *CloneOptions) GetPromptOptions() error {
+	var err error
+	o.WorkspaceID, err = prompt.PromptRequiredString("WorkspaceID", prompt.WithInputMessage("the workspace to clone"))
+	if err != nil {
+		return err
+	}
+
+	o.Description, err = prompt.PromptRequiredString("Description")
+	if err != nil {
+		return err
+	}
+
+	o.MountPath, err = prompt.PromptRequiredString("MountPath", prompt.WithInputMessage("abs path"))
+	if err != nil {
+		return err
+	}
+
+	o.MountType, err = prompt.PromptStringSelect("MountType", 1, []string{"nfs"})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CloneOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TextFormat
+}
@@ -19,6 +19,8 @@ type CreateOptions struct {
 	Description string
 	MountType   string
 	MountPath   string
+	// EngineBackend is the default WES backend to run submissions of the workspace
+	EngineBackend string
 
 	workspaceClient factory.WorkspaceClient
 	formatter       formatter.Formatter
@@ -47,6 +49,7 @@ func NewCmdCreate(opt *clioptions.GlobalOptions) *cobra.Command {
 	cmd.Flags().StringVarP(&o.Description, "description", "d", o.Description, "The description of the workspace.")
 	cmd.Flags().StringVarP(&o.MountType, "mount-type", "t", o.MountType, "The mount type of the workspace Storage.")
 	cmd.Flags().StringVarP(&o.MountPath, "mount-path", "p", o.MountPath, "The mount path of the workspace Storage.")
+	cmd.Flags().StringVar(&o.EngineBackend, "engine-backend", o.EngineBackend, "The default WES backend to run submissions of the workspace, the default of server will be used if not specified.")
 
 	return cmd
 }
@@ -84,8 +87,9 @@ func (o *CreateOptions) Run(args []string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
 	defer cancel()
 	req := &convert.CreateWorkspaceRequest{
-		Name:        workspaceName,
-		Description: o.Description,
+		Name:          workspaceName,
+		Description:   o.Description,
+		EngineBackend: o.EngineBackend,
 	}
 	if o.MountPath != "" {
 		req.Storage = &convert.WorkspaceStorage{
@@ -27,10 +27,16 @@ import (
 	"github.com/Bio-OS/bioos/pkg/utils"
 )
 
+// importJobPollInterval is the interval to get the import job when waiting
+const importJobPollInterval = 2 * time.Second
+
 type ImportOptions struct {
 	YamlPath  string
 	MountType string
 	MountPath string
+	// Wait for the import job finished
+	Wait        bool
+	WaitTimeout time.Duration
 
 	workspaceClient factory.WorkspaceClient
 	formatter       formatter.Formatter
@@ -59,6 +65,8 @@ func NewCmdImport(opt *clioptions.GlobalOptions) *cobra.Command {
 	cmd.Flags().StringVarP(&o.YamlPath, "yaml", "y", o.YamlPath, "The path of the workspace yaml file")
 	cmd.Flags().StringVarP(&o.MountType, "mount-type", "t", o.MountType, "The mount type of the workspace Storage.")
 	cmd.Flags().StringVarP(&o.MountPath, "mount-path", "p", o.MountPath, "The mount path of the workspace Storage.")
+	cmd.Flags().BoolVar(&o.Wait, "wait", o.Wait, "Wait until the workspace is imported and output the import job.")
+	cmd.Flags().DurationVar(&o.WaitTimeout, "wait-timeout", 30*time.Minute, "The max time to wait for the import job.")
 
 	return cmd
 }
@@ -94,6 +102,9 @@ func (o *ImportOptions) Validate() error {
 	if o.MountType != "nfs" {
 		return fmt.Errorf("workspace storage [%s] not support", o.MountType)
 	}
+	if o.Wait && o.WaitTimeout <= 0 {
+		return fmt.Errorf("wait timeout must be positive")
+	}
 	return nil
 }
 
@@ -130,11 +141,42 @@ func (o *ImportOptions) Run(args []string) error {
 	if err != nil {
 		return err
 	}
-	o.formatter.Write(resp.Id)
+	if !o.Wait {
+		o.formatter.Write(resp.Id)
+		return nil
+	}
 
+	job, err := waitImportJob(o.workspaceClient, o.options.Client.Timeout, resp.JobID, o.WaitTimeout)
+	if err != nil {
+		return err
+	}
+	o.formatter.Write(job)
+	if job.State != "Succeeded" {
+		return fmt.Errorf("failed to import workspace [%s]: %s", resp.Id, job.Error)
+	}
 	return nil
 }
 
+// waitImportJob gets the import or clone job until it is finished, it is not limited by the client timeout.
+func waitImportJob(client factory.WorkspaceClient, clientTimeout int, jobID string, waitTimeout time.Duration) (*convert.ImportJob, error) {
+	deadline := time.Now().Add(waitTimeout)
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(clientTimeout))
+		resp, err := client.GetImportJob(ctx, &convert.GetImportJobRequest{ID: jobID})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		if resp.IsFinished() {
+			return &resp.ImportJob, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("job [%s] is still %s after %s", jobID, resp.State, waitTimeout)
+		}
+		time.Sleep(importJobPollInterval)
+	}
+}
+
 func (o *ImportOptions) GetPromptArgs() ([]string, error) {
 	return nil, nil
 }
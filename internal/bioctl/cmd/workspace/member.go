@@ -0,0 +1,27 @@
+package workspace
+
+import (
+	"github.com/spf13/cobra"
+
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+// workspaceRoles are the roles of workspace member.
+var workspaceRoles = []string{"owner", "writer", "reader"}
+
+func NewCmdMember(opt *clioptions.GlobalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "member",
+		Aliases: []string{"members"},
+		Short:   "workspace member command",
+		Long:    `list, add, update and delete the members of workspace`,
+		Args:    cobra.NoArgs,
+		Run:     prompt.SelectSubCommand,
+	}
+	cmd.AddCommand(NewCmdMemberList(opt))
+	cmd.AddCommand(NewCmdMemberAdd(opt))
+	cmd.AddCommand(NewCmdMemberUpdate(opt))
+	cmd.AddCommand(NewCmdMemberDelete(opt))
+	return cmd
+}
@@ -0,0 +1,139 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/cmd"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+// MemberAddOptions is an options to add a member to workspace.
+type MemberAddOptions struct {
+	UserName string
+	Role     string
+
+	workspaceClient factory.WorkspaceClient
+	formatter       formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewMemberAddOptions returns a reference to a MemberAddOptions.
+func NewMemberAddOptions(opt *clioptions.GlobalOptions) *MemberAddOptions {
+	return &MemberAddOptions{
+		options: opt,
+	}
+}
+
+// NewCmdMemberAdd new an add workspace member cmd.
+func NewCmdMemberAdd(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewMemberAddOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "add <workspace_name>",
+		Short: "add a member to a workspace",
+		Long:  "add a user as the owner, writer or reader of a workspace",
+		Args:  cobra.ExactArgs(1),
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	cmd.Flags().StringVarP(&o.UserName, "user", "u", o.UserName, "The name of the user.")
+	cmd.Flags().StringVarP(&o.Role, "role", "r", "reader", "The role of the user, one of owner, writer and reader.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *MemberAddOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.workspaceClient, err = f.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the add member options
+func (o *MemberAddOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+	if o.UserName == "" {
+		return fmt.Errorf("user name should not be empty")
+	}
+	return validateRole(o.Role)
+}
+
+// Run run the add workspace member command
+func (o *MemberAddOptions) Run(args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+
+	workspaceID, err := ConvertWorkspaceNameIntoID(ctx, o.workspaceClient, args[0])
+	if err != nil {
+		return err
+	}
+
+	_, err = o.workspaceClient.AddWorkspaceMember(ctx, &convert.AddWorkspaceMemberRequest{
+		WorkspaceID: workspaceID,
+		UserName:    o.UserName,
+		Role:        o.Role,
+	})
+	if err != nil {
+		return err
+	}
+	o.formatter.Write(fmt.Sprintf("user [%s] is added to workspace [%s] as %s", o.UserName, args[0], o.Role))
+
+	return nil
+}
+
+func (o *MemberAddOptions) GetPromptArgs() ([]string, error) {
+	name, err := cmd.GetWorkspaceName(o.options.Client.Timeout, o.workspaceClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{name}, nil
+}
+
+func (o *MemberAddOptions) GetPromptOptions() error {
+	var err error
+	o.UserName, err = prompt.PromptRequiredString("User")
+	if err != nil {
+		return err
+	}
+
+	o.Role, err = prompt.PromptStringSelect("Role", len(workspaceRoles), workspaceRoles)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *MemberAddOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TextFormat
+}
+
+func validateRole(role string) error {
+	for _, r := range workspaceRoles {
+		if r == role {
+			return nil
+		}
+	}
+	return fmt.Errorf("role [%s] should be one of %v", role, workspaceRoles)
+}
@@ -0,0 +1,117 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/cmd"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+// MemberDeleteOptions is an options to delete a member from workspace.
+type MemberDeleteOptions struct {
+	UserName string
+
+	workspaceClient factory.WorkspaceClient
+	formatter       formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewMemberDeleteOptions returns a reference to a MemberDeleteOptions.
+func NewMemberDeleteOptions(opt *clioptions.GlobalOptions) *MemberDeleteOptions {
+	return &MemberDeleteOptions{
+		options: opt,
+	}
+}
+
+// NewCmdMemberDelete new a delete workspace member cmd.
+func NewCmdMemberDelete(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewMemberDeleteOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "delete <workspace_name>",
+		Short: "delete a member from a workspace",
+		Long:  "delete a member from a workspace, the last owner can not be deleted",
+		Args:  cobra.ExactArgs(1),
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	cmd.Flags().StringVarP(&o.UserName, "user", "u", o.UserName, "The name of the user.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *MemberDeleteOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.workspaceClient, err = f.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the delete member options
+func (o *MemberDeleteOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+	if o.UserName == "" {
+		return fmt.Errorf("user name should not be empty")
+	}
+	return nil
+}
+
+// Run run the delete workspace member command
+func (o *MemberDeleteOptions) Run(args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+
+	workspaceID, err := ConvertWorkspaceNameIntoID(ctx, o.workspaceClient, args[0])
+	if err != nil {
+		return err
+	}
+
+	_, err = o.workspaceClient.DeleteWorkspaceMember(ctx, &convert.DeleteWorkspaceMemberRequest{
+		WorkspaceID: workspaceID,
+		UserName:    o.UserName,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *MemberDeleteOptions) GetPromptArgs() ([]string, error) {
+	name, err := cmd.GetWorkspaceName(o.options.Client.Timeout, o.workspaceClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{name}, nil
+}
+
+func (o *MemberDeleteOptions) GetPromptOptions() error {
+	var err error
+	o.UserName, err = prompt.PromptRequiredString("User")
+	return err
+}
+
+func (o *MemberDeleteOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TextFormat
+}
@@ -0,0 +1,106 @@
+package workspace
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/cmd"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+)
+
+// MemberListOptions is an options to list the members of workspace.
+type MemberListOptions struct {
+	workspaceClient factory.WorkspaceClient
+	formatter       formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewMemberListOptions returns a reference to a MemberListOptions.
+func NewMemberListOptions(opt *clioptions.GlobalOptions) *MemberListOptions {
+	return &MemberListOptions{
+		options: opt,
+	}
+}
+
+// NewCmdMemberList new a list workspace members cmd.
+func NewCmdMemberList(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewMemberListOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "list <workspace_name>",
+		Short: "list members of a workspace",
+		Long:  "list members of a workspace",
+		Args:  cobra.ExactArgs(1),
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *MemberListOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.workspaceClient, err = f.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the list member options
+func (o *MemberListOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Run run the list workspace members command
+func (o *MemberListOptions) Run(args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+
+	workspaceID, err := ConvertWorkspaceNameIntoID(ctx, o.workspaceClient, args[0])
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.workspaceClient.ListWorkspaceMembers(ctx, &convert.ListWorkspaceMembersRequest{
+		WorkspaceID: workspaceID,
+	})
+	if err != nil {
+		return err
+	}
+	o.formatter.Write(resp)
+
+	return nil
+}
+
+func (o *MemberListOptions) GetPromptArgs() ([]string, error) {
+	name, err := cmd.GetWorkspaceName(o.options.Client.Timeout, o.workspaceClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{name}, nil
+}
+
+func (o *MemberListOptions) GetPromptOptions() error {
+	return nil
+}
+
+func (o *MemberListOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TableFormat
+}
@@ -0,0 +1,130 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/cmd"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory"
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	clioptions "github.com/Bio-OS/bioos/internal/bioctl/options"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/formatter"
+	"github.com/Bio-OS/bioos/internal/bioctl/utils/prompt"
+)
+
+// MemberUpdateOptions is an options to change the role of workspace member.
+type MemberUpdateOptions struct {
+	UserName string
+	Role     string
+
+	workspaceClient factory.WorkspaceClient
+	formatter       formatter.Formatter
+
+	options *clioptions.GlobalOptions
+}
+
+// NewMemberUpdateOptions returns a reference to a MemberUpdateOptions.
+func NewMemberUpdateOptions(opt *clioptions.GlobalOptions) *MemberUpdateOptions {
+	return &MemberUpdateOptions{
+		options: opt,
+	}
+}
+
+// NewCmdMemberUpdate new an update workspace member cmd.
+func NewCmdMemberUpdate(opt *clioptions.GlobalOptions) *cobra.Command {
+	o := NewMemberUpdateOptions(opt)
+
+	cmd := &cobra.Command{
+		Use:   "update <workspace_name>",
+		Short: "update a member of a workspace",
+		Long:  "change the role of a workspace member, the last owner can not be changed",
+		Args:  cobra.ExactArgs(1),
+		Run:   clioptions.GetCommonRunFunc(o),
+	}
+
+	cmd.Flags().StringVarP(&o.UserName, "user", "u", o.UserName, "The name of the user.")
+	cmd.Flags().StringVarP(&o.Role, "role", "r", o.Role, "The new role of the user, one of owner, writer and reader.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *MemberUpdateOptions) Complete() error {
+	var err error
+	f := factory.NewFactory(&o.options.Client)
+	o.workspaceClient, err = f.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+
+	if o.options.Stream.OutputFormat == "" {
+		o.options.Stream.OutputFormat = o.GetDefaultFormat()
+	}
+	o.formatter = formatter.NewFormatter(o.options.Stream.OutputFormat, o.options.Stream.Output)
+	return nil
+}
+
+// Validate validate the update member options
+func (o *MemberUpdateOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+	if o.UserName == "" {
+		return fmt.Errorf("user name should not be empty")
+	}
+	return validateRole(o.Role)
+}
+
+// Run run the update workspace member command
+func (o *MemberUpdateOptions) Run(args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(o.options.Client.Timeout))
+	defer cancel()
+
+	workspaceID, err := ConvertWorkspaceNameIntoID(ctx, o.workspaceClient, args[0])
+	if err != nil {
+		return err
+	}
+
+	_, err = o.workspaceClient.UpdateWorkspaceMember(ctx, &convert.UpdateWorkspaceMemberRequest{
+		WorkspaceID: workspaceID,
+		UserName:    o.UserName,
+		Role:        o.Role,
+	})
+	if err != nil {
+		return err
+	}
+	o.formatter.Write(fmt.Sprintf("user [%s] of workspace [%s] is updated to %s", o.UserName, args[0], o.Role))
+
+	return nil
+}
+
+func (o *MemberUpdateOptions) GetPromptArgs() ([]string, error) {
+	name, err := cmd.GetWorkspaceName(o.options.Client.Timeout, o.workspaceClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{name}, nil
+}
+
+func (o *MemberUpdateOptions) GetPromptOptions() error {
+	var err error
+	o.UserName, err = prompt.PromptRequiredString("User")
+	if err != nil {
+		return err
+	}
+
+	o.Role, err = prompt.PromptStringSelect("Role", len(workspaceRoles), workspaceRoles)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *MemberUpdateOptions) GetDefaultFormat() formatter.Format {
+	return formatter.TextFormat
+}
@@ -16,8 +16,9 @@ import (
 
 // UpdateOptions is an options to update workspaces.
 type UpdateOptions struct {
-	Name        string
-	Description string
+	Name          string
+	Description   string
+	EngineBackend string
 
 	workspaceClient factory.WorkspaceClient
 	formatter       formatter.Formatter
@@ -46,6 +47,7 @@ func NewCmdUpdate(opt *clioptions.GlobalOptions) *cobra.Command {
 
 	cmd.Flags().StringVarP(&o.Name, "name", "n", o.Name, "The name of the workspace.")
 	cmd.Flags().StringVarP(&o.Description, "description", "d", o.Description, "The description of the workspace.")
+	cmd.Flags().StringVar(&o.EngineBackend, "engine-backend", o.EngineBackend, "The default WES backend to run submissions of the workspace.")
 
 	return cmd
 }
@@ -94,6 +96,9 @@ func (o *UpdateOptions) Run(args []string) error {
 	if o.Description != "" {
 		req.Description = &o.Description
 	}
+	if o.EngineBackend != "" {
+		req.EngineBackend = &o.EngineBackend
+	}
 
 	_, err = o.workspaceClient.UpdateWorkspace(ctx, req)
 	if err != nil {
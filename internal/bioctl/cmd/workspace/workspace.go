@@ -21,5 +21,7 @@ func NewCmdWorkspace(opt *clioptions.GlobalOptions) *cobra.Command {
 	cmd.AddCommand(NewCmdUpdate(opt))
 	cmd.AddCommand(NewCmdImport(opt))
 	cmd.AddCommand(NewCmdExport(opt))
+	cmd.AddCommand(NewCmdClone(opt))
+	cmd.AddCommand(NewCmdMember(opt))
 	return cmd
 }
@@ -89,6 +89,7 @@ type ListDataModelRowsRequest struct {
 	SearchWord  string   `query:"searchWord"`
 	InSetIDs    []string `query:"inSetIDs"`
 	RowIDs      []string `query:"rowIDs"`
+	Where       string   `query:"where"`
 }
 
 func (req *ListDataModelRowsRequest) ToGRPC() *workspaceproto.ListDataModelRowsRequest {
@@ -101,19 +102,22 @@ func (req *ListDataModelRowsRequest) ToGRPC() *workspaceproto.ListDataModelRowsR
 		SearchWord:  req.SearchWord,
 		InSetIDs:    req.InSetIDs,
 		RowIDs:      req.RowIDs,
+		Where:       req.Where,
 	}
 }
 
 type ListDataModelRowsResponse struct {
-	Headers []string   `json:"headers"`
-	Rows    [][]string `json:"rows"`
-	Page    int32      `json:"page"`
-	Size    int32      `json:"size"`
-	Total   int64      `json:"total"`
+	Headers     []string   `json:"headers"`
+	HeaderTypes []string   `json:"headerTypes"`
+	Rows        [][]string `json:"rows"`
+	Page        int32      `json:"page"`
+	Size        int32      `json:"size"`
+	Total       int64      `json:"total"`
 }
 
 func (resp *ListDataModelRowsResponse) FromGRPC(protoResp *workspaceproto.ListDataModelRowsResponse) {
 	resp.Headers = protoResp.GetHeaders()
+	resp.HeaderTypes = protoResp.GetHeaderTypes()
 	resp.Rows = make([][]string, len(protoResp.Rows))
 	for i, r := range protoResp.Rows {
 		resp.Rows[i] = r.Grids
@@ -133,11 +137,12 @@ type Grid struct {
 }
 
 type PatchDataModelRequest struct {
-	WorkspaceID string     `path:"workspace_id"`
-	Name        string     `json:"name"`
-	Async       bool       `json:"async"`
-	Headers     []string   `json:"headers"`
-	Rows        [][]string `json:"rows"`
+	WorkspaceID string            `path:"workspace_id"`
+	Name        string            `json:"name"`
+	Async       bool              `json:"async"`
+	Headers     []string          `json:"headers"`
+	Rows        [][]string        `json:"rows"`
+	ColumnTypes map[string]string `json:"columnTypes,omitempty"`
 }
 
 func (req *PatchDataModelRequest) ToGRPC() *workspaceproto.PatchDataModelRequest {
@@ -146,6 +151,7 @@ func (req *PatchDataModelRequest) ToGRPC() *workspaceproto.PatchDataModelRequest
 		Name:        req.Name,
 		Async:       req.Async,
 		Headers:     req.Headers,
+		ColumnTypes: req.ColumnTypes,
 	}
 	out.Rows = make([]*workspaceproto.Row, len(req.Rows))
 	for i, r := range req.Rows {
@@ -205,3 +211,21 @@ type ListAllDataModelRowIDsResponse struct {
 func (resp *ListAllDataModelRowIDsResponse) FromGRPC(protoResp *workspaceproto.ListAllDataModelRowIDsResponse) {
 	resp.RowIDs = protoResp.GetRowIDs()
 }
+
+type ExportDataModelRequest struct {
+	WorkspaceID string   `path:"workspace_id"`
+	ID          string   `path:"id"`
+	Format      string   `query:"format,omitempty"`
+	Columns     []string `query:"columns,omitempty"`
+	Where       string   `query:"where,omitempty"`
+}
+
+func (req *ExportDataModelRequest) ToGRPC() *workspaceproto.ExportDataModelRequest {
+	return &workspaceproto.ExportDataModelRequest{
+		WorkspaceID: req.WorkspaceID,
+		Id:          req.ID,
+		Format:      req.Format,
+		Columns:     req.Columns,
+		Where:       req.Where,
+	}
+}
@@ -0,0 +1,183 @@
+package convert
+
+import (
+	"reflect"
+
+	workspaceproto "github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
+)
+
+type ListEventsRequest struct {
+	Page    int      `query:"page"`
+	Size    int      `query:"size"`
+	Types   []string `query:"types,omitempty"`
+	Status  []string `query:"status,omitempty"`
+	Payload string   `query:"payload,omitempty"`
+}
+
+func (req *ListEventsRequest) ToGRPC() *workspaceproto.ListEventsRequest {
+	return &workspaceproto.ListEventsRequest{
+		Page:    int32(req.Page),
+		Size:    int32(req.Size),
+		Types:   req.Types,
+		Status:  req.Status,
+		Payload: req.Payload,
+	}
+}
+
+type ListEventsResponse struct {
+	Page  int         `json:"page"`
+	Size  int         `json:"size"`
+	Items []EventItem `json:"items"`
+}
+
+type listEventsResponseBriefItems struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Status     string `json:"status"`
+	RetryCount int    `json:"retryCount"`
+	UpdatedAt  int64  `json:"updatedAt"`
+	Reason     string `json:"reason"`
+}
+
+func (resp *ListEventsResponse) BriefItems() reflect.Value {
+	briefItems := make([]listEventsResponseBriefItems, len(resp.Items))
+	for i, item := range resp.Items {
+		briefItems[i] = listEventsResponseBriefItems{
+			ID:         item.ID,
+			Type:       item.Type,
+			Status:     item.Status,
+			RetryCount: item.RetryCount,
+			UpdatedAt:  item.UpdatedAt,
+			Reason:     item.Reason,
+		}
+	}
+	return reflect.ValueOf(briefItems)
+}
+
+func (resp *ListEventsResponse) FromGRPC(protoResp *workspaceproto.ListEventsResponse) {
+	resp.Page = int(protoResp.GetPage())
+	resp.Size = int(protoResp.GetSize())
+	resp.Items = make([]EventItem, len(protoResp.GetItems()))
+	for i, item := range protoResp.GetItems() {
+		resp.Items[i] = eventItemFromGRPC(item)
+	}
+}
+
+type EventItem struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	Payload     string `json:"payload"`
+	Status      string `json:"status"`
+	Reason      string `json:"reason"`
+	RetryCount  int    `json:"retryCount"`
+	CreatedAt   int64  `json:"createdAt"`
+	UpdatedAt   int64  `json:"updatedAt"`
+	ScheduledAt int64  `json:"scheduledAt"`
+	LastError   string `json:"lastError"`
+	// Attempts are the latest attempts to handle the event, from oldest
+	Attempts []EventAttempt `json:"attempts"`
+	Owner    string         `json:"owner"`
+	// LeaseExpireAt is 0 if the event was never running
+	LeaseExpireAt int64 `json:"leaseExpireAt"`
+}
+
+type EventAttempt struct {
+	StartTime int64 `json:"startTime"`
+	// Duration in milliseconds
+	Duration int64                 `json:"duration"`
+	Failures []EventHandlerFailure `json:"failures"`
+}
+
+type EventHandlerFailure struct {
+	Handler string `json:"handler"`
+	Error   string `json:"error"`
+}
+
+func eventItemFromGRPC(event *workspaceproto.Event) EventItem {
+	return EventItem{
+		ID:            event.GetId(),
+		Type:          event.GetType(),
+		Payload:       event.GetPayload(),
+		Status:        event.GetStatus(),
+		Reason:        event.GetReason(),
+		RetryCount:    int(event.GetRetryCount()),
+		CreatedAt:     event.GetCreatedAt(),
+		UpdatedAt:     event.GetUpdatedAt(),
+		ScheduledAt:   event.GetScheduledAt(),
+		LastError:     event.GetLastError(),
+		Attempts:      eventAttemptsFromGRPC(event.GetAttempts()),
+		Owner:         event.GetOwner(),
+		LeaseExpireAt: event.GetLeaseExpireAt(),
+	}
+}
+
+func eventAttemptsFromGRPC(attempts []*workspaceproto.EventAttempt) []EventAttempt {
+	result := make([]EventAttempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = EventAttempt{
+			StartTime: attempt.GetStartTime(),
+			Duration:  attempt.GetDuration(),
+			Failures:  make([]EventHandlerFailure, len(attempt.GetFailures())),
+		}
+		for j, failure := range attempt.GetFailures() {
+			result[i].Failures[j] = EventHandlerFailure{
+				Handler: failure.GetHandler(),
+				Error:   failure.GetError(),
+			}
+		}
+	}
+	return result
+}
+
+type GetEventRequest struct {
+	ID string `path:"id"`
+}
+
+func (req *GetEventRequest) ToGRPC() *workspaceproto.GetEventRequest {
+	return &workspaceproto.GetEventRequest{
+		Id: req.ID,
+	}
+}
+
+type GetEventResponse EventItem
+
+func (resp *GetEventResponse) FromGRPC(protoResp *workspaceproto.GetEventResponse) {
+	*resp = GetEventResponse(eventItemFromGRPC(protoResp.GetEvent()))
+}
+
+type ReplayEventRequest struct {
+	ID string `path:"id"`
+}
+
+func (req *ReplayEventRequest) ToGRPC() *workspaceproto.ReplayEventRequest {
+	return &workspaceproto.ReplayEventRequest{
+		Id: req.ID,
+	}
+}
+
+type ReplayEventResponse struct {
+}
+
+func (resp *ReplayEventResponse) FromGRPC(protoResp *workspaceproto.ReplayEventResponse) {
+	return
+}
+
+type PurgeEventsRequest struct {
+	Before int64    `query:"before"`
+	Types  []string `query:"types,omitempty"`
+}
+
+func (req *PurgeEventsRequest) ToGRPC() *workspaceproto.PurgeEventsRequest {
+	return &workspaceproto.PurgeEventsRequest{
+		Before: req.Before,
+		Types:  req.Types,
+	}
+}
+
+type PurgeEventsResponse struct {
+	Count int64 `json:"count"`
+}
+
+func (resp *PurgeEventsResponse) FromGRPC(protoResp *workspaceproto.PurgeEventsResponse) {
+	resp.Count = protoResp.GetCount()
+}
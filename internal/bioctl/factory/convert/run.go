@@ -60,8 +60,20 @@ func (resp *ListRunsResponse) FromGRPC(protoResp *submissionproto.ListRunsRespon
 				Queued:       item.GetTaskStatus().GetQueued(),
 				Initializing: item.GetTaskStatus().GetInitializing(),
 			},
-			Log:     &item.Log,
-			Message: &item.Message,
+			Log:           &item.Log,
+			Message:       &item.Message,
+			Attempts:      make([]RunAttempt, len(item.GetAttempts())),
+			EngineBackend: item.GetEngineBackend(),
+		}
+		for j, attempt := range item.GetAttempts() {
+			resp.Items[i].Attempts[j] = RunAttempt{
+				EngineRunID: attempt.GetEngineRunID(),
+				Status:      attempt.GetStatus(),
+				Message:     &attempt.Message,
+				StartTime:   attempt.GetStartTime(),
+				FinishTime:  &attempt.FinishTime,
+				Duration:    attempt.GetDuration(),
+			}
 		}
 	}
 
@@ -80,6 +92,19 @@ type RunItem struct {
 	TaskStatus  Status  `json:"taskStatus"`
 	Log         *string `json:"log"`
 	Message     *string `json:"message"`
+	// Attempts are previous executions of the run, from oldest
+	Attempts []RunAttempt `json:"attempts"`
+	// EngineBackend is the name of WES backend the run is submitted to
+	EngineBackend string `json:"engineBackend"`
+}
+
+type RunAttempt struct {
+	EngineRunID string  `json:"engineRunID"`
+	Status      string  `json:"status"`
+	Message     *string `json:"message"`
+	StartTime   int64   `json:"startTime"`
+	FinishTime  *int64  `json:"finishTime"`
+	Duration    int64   `json:"duration"`
 }
 
 type CancelRunRequest struct {
@@ -103,6 +128,27 @@ func (resp *CancelRunResponse) FromGRPC(protoResp *submissionproto.CancelRunResp
 	return
 }
 
+type RerunRunRequest struct {
+	WorkspaceID  string `path:"workspace_id"`
+	SubmissionID string `path:"submission_id"`
+	ID           string `path:"id"`
+}
+
+func (req *RerunRunRequest) ToGRPC() *submissionproto.RerunRunRequest {
+	return &submissionproto.RerunRunRequest{
+		WorkspaceID:  req.WorkspaceID,
+		SubmissionID: req.SubmissionID,
+		Id:           req.ID,
+	}
+}
+
+type RerunRunResponse struct {
+}
+
+func (resp *RerunRunResponse) FromGRPC(protoResp *submissionproto.RerunRunResponse) {
+	return
+}
+
 type ListTasksRequest struct {
 	WorkspaceID  string `path:"workspace_id"`
 	SubmissionID string `path:"submission_id"`
@@ -145,6 +191,12 @@ func (resp *ListTasksResponse) FromGRPC(protoResp *submissionproto.ListTasksResp
 			Duration:   item.GetDuration(),
 			Stdout:     item.GetStdout(),
 			Stderr:     item.GetStderr(),
+			Resources: TaskResources{
+				CPU:         item.GetResources().GetCpu(),
+				Memory:      item.GetResources().GetMemory(),
+				MachineType: item.GetResources().GetMachineType(),
+				Preemptible: item.GetResources().GetPreemptible(),
+			},
 		}
 	}
 }
@@ -158,4 +210,115 @@ type TaskItem struct {
 	Duration   int64  `json:"duration"`
 	Stdout     string `json:"stdout"`
 	Stderr     string `json:"stderr"`
+	// Resources are requested by the task, zero value means not reported by engine
+	Resources TaskResources `json:"resources"`
+}
+
+type TaskResources struct {
+	CPU float64 `json:"cpu"`
+	// Memory in bytes
+	Memory      int64  `json:"memory"`
+	MachineType string `json:"machineType"`
+	Preemptible bool   `json:"preemptible"`
+}
+
+type StreamTaskLogRequest struct {
+	WorkspaceID  string `path:"workspace_id"`
+	SubmissionID string `path:"submission_id"`
+	RunID        string `path:"run_id"`
+	Name         string `path:"name"`
+	Stream       string `query:"stream,omitempty"`
+	Tail         int    `query:"tail,omitempty"`
+	Follow       bool   `query:"follow,omitempty"`
+}
+
+func (req *StreamTaskLogRequest) ToGRPC() *submissionproto.StreamTaskLogRequest {
+	return &submissionproto.StreamTaskLogRequest{
+		WorkspaceID:  req.WorkspaceID,
+		SubmissionID: req.SubmissionID,
+		RunID:        req.RunID,
+		TaskName:     req.Name,
+		Stream:       req.Stream,
+		Tail:         int32(req.Tail),
+		Follow:       req.Follow,
+	}
+}
+
+type GetUsageRequest struct {
+	WorkspaceID  string `path:"workspace_id"`
+	SubmissionID string `query:"submissionID,omitempty"`
+	StartTime    int64  `query:"startTime,omitempty"`
+	EndTime      int64  `query:"endTime,omitempty"`
+}
+
+func (req *GetUsageRequest) ToGRPC() *submissionproto.GetUsageRequest {
+	return &submissionproto.GetUsageRequest{
+		WorkspaceID:  req.WorkspaceID,
+		SubmissionID: req.SubmissionID,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+	}
+}
+
+type GetUsageResponse struct {
+	WorkspaceID string            `json:"workspaceID"`
+	StartTime   int64             `json:"startTime"`
+	EndTime     int64             `json:"endTime"`
+	Usage       Usage             `json:"usage"`
+	Submissions []SubmissionUsage `json:"submissions"`
+}
+
+func (resp *GetUsageResponse) FromGRPC(protoResp *submissionproto.GetUsageResponse) {
+	resp.WorkspaceID = protoResp.GetWorkspaceID()
+	resp.StartTime = protoResp.GetStartTime()
+	resp.EndTime = protoResp.GetEndTime()
+	resp.Usage = usageFromGRPC(protoResp.GetUsage())
+	resp.Submissions = make([]SubmissionUsage, len(protoResp.GetSubmissions()))
+	for i, submission := range protoResp.GetSubmissions() {
+		resp.Submissions[i] = SubmissionUsage{
+			SubmissionID: submission.GetSubmissionID(),
+			Name:         submission.GetName(),
+			Usage:        usageFromGRPC(submission.GetUsage()),
+			Runs:         make([]RunUsage, len(submission.GetRuns())),
+		}
+		for j, run := range submission.GetRuns() {
+			resp.Submissions[i].Runs[j] = RunUsage{
+				RunID: run.GetRunID(),
+				Name:  run.GetName(),
+				Usage: usageFromGRPC(run.GetUsage()),
+			}
+		}
+	}
+}
+
+type SubmissionUsage struct {
+	SubmissionID string `json:"submissionID"`
+	Name         string `json:"name"`
+	Usage        Usage  `json:"usage"`
+	// Runs are only reported if the usage of a submission is requested
+	Runs []RunUsage `json:"runs,omitempty"`
+}
+
+type RunUsage struct {
+	RunID string `json:"runID"`
+	Name  string `json:"name"`
+	Usage Usage  `json:"usage"`
+}
+
+type Usage struct {
+	TaskCount            int32   `json:"taskCount"`
+	PreemptibleTaskCount int32   `json:"preemptibleTaskCount"`
+	WallClock            int64   `json:"wallClock"`
+	CPUSeconds           float64 `json:"cpuSeconds"`
+	MemoryGBSeconds      float64 `json:"memoryGBSeconds"`
+}
+
+func usageFromGRPC(usage *submissionproto.Usage) Usage {
+	return Usage{
+		TaskCount:            usage.GetTaskCount(),
+		PreemptibleTaskCount: usage.GetPreemptibleTaskCount(),
+		WallClock:            usage.GetWallClock(),
+		CPUSeconds:           usage.GetCpuSeconds(),
+		MemoryGBSeconds:      usage.GetMemoryGBSeconds(),
+	}
 }
@@ -9,23 +9,27 @@ import (
 )
 
 type CreateSubmissionRequest struct {
-	WorkspaceID    string         `path:"workspace_id"`
-	Name           string         `json:"name"`
-	WorkflowID     string         `json:"workflowID"`
-	Description    *string        `json:"description"`
-	Type           string         `json:"type"`
-	Entity         *Entity        `json:"entity"`
-	ExposedOptions ExposedOptions `json:"exposedOptions"`
-	InOutMaterial  *InOutMaterial `json:"inOutMaterial"`
+	WorkspaceID       string         `path:"workspace_id"`
+	Name              string         `json:"name"`
+	WorkflowID        string         `json:"workflowID"`
+	WorkflowVersionID string         `json:"workflowVersionID,omitempty"`
+	Description       *string        `json:"description"`
+	Type              string         `json:"type"`
+	Entity            *Entity        `json:"entity"`
+	ExposedOptions    ExposedOptions `json:"exposedOptions"`
+	InOutMaterial     *InOutMaterial `json:"inOutMaterial"`
+	MaxConcurrentRuns int            `json:"maxConcurrentRuns,omitempty"`
+	EngineBackend     string         `json:"engineBackend,omitempty"`
 }
 
 func (req *CreateSubmissionRequest) ToGRPC() *submissionproto.CreateSubmissionRequest {
 	return &submissionproto.CreateSubmissionRequest{
-		WorkspaceID: req.WorkspaceID,
-		Name:        req.Name,
-		WorkflowID:  req.WorkflowID,
-		Description: pointer.StringDeref(req.Description, ""),
-		Type:        req.Type,
+		WorkspaceID:       req.WorkspaceID,
+		Name:              req.Name,
+		WorkflowID:        req.WorkflowID,
+		WorkflowVersionID: req.WorkflowVersionID,
+		Description:       pointer.StringDeref(req.Description, ""),
+		Type:              req.Type,
 		Entity: &submissionproto.Entity{
 			DataModelID:     req.Entity.DataModelID,
 			DataModelRowIDs: req.Entity.DataModelRowIDs,
@@ -39,6 +43,8 @@ func (req *CreateSubmissionRequest) ToGRPC() *submissionproto.CreateSubmissionRe
 			InputsMaterial:  req.InOutMaterial.InputsMaterial,
 			OutputsMaterial: req.InOutMaterial.OutputsMaterial,
 		},
+		MaxConcurrentRuns: int32(req.MaxConcurrentRuns),
+		EngineBackend:     req.EngineBackend,
 	}
 }
 
@@ -188,6 +194,11 @@ func (resp *ListSubmissionsResponse) FromGRPC(protoResp *submissionproto.ListSub
 				InputsMaterial:  item.GetInOutMaterial().GetInputsMaterial(),
 				OutputsMaterial: item.GetInOutMaterial().GetOutputsMaterial(),
 			},
+			MaxConcurrentRuns: int(item.GetMaxConcurrentRuns()),
+			EngineBackend:     item.GetEngineBackend(),
+		}
+		if item.GetParentSubmissionID() != "" {
+			resp.Items[i].ParentSubmissionID = pointer.String(item.GetParentSubmissionID())
 		}
 	}
 }
@@ -205,6 +216,12 @@ type SubmissionItem struct {
 	Entity          *Entity              `json:"entity"`
 	ExposedOptions  ExposedOptions       `json:"exposedOptions"`
 	InOutMaterial   *InOutMaterial       `json:"inOutMaterial"`
+	// ParentSubmissionID is the id of submission retried by this one
+	ParentSubmissionID *string `json:"parentSubmissionID"`
+	// MaxConcurrentRuns limits the runs submitted at the same time, 0 means unlimited
+	MaxConcurrentRuns int `json:"maxConcurrentRuns"`
+	// EngineBackend is the name of WES backend runs are submitted to
+	EngineBackend string `json:"engineBackend"`
 }
 
 type WorkflowVersionBrief struct {
@@ -261,3 +278,27 @@ type CancelSubmissionResponse struct {
 func (resp *CancelSubmissionResponse) FromGRPC(protoResp *submissionproto.CancelSubmissionResponse) {
 	return
 }
+
+type RetrySubmissionRequest struct {
+	WorkspaceID string   `path:"workspace_id"`
+	ID          string   `path:"id"`
+	Name        string   `json:"name,omitempty"`
+	Status      []string `json:"status,omitempty"`
+}
+
+func (req *RetrySubmissionRequest) ToGRPC() *submissionproto.RetrySubmissionRequest {
+	return &submissionproto.RetrySubmissionRequest{
+		WorkspaceID: req.WorkspaceID,
+		Id:          req.ID,
+		Name:        req.Name,
+		Status:      req.Status,
+	}
+}
+
+type RetrySubmissionResponse struct {
+	ID string `json:"id"`
+}
+
+func (resp *RetrySubmissionResponse) FromGRPC(protoResp *submissionproto.RetrySubmissionResponse) {
+	resp.ID = protoResp.GetId()
+}
@@ -14,7 +14,7 @@ type CreateWorkflowRequest struct {
 	WorkspaceID      string `path:"workspace-id"`
 	Name             string `json:"name" validate:"required,resName"`
 	Description      string `json:"description" validate:"required,workspaceDesc"`
-	Language         string `json:"language" validate:"required,oneof=WDL"`
+	Language         string `json:"language" validate:"required,oneof=WDL Nextflow CWL"`
 	Source           string `json:"source" validate:"required,oneof=git"`
 	Url              string `json:"url" validate:"required"`
 	Tag              string `json:"tag" validate:"required"`
@@ -11,9 +11,10 @@ import (
 )
 
 type CreateWorkspaceRequest struct {
-	Name        string            `json:"name"`
-	Description string            `json:"description"`
-	Storage     *WorkspaceStorage `json:"storage"`
+	Name          string            `json:"name"`
+	Description   string            `json:"description"`
+	Storage       *WorkspaceStorage `json:"storage"`
+	EngineBackend string            `json:"engineBackend,omitempty"`
 }
 
 func (req *CreateWorkspaceRequest) ToGRPC() *workspaceproto.CreateWorkspaceRequest {
@@ -25,6 +26,7 @@ func (req *CreateWorkspaceRequest) ToGRPC() *workspaceproto.CreateWorkspaceReque
 				MountPath: req.Storage.NFS.MountPath,
 			},
 		},
+		EngineBackend: req.EngineBackend,
 	}
 }
 
@@ -54,16 +56,18 @@ func (resp *DeleteWorkspaceResponse) FromGRPC(protoResp *workspaceproto.DeleteWo
 }
 
 type UpdateWorkspaceRequest struct {
-	ID          string  `path:"id"`
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
+	ID            string  `path:"id"`
+	Name          *string `json:"name,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	EngineBackend *string `json:"engineBackend,omitempty"`
 }
 
 func (req *UpdateWorkspaceRequest) ToGRPC() *workspaceproto.UpdateWorkspaceRequest {
 	return &workspaceproto.UpdateWorkspaceRequest{
-		Id:          req.ID,
-		Name:        pointer.StringDeref(req.Name, ""),
-		Description: pointer.StringDeref(req.Description, ""),
+		Id:            req.ID,
+		Name:          pointer.StringDeref(req.Name, ""),
+		Description:   pointer.StringDeref(req.Description, ""),
+		EngineBackend: req.EngineBackend,
 	}
 }
 
@@ -99,8 +103,10 @@ func (resp *GetWorkspaceResponse) FromGRPC(protoResp *workspaceproto.GetWorkspac
 				MountPath: protoResp.Workspace.Storage.Nfs.MountPath,
 			},
 		},
-		CreateTime: protoResp.Workspace.CreatedAt.GetSeconds(),
-		UpdateTime: protoResp.Workspace.UpdatedAt.GetSeconds(),
+		CreateTime:    protoResp.Workspace.CreatedAt.GetSeconds(),
+		UpdateTime:    protoResp.Workspace.UpdatedAt.GetSeconds(),
+		EngineBackend: protoResp.Workspace.EngineBackend,
+		Owner:         protoResp.Workspace.Owner,
 	}
 	return
 }
@@ -170,20 +176,24 @@ func (resp *ListWorkspacesResponse) FromGRPC(protoResp *workspaceproto.ListWorks
 					MountPath: item.Storage.Nfs.MountPath,
 				},
 			},
-			CreateTime: item.CreatedAt.GetSeconds(),
-			UpdateTime: item.UpdatedAt.GetSeconds(),
+			CreateTime:    item.CreatedAt.GetSeconds(),
+			UpdateTime:    item.UpdatedAt.GetSeconds(),
+			EngineBackend: item.EngineBackend,
+			Owner:         item.Owner,
 		}
 	}
 	return
 }
 
 type WorkspaceItem struct {
-	Id          string            `json:"id"`
-	Name        string            `json:"name"`
-	Description string            `json:"description"`
-	Storage     *WorkspaceStorage `json:"storage"`
-	CreateTime  int64             `json:"createTime"`
-	UpdateTime  int64             `json:"updateTime"`
+	Id            string            `json:"id"`
+	Name          string            `json:"name"`
+	Description   string            `json:"description"`
+	Storage       *WorkspaceStorage `json:"storage"`
+	CreateTime    int64             `json:"createTime"`
+	UpdateTime    int64             `json:"updateTime"`
+	EngineBackend string            `json:"engineBackend"`
+	Owner         string            `json:"owner,omitempty"`
 }
 
 type WorkspaceStorage struct {
@@ -206,9 +216,218 @@ type ImportWorkspaceRequest struct {
 }
 
 type ImportWorkspaceResponse struct {
-	Id string `json:"id"`
+	Id    string `json:"id"`
+	JobID string `json:"jobID"`
 }
 
 func (resp *ImportWorkspaceResponse) FromGRPC(protoResp *workspaceproto.ImportWorkspaceResponse) {
 	resp.Id = protoResp.GetId()
+	resp.JobID = protoResp.GetJobID()
+}
+
+type ExportWorkspaceRequest struct {
+	ID                 string `path:"id"`
+	IncludeSubmissions bool   `query:"includeSubmissions,omitempty"`
+}
+
+func (req *ExportWorkspaceRequest) ToGRPC() *workspaceproto.ExportWorkspaceRequest {
+	return &workspaceproto.ExportWorkspaceRequest{
+		Id:                 req.ID,
+		IncludeSubmissions: req.IncludeSubmissions,
+	}
+}
+
+type CloneWorkspaceRequest struct {
+	ID             string            `path:"id"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description"`
+	Storage        *WorkspaceStorage `json:"storage,omitempty"`
+	IncludeStorage bool              `json:"includeStorage,omitempty"`
+}
+
+func (req *CloneWorkspaceRequest) ToGRPC() *workspaceproto.CloneWorkspaceRequest {
+	res := &workspaceproto.CloneWorkspaceRequest{
+		Id:             req.ID,
+		Name:           req.Name,
+		Description:    req.Description,
+		IncludeStorage: req.IncludeStorage,
+	}
+	if req.Storage != nil && req.Storage.NFS != nil {
+		res.Storage = &workspaceproto.WorkspaceStorage{
+			Nfs: &workspaceproto.NFSWorkspaceStorage{
+				MountPath: req.Storage.NFS.MountPath,
+			},
+		}
+	}
+	return res
+}
+
+type CloneWorkspaceResponse struct {
+	Id    string `json:"id"`
+	JobID string `json:"jobID"`
+}
+
+func (resp *CloneWorkspaceResponse) FromGRPC(protoResp *workspaceproto.CloneWorkspaceResponse) {
+	resp.Id = protoResp.GetId()
+	resp.JobID = protoResp.GetJobID()
+}
+
+type GetImportJobRequest struct {
+	ID string `path:"id"`
+}
+
+func (req *GetImportJobRequest) ToGRPC() *workspaceproto.GetImportJobRequest {
+	return &workspaceproto.GetImportJobRequest{
+		Id: req.ID,
+	}
+}
+
+type GetImportJobResponse struct {
+	ImportJob `json:",inline"`
+}
+
+func (resp *GetImportJobResponse) FromGRPC(protoResp *workspaceproto.GetImportJobResponse) {
+	job := protoResp.GetJob()
+	resp.ImportJob = ImportJob{
+		ID:                job.GetId(),
+		Type:              job.GetType(),
+		WorkspaceID:       job.GetWorkspaceID(),
+		SourceWorkspaceID: job.GetSourceWorkspaceID(),
+		State:             job.GetState(),
+		Components:        make([]ImportJobComponent, len(job.GetComponents())),
+		Error:             job.GetError(),
+		CreateTime:        job.GetCreatedAt().GetSeconds(),
+		UpdateTime:        job.GetUpdatedAt().GetSeconds(),
+	}
+	if job.GetFinishedAt() != nil {
+		finishTime := job.GetFinishedAt().GetSeconds()
+		resp.FinishTime = &finishTime
+	}
+	for i, component := range job.GetComponents() {
+		resp.Components[i] = ImportJobComponent{
+			Name:    component.GetName(),
+			State:   component.GetState(),
+			Message: component.GetMessage(),
+		}
+	}
+}
+
+type ImportJob struct {
+	ID                string               `json:"id"`
+	Type              string               `json:"type"`
+	WorkspaceID       string               `json:"workspaceID"`
+	SourceWorkspaceID string               `json:"sourceWorkspaceID,omitempty"`
+	State             string               `json:"state"`
+	Components        []ImportJobComponent `json:"components"`
+	Error             string               `json:"error,omitempty"`
+	CreateTime        int64                `json:"createTime"`
+	UpdateTime        int64                `json:"updateTime"`
+	FinishTime        *int64               `json:"finishTime,omitempty"`
+}
+
+// IsFinished returns true if the import or clone job succeeded or failed.
+func (j *ImportJob) IsFinished() bool {
+	return j.State == "Succeeded" || j.State == "Failed"
+}
+
+type ImportJobComponent struct {
+	Name    string `json:"name"`
+	State   string `json:"state"`
+	Message string `json:"message,omitempty"`
+}
+
+type ListWorkspaceMembersRequest struct {
+	WorkspaceID string `path:"id"`
+}
+
+func (req *ListWorkspaceMembersRequest) ToGRPC() *workspaceproto.ListWorkspaceMembersRequest {
+	return &workspaceproto.ListWorkspaceMembersRequest{
+		WorkspaceID: req.WorkspaceID,
+	}
+}
+
+type ListWorkspaceMembersResponse struct {
+	Items []WorkspaceMember `json:"items"`
+}
+
+func (resp *ListWorkspaceMembersResponse) BriefItems() reflect.Value {
+	return reflect.ValueOf(resp.Items)
+}
+
+func (resp *ListWorkspaceMembersResponse) FromGRPC(protoResp *workspaceproto.ListWorkspaceMembersResponse) {
+	resp.Items = make([]WorkspaceMember, len(protoResp.GetItems()))
+	for i, item := range protoResp.GetItems() {
+		resp.Items[i] = WorkspaceMember{
+			UserName:   item.GetUserName(),
+			Role:       item.GetRole(),
+			CreateTime: item.GetCreatedAt().GetSeconds(),
+		}
+	}
+}
+
+type WorkspaceMember struct {
+	UserName   string `json:"userName"`
+	Role       string `json:"role"`
+	CreateTime int64  `json:"createTime"`
+}
+
+type AddWorkspaceMemberRequest struct {
+	WorkspaceID string `path:"id"`
+	UserName    string `json:"userName"`
+	Role        string `json:"role"`
+}
+
+func (req *AddWorkspaceMemberRequest) ToGRPC() *workspaceproto.AddWorkspaceMemberRequest {
+	return &workspaceproto.AddWorkspaceMemberRequest{
+		WorkspaceID: req.WorkspaceID,
+		UserName:    req.UserName,
+		Role:        req.Role,
+	}
+}
+
+type AddWorkspaceMemberResponse struct {
+}
+
+func (resp *AddWorkspaceMemberResponse) FromGRPC(protoResp *workspaceproto.AddWorkspaceMemberResponse) {
+	return
+}
+
+type UpdateWorkspaceMemberRequest struct {
+	WorkspaceID string `path:"id"`
+	UserName    string `path:"name"`
+	Role        string `json:"role"`
+}
+
+func (req *UpdateWorkspaceMemberRequest) ToGRPC() *workspaceproto.UpdateWorkspaceMemberRequest {
+	return &workspaceproto.UpdateWorkspaceMemberRequest{
+		WorkspaceID: req.WorkspaceID,
+		UserName:    req.UserName,
+		Role:        req.Role,
+	}
+}
+
+type UpdateWorkspaceMemberResponse struct {
+}
+
+func (resp *UpdateWorkspaceMemberResponse) FromGRPC(protoResp *workspaceproto.UpdateWorkspaceMemberResponse) {
+	return
+}
+
+type DeleteWorkspaceMemberRequest struct {
+	WorkspaceID string `path:"id"`
+	UserName    string `path:"name"`
+}
+
+func (req *DeleteWorkspaceMemberRequest) ToGRPC() *workspaceproto.DeleteWorkspaceMemberRequest {
+	return &workspaceproto.DeleteWorkspaceMemberRequest{
+		WorkspaceID: req.WorkspaceID,
+		UserName:    req.UserName,
+	}
+}
+
+type DeleteWorkspaceMemberResponse struct {
+}
+
+func (resp *DeleteWorkspaceMemberResponse) FromGRPC(protoResp *workspaceproto.DeleteWorkspaceMemberResponse) {
+	return
 }
@@ -2,6 +2,8 @@ package factory
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
 	workspaceproto "github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
@@ -14,6 +16,8 @@ type DataModelClient interface {
 	PatchDataModel(ctx context.Context, in *convert.PatchDataModelRequest) (*convert.PatchDataModelResponse, error)
 	DeleteDataModel(ctx context.Context, in *convert.DeleteDataModelRequest) (*convert.DeleteDataModelResponse, error)
 	ListAllDataModelRowIDs(ctx context.Context, in *convert.ListAllDataModelRowIDsRequest) (*convert.ListAllDataModelRowIDsResponse, error)
+	// ExportDataModel writes the exported file of data model to w.
+	ExportDataModel(ctx context.Context, in *convert.ExportDataModelRequest, w io.Writer) error
 }
 
 func (g *grpcClient) ListDataModels(ctx context.Context, in *convert.ListDataModelsRequest) (*convert.ListDataModelsResponse, error) {
@@ -82,6 +86,25 @@ func (g *grpcClient) ListAllDataModelRowIDs(ctx context.Context, in *convert.Lis
 	return out, nil
 }
 
+func (g *grpcClient) ExportDataModel(ctx context.Context, in *convert.ExportDataModelRequest, w io.Writer) error {
+	stream, err := workspaceproto.NewDataModelServiceClient(g.conn).ExportDataModel(ctx, in.ToGRPC())
+	if err != nil {
+		return err
+	}
+	for {
+		protoResp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(protoResp.GetContent()); err != nil {
+			return err
+		}
+	}
+}
+
 func (h *httpClient) ListDataModels(ctx context.Context, in *convert.ListDataModelsRequest) (*convert.ListDataModelsResponse, error) {
 	req := h.restR(ctx)
 	convert.AssignToHttpRequest(in, req)
@@ -153,3 +176,23 @@ func (h *httpClient) ListAllDataModelRowIDs(ctx context.Context, in *convert.Lis
 	convert.AssignFromHttpResponse(httpResp, out)
 	return out, nil
 }
+
+func (h *httpClient) ExportDataModel(ctx context.Context, in *convert.ExportDataModelRequest, w io.Writer) error {
+	req := h.restR(ctx).SetDoNotParseResponse(true)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Get(h.url("workspace/{workspace_id}/data_model/{id}/export"))
+	if err != nil {
+		return err
+	}
+	body := httpResp.RawBody()
+	defer body.Close()
+	if httpResp.StatusCode() >= 400 {
+		content, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		return errors.New(string(content))
+	}
+	_, err = io.Copy(w, body)
+	return err
+}
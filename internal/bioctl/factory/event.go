@@ -0,0 +1,105 @@
+package factory
+
+import (
+	"context"
+
+	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
+	workspaceproto "github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
+)
+
+type EventClient interface {
+	ListEvents(ctx context.Context, in *convert.ListEventsRequest) (*convert.ListEventsResponse, error)
+	GetEvent(ctx context.Context, in *convert.GetEventRequest) (*convert.GetEventResponse, error)
+	ReplayEvent(ctx context.Context, in *convert.ReplayEventRequest) (*convert.ReplayEventResponse, error)
+	PurgeEvents(ctx context.Context, in *convert.PurgeEventsRequest) (*convert.PurgeEventsResponse, error)
+}
+
+func (g *grpcClient) ListEvents(ctx context.Context, in *convert.ListEventsRequest) (*convert.ListEventsResponse, error) {
+	protoResp, err := workspaceproto.NewEventServiceClient(g.conn).ListEvents(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.ListEventsResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
+func (g *grpcClient) GetEvent(ctx context.Context, in *convert.GetEventRequest) (*convert.GetEventResponse, error) {
+	protoResp, err := workspaceproto.NewEventServiceClient(g.conn).GetEvent(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.GetEventResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
+func (g *grpcClient) ReplayEvent(ctx context.Context, in *convert.ReplayEventRequest) (*convert.ReplayEventResponse, error) {
+	protoResp, err := workspaceproto.NewEventServiceClient(g.conn).ReplayEvent(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.ReplayEventResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
+func (g *grpcClient) PurgeEvents(ctx context.Context, in *convert.PurgeEventsRequest) (*convert.PurgeEventsResponse, error) {
+	protoResp, err := workspaceproto.NewEventServiceClient(g.conn).PurgeEvents(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.PurgeEventsResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
+func (h *httpClient) ListEvents(ctx context.Context, in *convert.ListEventsRequest) (*convert.ListEventsResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Get(h.url("admin/event"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.ListEventsResponse{}
+	err = convert.AssignFromHttpResponse(httpResp, out)
+	return out, err
+}
+
+func (h *httpClient) GetEvent(ctx context.Context, in *convert.GetEventRequest) (*convert.GetEventResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Get(h.url("admin/event/{id}"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.GetEventResponse{}
+	err = convert.AssignFromHttpResponse(httpResp, out)
+	return out, err
+}
+
+func (h *httpClient) ReplayEvent(ctx context.Context, in *convert.ReplayEventRequest) (*convert.ReplayEventResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Post(h.url("admin/event/{id}/replay"))
+	if err != nil {
+		return nil, err
+	}
+	// replay is accepted without response body
+	if _, err = convert.RawBodyFromHttpResponse(httpResp); err != nil {
+		return nil, err
+	}
+	return &convert.ReplayEventResponse{}, nil
+}
+
+func (h *httpClient) PurgeEvents(ctx context.Context, in *convert.PurgeEventsRequest) (*convert.PurgeEventsResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Delete(h.url("admin/event"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.PurgeEventsResponse{}
+	err = convert.AssignFromHttpResponse(httpResp, out)
+	return out, err
+}
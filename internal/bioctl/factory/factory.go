@@ -22,6 +22,7 @@ type Factory interface {
 	NotebookClient() (NotebookClient, error)
 	VersionClient() (VersionClient, error)
 	SubmissionClient() (SubmissionClient, error)
+	EventClient() (EventClient, error)
 }
 
 func NewFactory(opts *clioptions.ClientOptions) Factory {
@@ -115,6 +116,19 @@ func (f factoryImpl) SubmissionClient() (SubmissionClient, error) {
 	return nil, nil
 }
 
+func (f factoryImpl) EventClient() (EventClient, error) {
+	if err := f.opts.Method.Validate(); err != nil {
+		return nil, err
+	}
+	switch f.opts.Method {
+	case client.GRPCMethod:
+		return f.newGrpcClient()
+	case client.HTTPMethod:
+		return f.newHttpClient()
+	}
+	return nil, nil
+}
+
 func (f factoryImpl) WorkflowClient() (WorkflowClient, error) {
 	if err := f.opts.Method.Validate(); err != nil {
 		return nil, err
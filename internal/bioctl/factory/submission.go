@@ -2,6 +2,10 @@ package factory
 
 import (
 	"context"
+	"errors"
+	"io"
+
+	"github.com/go-resty/resty/v2"
 
 	"github.com/Bio-OS/bioos/internal/bioctl/factory/convert"
 	submissionproto "github.com/Bio-OS/bioos/internal/context/submission/interface/grpc/proto"
@@ -12,9 +16,14 @@ type SubmissionClient interface {
 	CreateSubmission(ctx context.Context, in *convert.CreateSubmissionRequest) (*convert.CreateSubmissionResponse, error)
 	DeleteSubmission(ctx context.Context, in *convert.DeleteSubmissionRequest) (*convert.DeleteSubmissionResponse, error)
 	CancelSubmission(ctx context.Context, in *convert.CancelSubmissionRequest) (*convert.CancelSubmissionResponse, error)
+	RetrySubmission(ctx context.Context, in *convert.RetrySubmissionRequest) (*convert.RetrySubmissionResponse, error)
 	ListRuns(ctx context.Context, in *convert.ListRunsRequest) (*convert.ListRunsResponse, error)
 	CancelRun(ctx context.Context, in *convert.CancelRunRequest) (*convert.CancelRunResponse, error)
+	RerunRun(ctx context.Context, in *convert.RerunRunRequest) (*convert.RerunRunResponse, error)
 	ListTasks(ctx context.Context, in *convert.ListTasksRequest) (*convert.ListTasksResponse, error)
+	GetUsage(ctx context.Context, in *convert.GetUsageRequest) (*convert.GetUsageResponse, error)
+	// StreamTaskLog writes the task log to w until the stream ends.
+	StreamTaskLog(ctx context.Context, in *convert.StreamTaskLogRequest, w io.Writer) error
 }
 
 func (g *grpcClient) ListSubmissions(ctx context.Context, in *convert.ListSubmissionsRequest) (*convert.ListSubmissionsResponse, error) {
@@ -61,6 +70,17 @@ func (g *grpcClient) CancelSubmission(ctx context.Context, in *convert.CancelSub
 	return out, nil
 }
 
+func (g *grpcClient) RetrySubmission(ctx context.Context, in *convert.RetrySubmissionRequest) (*convert.RetrySubmissionResponse, error) {
+
+	protoResp, err := submissionproto.NewSubmissionServiceClient(g.conn).RetrySubmission(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.RetrySubmissionResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
 func (g *grpcClient) ListRuns(ctx context.Context, in *convert.ListRunsRequest) (*convert.ListRunsResponse, error) {
 
 	protoResp, err := submissionproto.NewSubmissionServiceClient(g.conn).ListRuns(ctx, in.ToGRPC())
@@ -83,6 +103,17 @@ func (g *grpcClient) CancelRun(ctx context.Context, in *convert.CancelRunRequest
 	return out, nil
 }
 
+func (g *grpcClient) RerunRun(ctx context.Context, in *convert.RerunRunRequest) (*convert.RerunRunResponse, error) {
+
+	protoResp, err := submissionproto.NewSubmissionServiceClient(g.conn).RerunRun(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.RerunRunResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
 func (g *grpcClient) ListTasks(ctx context.Context, in *convert.ListTasksRequest) (*convert.ListTasksResponse, error) {
 
 	protoResp, err := submissionproto.NewSubmissionServiceClient(g.conn).ListTasks(ctx, in.ToGRPC())
@@ -94,6 +125,36 @@ func (g *grpcClient) ListTasks(ctx context.Context, in *convert.ListTasksRequest
 	return out, nil
 }
 
+func (g *grpcClient) GetUsage(ctx context.Context, in *convert.GetUsageRequest) (*convert.GetUsageResponse, error) {
+
+	protoResp, err := submissionproto.NewSubmissionServiceClient(g.conn).GetUsage(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.GetUsageResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
+func (g *grpcClient) StreamTaskLog(ctx context.Context, in *convert.StreamTaskLogRequest, w io.Writer) error {
+	stream, err := submissionproto.NewSubmissionServiceClient(g.conn).StreamTaskLog(ctx, in.ToGRPC())
+	if err != nil {
+		return err
+	}
+	for {
+		protoResp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(protoResp.GetContent()); err != nil {
+			return err
+		}
+	}
+}
+
 func (h *httpClient) ListSubmissions(ctx context.Context, in *convert.ListSubmissionsRequest) (*convert.ListSubmissionsResponse, error) {
 	req := h.restR(ctx)
 	convert.AssignToHttpRequest(in, req)
@@ -142,6 +203,18 @@ func (h *httpClient) CancelSubmission(ctx context.Context, in *convert.CancelSub
 	return out, nil
 }
 
+func (h *httpClient) RetrySubmission(ctx context.Context, in *convert.RetrySubmissionRequest) (*convert.RetrySubmissionResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Post(h.url("workspace/{workspace_id}/submission/{id}/retry"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.RetrySubmissionResponse{}
+	convert.AssignFromHttpResponse(httpResp, out)
+	return out, nil
+}
+
 func (h *httpClient) ListRuns(ctx context.Context, in *convert.ListRunsRequest) (*convert.ListRunsResponse, error) {
 	req := h.restR(ctx)
 	convert.AssignToHttpRequest(in, req)
@@ -166,6 +239,18 @@ func (h *httpClient) CancelRun(ctx context.Context, in *convert.CancelRunRequest
 	return out, nil
 }
 
+func (h *httpClient) RerunRun(ctx context.Context, in *convert.RerunRunRequest) (*convert.RerunRunResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Post(h.url("workspace/{workspace_id}/submission/{submission_id}/run/{id}/rerun"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.RerunRunResponse{}
+	convert.AssignFromHttpResponse(httpResp, out)
+	return out, nil
+}
+
 func (h *httpClient) ListTasks(ctx context.Context, in *convert.ListTasksRequest) (*convert.ListTasksResponse, error) {
 	req := h.restR(ctx)
 	convert.AssignToHttpRequest(in, req)
@@ -177,3 +262,38 @@ func (h *httpClient) ListTasks(ctx context.Context, in *convert.ListTasksRequest
 	convert.AssignFromHttpResponse(httpResp, out)
 	return out, nil
 }
+
+func (h *httpClient) GetUsage(ctx context.Context, in *convert.GetUsageRequest) (*convert.GetUsageResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Get(h.url("workspace/{workspace_id}/usage"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.GetUsageResponse{}
+	convert.AssignFromHttpResponse(httpResp, out)
+	return out, nil
+}
+
+func (h *httpClient) StreamTaskLog(ctx context.Context, in *convert.StreamTaskLogRequest, w io.Writer) error {
+	// the log keeps streaming until the task finished if follow, so the timeout of client is not applied
+	client := *h.rest.GetClient()
+	client.Timeout = 0
+	req := resty.NewWithClient(&client).R().SetContext(ctx).SetDoNotParseResponse(true)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Get(h.url("workspace/{workspace_id}/submission/{submission_id}/run/{run_id}/task/{name}/log"))
+	if err != nil {
+		return err
+	}
+	body := httpResp.RawBody()
+	defer body.Close()
+	if httpResp.StatusCode() >= 400 {
+		content, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		return errors.New(string(content))
+	}
+	_, err = io.Copy(w, body)
+	return err
+}
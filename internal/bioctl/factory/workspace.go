@@ -2,6 +2,7 @@ package factory
 
 import (
 	"context"
+	"errors"
 	"io"
 	"os"
 	"path"
@@ -17,6 +18,14 @@ type WorkspaceClient interface {
 	ListWorkspaces(ctx context.Context, in *convert.ListWorkspacesRequest) (*convert.ListWorkspacesResponse, error)
 	ImportWorkspace(ctx context.Context, in *convert.ImportWorkspaceRequest) (*convert.ImportWorkspaceResponse, error)
 	GetWorkspace(ctx context.Context, in *convert.GetWorkspaceRequest) (*convert.GetWorkspaceResponse, error)
+	GetImportJob(ctx context.Context, in *convert.GetImportJobRequest) (*convert.GetImportJobResponse, error)
+	// ExportWorkspace writes the exported zip of workspace to w.
+	ExportWorkspace(ctx context.Context, in *convert.ExportWorkspaceRequest, w io.Writer) error
+	CloneWorkspace(ctx context.Context, in *convert.CloneWorkspaceRequest) (*convert.CloneWorkspaceResponse, error)
+	ListWorkspaceMembers(ctx context.Context, in *convert.ListWorkspaceMembersRequest) (*convert.ListWorkspaceMembersResponse, error)
+	AddWorkspaceMember(ctx context.Context, in *convert.AddWorkspaceMemberRequest) (*convert.AddWorkspaceMemberResponse, error)
+	UpdateWorkspaceMember(ctx context.Context, in *convert.UpdateWorkspaceMemberRequest) (*convert.UpdateWorkspaceMemberResponse, error)
+	DeleteWorkspaceMember(ctx context.Context, in *convert.DeleteWorkspaceMemberRequest) (*convert.DeleteWorkspaceMemberResponse, error)
 }
 
 func (g *grpcClient) CreateWorkspace(ctx context.Context, in *convert.CreateWorkspaceRequest) (*convert.CreateWorkspaceResponse, error) {
@@ -122,6 +131,85 @@ func (g *grpcClient) ImportWorkspace(ctx context.Context, in *convert.ImportWork
 	return out, nil
 }
 
+func (g *grpcClient) GetImportJob(ctx context.Context, in *convert.GetImportJobRequest) (*convert.GetImportJobResponse, error) {
+	protoResp, err := workspaceproto.NewWorkspaceServiceClient(g.conn).GetImportJob(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.GetImportJobResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
+func (g *grpcClient) CloneWorkspace(ctx context.Context, in *convert.CloneWorkspaceRequest) (*convert.CloneWorkspaceResponse, error) {
+	protoResp, err := workspaceproto.NewWorkspaceServiceClient(g.conn).CloneWorkspace(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.CloneWorkspaceResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
+func (g *grpcClient) ExportWorkspace(ctx context.Context, in *convert.ExportWorkspaceRequest, w io.Writer) error {
+	stream, err := workspaceproto.NewWorkspaceServiceClient(g.conn).ExportWorkspace(ctx, in.ToGRPC())
+	if err != nil {
+		return err
+	}
+	for {
+		protoResp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(protoResp.GetContent()); err != nil {
+			return err
+		}
+	}
+}
+
+func (g *grpcClient) ListWorkspaceMembers(ctx context.Context, in *convert.ListWorkspaceMembersRequest) (*convert.ListWorkspaceMembersResponse, error) {
+	protoResp, err := workspaceproto.NewWorkspaceServiceClient(g.conn).ListWorkspaceMembers(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.ListWorkspaceMembersResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
+func (g *grpcClient) AddWorkspaceMember(ctx context.Context, in *convert.AddWorkspaceMemberRequest) (*convert.AddWorkspaceMemberResponse, error) {
+	protoResp, err := workspaceproto.NewWorkspaceServiceClient(g.conn).AddWorkspaceMember(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.AddWorkspaceMemberResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
+func (g *grpcClient) UpdateWorkspaceMember(ctx context.Context, in *convert.UpdateWorkspaceMemberRequest) (*convert.UpdateWorkspaceMemberResponse, error) {
+	protoResp, err := workspaceproto.NewWorkspaceServiceClient(g.conn).UpdateWorkspaceMember(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.UpdateWorkspaceMemberResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
+func (g *grpcClient) DeleteWorkspaceMember(ctx context.Context, in *convert.DeleteWorkspaceMemberRequest) (*convert.DeleteWorkspaceMemberResponse, error) {
+	protoResp, err := workspaceproto.NewWorkspaceServiceClient(g.conn).DeleteWorkspaceMember(ctx, in.ToGRPC())
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.DeleteWorkspaceMemberResponse{}
+	out.FromGRPC(protoResp)
+	return out, nil
+}
+
 func (h *httpClient) CreateWorkspace(ctx context.Context, in *convert.CreateWorkspaceRequest) (*convert.CreateWorkspaceResponse, error) {
 	req := h.restR(ctx)
 	convert.AssignToHttpRequest(in, req)
@@ -203,3 +291,95 @@ func (h *httpClient) GetWorkspace(ctx context.Context, in *convert.GetWorkspaceR
 	convert.AssignFromHttpResponse(httpResp, out)
 	return out, nil
 }
+
+func (h *httpClient) GetImportJob(ctx context.Context, in *convert.GetImportJobRequest) (*convert.GetImportJobResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Get(h.url("workspace/import/{id}"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.GetImportJobResponse{}
+	convert.AssignFromHttpResponse(httpResp, out)
+	return out, nil
+}
+
+func (h *httpClient) CloneWorkspace(ctx context.Context, in *convert.CloneWorkspaceRequest) (*convert.CloneWorkspaceResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Post(h.url("workspace/{id}/clone"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.CloneWorkspaceResponse{}
+	convert.AssignFromHttpResponse(httpResp, out)
+	return out, nil
+}
+
+func (h *httpClient) ExportWorkspace(ctx context.Context, in *convert.ExportWorkspaceRequest, w io.Writer) error {
+	req := h.restR(ctx).SetDoNotParseResponse(true)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Get(h.url("workspace/{id}/export"))
+	if err != nil {
+		return err
+	}
+	body := httpResp.RawBody()
+	defer body.Close()
+	if httpResp.StatusCode() >= 400 {
+		content, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		return errors.New(string(content))
+	}
+	_, err = io.Copy(w, body)
+	return err
+}
+
+func (h *httpClient) ListWorkspaceMembers(ctx context.Context, in *convert.ListWorkspaceMembersRequest) (*convert.ListWorkspaceMembersResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Get(h.url("workspace/{id}/member"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.ListWorkspaceMembersResponse{}
+	convert.AssignFromHttpResponse(httpResp, out)
+	return out, nil
+}
+
+func (h *httpClient) AddWorkspaceMember(ctx context.Context, in *convert.AddWorkspaceMemberRequest) (*convert.AddWorkspaceMemberResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Post(h.url("workspace/{id}/member"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.AddWorkspaceMemberResponse{}
+	convert.AssignFromHttpResponse(httpResp, out)
+	return out, nil
+}
+
+func (h *httpClient) UpdateWorkspaceMember(ctx context.Context, in *convert.UpdateWorkspaceMemberRequest) (*convert.UpdateWorkspaceMemberResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Patch(h.url("workspace/{id}/member/{name}"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.UpdateWorkspaceMemberResponse{}
+	convert.AssignFromHttpResponse(httpResp, out)
+	return out, nil
+}
+
+func (h *httpClient) DeleteWorkspaceMember(ctx context.Context, in *convert.DeleteWorkspaceMemberRequest) (*convert.DeleteWorkspaceMemberResponse, error) {
+	req := h.restR(ctx)
+	convert.AssignToHttpRequest(in, req)
+	httpResp, err := req.Delete(h.url("workspace/{id}/member/{name}"))
+	if err != nil {
+		return nil, err
+	}
+	out := &convert.DeleteWorkspaceMemberResponse{}
+	convert.AssignFromHttpResponse(httpResp, out)
+	return out, nil
+}
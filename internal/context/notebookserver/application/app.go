@@ -99,8 +99,10 @@ func NewService(ctx context.Context, opts *options.Options, workspaceService pro
 		eventbus.WithMaxRetries(opts.EventBusOption.MaxRetries),
 		eventbus.WithSyncPeriod(opts.EventBusOption.SyncPeriod),
 		eventbus.WithBatchSize(opts.EventBusOption.BatchSize),
+		eventbus.WithRetryPolicies(opts.EventBusOption.DefaultRetryPolicy(), opts.EventBusOption.RetryPolicies),
+		eventbus.WithLeaseDuration(opts.EventBusOption.LeaseDuration),
 	}
-	if eventBus, err = eventbus.NewEventBus(eventRepo, eOpts...); err != nil {
+	if eventBus, err = eventbus.NewEventBusOfBackend(opts.EventBusOption, eventRepo, "notebookserver", eOpts...); err != nil {
 		return nil, err
 	}
 	go func() {
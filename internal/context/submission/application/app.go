@@ -14,7 +14,9 @@ import (
 	"github.com/Bio-OS/bioos/internal/context/submission/domain/run"
 	"github.com/Bio-OS/bioos/internal/context/submission/domain/submission"
 	"github.com/Bio-OS/bioos/internal/context/submission/infrastructure/client/wes"
+	runmongopo "github.com/Bio-OS/bioos/internal/context/submission/infrastructure/persistence/run/mongo"
 	runsqlpo "github.com/Bio-OS/bioos/internal/context/submission/infrastructure/persistence/run/sql"
+	submissionmongopo "github.com/Bio-OS/bioos/internal/context/submission/infrastructure/persistence/submission/mongo"
 	submissionsqlpo "github.com/Bio-OS/bioos/internal/context/submission/infrastructure/persistence/submission/sql"
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
 	eventmongopo "github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/persistence/event/mongo"
@@ -55,7 +57,7 @@ func NewSubmissionService(ctx context.Context, opts *options.Options) (*Submissi
 		eventRepo           eventbus.EventRepository
 		eventBus            eventbus.EventBus
 		grpcFactory         grpc.Factory
-		wesClient           wes.Client
+		wesRegistry         wes.Registry
 	)
 
 	if opts.DBOption.Mongo != nil && opts.DBOption.Mongo.Enabled() {
@@ -63,7 +65,18 @@ func NewSubmissionService(ctx context.Context, opts *options.Options) (*Submissi
 		if err != nil {
 			return nil, fmt.Errorf("get mongo db client fail: %w", err)
 		}
-		// todo add submission&run mongo repository and read model
+		if submissionRepo, err = submissionmongopo.NewSubmissionRepository(ctx, mongoDB); err != nil {
+			return nil, fmt.Errorf("new mongodb repository fail: %w", err)
+		}
+		if submissionReadModel, err = submissionmongopo.NewSubmissionReadModel(ctx, mongoDB); err != nil {
+			return nil, fmt.Errorf("new mongodb read model fail: %w", err)
+		}
+		if runRepo, err = runmongopo.NewRunRepository(ctx, mongoDB); err != nil {
+			return nil, fmt.Errorf("new mongodb repository fail: %w", err)
+		}
+		if runReadModel, err = runmongopo.NewRunReadModel(ctx, mongoDB); err != nil {
+			return nil, fmt.Errorf("new mongodb read model fail: %w", err)
+		}
 		if eventRepo, err = eventmongopo.NewEventRepository(ctx, mongoDB, opts.EventBusOption.DequeueTimeout, opts.EventBusOption.RunningTimeout); err != nil {
 			return nil, fmt.Errorf("new mongodb event repository fail: %w", err)
 		}
@@ -109,14 +122,16 @@ func NewSubmissionService(ctx context.Context, opts *options.Options) (*Submissi
 		grpcFactory = grpc.NewFactory(opts.Client)
 	}
 
-	wesClient = wes.NewClient(opts.WesOption)
+	wesRegistry = wes.NewRegistry(opts.WesOption)
 
 	eOpts := []eventbus.Option{
 		eventbus.WithMaxRetries(opts.EventBusOption.MaxRetries),
 		eventbus.WithSyncPeriod(opts.EventBusOption.SyncPeriod),
 		eventbus.WithBatchSize(opts.EventBusOption.BatchSize),
+		eventbus.WithRetryPolicies(opts.EventBusOption.DefaultRetryPolicy(), opts.EventBusOption.RetryPolicies),
+		eventbus.WithLeaseDuration(opts.EventBusOption.LeaseDuration),
 	}
-	if eventBus, err = eventbus.NewEventBus(eventRepo, eOpts...); err != nil {
+	if eventBus, err = eventbus.NewEventBusOfBackend(opts.EventBusOption, eventRepo, "submission", eOpts...); err != nil {
 		return nil, err
 	}
 	go func() {
@@ -126,11 +141,16 @@ func NewSubmissionService(ctx context.Context, opts *options.Options) (*Submissi
 		}
 	}()
 
-	submissionFactory := submission.NewSubmissionFactory(ctx)
+	reconciler := run.NewReconciler(wesRegistry, runRepo, eventBus)
+	if runRepo != nil && opts.WesOption.ReconcilePeriod > 0 {
+		go reconciler.Start(ctx, opts.WesOption.ReconcilePeriod)
+	}
+
+	submissionFactory := submission.NewSubmissionFactory(ctx, opts.WesOption.MaxConcurrentRuns)
 	return &SubmissionService{
-		SubmissionCommands: submissioncommand.NewCommands(grpcFactory, submissionRepo, submissionFactory, eventBus, submissionReadModel, runReadModel),
+		SubmissionCommands: submissioncommand.NewCommands(grpcFactory, submissionRepo, submissionFactory, eventBus, submissionReadModel, runReadModel, runRepo, wesRegistry),
 		SubmissionQueries:  submissionquery.NewQueries(grpcFactory, submissionReadModel),
-		RunCommands:        runcommand.NewCommands(grpcFactory, runRepo, eventBus, submissionReadModel, wesClient),
+		RunCommands:        runcommand.NewCommands(grpcFactory, runRepo, eventBus, submissionReadModel, wesRegistry, reconciler),
 		RunQueries:         runquery.NewQueries(grpcFactory, runReadModel, submissionReadModel),
 		closer:             dbCloser,
 	}, nil
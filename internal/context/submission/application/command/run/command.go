@@ -14,13 +14,46 @@ type CancelRunCommand struct {
 	ID           string `validate:"required"`
 }
 
+type RerunRunCommand struct {
+	WorkspaceID  string `validate:"required"`
+	SubmissionID string `validate:"required"`
+	ID           string `validate:"required"`
+}
+
+type ReconcileRunsCommand struct {
+	// DryRun only reports what would be done
+	DryRun bool
+}
+
+// ReconcileReport is the result of reconciling active runs with the runs of WES backends.
+type ReconcileReport struct {
+	DryRun     bool
+	StartTime  int64
+	FinishTime int64
+	Checked    int
+	Items      []ReconcileItem
+}
+
+type ReconcileItem struct {
+	RunID         string
+	SubmissionID  string
+	EngineBackend string
+	EngineRunID   string
+	Action        string
+	Message       string
+}
+
 type Commands struct {
-	CancelRun CancelRunHandler
+	CancelRun     CancelRunHandler
+	RerunRun      RerunRunHandler
+	ReconcileRuns ReconcileRunsHandler
 }
 
-func NewCommands(grpcFactory grpc.Factory, runRepo run.Repository, eventBus eventbus.EventBus, submissionReadModel submission.ReadModel, wesClient wes.Client) *Commands {
-	service := run.NewService(grpcFactory, runRepo, eventBus, wesClient)
+func NewCommands(grpcFactory grpc.Factory, runRepo run.Repository, eventBus eventbus.EventBus, submissionReadModel submission.ReadModel, wesRegistry wes.Registry, reconciler *run.Reconciler) *Commands {
+	service := run.NewService(grpcFactory, runRepo, eventBus, wesRegistry)
 	return &Commands{
-		CancelRun: NewCancelRunHandler(service, eventBus, submissionReadModel),
+		CancelRun:     NewCancelRunHandler(service, eventBus, submissionReadModel),
+		RerunRun:      NewRerunRunHandler(service, eventBus, submissionReadModel),
+		ReconcileRuns: NewReconcileRunsHandler(reconciler),
 	}
 }
@@ -0,0 +1,56 @@
+package run
+
+import (
+	"context"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/run"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type ReconcileRunsHandler interface {
+	Handle(ctx context.Context, cmd *ReconcileRunsCommand) (*ReconcileReport, error)
+}
+
+type reconcileRunsHandler struct {
+	reconciler *run.Reconciler
+}
+
+var _ ReconcileRunsHandler = &reconcileRunsHandler{}
+
+func NewReconcileRunsHandler(reconciler *run.Reconciler) ReconcileRunsHandler {
+	return &reconcileRunsHandler{
+		reconciler: reconciler,
+	}
+}
+
+func (r *reconcileRunsHandler) Handle(ctx context.Context, cmd *ReconcileRunsCommand) (*ReconcileReport, error) {
+	if err := validator.Validate(cmd); err != nil {
+		return nil, err
+	}
+	report, err := r.reconciler.Reconcile(ctx, cmd.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return reconcileReportDoToDto(report), nil
+}
+
+func reconcileReportDoToDto(report *run.ReconcileReport) *ReconcileReport {
+	items := make([]ReconcileItem, len(report.Items))
+	for i, item := range report.Items {
+		items[i] = ReconcileItem{
+			RunID:         item.RunID,
+			SubmissionID:  item.SubmissionID,
+			EngineBackend: item.EngineBackend,
+			EngineRunID:   item.EngineRunID,
+			Action:        item.Action,
+			Message:       item.Message,
+		}
+	}
+	return &ReconcileReport{
+		DryRun:     report.DryRun,
+		StartTime:  report.StartTime.Unix(),
+		FinishTime: report.FinishTime.Unix(),
+		Checked:    report.Checked,
+		Items:      items,
+	}
+}
@@ -0,0 +1,44 @@
+package run
+
+import (
+	"context"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/application/query/submission"
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/run"
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type RerunRunHandler interface {
+	Handle(ctx context.Context, cmd *RerunRunCommand) error
+}
+
+type rerunRunHandler struct {
+	service             run.Service
+	eventBus            eventbus.EventBus
+	submissionReadModel submission.ReadModel
+}
+
+var _ RerunRunHandler = &rerunRunHandler{}
+
+func NewRerunRunHandler(service run.Service, eventBus eventbus.EventBus, submissionReadModel submission.ReadModel) RerunRunHandler {
+	return &rerunRunHandler{
+		service:             service,
+		eventBus:            eventBus,
+		submissionReadModel: submissionReadModel,
+	}
+}
+
+func (c *rerunRunHandler) Handle(ctx context.Context, cmd *RerunRunCommand) error {
+	if err := validator.Validate(cmd); err != nil {
+		return err
+	}
+
+	if err := c.service.CheckWorkspaceExist(ctx, cmd.WorkspaceID); err != nil {
+		return err
+	}
+	if err := submission.CheckSubmissionExist(ctx, c.submissionReadModel, cmd.WorkspaceID, cmd.SubmissionID); err != nil {
+		return err
+	}
+	return c.service.Rerun(ctx, cmd.SubmissionID, cmd.ID)
+}
@@ -4,19 +4,25 @@ import (
 	"github.com/Bio-OS/bioos/internal/context/submission/application/query/run"
 	submissionquery "github.com/Bio-OS/bioos/internal/context/submission/application/query/submission"
 	"github.com/Bio-OS/bioos/internal/context/submission/domain/submission"
+	"github.com/Bio-OS/bioos/internal/context/submission/infrastructure/client/wes"
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
 	"github.com/Bio-OS/bioos/pkg/utils/grpc"
 )
 
 type CreateSubmissionCommand struct {
-	WorkspaceID    string  `validate:"required"`
-	Name           string  `validate:"required,submissionName"`
-	WorkflowID     string  `validate:"required"`
-	Description    *string `validate:"omitempty,submissionDesc"`
-	Type           string  `validate:"required,oneof=dataModel filePath"`
-	Entity         *Entity
-	ExposedOptions ExposedOptions
-	InOutMaterial  *InOutMaterial
+	WorkspaceID       string `validate:"required"`
+	Name              string `validate:"required,submissionName"`
+	WorkflowID        string `validate:"required"`
+	WorkflowVersionID string
+	Description       *string `validate:"omitempty,submissionDesc"`
+	Type              string  `validate:"required,oneof=dataModel filePath"`
+	Entity            *Entity
+	ExposedOptions    ExposedOptions
+	InOutMaterial     *InOutMaterial
+	// MaxConcurrentRuns 0 means using the default of server
+	MaxConcurrentRuns int `validate:"min=0"`
+	// EngineBackend overrides the default WES backend of workspace
+	EngineBackend string `validate:"omitempty,max=64"`
 }
 
 type Entity struct {
@@ -45,17 +51,28 @@ type CancelSubmissionCommand struct {
 	ID          string `validate:"required"`
 }
 
+type RetrySubmissionCommand struct {
+	WorkspaceID string `validate:"required"`
+	ID          string `validate:"required"`
+	// Name of the new submission, generated from the retried one if empty
+	Name string `validate:"omitempty,submissionName"`
+	// Status of runs to retry, Failed if empty
+	Status []string `validate:"unique,dive,oneof=Failed Cancelled"`
+}
+
 type Commands struct {
 	CreateSubmission CreateSubmissionHandler
 	DeleteSubmission DeleteSubmissionHandler
 	CancelSubmission CancelSubmissionHandler
+	RetrySubmission  RetrySubmissionHandler
 }
 
-func NewCommands(grpcFactory grpc.Factory, submissionRepo submission.Repository, submissionFactory *submission.Factory, eventBus eventbus.EventBus, submissionReadModel submissionquery.ReadModel, runReadModel run.ReadModel) *Commands {
-	service := submission.NewService(grpcFactory, submissionRepo, eventBus, submissionReadModel, runReadModel)
+func NewCommands(grpcFactory grpc.Factory, submissionRepo submission.Repository, submissionFactory *submission.Factory, eventBus eventbus.EventBus, submissionReadModel submissionquery.ReadModel, runReadModel run.ReadModel, runQueue submission.RunQueue, wesRegistry wes.Registry) *Commands {
+	service := submission.NewService(grpcFactory, submissionRepo, eventBus, submissionReadModel, runReadModel, runQueue, wesRegistry)
 	return &Commands{
 		CreateSubmission: NewCreateSubmissionHandler(service, submissionFactory, eventBus),
 		DeleteSubmission: NewDeleteSubmissionHandler(service, eventBus),
 		CancelSubmission: NewCancelSubmissionHandler(service, eventBus),
+		RetrySubmission:  NewRetrySubmissionHandler(service, submissionFactory, eventBus),
 	}
 }
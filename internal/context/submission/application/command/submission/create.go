@@ -8,6 +8,7 @@ import (
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
 	"github.com/Bio-OS/bioos/pkg/consts"
 	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
 	"github.com/Bio-OS/bioos/pkg/validator"
 )
 
@@ -44,17 +45,30 @@ func (c *createSubmissionHandler) Handle(ctx context.Context, cmd *CreateSubmiss
 		return "", err
 	}
 
+	workflowVersionID, err := c.service.GetSubmittableWorkflowVersion(ctx, cmd.WorkspaceID, cmd.WorkflowID, cmd.WorkflowVersionID)
+	if err != nil {
+		return "", err
+	}
+
+	engineBackend, err := c.service.GetEngineBackend(ctx, cmd.WorkspaceID, cmd.EngineBackend)
+	if err != nil {
+		return "", err
+	}
+
 	param := submission.CreateSubmissionParam{
-		Name:        cmd.Name,
-		Description: cmd.Description,
-		WorkflowID:  cmd.WorkflowID,
-		WorkspaceID: cmd.WorkspaceID,
-		Type:        cmd.Type,
+		Name:              cmd.Name,
+		Description:       cmd.Description,
+		WorkflowID:        cmd.WorkflowID,
+		WorkflowVersionID: workflowVersionID,
+		WorkspaceID:       cmd.WorkspaceID,
+		Type:              cmd.Type,
 		ExposedOptions: submission.ExposedOptions{
 			ReadFromCache: cmd.ExposedOptions.ReadFromCache,
 		},
-		Inputs:  make(map[string]interface{}),
-		Outputs: make(map[string]interface{}),
+		Inputs:            make(map[string]interface{}),
+		Outputs:           make(map[string]interface{}),
+		MaxConcurrentRuns: cmd.MaxConcurrentRuns,
+		EngineBackend:     engineBackend,
 	}
 
 	switch param.Type {
@@ -76,6 +90,12 @@ func (c *createSubmissionHandler) Handle(ctx context.Context, cmd *CreateSubmiss
 			}
 			param.Outputs = output
 		}
+		// the inputs are read from snapshot, so they stay traceable after the data model changes
+		snapshotID, err := c.service.SnapshotDataModel(ctx, cmd.WorkspaceID, cmd.Entity.DataModelID, cmd.Name)
+		if err != nil {
+			return "", err
+		}
+		param.DataModelSnapshotID = &snapshotID
 	case consts.FilePathTypeSubmission:
 		if cmd.InOutMaterial.OutputsMaterial != "" {
 			var output map[string]interface{}
@@ -95,10 +115,23 @@ func (c *createSubmissionHandler) Handle(ctx context.Context, cmd *CreateSubmiss
 	}
 	sub, err := c.submissionFactory.CreateWithSubmissionParam(param)
 	if err != nil {
+		c.deleteDataModelSnapshot(ctx, &param)
 		return "", err
 	}
 	if err = c.service.Create(ctx, sub); err != nil {
+		c.deleteDataModelSnapshot(ctx, &param)
 		return "", err
 	}
 	return sub.ID, nil
 }
+
+// deleteDataModelSnapshot deletes the snapshot taken for the submission failed to create, so that
+// it is not left behind without any submission reading from it.
+func (c *createSubmissionHandler) deleteDataModelSnapshot(ctx context.Context, param *submission.CreateSubmissionParam) {
+	if param.DataModelSnapshotID == nil {
+		return
+	}
+	if err := c.service.DeleteDataModelSnapshot(ctx, param.WorkspaceID, *param.DataModelID, *param.DataModelSnapshotID); err != nil {
+		applog.Errorw("failed to delete data model snapshot of submission", "snapshotID", *param.DataModelSnapshotID, "err", err)
+	}
+}
@@ -0,0 +1,46 @@
+package submission
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/submission"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+)
+
+func TestCreateSubmission(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+
+	svc := &fakeService{}
+	handler := NewCreateSubmissionHandler(svc, submission.NewSubmissionFactory(ctx, 10), nil)
+	cmd := &CreateSubmissionCommand{
+		WorkspaceID: "ws-1",
+		Name:        "call-samples",
+		WorkflowID:  "wf-1",
+		Type:        consts.DataModelTypeSubmission,
+		Entity: &Entity{
+			DataModelID:     "dm-1",
+			DataModelRowIDs: []string{"s1", "s2"},
+			InputsTemplate:  `{"wf.bam": "this.bam"}`,
+		},
+	}
+
+	// the inputs are read from the snapshot taken on creating
+	id, err := handler.Handle(ctx, cmd)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(svc.created).To(gomega.HaveLen(1))
+	g.Expect(svc.created[0].ID).To(gomega.Equal(id))
+	g.Expect(*svc.created[0].DataModelSnapshotID).To(gomega.Equal("snapshot-of-dm-1"))
+	g.Expect(svc.deletedSnapshots).To(gomega.BeEmpty())
+
+	// the snapshot is deleted if the submission fails to create
+	svc.createErr = apperrors.NewInternalError(context.DeadlineExceeded)
+	_, err = handler.Handle(ctx, cmd)
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(svc.created).To(gomega.HaveLen(1))
+	g.Expect(svc.deletedSnapshots).To(gomega.Equal([]string{"snapshot-of-dm-1"}))
+}
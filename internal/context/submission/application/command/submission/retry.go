@@ -0,0 +1,143 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/submission"
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/utils"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+const retrySubmissionNameInfix = "-retry-"
+
+type RetrySubmissionHandler interface {
+	Handle(ctx context.Context, cmd *RetrySubmissionCommand) (string, error)
+}
+
+type retrySubmissionHandler struct {
+	service           submission.Service
+	submissionFactory *submission.Factory
+	eventBus          eventbus.EventBus
+}
+
+var _ RetrySubmissionHandler = &retrySubmissionHandler{}
+
+func NewRetrySubmissionHandler(service submission.Service, submissionFactory *submission.Factory, eventBus eventbus.EventBus) RetrySubmissionHandler {
+	return &retrySubmissionHandler{
+		service:           service,
+		submissionFactory: submissionFactory,
+		eventBus:          eventBus,
+	}
+}
+
+// Handle creates a new submission from the retried one, which only contains runs of the retried submission in
+// the specified status.
+func (c *retrySubmissionHandler) Handle(ctx context.Context, cmd *RetrySubmissionCommand) (string, error) {
+	if err := validator.Validate(cmd); err != nil {
+		return "", err
+	}
+
+	if err := c.service.CheckWorkspaceExist(ctx, cmd.WorkspaceID); err != nil {
+		return "", err
+	}
+
+	parent, err := c.service.Get(ctx, cmd.ID)
+	if err != nil {
+		return "", err
+	}
+	if parent.WorkspaceID != cmd.WorkspaceID {
+		return "", apperrors.NewNotFoundError("submission", cmd.ID)
+	}
+
+	status := cmd.Status
+	if len(status) == 0 {
+		status = []string{consts.RunFailed}
+	}
+	runNames, err := c.service.ListRunNames(ctx, parent.ID, status)
+	if err != nil {
+		return "", err
+	}
+	if len(runNames) == 0 {
+		return "", apperrors.NewInvalidError(fmt.Sprintf("submission %s has no run in status %s", parent.Name, strings.Join(status, ",")))
+	}
+
+	name := cmd.Name
+	if name == "" {
+		name = genRetrySubmissionName(parent.Name, time.Now())
+	}
+	if err := c.service.CheckSubmissionExist(ctx, cmd.WorkspaceID, name); err != nil {
+		return "", err
+	}
+
+	workflowVersionID, err := c.service.GetSubmittableWorkflowVersion(ctx, parent.WorkspaceID, parent.WorkflowID, parent.WorkflowVersionID)
+	if err != nil {
+		return "", err
+	}
+
+	// the backend of retried submission may be removed from config
+	engineBackend, err := c.service.GetEngineBackend(ctx, parent.WorkspaceID, parent.EngineBackend)
+	if err != nil {
+		return "", err
+	}
+
+	param := submission.CreateSubmissionParam{
+		Name:               name,
+		Description:        parent.Description,
+		WorkflowID:         parent.WorkflowID,
+		WorkflowVersionID:  workflowVersionID,
+		WorkspaceID:        parent.WorkspaceID,
+		Type:               parent.Type,
+		ParentSubmissionID: utils.PointString(parent.ID),
+		ExposedOptions:     parent.ExposedOptions,
+		Inputs:             parent.Inputs,
+		Outputs:            parent.Outputs,
+		MaxConcurrentRuns:  parent.MaxConcurrentRuns,
+		EngineBackend:      engineBackend,
+	}
+
+	switch param.Type {
+	case consts.DataModelTypeSubmission:
+		// runs of data model submission are named by data model row id
+		param.DataModelID = parent.DataModelID
+		param.DataModelRowIDs = runNames
+		// retried runs read the same inputs as the parent
+		param.DataModelSnapshotID = parent.DataModelSnapshotID
+	case consts.FilePathTypeSubmission:
+		// runs of file path submission are named by the key of inputs
+		inputs := make(map[string]interface{}, len(runNames))
+		for _, runName := range runNames {
+			if input, ok := parent.Inputs[runName]; ok {
+				inputs[runName] = input
+			}
+		}
+		param.Inputs = inputs
+	default:
+		return "", apperrors.NewInvalidError(fmt.Sprintf("unsupported submission type: %s", param.Type))
+	}
+
+	sub, err := c.submissionFactory.CreateWithSubmissionParam(param)
+	if err != nil {
+		return "", err
+	}
+	if err = c.service.Create(ctx, sub); err != nil {
+		return "", err
+	}
+	return sub.ID, nil
+}
+
+// genRetrySubmissionName returns name like ${parentName}-retry-2006-01-02-15-04-05, parentName will be truncated
+// if the name is too long.
+func genRetrySubmissionName(parentName string, now time.Time) string {
+	suffix := retrySubmissionNameInfix + now.Format("2006-01-02-15-04-05")
+	prefix := []rune(parentName)
+	if maxLength := validator.MaxSubmissionNameLength - len(suffix); len(prefix) > maxLength {
+		prefix = prefix[:maxLength]
+	}
+	return string(prefix) + suffix
+}
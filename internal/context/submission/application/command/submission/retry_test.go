@@ -0,0 +1,169 @@
+package submission
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/submission"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+func TestMain(m *testing.M) {
+	applog.RegisterLogger(&applog.Options{
+		Level: "fatal",
+	})
+	os.Exit(m.Run())
+}
+
+// fakeService has the submissions by id and the names of their failed runs, the submissions created
+// and the data model snapshots deleted are recorded. Creating submission fails with createErr if set.
+type fakeService struct {
+	submission.Service
+	submissions      map[string]*submission.Submission
+	failedRuns       map[string][]string
+	created          []*submission.Submission
+	createErr        error
+	deletedSnapshots []string
+}
+
+func (f *fakeService) CheckWorkspaceExist(context.Context, string) error {
+	return nil
+}
+
+func (f *fakeService) CheckSubmissionExist(context.Context, string, string) error {
+	return nil
+}
+
+func (f *fakeService) Get(_ context.Context, id string) (*submission.Submission, error) {
+	sub, ok := f.submissions[id]
+	if !ok {
+		return nil, apperrors.NewNotFoundError("submission", id)
+	}
+	return sub, nil
+}
+
+func (f *fakeService) ListRunNames(_ context.Context, submissionID string, status []string) ([]string, error) {
+	if utils.In(consts.RunFailed, status) {
+		return f.failedRuns[submissionID], nil
+	}
+	return nil, nil
+}
+
+func (f *fakeService) GetSubmittableWorkflowVersion(_ context.Context, _, _, workflowVersionID string) (string, error) {
+	return workflowVersionID, nil
+}
+
+func (f *fakeService) GetEngineBackend(_ context.Context, _, engineBackend string) (string, error) {
+	if engineBackend == "" {
+		return "default", nil
+	}
+	return engineBackend, nil
+}
+
+func (f *fakeService) Create(_ context.Context, sub *submission.Submission) error {
+	if f.createErr != nil {
+		return f.createErr
+	}
+	f.created = append(f.created, sub)
+	return nil
+}
+
+func (f *fakeService) SnapshotDataModel(_ context.Context, _, dataModelID, _ string) (string, error) {
+	return "snapshot-of-" + dataModelID, nil
+}
+
+func (f *fakeService) DeleteDataModelSnapshot(_ context.Context, _, _, snapshotID string) error {
+	f.deletedSnapshots = append(f.deletedSnapshots, snapshotID)
+	return nil
+}
+
+func TestRetrySubmission(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+
+	svc := &fakeService{
+		submissions: map[string]*submission.Submission{
+			"sub-file": {
+				ID:                "sub-file",
+				Name:              "align",
+				WorkspaceID:       "ws-1",
+				WorkflowID:        "wf-1",
+				WorkflowVersionID: "v1",
+				Type:              consts.FilePathTypeSubmission,
+				Inputs:            map[string]interface{}{"a": "s3://bucket/a.bam", "b": "s3://bucket/b.bam", "c": "s3://bucket/c.bam"},
+				MaxConcurrentRuns: 2,
+				EngineBackend:     "hpc",
+			},
+			"sub-data-model": {
+				ID:                  "sub-data-model",
+				Name:                "call",
+				WorkspaceID:         "ws-1",
+				WorkflowID:          "wf-1",
+				WorkflowVersionID:   "v1",
+				Type:                consts.DataModelTypeSubmission,
+				Inputs:              map[string]interface{}{"wf.bam": "this.bam"},
+				DataModelID:         utils.PointString("dm-1"),
+				DataModelRowIDs:     []string{"s1", "s2", "s3"},
+				DataModelSnapshotID: utils.PointString("ds-1"),
+			},
+		},
+		failedRuns: map[string][]string{
+			"sub-file":       {"a", "c"},
+			"sub-data-model": {"s2"},
+		},
+	}
+	handler := NewRetrySubmissionHandler(svc, submission.NewSubmissionFactory(ctx, 10), nil)
+
+	// only failed inputs of file path submission are retried
+	id, err := handler.Handle(ctx, &RetrySubmissionCommand{WorkspaceID: "ws-1", ID: "sub-file"})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(svc.created).To(gomega.HaveLen(1))
+	sub := svc.created[0]
+	g.Expect(sub.ID).To(gomega.Equal(id))
+	g.Expect(sub.Name).To(gomega.HavePrefix("align" + retrySubmissionNameInfix))
+	g.Expect(*sub.ParentSubmissionID).To(gomega.Equal("sub-file"))
+	g.Expect(sub.WorkflowVersionID).To(gomega.Equal("v1"))
+	g.Expect(sub.Inputs).To(gomega.Equal(map[string]interface{}{"a": "s3://bucket/a.bam", "c": "s3://bucket/c.bam"}))
+	g.Expect(sub.MaxConcurrentRuns).To(gomega.Equal(2))
+	g.Expect(sub.EngineBackend).To(gomega.Equal("hpc"))
+
+	// only failed rows of data model submission are retried from the same snapshot
+	_, err = handler.Handle(ctx, &RetrySubmissionCommand{WorkspaceID: "ws-1", ID: "sub-data-model", Name: "call-history-again"})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(svc.created).To(gomega.HaveLen(2))
+	sub = svc.created[1]
+	g.Expect(sub.Name).To(gomega.Equal("call-history-again"))
+	g.Expect(sub.Inputs).To(gomega.Equal(map[string]interface{}{"wf.bam": "this.bam"}))
+	g.Expect(*sub.DataModelID).To(gomega.Equal("dm-1"))
+	g.Expect(sub.DataModelRowIDs).To(gomega.Equal([]string{"s2"}))
+	g.Expect(*sub.DataModelSnapshotID).To(gomega.Equal("ds-1"))
+	g.Expect(sub.MaxConcurrentRuns).To(gomega.Equal(10))
+	g.Expect(sub.EngineBackend).To(gomega.Equal("default"))
+
+	// the submission of other workspace is not found
+	_, err = handler.Handle(ctx, &RetrySubmissionCommand{WorkspaceID: "ws-2", ID: "sub-file"})
+	g.Expect(err).To(gomega.Equal(apperrors.NewNotFoundError("submission", "sub-file")))
+	// nothing to retry
+	_, err = handler.Handle(ctx, &RetrySubmissionCommand{WorkspaceID: "ws-1", ID: "sub-file", Status: []string{consts.RunCancelled}})
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(svc.created).To(gomega.HaveLen(2))
+}
+
+func TestGenRetrySubmissionName(t *testing.T) {
+	g := gomega.NewWithT(t)
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	g.Expect(genRetrySubmissionName("align", now)).To(gomega.Equal("align-retry-2023-01-02-03-04-05"))
+	name := genRetrySubmissionName(strings.Repeat("测", validator.MaxSubmissionNameLength), now)
+	g.Expect(name).To(gomega.HaveSuffix("-retry-2023-01-02-03-04-05"))
+	g.Expect(len([]rune(name))).To(gomega.BeNumerically("<=", validator.MaxSubmissionNameLength))
+}
@@ -0,0 +1,137 @@
+package run
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/application/query/submission"
+	workspaceproto "github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+	"github.com/Bio-OS/bioos/pkg/utils/grpc"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type GetUsageQuery struct {
+	WorkspaceID string `validate:"required"`
+	// SubmissionID limits the report to one submission, the usage of each run is reported if set
+	SubmissionID string
+	// StartTime and EndTime are unix seconds, tasks started in [StartTime, EndTime) are counted, 0 means unlimited
+	StartTime int64 `validate:"min=0"`
+	EndTime   int64 `validate:"min=0"`
+}
+
+type GetUsageHandler interface {
+	Handle(context.Context, *GetUsageQuery) (*UsageReport, error)
+}
+
+type getUsageHandler struct {
+	runReadModel        ReadModel
+	submissionReadModel submission.ReadModel
+	workspaceClient     grpc.WorkspaceClient
+}
+
+func NewGetUsageHandler(grpcFactory grpc.Factory, runReadModel ReadModel, submissionReadModel submission.ReadModel) GetUsageHandler {
+	workspaceClient, err := grpcFactory.WorkspaceClient()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+	return &getUsageHandler{
+		runReadModel:        runReadModel,
+		submissionReadModel: submissionReadModel,
+		workspaceClient:     workspaceClient,
+	}
+}
+
+func (g *getUsageHandler) Handle(ctx context.Context, query *GetUsageQuery) (*UsageReport, error) {
+	if err := validator.Validate(query); err != nil {
+		return nil, err
+	}
+	if query.EndTime != 0 && query.EndTime <= query.StartTime {
+		return nil, apperrors.NewInvalidError("endTime must be later than startTime")
+	}
+
+	if _, err := g.workspaceClient.GetWorkspace(ctx, &workspaceproto.GetWorkspaceRequest{Id: query.WorkspaceID}); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	var filter *submission.ListSubmissionsFilter
+	if query.SubmissionID != "" {
+		if err := submission.CheckSubmissionExist(ctx, g.submissionReadModel, query.WorkspaceID, query.SubmissionID); err != nil {
+			return nil, err
+		}
+		filter = &submission.ListSubmissionsFilter{IDs: []string{query.SubmissionID}}
+	}
+	names, err := g.submissionReadModel.ListSubmissionNames(ctx, query.WorkspaceID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &UsageReport{
+		WorkspaceID: query.WorkspaceID,
+		StartTime:   query.StartTime,
+		EndTime:     query.EndTime,
+		Submissions: make([]*SubmissionUsage, 0),
+	}
+	if len(names) == 0 {
+		return report, nil
+	}
+	submissionIDs := make([]string, 0, len(names))
+	for id := range names {
+		submissionIDs = append(submissionIDs, id)
+	}
+	usageFilter := &TaskUsageFilter{}
+	if query.StartTime != 0 {
+		usageFilter.StartTime = utils.PointTime(time.Unix(query.StartTime, 0))
+	}
+	if query.EndTime != 0 {
+		usageFilter.EndTime = utils.PointTime(time.Unix(query.EndTime, 0))
+	}
+	tasks, err := g.runReadModel.ListTaskUsages(ctx, submissionIDs, usageFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	submissions := make(map[string]*SubmissionUsage)
+	runs := make(map[string]*RunUsage)
+	for _, task := range tasks {
+		report.Usage.add(task)
+		submissionUsage, ok := submissions[task.SubmissionID]
+		if !ok {
+			submissionUsage = &SubmissionUsage{SubmissionID: task.SubmissionID, Name: names[task.SubmissionID]}
+			submissions[task.SubmissionID] = submissionUsage
+			report.Submissions = append(report.Submissions, submissionUsage)
+		}
+		submissionUsage.Usage.add(task)
+		if query.SubmissionID == "" {
+			continue
+		}
+		runUsage, ok := runs[task.RunID]
+		if !ok {
+			runUsage = &RunUsage{RunID: task.RunID, Name: task.RunName}
+			runs[task.RunID] = runUsage
+			submissionUsage.Runs = append(submissionUsage.Runs, runUsage)
+		}
+		runUsage.Usage.add(task)
+	}
+	sort.Slice(report.Submissions, func(i, j int) bool {
+		return report.Submissions[i].Name < report.Submissions[j].Name
+	})
+	for _, submissionUsage := range report.Submissions {
+		sort.Slice(submissionUsage.Runs, func(i, j int) bool {
+			return submissionUsage.Runs[i].Name < submissionUsage.Runs[j].Name
+		})
+	}
+	return report, nil
+}
+
+func (u *Usage) add(task *TaskUsageItem) {
+	u.TaskCount++
+	if task.Resources.Preemptible {
+		u.PreemptibleTaskCount++
+	}
+	u.WallClock += task.Duration
+	u.CPUSeconds += task.Resources.CPU * float64(task.Duration)
+	u.MemoryGBSeconds += float64(task.Resources.Memory) / 1e9 * float64(task.Duration)
+}
@@ -0,0 +1,113 @@
+package run
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/application/query/submission"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+func (f *fakeSubmissionReadModel) ListSubmissionNames(_ context.Context, workspaceID string, filter *submission.ListSubmissionsFilter) (map[string]string, error) {
+	names := map[string]string{}
+	if workspaceID != "ws-1" {
+		return names, nil
+	}
+	for id, name := range map[string]string{"sub-1": "align", "sub-2": "call"} {
+		if filter == nil || utils.In(id, filter.IDs) {
+			names[id] = name
+		}
+	}
+	return names, nil
+}
+
+func (f *fakeRunReadModel) ListTaskUsages(_ context.Context, submissionIDs []string, filter *TaskUsageFilter) ([]*TaskUsageItem, error) {
+	f.usageFilter = filter
+	usages := make([]*TaskUsageItem, 0)
+	for _, usage := range f.usages {
+		if utils.In(usage.SubmissionID, submissionIDs) {
+			usages = append(usages, usage)
+		}
+	}
+	return usages, nil
+}
+
+func TestGetUsage(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+	runReadModel := &fakeRunReadModel{usages: []*TaskUsageItem{
+		{SubmissionID: "sub-1", RunID: "run-1", RunName: "s1", Duration: 100, Resources: TaskResources{CPU: 2, Memory: 2e9, Preemptible: true}},
+		{SubmissionID: "sub-1", RunID: "run-2", RunName: "s2", Duration: 50, Resources: TaskResources{CPU: 1, Memory: 1e9}},
+		// task of previous attempt of run-1
+		{SubmissionID: "sub-1", RunID: "run-1", RunName: "s1", Duration: 10, Resources: TaskResources{CPU: 4}},
+		{SubmissionID: "sub-2", RunID: "run-3", RunName: "t1", Duration: 20, Resources: TaskResources{CPU: 1, Memory: 4e9, Preemptible: true}},
+		// task of submission in other workspace
+		{SubmissionID: "sub-3", RunID: "run-4", RunName: "u1", Duration: 1000, Resources: TaskResources{CPU: 1}},
+	}}
+	handler := &getUsageHandler{
+		runReadModel:        runReadModel,
+		submissionReadModel: &fakeSubmissionReadModel{},
+		workspaceClient:     &fakeWorkspaceClient{},
+	}
+
+	// the usage of workspace is reported by submission
+	report, err := handler.Handle(ctx, &GetUsageQuery{WorkspaceID: "ws-1"})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(runReadModel.usageFilter).To(gomega.Equal(&TaskUsageFilter{}))
+	g.Expect(report.Usage).To(gomega.Equal(Usage{TaskCount: 4, PreemptibleTaskCount: 2, WallClock: 180, CPUSeconds: 310, MemoryGBSeconds: 330}))
+	g.Expect(report.Submissions).To(gomega.Equal([]*SubmissionUsage{
+		{SubmissionID: "sub-1", Name: "align", Usage: Usage{TaskCount: 3, PreemptibleTaskCount: 1, WallClock: 160, CPUSeconds: 290, MemoryGBSeconds: 250}},
+		{SubmissionID: "sub-2", Name: "call", Usage: Usage{TaskCount: 1, PreemptibleTaskCount: 1, WallClock: 20, CPUSeconds: 20, MemoryGBSeconds: 80}},
+	}))
+
+	// the usage of submission is reported by run
+	report, err = handler.Handle(ctx, &GetUsageQuery{WorkspaceID: "ws-1", SubmissionID: "sub-1", StartTime: 100, EndTime: 200})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(runReadModel.usageFilter).To(gomega.Equal(&TaskUsageFilter{
+		StartTime: utils.PointTime(time.Unix(100, 0)),
+		EndTime:   utils.PointTime(time.Unix(200, 0)),
+	}))
+	g.Expect(report.StartTime).To(gomega.BeEquivalentTo(100))
+	g.Expect(report.EndTime).To(gomega.BeEquivalentTo(200))
+	g.Expect(report.Usage).To(gomega.Equal(report.Submissions[0].Usage))
+	g.Expect(report.Submissions).To(gomega.Equal([]*SubmissionUsage{{
+		SubmissionID: "sub-1",
+		Name:         "align",
+		Usage:        Usage{TaskCount: 3, PreemptibleTaskCount: 1, WallClock: 160, CPUSeconds: 290, MemoryGBSeconds: 250},
+		Runs: []*RunUsage{
+			{RunID: "run-1", Name: "s1", Usage: Usage{TaskCount: 2, PreemptibleTaskCount: 1, WallClock: 110, CPUSeconds: 240, MemoryGBSeconds: 200}},
+			{RunID: "run-2", Name: "s2", Usage: Usage{TaskCount: 1, WallClock: 50, CPUSeconds: 50, MemoryGBSeconds: 50}},
+		},
+	}}))
+
+	// no submission in workspace
+	report, err = handler.Handle(ctx, &GetUsageQuery{WorkspaceID: "ws-2"})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(report.Usage).To(gomega.Equal(Usage{}))
+	g.Expect(report.Submissions).To(gomega.BeEmpty())
+
+	_, err = handler.Handle(ctx, &GetUsageQuery{WorkspaceID: "ws-1", SubmissionID: "sub-3"})
+	g.Expect(err).To(gomega.Equal(apperrors.NewNotFoundError("submission", "sub-3")))
+	_, err = handler.Handle(ctx, &GetUsageQuery{WorkspaceID: "ws-1", StartTime: 200, EndTime: 100})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestTaskUsageFilterContains(t *testing.T) {
+	g := gomega.NewWithT(t)
+	startTime := time.Unix(100, 0)
+	endTime := time.Unix(200, 0)
+
+	var filter *TaskUsageFilter
+	g.Expect(filter.Contains(startTime)).To(gomega.BeTrue())
+	filter = &TaskUsageFilter{StartTime: &startTime, EndTime: &endTime}
+	g.Expect(filter.Contains(startTime)).To(gomega.BeTrue())
+	g.Expect(filter.Contains(time.Unix(199, 0))).To(gomega.BeTrue())
+	g.Expect(filter.Contains(time.Unix(99, 0))).To(gomega.BeFalse())
+	g.Expect(filter.Contains(endTime)).To(gomega.BeFalse())
+	filter = &TaskUsageFilter{EndTime: &endTime}
+	g.Expect(filter.Contains(time.Unix(0, 0))).To(gomega.BeTrue())
+}
@@ -1,5 +1,7 @@
 package run
 
+import "time"
+
 type RunItem struct {
 	ID          string
 	Name        string
@@ -13,6 +15,18 @@ type RunItem struct {
 	TaskStatus  Status
 	Log         *string
 	Message     *string
+	Attempts    []Attempt
+	// EngineBackend is the name of WES backend the run is submitted to
+	EngineBackend string
+}
+
+type Attempt struct {
+	EngineRunID string
+	Status      string
+	Message     *string
+	StartTime   int64
+	FinishTime  *int64
+	Duration    int64
 }
 
 type TaskItem struct {
@@ -24,6 +38,16 @@ type TaskItem struct {
 	Duration   int64
 	Stdout     string
 	Stderr     string
+	Resources  TaskResources
+}
+
+// TaskResources is the resources of task, zero value means not reported by engine.
+type TaskResources struct {
+	CPU float64
+	// Memory in bytes
+	Memory      int64
+	MachineType string
+	Preemptible bool
 }
 
 type Status struct {
@@ -43,6 +67,70 @@ type ListRunsFilter struct {
 	Exact      bool
 	Status     []string
 	IDs        []string
+	// Queued filters runs waiting for a free slot of submission concurrency limit
+	Queued *bool
+}
+
+// TaskUsageFilter filters tasks started in [StartTime, EndTime).
+type TaskUsageFilter struct {
+	StartTime *time.Time
+	EndTime   *time.Time
+}
+
+// Contains returns whether the task started at startTime matches the filter, nil filter matches all.
+func (f *TaskUsageFilter) Contains(startTime time.Time) bool {
+	if f == nil {
+		return true
+	}
+	if f.StartTime != nil && startTime.Before(*f.StartTime) {
+		return false
+	}
+	return f.EndTime == nil || startTime.Before(*f.EndTime)
+}
+
+// TaskUsageItem is the resources used by a task.
+type TaskUsageItem struct {
+	SubmissionID string
+	RunID        string
+	RunName      string
+	// Duration is the wall-clock of task in seconds, until now if unfinished
+	Duration  int64
+	Resources TaskResources
+}
+
+// UsageReport is the resources used by tasks of a workspace.
+type UsageReport struct {
+	WorkspaceID string
+	StartTime   int64
+	EndTime     int64
+	Usage       Usage
+	Submissions []*SubmissionUsage
+}
+
+type SubmissionUsage struct {
+	SubmissionID string
+	Name         string
+	Usage        Usage
+	// Runs is only reported for the usage of one submission
+	Runs []*RunUsage
+}
+
+type RunUsage struct {
+	RunID string
+	Name  string
+	Usage Usage
+}
+
+// Usage is the sum of resources used by tasks.
+type Usage struct {
+	TaskCount            int
+	PreemptibleTaskCount int
+	// WallClock is the sum of task duration in seconds
+	WallClock int64
+	// CPUSeconds is the sum of cpu multiplied by task duration
+	CPUSeconds float64
+	// MemoryGBSeconds is the sum of memory in GB multiplied by task duration
+	MemoryGBSeconds float64
 }
 
 // StatusCount ...
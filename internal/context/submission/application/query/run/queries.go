@@ -9,6 +9,8 @@ type Queries struct {
 	ListRuns        ListRunsHandler
 	ListTasks       ListTasksHandler
 	CountRunsResult CountRunsResultHandler
+	StreamTaskLog   StreamTaskLogHandler
+	GetUsage        GetUsageHandler
 }
 
 func NewQueries(grpcFactory grpc.Factory, runReadModel ReadModel, submissionReadModel submission.ReadModel) *Queries {
@@ -16,5 +18,7 @@ func NewQueries(grpcFactory grpc.Factory, runReadModel ReadModel, submissionRead
 		ListRuns:        NewListRunsHandler(grpcFactory, runReadModel, submissionReadModel),
 		ListTasks:       NewListTasksHandler(grpcFactory, runReadModel, submissionReadModel),
 		CountRunsResult: NewCountRunsResultHandler(runReadModel),
+		StreamTaskLog:   NewStreamTaskLogHandler(grpcFactory, runReadModel, submissionReadModel),
+		GetUsage:        NewGetUsageHandler(grpcFactory, runReadModel, submissionReadModel),
 	}
 }
@@ -8,11 +8,16 @@ import (
 
 type ReadModel interface {
 	ListAllRunIDs(ctx context.Context, submissionID string) ([]string, error)
+	ListAllRunNames(ctx context.Context, submissionID string, filter *ListRunsFilter) ([]string, error)
+	ListQueuedRunIDs(ctx context.Context, submissionID string, limit int) ([]string, error)
 	ListRuns(ctx context.Context, submissionID string, pg *utils.Pagination, filter *ListRunsFilter) ([]*RunItem, error)
 	CountRuns(ctx context.Context, submissionID string, filter *ListRunsFilter) (int, error)
 
 	ListTasks(ctx context.Context, runID string, pg *utils.Pagination) ([]*TaskItem, error)
 	CountTasks(ctx context.Context, runID string) (int, error)
+	GetTask(ctx context.Context, runID, name string) (*TaskItem, error)
+	// ListTaskUsages lists the resources of tasks of submissions which started in the time range.
+	ListTaskUsages(ctx context.Context, submissionIDs []string, filter *TaskUsageFilter) ([]*TaskUsageItem, error)
 	CountRunsResult(ctx context.Context, submissionID string) ([]*StatusCount, error)
 	CountTasksResult(ctx context.Context, runID string) ([]*StatusCount, error)
 }
@@ -0,0 +1,291 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/application/query/submission"
+	workspaceproto "github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+	"github.com/Bio-OS/bioos/pkg/utils/grpc"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+// task log streams
+const (
+	TaskLogStdout = "stdout"
+	TaskLogStderr = "stderr"
+)
+
+const (
+	taskLogPollInterval  = time.Second
+	taskLogCheckInterval = 5 * time.Second
+	taskLogChunkSize     = 32 * 1024
+)
+
+type StreamTaskLogQuery struct {
+	WorkspaceID  string `validate:"required"`
+	SubmissionID string `validate:"required"`
+	RunID        string `validate:"required"`
+	TaskName     string `validate:"required"`
+	// Stream is stdout if empty
+	Stream string `validate:"omitempty,oneof=stdout stderr"`
+	// Tail only outputs the last lines of log if it is greater than 0
+	Tail int `validate:"min=0"`
+	// Follow keeps outputting the appended log until the task or run is finished
+	Follow bool
+}
+
+// StreamTaskLogHandler writes the log of task on the storage of workspace to w,
+// w is flushed after each write if it implements Flush() error.
+type StreamTaskLogHandler interface {
+	Handle(ctx context.Context, query *StreamTaskLogQuery, w io.Writer) error
+}
+
+type streamTaskLogHandler struct {
+	runReadModel        ReadModel
+	submissionReadModel submission.ReadModel
+	workspaceClient     grpc.WorkspaceClient
+}
+
+func NewStreamTaskLogHandler(grpcFactory grpc.Factory, runReadModel ReadModel, submissionReadModel submission.ReadModel) StreamTaskLogHandler {
+	workspaceClient, err := grpcFactory.WorkspaceClient()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+	return &streamTaskLogHandler{
+		runReadModel:        runReadModel,
+		submissionReadModel: submissionReadModel,
+		workspaceClient:     workspaceClient,
+	}
+}
+
+func (s *streamTaskLogHandler) Handle(ctx context.Context, query *StreamTaskLogQuery, w io.Writer) error {
+	if err := validator.Validate(query); err != nil {
+		return err
+	}
+	if query.Stream == "" {
+		query.Stream = TaskLogStdout
+	}
+
+	resp, err := s.workspaceClient.GetWorkspace(ctx, &workspaceproto.GetWorkspaceRequest{Id: query.WorkspaceID})
+	if err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	if err := submission.CheckSubmissionExist(ctx, s.submissionReadModel, query.WorkspaceID, query.SubmissionID); err != nil {
+		return err
+	}
+	run, err := s.getRun(ctx, query.SubmissionID, query.RunID)
+	if err != nil {
+		return err
+	}
+	task, err := s.runReadModel.GetTask(ctx, query.RunID, query.TaskName)
+	if err != nil {
+		return err
+	}
+
+	location := task.Stdout
+	if query.Stream == TaskLogStderr {
+		location = task.Stderr
+	}
+	logPath, err := resolveTaskLogPath(resp.GetWorkspace().GetStorage().GetNfs().GetMountPath(), location)
+	if err != nil {
+		return err
+	}
+	follow := query.Follow && !utils.In(run.Status, consts.FinishedRunStatuses) && !utils.In(task.Status, consts.FinishedTaskStatuses)
+
+	file, err := s.openTaskLog(ctx, logPath, query, follow)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if query.Tail > 0 {
+		offset, err := tailOffset(file, query.Tail)
+		if err != nil {
+			return apperrors.NewInternalError(err)
+		}
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			return apperrors.NewInternalError(err)
+		}
+	}
+	if err := copyTaskLog(file, w); err != nil {
+		return err
+	}
+	if !follow {
+		return nil
+	}
+
+	poll := time.NewTicker(taskLogPollInterval)
+	defer poll.Stop()
+	lastCheck := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-poll.C:
+		}
+		if err := copyTaskLog(file, w); err != nil {
+			return err
+		}
+		if time.Since(lastCheck) < taskLogCheckInterval {
+			continue
+		}
+		lastCheck = time.Now()
+		finished, err := s.isFinished(ctx, query)
+		if err != nil {
+			return err
+		}
+		if finished {
+			// output the log written before the task finished
+			return copyTaskLog(file, w)
+		}
+	}
+}
+
+func (s *streamTaskLogHandler) getRun(ctx context.Context, submissionID, runID string) (*RunItem, error) {
+	runs, err := s.runReadModel.ListRuns(ctx, submissionID, utils.NewPagination(1, 1), &ListRunsFilter{IDs: []string{runID}})
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return nil, apperrors.NewNotFoundError("run", runID)
+	}
+	return runs[0], nil
+}
+
+func (s *streamTaskLogHandler) isFinished(ctx context.Context, query *StreamTaskLogQuery) (bool, error) {
+	run, err := s.getRun(ctx, query.SubmissionID, query.RunID)
+	if err != nil {
+		return false, err
+	}
+	task, err := s.runReadModel.GetTask(ctx, query.RunID, query.TaskName)
+	if err != nil {
+		return false, err
+	}
+	return utils.In(run.Status, consts.FinishedRunStatuses) || utils.In(task.Status, consts.FinishedTaskStatuses), nil
+}
+
+// openTaskLog opens the log file, which may not be created by the engine yet if follow.
+func (s *streamTaskLogHandler) openTaskLog(ctx context.Context, logPath string, query *StreamTaskLogQuery, follow bool) (*os.File, error) {
+	poll := time.NewTicker(taskLogPollInterval)
+	defer poll.Stop()
+	for {
+		file, err := os.Open(logPath)
+		if err == nil {
+			return file, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, apperrors.NewInternalError(err)
+		}
+		if !follow {
+			return nil, apperrors.NewNotFoundError("task log", fmt.Sprintf("%s of %s", query.Stream, query.TaskName))
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-poll.C:
+		}
+		finished, err := s.isFinished(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		follow = !finished
+	}
+}
+
+// resolveTaskLogPath resolves the log location reported by engine to the path on the storage of workspace,
+// locations outside the storage are rejected.
+func resolveTaskLogPath(mountPath, location string) (string, error) {
+	if mountPath == "" {
+		return "", apperrors.NewInvalidError("workspace has no file storage")
+	}
+	location = strings.TrimPrefix(location, "file://")
+	if location == "" {
+		return "", apperrors.NewNotFoundError("task log", "location")
+	}
+	mountPath = filepath.Clean(mountPath)
+	logPath := location
+	if !filepath.IsAbs(logPath) {
+		logPath = filepath.Join(mountPath, logPath)
+	}
+	logPath = filepath.Clean(logPath)
+	if !strings.HasPrefix(logPath, mountPath+string(filepath.Separator)) {
+		return "", apperrors.NewInvalidError(fmt.Sprintf("task log %s is not on the storage of workspace", location))
+	}
+	return logPath, nil
+}
+
+// tailOffset returns the offset of the last n lines of file.
+func tailOffset(file *os.File, n int) (int64, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	end := info.Size()
+	buf := make([]byte, taskLogChunkSize)
+	// the trailing newline does not start a new line
+	if end > 0 {
+		if _, err := file.ReadAt(buf[:1], end-1); err != nil {
+			return 0, err
+		}
+		if buf[0] == '\n' {
+			end--
+		}
+	}
+	lines := 0
+	for end > 0 {
+		size := int64(len(buf))
+		if end < size {
+			size = end
+		}
+		start := end - size
+		if _, err := file.ReadAt(buf[:size], start); err != nil {
+			return 0, err
+		}
+		for i := size - 1; i >= 0; i-- {
+			if buf[i] != '\n' {
+				continue
+			}
+			lines++
+			if lines == n {
+				return start + i + 1, nil
+			}
+		}
+		end = start
+	}
+	return 0, nil
+}
+
+// copyTaskLog copies the log from the current offset of file to the end.
+func copyTaskLog(file *os.File, w io.Writer) error {
+	buf := make([]byte, taskLogChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				return werr
+			}
+			if flusher, ok := w.(interface{ Flush() error }); ok {
+				if err := flusher.Flush(); err != nil {
+					return err
+				}
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return apperrors.NewInternalError(err)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/application/query/submission"
+	workspaceproto "github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+	"github.com/Bio-OS/bioos/pkg/utils/grpc"
+)
+
+func TestMain(m *testing.M) {
+	applog.RegisterLogger(&applog.Options{
+		Level: "fatal",
+	})
+	os.Exit(m.Run())
+}
+
+// fakeWorkspaceClient has workspace ws-1 whose storage is mounted on mountPath.
+type fakeWorkspaceClient struct {
+	grpc.WorkspaceClient
+	mountPath string
+}
+
+func (f *fakeWorkspaceClient) GetWorkspace(_ context.Context, in *workspaceproto.GetWorkspaceRequest) (*workspaceproto.GetWorkspaceResponse, error) {
+	return &workspaceproto.GetWorkspaceResponse{Workspace: &workspaceproto.Workspace{
+		Id:      in.Id,
+		Storage: &workspaceproto.WorkspaceStorage{Nfs: &workspaceproto.NFSWorkspaceStorage{MountPath: f.mountPath}},
+	}}, nil
+}
+
+// fakeSubmissionReadModel has submission sub-1 and sub-2 of workspace ws-1.
+type fakeSubmissionReadModel struct {
+	submission.ReadModel
+}
+
+func (f *fakeSubmissionReadModel) CountSubmissions(_ context.Context, workspaceID string, filter *submission.ListSubmissionsFilter) (int, error) {
+	if workspaceID == "ws-1" && (utils.In("sub-1", filter.IDs) || utils.In("sub-2", filter.IDs)) {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// fakeRunReadModel has run run-1 of submission sub-1 with the only task, and the usages of tasks of all submissions.
+type fakeRunReadModel struct {
+	ReadModel
+	run    *RunItem
+	task   *TaskItem
+	usages []*TaskUsageItem
+	// usageFilter is the last filter of ListTaskUsages
+	usageFilter *TaskUsageFilter
+}
+
+func (f *fakeRunReadModel) ListRuns(_ context.Context, submissionID string, _ *utils.Pagination, filter *ListRunsFilter) ([]*RunItem, error) {
+	if submissionID == "sub-1" && utils.In(f.run.ID, filter.IDs) {
+		return []*RunItem{f.run}, nil
+	}
+	return []*RunItem{}, nil
+}
+
+func (f *fakeRunReadModel) GetTask(_ context.Context, runID, name string) (*TaskItem, error) {
+	if runID != f.run.ID || name != f.task.Name {
+		return nil, apperrors.NewNotFoundError("task", name)
+	}
+	return f.task, nil
+}
+
+// syncBuffer is written by the handler and read by the test at the same time.
+type syncBuffer struct {
+	mutex  sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buffer.String()
+}
+
+func newTestStreamTaskLogHandler(mountPath, runStatus, taskStatus string) StreamTaskLogHandler {
+	return &streamTaskLogHandler{
+		runReadModel: &fakeRunReadModel{
+			run: &RunItem{ID: "run-1", Status: runStatus},
+			task: &TaskItem{
+				Name:   "call-a",
+				RunID:  "run-1",
+				Status: taskStatus,
+				Stdout: "file://" + filepath.Join(mountPath, "call-a", "stdout"),
+				// relative to the storage of workspace
+				Stderr: "call-a/stderr",
+			},
+		},
+		submissionReadModel: &fakeSubmissionReadModel{},
+		workspaceClient:     &fakeWorkspaceClient{mountPath: mountPath},
+	}
+}
+
+func TestStreamTaskLog(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+	mountPath := t.TempDir()
+	g.Expect(os.MkdirAll(filepath.Join(mountPath, "call-a"), 0755)).To(gomega.Succeed())
+	g.Expect(os.WriteFile(filepath.Join(mountPath, "call-a", "stdout"), []byte("line1\nline2\nline3\n"), 0644)).To(gomega.Succeed())
+	g.Expect(os.WriteFile(filepath.Join(mountPath, "call-a", "stderr"), []byte("error1\nerror2"), 0644)).To(gomega.Succeed())
+	handler := newTestStreamTaskLogHandler(mountPath, consts.RunSucceeded, consts.TaskSucceeded)
+
+	for _, c := range []struct {
+		stream   string
+		tail     int
+		expected string
+	}{
+		{stream: "", expected: "line1\nline2\nline3\n"},
+		{stream: TaskLogStdout, tail: 2, expected: "line2\nline3\n"},
+		{stream: TaskLogStdout, tail: 10, expected: "line1\nline2\nline3\n"},
+		{stream: TaskLogStderr, tail: 1, expected: "error2"},
+	} {
+		w := &bytes.Buffer{}
+		// follow is ignored as the task is finished
+		g.Expect(handler.Handle(ctx, &StreamTaskLogQuery{
+			WorkspaceID:  "ws-1",
+			SubmissionID: "sub-1",
+			RunID:        "run-1",
+			TaskName:     "call-a",
+			Stream:       c.stream,
+			Tail:         c.tail,
+			Follow:       true,
+		}, w)).To(gomega.Succeed())
+		g.Expect(w.String()).To(gomega.Equal(c.expected), "stream %s tail %d", c.stream, c.tail)
+	}
+
+	err := handler.Handle(ctx, &StreamTaskLogQuery{WorkspaceID: "ws-1", SubmissionID: "sub-3", RunID: "run-1", TaskName: "call-a"}, &bytes.Buffer{})
+	g.Expect(err).To(gomega.Equal(apperrors.NewNotFoundError("submission", "sub-3")))
+	err = handler.Handle(ctx, &StreamTaskLogQuery{WorkspaceID: "ws-1", SubmissionID: "sub-1", RunID: "run-1", TaskName: "call-b"}, &bytes.Buffer{})
+	g.Expect(err).To(gomega.Equal(apperrors.NewNotFoundError("task", "call-b")))
+
+	// the log of finished task is not waited for
+	g.Expect(os.Remove(filepath.Join(mountPath, "call-a", "stdout"))).To(gomega.Succeed())
+	err = handler.Handle(ctx, &StreamTaskLogQuery{WorkspaceID: "ws-1", SubmissionID: "sub-1", RunID: "run-1", TaskName: "call-a", Follow: true}, &bytes.Buffer{})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestStreamTaskLogFollow(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	mountPath := t.TempDir()
+	logPath := filepath.Join(mountPath, "call-a", "stdout")
+	handler := newTestStreamTaskLogHandler(mountPath, consts.RunRunning, consts.TaskRunning)
+
+	w := &syncBuffer{}
+	done := make(chan error, 1)
+	go func() {
+		done <- handler.Handle(ctx, &StreamTaskLogQuery{
+			WorkspaceID:  "ws-1",
+			SubmissionID: "sub-1",
+			RunID:        "run-1",
+			TaskName:     "call-a",
+			Follow:       true,
+		}, w)
+	}()
+
+	// the log is waited for until it is created by engine
+	g.Consistently(done, 1500*time.Millisecond).ShouldNot(gomega.Receive())
+	g.Expect(os.MkdirAll(filepath.Dir(logPath), 0755)).To(gomega.Succeed())
+	g.Expect(os.WriteFile(logPath, []byte("line1\n"), 0644)).To(gomega.Succeed())
+	g.Eventually(w.String, 5*time.Second, 100*time.Millisecond).Should(gomega.Equal("line1\n"))
+
+	// the appended log is output
+	file, err := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0644)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	_, err = file.WriteString(strings.Repeat("x", taskLogChunkSize) + "\nline3\n")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(file.Close()).To(gomega.Succeed())
+	g.Eventually(w.String, 5*time.Second, 100*time.Millisecond).Should(gomega.HaveSuffix("\nline3\n"))
+	g.Expect(w.String()).To(gomega.HavePrefix("line1\nxxx"))
+
+	cancel()
+	g.Eventually(done, 5*time.Second).Should(gomega.Receive(gomega.BeNil()))
+}
+
+func TestTailOffset(t *testing.T) {
+	g := gomega.NewWithT(t)
+	dir := t.TempDir()
+	// lines longer than the chunk read at once
+	long := strings.Repeat("y", taskLogChunkSize+10)
+
+	for _, c := range []struct {
+		content  string
+		tail     int
+		expected string
+	}{
+		{content: "", tail: 1, expected: ""},
+		{content: "\n", tail: 1, expected: "\n"},
+		{content: "a\nb\nc\n", tail: 1, expected: "c\n"},
+		{content: "a\nb\nc", tail: 2, expected: "b\nc"},
+		{content: "a\nb\nc", tail: 3, expected: "a\nb\nc"},
+		{content: "a\n\nc\n", tail: 2, expected: "\nc\n"},
+		{content: long + "\n" + long + "\nz\n", tail: 2, expected: long + "\nz\n"},
+		{content: long + "\n" + long + "\nz\n", tail: 5, expected: long + "\n" + long + "\nz\n"},
+	} {
+		path := filepath.Join(dir, "log")
+		g.Expect(os.WriteFile(path, []byte(c.content), 0644)).To(gomega.Succeed())
+		file, err := os.Open(path)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		offset, err := tailOffset(file, c.tail)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(file.Close()).To(gomega.Succeed())
+		g.Expect(c.content[offset:]).To(gomega.Equal(c.expected), "tail %d of %q", c.tail, c.content)
+	}
+}
+
+func TestResolveTaskLogPath(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	path, err := resolveTaskLogPath("/mnt/ws-1/", "file:///mnt/ws-1/cromwell/call-a/stdout")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(path).To(gomega.Equal("/mnt/ws-1/cromwell/call-a/stdout"))
+	path, err = resolveTaskLogPath("/mnt/ws-1", "cromwell/call-a/stderr")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(path).To(gomega.Equal("/mnt/ws-1/cromwell/call-a/stderr"))
+
+	for _, c := range []struct {
+		mountPath string
+		location  string
+	}{
+		{mountPath: "", location: "cromwell/call-a/stdout"},
+		{mountPath: "/mnt/ws-1", location: ""},
+		{mountPath: "/mnt/ws-1", location: "/mnt/ws-2/call-a/stdout"},
+		{mountPath: "/mnt/ws-1", location: "/mnt/ws-10/call-a/stdout"},
+		{mountPath: "/mnt/ws-1", location: "../ws-2/call-a/stdout"},
+		{mountPath: "/mnt/ws-1", location: "/mnt/ws-1"},
+	} {
+		_, err := resolveTaskLogPath(c.mountPath, c.location)
+		g.Expect(err).To(gomega.HaveOccurred(), "%s on %s", c.location, c.mountPath)
+	}
+}
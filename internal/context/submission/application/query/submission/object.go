@@ -1,28 +1,34 @@
 package submission
 
 type SubmissionItem struct {
-	ID                string
-	Name              string
-	Description       *string
-	Type              string
-	Status            string
-	StartTime         int64
-	FinishTime        *int64
-	Duration          int64
-	WorkflowID        string
-	WorkflowVersionID string
-	RunStatus         Status
-	Entity            *Entity
-	ExposedOptions    ExposedOptions
-	InOutMaterial     *InOutMaterial
-	WorkspaceID       string
+	ID                 string
+	Name               string
+	Description        *string
+	Type               string
+	Status             string
+	StartTime          int64
+	FinishTime         *int64
+	Duration           int64
+	WorkflowID         string
+	WorkflowVersionID  string
+	RunStatus          Status
+	Entity             *Entity
+	ExposedOptions     ExposedOptions
+	InOutMaterial      *InOutMaterial
+	WorkspaceID        string
+	ParentSubmissionID *string
+	MaxConcurrentRuns  int
+	EngineBackend      string
 }
 
 type Entity struct {
 	DataModelID     string
 	DataModelRowIDs []string
-	InputsTemplate  string
-	OutputsTemplate string
+	// DataModelSnapshotID is the snapshot of data model the inputs are read from, empty for submissions
+	// created before snapshots are taken
+	DataModelSnapshotID string
+	InputsTemplate      string
+	OutputsTemplate     string
 }
 
 type InOutMaterial struct {
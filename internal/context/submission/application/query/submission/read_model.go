@@ -10,4 +10,6 @@ type ReadModel interface {
 	ListSubmissions(ctx context.Context, workspaceID string, pg *utils.Pagination, filter *ListSubmissionsFilter) ([]*SubmissionItem, error)
 
 	CountSubmissions(ctx context.Context, workspaceID string, filter *ListSubmissionsFilter) (int, error)
+	// ListSubmissionNames returns the names of submissions in workspace by id.
+	ListSubmissionNames(ctx context.Context, workspaceID string, filter *ListSubmissionsFilter) (map[string]string, error)
 }
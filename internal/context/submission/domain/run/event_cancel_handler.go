@@ -14,12 +14,12 @@ import (
 )
 
 type CancelHandler struct {
-	wes        wes.Client
+	wes        wes.Registry
 	repository Repository
 	eventbus   eventbus.EventBus
 }
 
-func NewEventHandlerCancelRun(wes wes.Client, repository Repository, eventbus eventbus.EventBus) *CancelHandler {
+func NewEventHandlerCancelRun(wes wes.Registry, repository Repository, eventbus eventbus.EventBus) *CancelHandler {
 	return &CancelHandler{
 		wes:        wes,
 		repository: repository,
@@ -44,7 +44,11 @@ func (h *CancelHandler) Handle(ctx context.Context, event *submission.EventRun)
 		}
 		return h.repository.Save(ctx, run)
 	}
-	if _, err = h.wes.CancelRun(ctx, &wes.CancelRunRequest{RunID: run.EngineRunID}); err != nil {
+	wesClient, err := h.wes.Get(run.EngineBackend)
+	if err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	if _, err = wesClient.CancelRun(ctx, &wes.CancelRunRequest{RunID: run.EngineRunID}); err != nil {
 		if !wes.IsNotFound(err) {
 			return apperrors.NewInternalError(err)
 		}
@@ -47,7 +47,9 @@ func (e *EventHandlerCreateRuns) Handle(ctx context.Context, event *submission.E
 		return err
 	}
 
-	for _, run := range runList {
+	for i, run := range runList {
+		// runs over the concurrency limit wait in queue, they will be released while syncing submission
+		run.Queued = event.MaxConcurrentRuns > 0 && i >= event.MaxConcurrentRuns
 		// public submit run
 		if err = e.Publish(ctx, run, event); err != nil {
 			return err
@@ -74,10 +76,11 @@ func (e *EventHandlerCreateRuns) genRunList(ctx context.Context, dataList *dataL
 				return nil, err
 			}
 			tempRun, err := e.runFactory.CreateWithRunParam(CreateRunParam{
-				SubmissionID: event.SubmissionID,
-				Name:         name,
-				Inputs:       inputStr,
-				Status:       consts.RunPending,
+				SubmissionID:  event.SubmissionID,
+				Name:          name,
+				Inputs:        inputStr,
+				Status:        consts.RunPending,
+				EngineBackend: event.EngineBackend,
 			})
 			if err != nil {
 				return nil, err
@@ -94,10 +97,11 @@ func (e *EventHandlerCreateRuns) genRunList(ctx context.Context, dataList *dataL
 			return nil, err
 		}
 		tempRun, err := e.runFactory.CreateWithRunParam(CreateRunParam{
-			SubmissionID: event.SubmissionID,
-			Name:         rowID,
-			Inputs:       inputStr,
-			Status:       consts.RunPending,
+			SubmissionID:  event.SubmissionID,
+			Name:          rowID,
+			Inputs:        inputStr,
+			Status:        consts.RunPending,
+			EngineBackend: event.EngineBackend,
 		})
 		if err != nil {
 			return nil, err
@@ -117,7 +121,7 @@ func (e *EventHandlerCreateRuns) genDataList(ctx context.Context, event *submiss
 			workspaceModel: wsModelData,
 		}, nil
 	}
-	entityModel, setModelList, err := e.genEntityModelData(ctx, event.WorkspaceID, *event.DataModelID, event.DataModelRowIDs)
+	entityModel, setModelList, err := e.genEntityModelData(ctx, event.WorkspaceID, *event.DataModelID, event.DataModelSnapshotID, event.DataModelRowIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +132,9 @@ func (e *EventHandlerCreateRuns) genDataList(ctx context.Context, event *submiss
 	}, nil
 }
 
-func (e *EventHandlerCreateRuns) genEntityModelData(ctx context.Context, workspaceID, dataModelID string, rowIDs []string) (*dataModel, []setModel, error) {
+// genEntityModelData reads the rows of data model from snapshot if snapshotID is set, so that runs see the
+// data model as it was when submission was created.
+func (e *EventHandlerCreateRuns) genEntityModelData(ctx context.Context, workspaceID, dataModelID string, snapshotID *string, rowIDs []string) (*dataModel, []setModel, error) {
 	originDataModelResp, err := e.dataModelClient.GetDataModel(ctx, &workspaceproto.GetDataModelRequest{
 		WorkspaceID: workspaceID,
 		Id:          dataModelID,
@@ -140,17 +146,13 @@ func (e *EventHandlerCreateRuns) genEntityModelData(ctx context.Context, workspa
 		return nil, nil, apperrors.NewInternalError(err)
 	}
 	originDataModel := originDataModelResp.DataModel
-	originRowsResp, err := e.dataModelClient.ListDataModelRows(ctx, &workspaceproto.ListDataModelRowsRequest{
-		Id:          originDataModel.Id,
-		WorkspaceID: workspaceID,
-		RowIDs:      rowIDs,
-	})
+	originHeaders, originHeaderTypes, originRows, err := e.listOriginRows(ctx, workspaceID, originDataModel.Id, snapshotID, rowIDs)
 	if err != nil {
-		return nil, nil, apperrors.NewInternalError(err)
+		return nil, nil, err
 	}
 	switch originDataModel.Type {
 	case consts.DataModelTypeEntity:
-		return NewDataModel(originDataModel, originRowsResp.Headers, originRowsResp.Rows), nil, nil
+		return NewDataModel(originDataModel, originHeaders, originHeaderTypes, originRows), nil, nil
 	case consts.DataModelTypeEntitySet:
 		// get all set model name and final entity name
 		setModelNameList := []string{}
@@ -177,8 +179,8 @@ func (e *EventHandlerCreateRuns) genEntityModelData(ctx context.Context, workspa
 		var finalDataModels *dataModel
 		setModelChain := []setModel{
 			{
-				NewDataModel(originDataModel, originRowsResp.Headers, nil),
-				parseSetIDList(originDataModel.Name, originRowsResp.Headers, originRowsResp.Rows),
+				NewDataModel(originDataModel, originHeaders, originHeaderTypes, nil),
+				parseSetIDList(originDataModel.Name, originHeaders, originRows),
 			},
 		}
 		for _, name := range setModelNameList {
@@ -198,10 +200,10 @@ func (e *EventHandlerCreateRuns) genEntityModelData(ctx context.Context, workspa
 				return nil, nil, apperrors.NewInternalError(err)
 			}
 			if dm.Type != consts.DataModelTypeEntitySet {
-				finalDataModels = NewDataModel(dm, resp.Headers, resp.Rows)
+				finalDataModels = NewDataModel(dm, resp.Headers, resp.HeaderTypes, resp.Rows)
 				break
 			}
-			setModelChain = append(setModelChain, setModel{NewDataModel(dm, resp.Headers, nil), parseSetIDList(dm.Name, resp.Headers, resp.Rows)})
+			setModelChain = append(setModelChain, setModel{NewDataModel(dm, resp.Headers, resp.HeaderTypes, nil), parseSetIDList(dm.Name, resp.Headers, resp.Rows)})
 		}
 		return finalDataModels, setModelChain, nil
 	default:
@@ -210,6 +212,30 @@ func (e *EventHandlerCreateRuns) genEntityModelData(ctx context.Context, workspa
 	}
 }
 
+func (e *EventHandlerCreateRuns) listOriginRows(ctx context.Context, workspaceID, dataModelID string, snapshotID *string, rowIDs []string) ([]string, []string, []*workspaceproto.Row, error) {
+	if snapshotID != nil {
+		resp, err := e.dataModelClient.GetDataModelSnapshot(ctx, &workspaceproto.GetDataModelSnapshotRequest{
+			WorkspaceID: workspaceID,
+			DataModelID: dataModelID,
+			Id:          *snapshotID,
+			RowIDs:      rowIDs,
+		})
+		if err != nil {
+			return nil, nil, nil, apperrors.NewInternalError(err)
+		}
+		return resp.GetSnapshot().GetHeaders(), resp.GetSnapshot().GetHeaderTypes(), resp.GetRows(), nil
+	}
+	resp, err := e.dataModelClient.ListDataModelRows(ctx, &workspaceproto.ListDataModelRowsRequest{
+		Id:          dataModelID,
+		WorkspaceID: workspaceID,
+		RowIDs:      rowIDs,
+	})
+	if err != nil {
+		return nil, nil, nil, apperrors.NewInternalError(err)
+	}
+	return resp.Headers, resp.HeaderTypes, resp.Rows, nil
+}
+
 func (e *EventHandlerCreateRuns) genWsModelData(ctx context.Context, workspaceID string, inputs map[string]interface{}, submissionType string) (*dataModel, error) {
 	// get ws datamodel
 	wsDataModelResp, err := e.dataModelClient.ListDataModels(ctx, &workspaceproto.ListDataModelsRequest{
@@ -277,13 +303,16 @@ func (e *EventHandlerCreateRuns) genWsModelData(ctx context.Context, workspaceID
 	if err != nil {
 		return nil, apperrors.NewInternalError(err)
 	}
-	return NewDataModel(wsDataModelResp.Items[0], wsDataModelRows.Headers, wsDataModelRows.Rows), nil
+	return NewDataModel(wsDataModelResp.Items[0], wsDataModelRows.Headers, wsDataModelRows.HeaderTypes, wsDataModelRows.Rows), nil
 }
 
 func (e *EventHandlerCreateRuns) Publish(ctx context.Context, run *Run, event *submission.EventCreateRuns) error {
 	if err := e.runRepo.Save(ctx, run); err != nil {
 		return err
 	}
+	if run.Queued {
+		return nil
+	}
 	eventSubmitRun := submission.NewEventSubmitRun(run.ID, event.RunConfig)
 	if err := e.eventBus.Publish(ctx, eventSubmitRun); err != nil {
 		return apperrors.NewInternalError(err)
@@ -347,7 +376,9 @@ type dataModel struct {
 	Name         string
 	Type         string
 	RowHeaderMap map[string]int
-	Rows         []*row
+	// HeaderTypes are the column types aligned with headers, values are rendered by them
+	HeaderTypes []string
+	Rows        []*row
 }
 type row struct {
 	Grids []string
@@ -382,14 +413,18 @@ func (d *dataModel) pickData(columnID, rowID string) (interface{}, bool) {
 				return nil, false
 			}
 			dataString := tempRow.Grids[columnIndex]
-			return utils.UnmarshalParamValue(dataString), true
+			var columnType string
+			if columnIndex < len(d.HeaderTypes) {
+				columnType = d.HeaderTypes[columnIndex]
+			}
+			return utils.ParseDataModelValue(columnType, dataString), true
 		}
 	}
 
 	return nil, false
 }
 
-func NewDataModel(srcDataModel *workspaceproto.DataModel, srcHeader []string, srcRows []*workspaceproto.Row) *dataModel {
+func NewDataModel(srcDataModel *workspaceproto.DataModel, srcHeader, srcHeaderTypes []string, srcRows []*workspaceproto.Row) *dataModel {
 	if srcDataModel == nil {
 		return nil
 	}
@@ -400,6 +435,7 @@ func NewDataModel(srcDataModel *workspaceproto.DataModel, srcHeader []string, sr
 	}
 
 	dataModel.RowHeaderMap = convertHeader(srcHeader)
+	dataModel.HeaderTypes = srcHeaderTypes
 
 	rows := []*row{}
 	for _, srcRow := range srcRows {
@@ -8,6 +8,7 @@ import (
 	"github.com/Bio-OS/bioos/internal/context/submission/domain/submission"
 	"github.com/Bio-OS/bioos/internal/context/submission/infrastructure/client/wes"
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	"github.com/Bio-OS/bioos/pkg/consts"
 	apperrors "github.com/Bio-OS/bioos/pkg/errors"
 	applog "github.com/Bio-OS/bioos/pkg/log"
 	"github.com/Bio-OS/bioos/pkg/utils"
@@ -18,14 +19,14 @@ const (
 )
 
 type EventHandlerSubmitRun struct {
-	wes      wes.Client
+	wes      wes.Registry
 	runRepo  Repository
 	eventBus eventbus.EventBus
 }
 
-func NewEventHandlerSubmitRun(wesClient wes.Client, eventBus eventbus.EventBus, runRepo Repository) *EventHandlerSubmitRun {
+func NewEventHandlerSubmitRun(wesRegistry wes.Registry, eventBus eventbus.EventBus, runRepo Repository) *EventHandlerSubmitRun {
 	return &EventHandlerSubmitRun{
-		wes:      wesClient,
+		wes:      wesRegistry,
 		runRepo:  runRepo,
 		eventBus: eventBus,
 	}
@@ -46,6 +47,18 @@ func (e *EventHandlerSubmitRun) Handle(ctx context.Context, event *submission.Ev
 		return nil
 	}
 
+	if event.Queued {
+		// the run may be released by several syncs of submission, only one of them can submit it
+		dequeued, err := e.runRepo.Dequeue(ctx, run.ID)
+		if err != nil {
+			return err
+		}
+		if !dequeued {
+			return nil
+		}
+		run.Queued = false
+	}
+
 	if run.EngineRunID != "" {
 		// todo check delay
 		eventSync := submission.NewEventSyncRun(event.RunID, 0)
@@ -55,18 +68,32 @@ func (e *EventHandlerSubmitRun) Handle(ctx context.Context, event *submission.Ev
 		return nil
 	}
 
+	wesClient, err := e.wes.Get(run.EngineBackend)
+	if err != nil {
+		// backend may be removed from config
+		applog.Errorw("failed to get wes backend", "err", err)
+		return e.markRunFailed(ctx, run, err.Error())
+	}
+
 	// not submit before
-	resp, err := e.wes.RunWorkflow(ctx, &wes.RunWorkflowRequest{
+	runConfig := event.RunConfig
+	if len(run.Attempts) > 0 {
+		// tasks succeeded in previous attempts will not be executed again
+		runConfig = runConfig.WithReadFromCache()
+	}
+	workflowType := wes.WorkflowTypeFromLanguage(runConfig.Language)
+	resp, err := wesClient.RunWorkflow(ctx, &wes.RunWorkflowRequest{
 		RunRequest: wes.RunRequest{
 			WorkflowParams:      run.Inputs,
-			WorkflowType:        event.RunConfig.Language,
-			WorkflowTypeVersion: event.RunConfig.Version,
+			WorkflowURL:         wes.WorkflowURLOfType(workflowType, runConfig.MainWorkflowFilePath),
+			WorkflowType:        workflowType,
+			WorkflowTypeVersion: runConfig.Version,
 			Tags: map[string]interface{}{
 				BioosRunIDKey: run.ID,
 			},
-			WorkflowEngineParameters: event.RunConfig.WorkflowEngineParameters,
+			WorkflowEngineParameters: runConfig.WorkflowEngineParameters,
 		},
-		WorkflowAttachment: event.RunConfig.WorkflowContents,
+		WorkflowAttachment: runConfig.WorkflowContents,
 	})
 	if err != nil {
 		if wes.IsBadRequest(err) {
@@ -85,7 +112,13 @@ func (e *EventHandlerSubmitRun) markRunFailed(ctx context.Context, run *Run, mes
 	tempRun := run.Copy()
 	tempRun.Message = utils.PointString(message)
 	tempRun.FinishTime = utils.PointTime(time.Now())
-	if err := e.runRepo.Save(ctx, run); err != nil {
+	tempRun.Status = consts.RunFailed
+	if err := e.runRepo.Save(ctx, tempRun); err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	// sync submission to release queued runs
+	eventSyncSubmission := submission.NewSyncSubmissionEvent(run.SubmissionID)
+	if err := e.eventBus.Publish(ctx, eventSyncSubmission); err != nil {
 		return apperrors.NewInternalError(err)
 	}
 	return nil
@@ -16,15 +16,15 @@ import (
 
 // EventHandlerSyncRun submit run -> list run/task
 type EventHandlerSyncRun struct {
-	wes        wes.Client
+	wes        wes.Registry
 	runRepo    Repository
 	runFactory Factory
 	eventBus   eventbus.EventBus
 }
 
-func NewEventHandlerSyncRun(wesClient wes.Client, runRepo Repository, runFactory Factory, eventBus eventbus.EventBus) *EventHandlerSyncRun {
+func NewEventHandlerSyncRun(wesRegistry wes.Registry, runRepo Repository, runFactory Factory, eventBus eventbus.EventBus) *EventHandlerSyncRun {
 	return &EventHandlerSyncRun{
-		wes:        wesClient,
+		wes:        wesRegistry,
 		runRepo:    runRepo,
 		runFactory: runFactory,
 		eventBus:   eventBus,
@@ -46,7 +46,13 @@ func (e *EventHandlerSyncRun) Handle(ctx context.Context, event *submission.Even
 		return e.markRunFailed(ctx, curRun, "nil engineRunID while sync run")
 	}
 
-	resp, err := e.wes.GetRunLog(ctx, &wes.GetRunLogRequest{RunID: curRun.EngineRunID})
+	wesClient, err := e.wes.Get(curRun.EngineBackend)
+	if err != nil {
+		applog.Errorw("failed to get wes backend", "err", err)
+		return e.markRunFailed(ctx, curRun, err.Error())
+	}
+
+	resp, err := wesClient.GetRunLog(ctx, &wes.GetRunLogRequest{RunID: curRun.EngineRunID})
 	if err != nil {
 		if wes.IsNotFound(err) {
 			return e.markRunFailed(ctx, curRun, "not found ")
@@ -130,6 +136,13 @@ func (e *EventHandlerSyncRun) genTasks(runID string, runState string, resp *wes.
 			Stdout: log.Stdout,
 			Stderr: log.Stderr,
 		}
+		resources := log.Resources()
+		taskParam.Resources = TaskResources{
+			CPU:         resources.CPU,
+			Memory:      resources.Memory,
+			MachineType: resources.MachineType,
+			Preemptible: resources.Preemptible,
+		}
 		if log.StartTime != nil {
 			taskParam.StartTime = log.StartTime.Time()
 		} else {
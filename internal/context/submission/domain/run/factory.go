@@ -8,17 +8,19 @@ import (
 )
 
 type CreateRunParam struct {
-	ID           string
-	Name         string
-	SubmissionID string
-	Inputs       map[string]interface{}  `gorm:"serializer:json"`
-	Outputs      *map[string]interface{} `gorm:"serializer:json"`
-	EngineRunID  string
-	Status       string
-	Log          *string
-	Message      *string
-	StartTime    time.Time
-	FinishTime   *time.Time
+	ID            string
+	Name          string
+	SubmissionID  string
+	Inputs        map[string]interface{}  `gorm:"serializer:json"`
+	Outputs       *map[string]interface{} `gorm:"serializer:json"`
+	EngineRunID   string
+	Status        string
+	Log           *string
+	Message       *string
+	StartTime     time.Time
+	FinishTime    *time.Time
+	Queued        bool
+	EngineBackend string
 }
 
 type CreateTaskParam struct {
@@ -29,6 +31,7 @@ type CreateTaskParam struct {
 	Stderr     string
 	StartTime  time.Time
 	FinishTime *time.Time
+	Resources  TaskResources
 }
 
 func (p CreateRunParam) validate() error {
@@ -60,17 +63,19 @@ func (fac *Factory) CreateWithRunParam(param CreateRunParam) (*Run, error) {
 	}
 
 	return &Run{
-		ID:           param.ID,
-		Name:         param.Name,
-		SubmissionID: param.SubmissionID,
-		Inputs:       param.Inputs,
-		Outputs:      param.Outputs,
-		EngineRunID:  param.EngineRunID,
-		Status:       param.Status,
-		Log:          param.Log,
-		Message:      param.Message,
-		StartTime:    param.StartTime,
-		FinishTime:   param.FinishTime,
+		ID:            param.ID,
+		Name:          param.Name,
+		SubmissionID:  param.SubmissionID,
+		Inputs:        param.Inputs,
+		Outputs:       param.Outputs,
+		EngineRunID:   param.EngineRunID,
+		Status:        param.Status,
+		Log:           param.Log,
+		Message:       param.Message,
+		StartTime:     param.StartTime,
+		FinishTime:    param.FinishTime,
+		Queued:        param.Queued,
+		EngineBackend: param.EngineBackend,
 	}, nil
 }
 
@@ -91,5 +96,6 @@ func (fac *Factory) CreateWithTaskParam(param CreateTaskParam) (*Task, error) {
 		Stderr:     param.Stderr,
 		StartTime:  param.StartTime,
 		FinishTime: param.FinishTime,
+		Resources:  param.Resources,
 	}, nil
 }
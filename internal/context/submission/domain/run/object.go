@@ -22,6 +22,31 @@ type Run struct {
 	StartTime    time.Time
 	FinishTime   *time.Time
 	Tasks        []*Task
+	// Queued means the run waits for a free slot of the concurrency limit of its submission
+	Queued bool
+	// EngineBackend is the name of WES backend the run is submitted to, empty means the default backend
+	EngineBackend string
+	// Attempts are previous executions of the run, from oldest
+	Attempts []*Attempt
+}
+
+// Attempt is a previous execution of run in workflow engine.
+type Attempt struct {
+	EngineRunID string
+	Status      string
+	Message     *string
+	StartTime   time.Time
+	FinishTime  *time.Time
+	// Tasks are the resources used by the tasks of attempt, which are still counted in usage after rerun
+	Tasks []*TaskUsage
+}
+
+// TaskUsage is the resources used by a task of previous attempt.
+type TaskUsage struct {
+	Name       string
+	StartTime  time.Time
+	FinishTime *time.Time
+	Resources  TaskResources
 }
 
 // Task ...
@@ -33,6 +58,17 @@ type Task struct {
 	Stderr     string
 	StartTime  time.Time
 	FinishTime *time.Time
+	// Resources are requested by the task, reported by engine
+	Resources TaskResources
+}
+
+// TaskResources is the resources of task, zero value means not reported by engine.
+type TaskResources struct {
+	CPU float64
+	// Memory in bytes
+	Memory      int64
+	MachineType string
+	Preemptible bool
 }
 
 func (run *Run) Copy() *Run {
@@ -48,3 +84,34 @@ func (run *Run) IsCancelling() bool {
 func (run *Run) IsFinished() bool {
 	return run.Status == consts.RunFailed || run.Status == consts.RunSucceeded || run.Status == consts.RunCancelled
 }
+
+// Reset records the current execution with its tasks as an attempt and resets the run to be submitted again,
+// the run waits in queue until there is a free slot of the concurrency limit of its submission.
+func (run *Run) Reset() {
+	tasks := make([]*TaskUsage, len(run.Tasks))
+	for i, task := range run.Tasks {
+		tasks[i] = &TaskUsage{
+			Name:       task.Name,
+			StartTime:  task.StartTime,
+			FinishTime: task.FinishTime,
+			Resources:  task.Resources,
+		}
+	}
+	run.Attempts = append(run.Attempts, &Attempt{
+		EngineRunID: run.EngineRunID,
+		Status:      run.Status,
+		Message:     run.Message,
+		StartTime:   run.StartTime,
+		FinishTime:  run.FinishTime,
+		Tasks:       tasks,
+	})
+	run.EngineRunID = ""
+	run.Status = consts.RunPending
+	run.Queued = true
+	run.Outputs = nil
+	run.Log = nil
+	run.Message = nil
+	run.Tasks = nil
+	run.StartTime = time.Now()
+	run.FinishTime = nil
+}
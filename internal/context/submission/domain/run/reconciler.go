@@ -0,0 +1,383 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/submission"
+	"github.com/Bio-OS/bioos/internal/context/submission/infrastructure/client/wes"
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+// reconcile actions
+const (
+	ReconcileActionReattach       = "Reattach"
+	ReconcileActionAbortDuplicate = "AbortDuplicate"
+	ReconcileActionMarkVanished   = "MarkVanished"
+	ReconcileActionError          = "Error"
+)
+
+const (
+	reconcileListRunsPageSize      = 100
+	defaultReconcilePendingTimeout = 5 * time.Minute
+	// reconcileLeaseName is the lease held by the replica reconciling runs
+	reconcileLeaseName = "run-reconciler"
+	// reconcileLeaseDuration is how long the lease is held without renewal, it is renewed before
+	// reconciling the runs of each backend and released after the round
+	reconcileLeaseDuration = 5 * time.Minute
+)
+
+// ErrReconcileLeaseHeld is returned if the runs are being reconciled by another replica.
+var ErrReconcileLeaseHeld = &apperrors.AppError{
+	Code:    apperrors.TemporaryDisabledCode,
+	Message: "runs are being reconciled by another replica",
+}
+
+// ReconcileReport is the result of one round of reconciliation.
+type ReconcileReport struct {
+	DryRun     bool
+	StartTime  time.Time
+	FinishTime time.Time
+	// Checked is the count of active runs checked
+	Checked int
+	Items   []*ReconcileItem
+}
+
+// ReconcileItem is an action taken (or to be taken in dry run) on a run.
+type ReconcileItem struct {
+	RunID         string
+	SubmissionID  string
+	EngineBackend string
+	EngineRunID   string
+	Action        string
+	Message       string
+}
+
+// Reconciler reconciles the active runs with the runs of WES backends found by the bioos-run-id tag:
+// orphaned engine runs of pending runs are re-attached, duplicated engine runs are aborted and
+// runs whose engine run vanished are marked failed.
+// The runs of each backend are listed once per round, and only the replica holding the lease
+// reconciles runs.
+type Reconciler struct {
+	wes      wes.Registry
+	runRepo  Repository
+	eventBus eventbus.EventBus
+	// pendingTimeout is the time a pending run without engine run id is left to the submit handler
+	pendingTimeout time.Duration
+	// owner identifies the replica holding the lease
+	owner string
+
+	// mutex makes sure only one round of reconciliation runs at the same time in the process
+	mutex sync.Mutex
+	// tags caches the bioos-run-id tag of engine runs of each backend got by GetRunLog, the engine
+	// runs no longer listed are dropped
+	tags map[string]map[string]string
+}
+
+func NewReconciler(wesRegistry wes.Registry, runRepo Repository, eventBus eventbus.EventBus) *Reconciler {
+	hostname, _ := os.Hostname()
+	return &Reconciler{
+		wes:            wesRegistry,
+		runRepo:        runRepo,
+		eventBus:       eventBus,
+		pendingTimeout: defaultReconcilePendingTimeout,
+		owner:          fmt.Sprintf("%s-%s", hostname, uuid.New().String()),
+		tags:           make(map[string]map[string]string),
+	}
+}
+
+// Start reconciles runs periodically until ctx is done.
+func (r *Reconciler) Start(ctx context.Context, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := r.Reconcile(ctx, false)
+			if errors.Is(err, ErrReconcileLeaseHeld) {
+				applog.Infow("skip reconciling runs", "reason", err.Error())
+				continue
+			}
+			if err != nil {
+				applog.Errorw("failed to reconcile runs", "err", err)
+				continue
+			}
+			applog.Infow("runs reconciled", "checked", report.Checked, "actions", len(report.Items))
+		}
+	}
+}
+
+// Reconcile checks all active runs once, nothing is changed if dryRun. ErrReconcileLeaseHeld is
+// returned if not dryRun and the runs are being reconciled by another replica.
+func (r *Reconciler) Reconcile(ctx context.Context, dryRun bool) (*ReconcileReport, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !dryRun {
+		if err := r.acquireLease(ctx); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := r.runRepo.ReleaseLease(ctx, reconcileLeaseName, r.owner); err != nil {
+				applog.Errorw("failed to release lease of reconciler", "err", err)
+			}
+		}()
+	}
+
+	report := &ReconcileReport{
+		DryRun:    dryRun,
+		StartTime: time.Now(),
+		Items:     make([]*ReconcileItem, 0),
+	}
+	runs, err := r.runRepo.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	backends := make([]string, 0)
+	runsOfBackend := make(map[string][]*Run)
+	for _, run := range runs {
+		// the submit handler may be submitting the run right now
+		if run.EngineRunID == "" && time.Since(run.StartTime) < r.pendingTimeout {
+			continue
+		}
+		report.Checked++
+		if _, ok := runsOfBackend[run.EngineBackend]; !ok {
+			backends = append(backends, run.EngineBackend)
+		}
+		runsOfBackend[run.EngineBackend] = append(runsOfBackend[run.EngineBackend], run)
+	}
+	for _, backend := range backends {
+		if !dryRun {
+			// renew the lease, as listing the runs of backend may take long
+			if err := r.acquireLease(ctx); err != nil {
+				return nil, err
+			}
+		}
+		report.Items = append(report.Items, r.reconcileBackend(ctx, backend, runsOfBackend[backend], dryRun)...)
+	}
+	report.FinishTime = time.Now()
+	return report, nil
+}
+
+func (r *Reconciler) acquireLease(ctx context.Context) error {
+	ok, err := r.runRepo.AcquireLease(ctx, reconcileLeaseName, r.owner, time.Now().Add(reconcileLeaseDuration))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrReconcileLeaseHeld
+	}
+	return nil
+}
+
+// reconcileBackend reconciles the runs of backend with the engine runs listed once.
+func (r *Reconciler) reconcileBackend(ctx context.Context, backend string, runs []*Run, dryRun bool) []*ReconcileItem {
+	items := make([]*ReconcileItem, 0)
+	wesClient, err := r.wes.Get(backend)
+	if err == nil {
+		var engineRuns map[string]map[string]wes.RunState
+		if engineRuns, err = r.listEngineRuns(ctx, backend, wesClient, runs); err == nil {
+			for _, run := range runs {
+				runItems, err := r.reconcileRun(ctx, wesClient, run, engineRuns[run.ID], dryRun)
+				if err != nil {
+					applog.Errorw("failed to reconcile run", "runID", run.ID, "err", err)
+					runItems = append(runItems, newReconcileItem(run, run.EngineRunID, ReconcileActionError, err.Error()))
+				}
+				items = append(items, runItems...)
+			}
+			return items
+		}
+	}
+	applog.Errorw("failed to reconcile runs of backend", "backend", backend, "err", err)
+	for _, run := range runs {
+		items = append(items, newReconcileItem(run, run.EngineRunID, ReconcileActionError, err.Error()))
+	}
+	return items
+}
+
+// reconcileRun reconciles run with its engine runs except the previous attempts.
+func (r *Reconciler) reconcileRun(ctx context.Context, wesClient wes.Client, run *Run, engineRuns map[string]wes.RunState, dryRun bool) ([]*ReconcileItem, error) {
+	items := make([]*ReconcileItem, 0)
+	engineRunID := run.EngineRunID
+	if engineRunID == "" {
+		// the run was submitted but the engine run id was not saved
+		engineRunID = pickEngineRun(engineRuns)
+		if engineRunID == "" {
+			return items, nil
+		}
+		items = append(items, newReconcileItem(run, engineRunID, ReconcileActionReattach, "orphaned engine run found by tag"))
+		if !dryRun {
+			if err := r.reattach(ctx, run, engineRunID); err != nil {
+				return items, err
+			}
+		}
+	} else if _, ok := engineRuns[engineRunID]; !ok {
+		vanished, err := r.isVanished(ctx, wesClient, engineRunID)
+		if err != nil {
+			return items, err
+		}
+		if vanished {
+			items = append(items, newReconcileItem(run, engineRunID, ReconcileActionMarkVanished, "engine run not found"))
+			if !dryRun {
+				if err := r.markVanished(ctx, run); err != nil {
+					return items, err
+				}
+			}
+		}
+	}
+
+	for id, state := range engineRuns {
+		if id == engineRunID || state.IsFinished() {
+			continue
+		}
+		items = append(items, newReconcileItem(run, id, ReconcileActionAbortDuplicate, fmt.Sprintf("duplicated engine run in state %s", state)))
+		if dryRun {
+			continue
+		}
+		if _, err := wesClient.CancelRun(ctx, &wes.CancelRunRequest{RunID: id}); err != nil && !wes.IsNotFound(err) {
+			return items, err
+		}
+	}
+	return items, nil
+}
+
+// listEngineRuns lists all engine runs of backend once and groups them by the bioos-run-id tag,
+// the previous attempts of runs and the engine runs of other runs are left out.
+// The tag is got by GetRunLog only if it is neither listed by the backend nor known, i.e. the
+// engine run is not the current one of a run and not cached.
+func (r *Reconciler) listEngineRuns(ctx context.Context, backend string, wesClient wes.Client, runs []*Run) (map[string]map[string]wes.RunState, error) {
+	active := make(map[string]struct{}, len(runs))
+	current := make(map[string]string, len(runs))
+	previous := make(map[string]struct{})
+	for _, run := range runs {
+		active[run.ID] = struct{}{}
+		if run.EngineRunID != "" {
+			current[run.EngineRunID] = run.ID
+		}
+		for _, attempt := range run.Attempts {
+			previous[attempt.EngineRunID] = struct{}{}
+		}
+	}
+
+	cached := r.tags[backend]
+	tags := make(map[string]string)
+	engineRuns := make(map[string]map[string]wes.RunState)
+	req := &wes.ListRunsRequest{
+		PageSize: utils.PointInt64(reconcileListRunsPageSize),
+	}
+	for {
+		resp, err := wesClient.ListRuns(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, engineRun := range resp.Runs {
+			if _, ok := previous[engineRun.RunID]; ok {
+				continue
+			}
+			tag, ok := current[engineRun.RunID]
+			if !ok && engineRun.Tags != nil {
+				tag, _ = engineRun.Tags[BioosRunIDKey].(string)
+				ok = true
+			}
+			if !ok {
+				tag, ok = cached[engineRun.RunID]
+			}
+			if !ok {
+				logResp, err := wesClient.GetRunLog(ctx, &wes.GetRunLogRequest{RunID: engineRun.RunID})
+				if err != nil {
+					if wes.IsNotFound(err) {
+						continue
+					}
+					return nil, err
+				}
+				tag, _ = logResp.Request.Tags[BioosRunIDKey].(string)
+			}
+			tags[engineRun.RunID] = tag
+			if _, ok := active[tag]; !ok {
+				continue
+			}
+			if engineRuns[tag] == nil {
+				engineRuns[tag] = make(map[string]wes.RunState)
+			}
+			engineRuns[tag][engineRun.RunID] = engineRun.State
+		}
+		if resp.NextPageToken == "" {
+			r.tags[backend] = tags
+			return engineRuns, nil
+		}
+		req.PageToken = utils.PointString(resp.NextPageToken)
+	}
+}
+
+func (r *Reconciler) isVanished(ctx context.Context, wesClient wes.Client, engineRunID string) (bool, error) {
+	if _, err := wesClient.GetRunLog(ctx, &wes.GetRunLogRequest{RunID: engineRunID}); err != nil {
+		if wes.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func (r *Reconciler) reattach(ctx context.Context, run *Run, engineRunID string) error {
+	tempRun := run.Copy()
+	tempRun.EngineRunID = engineRunID
+	if tempRun.Status == consts.RunPending {
+		tempRun.Status = consts.RunRunning
+	}
+	if err := r.runRepo.Save(ctx, tempRun); err != nil {
+		return err
+	}
+	if err := r.eventBus.Publish(ctx, submission.NewEventSyncRun(run.ID, 0)); err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *Reconciler) markVanished(ctx context.Context, run *Run) error {
+	tempRun := run.Copy()
+	tempRun.Message = utils.PointString("engine run vanished")
+	tempRun.FinishTime = utils.PointTime(time.Now())
+	tempRun.Status = consts.RunFailed
+	if err := r.runRepo.Save(ctx, tempRun); err != nil {
+		return err
+	}
+	if err := r.eventBus.Publish(ctx, submission.NewSyncSubmissionEvent(run.SubmissionID)); err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+// pickEngineRun prefers the unfinished engine run, so that the finished ones are not aborted.
+func pickEngineRun(engineRuns map[string]wes.RunState) string {
+	picked := ""
+	for id, state := range engineRuns {
+		if picked == "" || (!state.IsFinished() && engineRuns[picked].IsFinished()) || (state.IsFinished() == engineRuns[picked].IsFinished() && id < picked) {
+			picked = id
+		}
+	}
+	return picked
+}
+
+func newReconcileItem(run *Run, engineRunID, action, message string) *ReconcileItem {
+	return &ReconcileItem{
+		RunID:         run.ID,
+		SubmissionID:  run.SubmissionID,
+		EngineBackend: run.EngineBackend,
+		EngineRunID:   engineRunID,
+		Action:        action,
+		Message:       message,
+	}
+}
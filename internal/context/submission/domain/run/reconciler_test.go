@@ -0,0 +1,281 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/submission"
+	"github.com/Bio-OS/bioos/internal/context/submission/infrastructure/client/wes"
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+)
+
+func TestMain(m *testing.M) {
+	applog.RegisterLogger(&applog.Options{
+		Level: "fatal",
+	})
+	os.Exit(m.Run())
+}
+
+// fakeEngineRun is a run of fake wes backend tagged with the id of bioos run.
+type fakeEngineRun struct {
+	state wes.RunState
+	tag   string
+}
+
+// fakeWESClient lists engine runs 2 per page, the tags are listed only if listsTags.
+// The calls of ListRuns and GetRunLog are counted.
+type fakeWESClient struct {
+	wes.Client
+	runs           map[string]fakeEngineRun
+	listsTags      bool
+	canceled       []string
+	listRunsCalls  int
+	getRunLogCalls int
+}
+
+func (f *fakeWESClient) ListRuns(_ context.Context, req *wes.ListRunsRequest) (*wes.ListRunsResponse, error) {
+	f.listRunsCalls++
+	ids := make([]string, 0, len(f.runs))
+	for id := range f.runs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	start := 0
+	if req.PageToken != nil {
+		start, _ = strconv.Atoi(*req.PageToken)
+	}
+	resp := &wes.ListRunsResponse{Runs: []wes.RunStatus{}}
+	for i := start; i < len(ids) && i < start+2; i++ {
+		status := wes.RunStatus{RunID: ids[i], State: f.runs[ids[i]].state}
+		if f.listsTags {
+			status.Tags = fakeTags(f.runs[ids[i]])
+		}
+		resp.Runs = append(resp.Runs, status)
+	}
+	if start+2 < len(ids) {
+		resp.NextPageToken = strconv.Itoa(start + 2)
+	}
+	return resp, nil
+}
+
+func (f *fakeWESClient) GetRunLog(_ context.Context, req *wes.GetRunLogRequest) (*wes.GetRunLogResponse, error) {
+	f.getRunLogCalls++
+	run, ok := f.runs[req.RunID]
+	if !ok {
+		return nil, wes.ErrorResp{Msg: fmt.Sprintf("run %s not found", req.RunID), StatusCode: http.StatusNotFound}
+	}
+	return &wes.GetRunLogResponse{RunID: req.RunID, State: run.state, Request: wes.RunRequest{Tags: fakeTags(run)}}, nil
+}
+
+func fakeTags(run fakeEngineRun) map[string]interface{} {
+	tags := map[string]interface{}{}
+	if run.tag != "" {
+		tags[BioosRunIDKey] = run.tag
+	}
+	return tags
+}
+
+func (f *fakeWESClient) CancelRun(_ context.Context, req *wes.CancelRunRequest) (*wes.CancelRunResponse, error) {
+	f.canceled = append(f.canceled, req.RunID)
+	return &wes.CancelRunResponse{RunID: req.RunID}, nil
+}
+
+// fakeRegistry has the only default backend.
+type fakeRegistry struct {
+	wes.Registry
+	client wes.Client
+}
+
+func (f *fakeRegistry) Get(name string) (wes.Client, error) {
+	if name != "" && name != wes.DefaultBackend {
+		return nil, fmt.Errorf("wes backend %s is not configured", name)
+	}
+	return f.client, nil
+}
+
+// fakeRepository has the active runs, the runs saved are recorded.
+type fakeRepository struct {
+	Repository
+	active     []*Run
+	saved      map[string]*Run
+	leaseOwner string
+	leaseUntil time.Time
+}
+
+func (f *fakeRepository) ListActive(context.Context) ([]*Run, error) {
+	return f.active, nil
+}
+
+func (f *fakeRepository) Save(_ context.Context, run *Run) error {
+	f.saved[run.ID] = run
+	return nil
+}
+
+func (f *fakeRepository) AcquireLease(_ context.Context, _, owner string, expireAt time.Time) (bool, error) {
+	if f.leaseOwner != "" && f.leaseOwner != owner && f.leaseUntil.After(time.Now()) {
+		return false, nil
+	}
+	f.leaseOwner, f.leaseUntil = owner, expireAt
+	return true, nil
+}
+
+func (f *fakeRepository) ReleaseLease(_ context.Context, _, owner string) error {
+	if f.leaseOwner == owner {
+		f.leaseOwner = ""
+	}
+	return nil
+}
+
+// fakeEventBus records the types of published events, publishing fails if err is set.
+type fakeEventBus struct {
+	eventbus.EventBus
+	published []string
+	err       error
+}
+
+func (f *fakeEventBus) Publish(_ context.Context, event eventbus.IEvent) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, event.EventType())
+	return nil
+}
+
+func newTestReconciler(client wes.Client, active []*Run) (*Reconciler, *fakeRepository, *fakeEventBus) {
+	repo := &fakeRepository{active: active, saved: map[string]*Run{}}
+	bus := &fakeEventBus{}
+	return NewReconciler(&fakeRegistry{client: client}, repo, bus), repo, bus
+}
+
+func reconcileActions(report *ReconcileReport) map[string]string {
+	actions := make(map[string]string, len(report.Items))
+	for _, item := range report.Items {
+		actions[item.EngineRunID] = item.RunID + ":" + item.Action
+	}
+	return actions
+}
+
+func TestReconcileTagMatching(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+	startTime := time.Now().Add(-time.Hour)
+
+	for _, listsTags := range []bool{false, true} {
+		client := &fakeWESClient{
+			listsTags: listsTags,
+			runs: map[string]fakeEngineRun{
+				// engine run of previous attempt of run-1
+				"engine-0": {state: wes.RunStateRunning, tag: "run-1"},
+				"engine-1": {state: wes.RunStateComplete, tag: "run-1"},
+				"engine-2": {state: wes.RunStateRunning, tag: "run-1"},
+				"engine-3": {state: wes.RunStateRunning, tag: "run-2"},
+				"engine-4": {state: wes.RunStateRunning},
+				"engine-5": {state: wes.RunStateRunning, tag: "run-2"},
+			},
+		}
+		reconciler, repo, bus := newTestReconciler(client, []*Run{
+			// submitted but the engine run id was not saved
+			{ID: "run-1", SubmissionID: "sub-1", Status: consts.RunPending, StartTime: startTime, Attempts: []*Attempt{{EngineRunID: "engine-0"}}},
+			{ID: "run-2", SubmissionID: "sub-1", Status: consts.RunRunning, StartTime: startTime, EngineRunID: "engine-3"},
+			{ID: "run-3", SubmissionID: "sub-1", Status: consts.RunRunning, StartTime: startTime, EngineRunID: "engine-6"},
+			// the submit handler may be submitting it
+			{ID: "run-4", SubmissionID: "sub-1", Status: consts.RunPending, StartTime: time.Now()},
+		})
+
+		report, err := reconciler.Reconcile(ctx, true)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(report.Checked).To(gomega.Equal(3))
+		expected := map[string]string{
+			// the unfinished engine run is re-attached rather than the finished one
+			"engine-2": "run-1:" + ReconcileActionReattach,
+			"engine-5": "run-2:" + ReconcileActionAbortDuplicate,
+			"engine-6": "run-3:" + ReconcileActionMarkVanished,
+		}
+		g.Expect(reconcileActions(report)).To(gomega.Equal(expected), "tags listed: %v", listsTags)
+		// the runs of backend are listed once in 3 pages, the log of the vanished engine run-6 is got
+		// to make sure it is not found, the tags not listed are got from the log of engine runs
+		// except the current engine run-3 and the previous attempt engine run-0
+		g.Expect(client.listRunsCalls).To(gomega.Equal(3))
+		if listsTags {
+			g.Expect(client.getRunLogCalls).To(gomega.Equal(1))
+		} else {
+			g.Expect(client.getRunLogCalls).To(gomega.Equal(5))
+		}
+		// nothing is changed in dry run
+		g.Expect(repo.saved).To(gomega.BeEmpty())
+		g.Expect(bus.published).To(gomega.BeEmpty())
+		g.Expect(client.canceled).To(gomega.BeEmpty())
+
+		listRunsCalls, getRunLogCalls := client.listRunsCalls, client.getRunLogCalls
+		report, err = reconciler.Reconcile(ctx, false)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(reconcileActions(report)).To(gomega.Equal(expected))
+		// the tags got in the previous round are cached
+		g.Expect(client.listRunsCalls - listRunsCalls).To(gomega.Equal(3))
+		g.Expect(client.getRunLogCalls - getRunLogCalls).To(gomega.Equal(1))
+		// the lease is released after the round
+		g.Expect(repo.leaseOwner).To(gomega.BeEmpty())
+		g.Expect(repo.saved).To(gomega.HaveLen(2))
+		g.Expect(repo.saved["run-1"].EngineRunID).To(gomega.Equal("engine-2"))
+		g.Expect(repo.saved["run-1"].Status).To(gomega.Equal(consts.RunRunning))
+		g.Expect(repo.saved["run-3"].Status).To(gomega.Equal(consts.RunFailed))
+		g.Expect(client.canceled).To(gomega.Equal([]string{"engine-5"}))
+		g.Expect(bus.published).To(gomega.ConsistOf(submission.SyncRun, submission.SyncSubmission))
+	}
+}
+
+func TestReconcileLease(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+
+	client := &fakeWESClient{runs: map[string]fakeEngineRun{}}
+	reconciler, repo, _ := newTestReconciler(client, []*Run{
+		{ID: "run-1", SubmissionID: "sub-1", Status: consts.RunRunning, StartTime: time.Now(), EngineRunID: "engine-1"},
+	})
+	other, _, _ := newTestReconciler(client, nil)
+	other.runRepo = repo
+	g.Expect(other.acquireLease(ctx)).To(gomega.Succeed())
+
+	// the lease is held by another replica
+	_, err := reconciler.Reconcile(ctx, false)
+	g.Expect(err).To(gomega.MatchError(ErrReconcileLeaseHeld))
+	g.Expect(client.listRunsCalls).To(gomega.BeZero())
+	g.Expect(repo.saved).To(gomega.BeEmpty())
+	// dry run does not need the lease
+	report, err := reconciler.Reconcile(ctx, true)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(reconcileActions(report)).To(gomega.Equal(map[string]string{"engine-1": "run-1:" + ReconcileActionMarkVanished}))
+
+	// the expired lease is taken over
+	repo.leaseUntil = time.Now().Add(-time.Second)
+	report, err = reconciler.Reconcile(ctx, false)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(report.Items).To(gomega.HaveLen(1))
+	g.Expect(repo.saved["run-1"].Status).To(gomega.Equal(consts.RunFailed))
+	g.Expect(repo.leaseOwner).To(gomega.BeEmpty())
+}
+
+func TestPickEngineRun(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	g.Expect(pickEngineRun(map[string]wes.RunState{})).To(gomega.BeEmpty())
+	g.Expect(pickEngineRun(map[string]wes.RunState{
+		"engine-1": wes.RunStateComplete,
+		"engine-2": wes.RunStateRunning,
+		"engine-3": wes.RunStateQueued,
+	})).To(gomega.Equal("engine-2"))
+	g.Expect(pickEngineRun(map[string]wes.RunState{
+		"engine-2": wes.RunStateExecutorError,
+		"engine-1": wes.RunStateComplete,
+	})).To(gomega.Equal("engine-1"))
+}
@@ -1,10 +1,30 @@
 package run
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Repository allows to get/save events from/to event store.
 type Repository interface {
 	Save(ctx context.Context, r *Run) error
 	Get(ctx context.Context, id string) (*Run, error)
 	Delete(ctx context.Context, r *Run) error
+	DeleteTasks(ctx context.Context, r *Run) error
+	// SaveReset saves the reset run and deletes the tasks of its previous execution in a transaction,
+	// only if the stored run is finished. Returns false if the run is not finished any more.
+	SaveReset(ctx context.Context, r *Run) (bool, error)
+	// ListTasks lists the tasks of the current execution of run.
+	ListTasks(ctx context.Context, r *Run) ([]*Task, error)
+	// Dequeue takes the run out of the queue, returns false if it is not queued any more.
+	Dequeue(ctx context.Context, id string) (bool, error)
+	// Enqueue puts the run taken out of the queue back if it is not submitted yet.
+	Enqueue(ctx context.Context, id string) error
+	// ListActive lists the runs which are not finished and not queued.
+	ListActive(ctx context.Context) ([]*Run, error)
+	// AcquireLease acquires or renews the lease of name for owner until expireAt, returns false if
+	// it is held by another owner and not expired.
+	AcquireLease(ctx context.Context, name, owner string, expireAt time.Time) (bool, error)
+	// ReleaseLease releases the lease of name if it is held by owner.
+	ReleaseLease(ctx context.Context, name, owner string) error
 }
@@ -21,10 +21,11 @@ type Service interface {
 	Update(context.Context, *Run, []*Task) error
 	Delete(ctx context.Context, id string) error
 	Cancel(ctx context.Context, id string) error
+	Rerun(ctx context.Context, submissionID, id string) error
 	CheckWorkspaceExist(ctx context.Context, workspaceID string) error
 }
 
-func NewService(grpcFactory grpc.Factory, repo Repository, eventbus eventbus.EventBus, wesClient wes.Client) Service {
+func NewService(grpcFactory grpc.Factory, repo Repository, eventbus eventbus.EventBus, wesRegistry wes.Registry) Service {
 	dataModelClient, err := grpcFactory.DataModelClient()
 	if err != nil {
 		log.Fatalf(err.Error())
@@ -39,7 +40,7 @@ func NewService(grpcFactory grpc.Factory, repo Repository, eventbus eventbus.Eve
 		factory:         Factory{},
 		dataModelClient: dataModelClient,
 		workspaceClient: workspaceClient,
-		wesClient:       wesClient,
+		wesRegistry:     wesRegistry,
 	}
 	svc.subscribeEvents()
 	return svc
@@ -51,7 +52,7 @@ type service struct {
 	factory         Factory
 	dataModelClient grpc.DataModelClient
 	workspaceClient grpc.WorkspaceClient
-	wesClient       wes.Client
+	wesRegistry     wes.Registry
 }
 
 func (s *service) Upsert(ctx context.Context, run *Run, tasks []*Task) error {
@@ -109,6 +110,57 @@ func (s *service) Cancel(ctx context.Context, id string) error {
 	return s.repository.Save(ctx, run)
 }
 
+// Rerun resets the finished run and queues it to be submitted again, the previous execution is kept in the attempt
+// history of run. The run is restored if the rerun event fails to be published.
+func (s *service) Rerun(ctx context.Context, submissionID, id string) error {
+	run, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if run.SubmissionID != submissionID {
+		return apperrors.NewNotFoundError("run", id)
+	}
+	if !run.IsFinished() {
+		return apperrors.NewInvalidError("cannot rerun unfinished run")
+	}
+
+	if run.Tasks, err = s.repository.ListTasks(ctx, run); err != nil {
+		return err
+	}
+	previous := run.Copy()
+	run.Reset()
+	// the resources of tasks are recorded in the attempt, the run is not reset if it is rerun concurrently
+	saved, err := s.repository.SaveReset(ctx, run)
+	if err != nil {
+		return err
+	}
+	if !saved {
+		return apperrors.NewInvalidError("cannot rerun unfinished run")
+	}
+	event := submission.NewEventRerunRun(run.SubmissionID, run.ID)
+	if err = s.eventbus.Publish(ctx, event); err != nil {
+		s.restore(ctx, previous)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+// restore restores the run and its tasks before reset, unless the queued run is taken out of the
+// queue by others already.
+func (s *service) restore(ctx context.Context, previous *Run) {
+	dequeued, err := s.repository.Dequeue(ctx, previous.ID)
+	if err != nil {
+		log.Errorw("failed to restore run", "runID", previous.ID, "err", err)
+		return
+	}
+	if !dequeued {
+		return
+	}
+	if err := s.repository.Save(ctx, previous); err != nil {
+		log.Errorw("failed to restore run", "runID", previous.ID, "err", err)
+	}
+}
+
 func (s *service) CheckWorkspaceExist(ctx context.Context, workspaceID string) error {
 	if _, err := s.workspaceClient.GetWorkspace(ctx, &workspaceproto.GetWorkspaceRequest{Id: workspaceID}); err != nil {
 		return apperrors.NewInternalError(err)
@@ -133,7 +185,7 @@ func (s *service) subscribeEvents() {
 			return err
 		}
 
-		handler := NewEventHandlerSubmitRun(s.wesClient, s.eventbus, s.repository)
+		handler := NewEventHandlerSubmitRun(s.wesRegistry, s.eventbus, s.repository)
 		return handler.Handle(ctx, event)
 	}))
 
@@ -143,7 +195,7 @@ func (s *service) subscribeEvents() {
 			return err
 		}
 
-		handler := NewEventHandlerSyncRun(s.wesClient, s.repository, s.factory, s.eventbus)
+		handler := NewEventHandlerSyncRun(s.wesRegistry, s.repository, s.factory, s.eventbus)
 		return handler.Handle(ctx, event)
 	}))
 	s.eventbus.Subscribe(submission.DeleteRun, eventbus.EventHandlerFunc(func(ctx context.Context, payload string) error {
@@ -161,7 +213,7 @@ func (s *service) subscribeEvents() {
 			return err
 		}
 
-		handler := NewEventHandlerCancelRun(s.wesClient, s.repository, s.eventbus)
+		handler := NewEventHandlerCancelRun(s.wesRegistry, s.repository, s.eventbus)
 		return handler.Handle(ctx, event)
 	}))
 }
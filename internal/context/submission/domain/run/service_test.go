@@ -0,0 +1,130 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/submission"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+)
+
+// fakeRerunRepository stores one run with its tasks, the reset is saved only if the stored run is finished.
+type fakeRerunRepository struct {
+	Repository
+	run   *Run
+	tasks []*Task
+	// dequeued is whether the queued run is taken out of the queue by others
+	dequeued bool
+}
+
+func (f *fakeRerunRepository) Get(_ context.Context, id string) (*Run, error) {
+	if f.run == nil || f.run.ID != id {
+		return nil, apperrors.NewNotFoundError("run", id)
+	}
+	return f.run.Copy(), nil
+}
+
+func (f *fakeRerunRepository) ListTasks(context.Context, *Run) ([]*Task, error) {
+	return f.tasks, nil
+}
+
+func (f *fakeRerunRepository) SaveReset(_ context.Context, run *Run) (bool, error) {
+	if !f.run.IsFinished() {
+		return false, nil
+	}
+	f.run, f.tasks = run, nil
+	return true, nil
+}
+
+func (f *fakeRerunRepository) Dequeue(context.Context, string) (bool, error) {
+	if !f.run.Queued || f.dequeued {
+		return false, nil
+	}
+	f.run.Queued = false
+	return true, nil
+}
+
+func (f *fakeRerunRepository) Save(_ context.Context, run *Run) error {
+	f.run, f.tasks = run, run.Tasks
+	return nil
+}
+
+func newFinishedRun() *Run {
+	return &Run{
+		ID:           "run-1",
+		SubmissionID: "sub-1",
+		EngineRunID:  "engine-1",
+		Status:       consts.RunFailed,
+		StartTime:    time.Now().Add(-time.Hour),
+		FinishTime:   &time.Time{},
+	}
+}
+
+func TestRerun(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+
+	repo := &fakeRerunRepository{run: newFinishedRun(), tasks: []*Task{{Name: "call-a", RunID: "run-1"}}}
+	bus := &fakeEventBus{}
+	svc := &service{repository: repo, eventbus: bus}
+	g.Expect(svc.Rerun(ctx, "sub-2", "run-1")).ToNot(gomega.Succeed())
+	g.Expect(svc.Rerun(ctx, "sub-1", "run-1")).To(gomega.Succeed())
+	g.Expect(repo.run.Status).To(gomega.Equal(consts.RunPending))
+	g.Expect(repo.run.Queued).To(gomega.BeTrue())
+	g.Expect(repo.run.Attempts).To(gomega.HaveLen(1))
+	g.Expect(repo.run.Attempts[0].Tasks).To(gomega.HaveLen(1))
+	g.Expect(repo.tasks).To(gomega.BeEmpty())
+	g.Expect(bus.published).To(gomega.Equal([]string{submission.RerunRun}))
+	// the run is not finished any more
+	g.Expect(svc.Rerun(ctx, "sub-1", "run-1")).ToNot(gomega.Succeed())
+	g.Expect(bus.published).To(gomega.HaveLen(1))
+}
+
+func TestRerunConcurrently(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+
+	// the run is reset by another caller after it is got
+	repo := &fakeRerunRepository{run: newFinishedRun()}
+	repo.run.Status = consts.RunPending
+	bus := &fakeEventBus{}
+	svc := &service{repository: &staleRerunRepository{fakeRerunRepository: repo, stale: newFinishedRun()}, eventbus: bus}
+	g.Expect(svc.Rerun(ctx, "sub-1", "run-1")).ToNot(gomega.Succeed())
+	g.Expect(bus.published).To(gomega.BeEmpty())
+	g.Expect(repo.run.Attempts).To(gomega.BeEmpty())
+}
+
+// staleRerunRepository gets the stale run, as if it is reset by others after it is got.
+type staleRerunRepository struct {
+	*fakeRerunRepository
+	stale *Run
+}
+
+func (f *staleRerunRepository) Get(context.Context, string) (*Run, error) {
+	return f.stale, nil
+}
+
+func TestRerunPublishFailed(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+
+	repo := &fakeRerunRepository{run: newFinishedRun(), tasks: []*Task{{Name: "call-a", RunID: "run-1"}}}
+	bus := &fakeEventBus{err: fmt.Errorf("an error")}
+	svc := &service{repository: repo, eventbus: bus}
+	// the run is restored with its tasks
+	g.Expect(svc.Rerun(ctx, "sub-1", "run-1")).ToNot(gomega.Succeed())
+	g.Expect(repo.run.Status).To(gomega.Equal(consts.RunFailed))
+	g.Expect(repo.run.Queued).To(gomega.BeFalse())
+	g.Expect(repo.run.Attempts).To(gomega.BeEmpty())
+	g.Expect(repo.tasks).To(gomega.HaveLen(1))
+
+	// the run taken out of the queue by others is not restored
+	repo.dequeued = true
+	g.Expect(svc.Rerun(ctx, "sub-1", "run-1")).ToNot(gomega.Succeed())
+	g.Expect(repo.run.Status).To(gomega.Equal(consts.RunPending))
+}
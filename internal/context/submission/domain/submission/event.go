@@ -14,6 +14,7 @@ const (
 
 	CreateRuns = "CreateRuns"
 	SubmitRun  = "SubmitRun"
+	RerunRun   = "RerunRun"
 	SyncRun    = "SyncRun"
 	CancelRun  = "CancelRun"
 	DeleteRun  = "DeleteRun"
@@ -151,6 +152,12 @@ type EventCreateRuns struct {
 	SubmisstionType string // filePath or dataModel
 	DataModelID     *string
 	DataModelRowIDs []string
+	// DataModelSnapshotID is the snapshot of data model rows are read from, the current rows are read if nil
+	DataModelSnapshotID *string
+	// MaxConcurrentRuns limits the runs submitted at the same time, 0 means unlimited
+	MaxConcurrentRuns int
+	// EngineBackend is the name of WES backend runs are submitted to
+	EngineBackend string
 
 	RunConfig *RunConfig
 }
@@ -163,6 +170,19 @@ type RunConfig struct {
 	Version                  string
 }
 
+// WithReadFromCache returns a copy of config which submits run with call caching enabled.
+func (c *RunConfig) WithReadFromCache() *RunConfig {
+	config := *c
+	config.WorkflowEngineParameters = make(map[string]interface{}, len(c.WorkflowEngineParameters)+1)
+	for key, value := range c.WorkflowEngineParameters {
+		config.WorkflowEngineParameters[key] = value
+	}
+	for key, value := range exposedOptions2Map(&ExposedOptions{ReadFromCache: true}) {
+		config.WorkflowEngineParameters[key] = value
+	}
+	return &config
+}
+
 func NewEventCreateRuns(workspaceID, submissionID, submissionType string, inputs, outputs map[string]interface{}, dataModelID *string, DataModelRowIDs []string, runConfig *RunConfig) *EventCreateRuns {
 	return &EventCreateRuns{
 		WorkspaceID:     workspaceID,
@@ -200,6 +220,8 @@ func NewEventCreateRunFromPayload(data []byte) (*EventCreateRuns, error) {
 type EventSubmitRun struct {
 	RunID     string
 	RunConfig *RunConfig
+	// Queued means the run is released from the queue, it should be dequeued before submitting
+	Queued bool `json:",omitempty"`
 }
 
 func NewEventSubmitRun(runID string, runConfig *RunConfig) *EventSubmitRun {
@@ -209,6 +231,14 @@ func NewEventSubmitRun(runID string, runConfig *RunConfig) *EventSubmitRun {
 	}
 }
 
+func NewEventSubmitQueuedRun(runID string, runConfig *RunConfig) *EventSubmitRun {
+	return &EventSubmitRun{
+		RunID:     runID,
+		RunConfig: runConfig,
+		Queued:    true,
+	}
+}
+
 func (e *EventSubmitRun) EventType() string {
 	return SubmitRun
 }
@@ -230,6 +260,39 @@ func NewEventSubmitRunFromPayload(data []byte) (*EventSubmitRun, error) {
 	return res, nil
 }
 
+type EventRerunRun struct {
+	SubmissionID string
+	RunID        string
+}
+
+func NewEventRerunRun(submissionID, runID string) *EventRerunRun {
+	return &EventRerunRun{
+		SubmissionID: submissionID,
+		RunID:        runID,
+	}
+}
+
+func (e *EventRerunRun) EventType() string {
+	return RerunRun
+}
+
+func (e *EventRerunRun) Payload() []byte {
+	payload, _ := json.Marshal(e)
+	return payload
+}
+
+func (e *EventRerunRun) Delay() time.Duration {
+	return 0
+}
+
+func NewEventRerunRunFromPayload(data []byte) (*EventRerunRun, error) {
+	res := &EventRerunRun{}
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 type EventRun struct {
 	RunID         string
 	EventTyp      string
@@ -2,6 +2,7 @@ package submission
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
@@ -36,7 +37,7 @@ func (h *CreateHandler) Handle(ctx context.Context, event *CreateEvent) (err err
 		return err
 	}
 	// todo we should store the data model & workflow used in submission
-	createRunEvent, err := h.genCreateRunEvent(ctx, event, sub)
+	createRunEvent, err := h.genCreateRunEvent(ctx, sub)
 	if err != nil {
 		return err
 	}
@@ -44,48 +45,68 @@ func (h *CreateHandler) Handle(ctx context.Context, event *CreateEvent) (err err
 	return h.eventbus.Publish(ctx, createRunEvent)
 }
 
-func (h *CreateHandler) genCreateRunEvent(ctx context.Context, event *CreateEvent, sub *Submission) (*EventCreateRuns, error) {
-	getWorkflowResp, err := h.workflowClient.GetWorkflow(ctx, &workspaceproto.GetWorkflowRequest{
-		Id:          event.SourceWorkflowID,
-		WorkspaceID: event.WorkspaceID,
-	})
+func (h *CreateHandler) genCreateRunEvent(ctx context.Context, sub *Submission) (*EventCreateRuns, error) {
+	runConfig, err := genRunConfig(ctx, h.workflowClient, sub, &sub.ExposedOptions)
 	if err != nil {
-		return nil, apperrors.NewInternalError(err)
+		return nil, err
 	}
-	getWorkflowVersionResp, err := h.workflowClient.GetWorkflowVersion(ctx, &workspaceproto.GetWorkflowVersionRequest{
-		Id:          getWorkflowResp.Workflow.LatestVersion.Id,
-		WorkflowID:  event.SourceWorkflowID,
-		WorkspaceID: event.WorkspaceID,
+	event := NewEventCreateRuns(sub.WorkspaceID, sub.ID, sub.Type, sub.Inputs, sub.Outputs, sub.DataModelID, sub.DataModelRowIDs, runConfig)
+	event.DataModelSnapshotID = sub.DataModelSnapshotID
+	event.MaxConcurrentRuns = sub.MaxConcurrentRuns
+	event.EngineBackend = sub.EngineBackend
+	return event, nil
+}
+
+// genRunConfig generates the config to submit runs of submission to workflow engine.
+func genRunConfig(ctx context.Context, workflowClient grpc.WorkflowClient, sub *Submission, exposedOptions *ExposedOptions) (*RunConfig, error) {
+	workflowVersionID := sub.WorkflowVersionID
+	if workflowVersionID == "" {
+		// submissions created before workflow version was recorded fall back to the latest version
+		getWorkflowResp, err := workflowClient.GetWorkflow(ctx, &workspaceproto.GetWorkflowRequest{
+			Id:          sub.WorkflowID,
+			WorkspaceID: sub.WorkspaceID,
+		})
+		if err != nil {
+			return nil, apperrors.NewInternalError(err)
+		}
+		if getWorkflowResp.GetWorkflow().GetLatestVersion() == nil {
+			return nil, apperrors.NewInvalidError(fmt.Sprintf("workflow %s has no version", sub.WorkflowID))
+		}
+		workflowVersionID = getWorkflowResp.Workflow.LatestVersion.Id
+	}
+	getWorkflowVersionResp, err := workflowClient.GetWorkflowVersion(ctx, &workspaceproto.GetWorkflowVersionRequest{
+		Id:          workflowVersionID,
+		WorkflowID:  sub.WorkflowID,
+		WorkspaceID: sub.WorkspaceID,
 	})
 	if err != nil {
 		return nil, apperrors.NewInternalError(err)
 	}
-	workflowEngineParameters := exposedOptions2Map(&sub.ExposedOptions)
-	files, err := h.genWorkflowFiles(ctx, getWorkflowVersionResp.Version, event)
+	workflowEngineParameters := exposedOptions2Map(exposedOptions)
+	files, err := genWorkflowFiles(ctx, workflowClient, getWorkflowVersionResp.Version, sub)
 	if err != nil {
 		return nil, err
 	}
-	return NewEventCreateRuns(sub.WorkspaceID, sub.ID, sub.Type, sub.Inputs, sub.Outputs, sub.DataModelID, sub.DataModelRowIDs, &RunConfig{
+	return &RunConfig{
 		Language:                 getWorkflowVersionResp.Version.Language,
 		MainWorkflowFilePath:     getWorkflowVersionResp.Version.MainWorkflowPath,
 		WorkflowContents:         files,
 		WorkflowEngineParameters: workflowEngineParameters,
 		Version:                  getWorkflowVersionResp.Version.LanguageVersion,
-	}), nil
-
+	}, nil
 }
 
-func (h *CreateHandler) genWorkflowFiles(ctx context.Context, workflowVersion *workspaceproto.WorkflowVersion, event *CreateEvent) (workflowFiles map[string]string, err error) {
+func genWorkflowFiles(ctx context.Context, workflowClient grpc.WorkflowClient, workflowVersion *workspaceproto.WorkflowVersion, sub *Submission) (workflowFiles map[string]string, err error) {
 	ids := make([]string, 0)
 	for _, fileInfo := range workflowVersion.Files {
 		ids = append(ids, fileInfo.Id)
 	}
-	ListWorkflowFilesResponse, err := h.workflowClient.ListWorkflowFiles(ctx, &workspaceproto.ListWorkflowFilesRequest{
+	ListWorkflowFilesResponse, err := workflowClient.ListWorkflowFiles(ctx, &workspaceproto.ListWorkflowFilesRequest{
 		Page:              1,
 		Size:              int32(len(ids)),
 		Ids:               ids,
-		WorkspaceID:       event.WorkspaceID,
-		WorkflowID:        event.SourceWorkflowID,
+		WorkspaceID:       sub.WorkspaceID,
+		WorkflowID:        sub.WorkflowID,
 		WorkflowVersionID: utils.PointString(workflowVersion.Id),
 	})
 	if err != nil {
@@ -0,0 +1,60 @@
+package submission
+
+import (
+	"context"
+	"time"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+// DeadLetteredHandler marks the submission failed if the event creating it or its runs is dead lettered,
+// otherwise the submission will never be finished.
+type DeadLetteredHandler struct {
+	repository Repository
+}
+
+func NewDeadLetteredHandler(repository Repository) *DeadLetteredHandler {
+	return &DeadLetteredHandler{
+		repository: repository,
+	}
+}
+
+func (h *DeadLetteredHandler) Handle(ctx context.Context, event *eventbus.DeadLetteredEvent) error {
+	if event == nil || event.Event == nil {
+		return nil
+	}
+	var submissionID string
+	switch event.Event.Type {
+	case CreateSubmission:
+		createEvent, err := NewCreateEventFromPayload([]byte(event.Event.Payload))
+		if err != nil {
+			return err
+		}
+		submissionID = createEvent.SubmissionID
+	case CreateRuns:
+		createRunsEvent, err := NewEventCreateRunFromPayload([]byte(event.Event.Payload))
+		if err != nil {
+			return err
+		}
+		submissionID = createRunsEvent.SubmissionID
+	default:
+		return nil
+	}
+
+	sub, err := h.repository.Get(ctx, submissionID)
+	if err != nil {
+		return err
+	}
+	if !utils.In(sub.Status, consts.NonFinishedSubmissionStatuses) {
+		return nil
+	}
+	applog.Infow("mark submission failed for dead lettered event", "submissionID", submissionID, "eventID", event.Event.EventID)
+	sub.Status = consts.SubmissionFailed
+	if sub.FinishTime == nil {
+		sub.FinishTime = utils.PointTime(time.Now())
+	}
+	return h.repository.Save(ctx, sub)
+}
@@ -0,0 +1,48 @@
+package submission
+
+import (
+	"context"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/utils/grpc"
+)
+
+// RerunRunHandler submits the reset run again, the run waits in queue if its submission limits the concurrent runs.
+type RerunRunHandler struct {
+	workflowClient grpc.WorkflowClient
+	repository     Repository
+	eventbus       eventbus.EventBus
+}
+
+func NewRerunRunHandler(repository Repository, eventbus eventbus.EventBus, workflowClient grpc.WorkflowClient) *RerunRunHandler {
+	return &RerunRunHandler{
+		repository:     repository,
+		workflowClient: workflowClient,
+		eventbus:       eventbus,
+	}
+}
+
+func (h *RerunRunHandler) Handle(ctx context.Context, event *EventRerunRun) (err error) {
+	if event == nil {
+		return nil
+	}
+	sub, err := h.repository.Get(ctx, event.SubmissionID)
+	if err != nil {
+		return err
+	}
+	if sub.MaxConcurrentRuns <= 0 {
+		runConfig, err := genRunConfig(ctx, h.workflowClient, sub, &sub.ExposedOptions)
+		if err != nil {
+			return err
+		}
+		if err = h.eventbus.Publish(ctx, NewEventSubmitQueuedRun(event.RunID, runConfig)); err != nil {
+			return apperrors.NewInternalError(err)
+		}
+	}
+	// submission is not finished any more, the queued run is released while syncing submission
+	if err = h.eventbus.Publish(ctx, NewSyncSubmissionEvent(sub.ID)); err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
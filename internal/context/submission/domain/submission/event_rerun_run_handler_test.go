@@ -0,0 +1,84 @@
+package submission
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+)
+
+// fakeRepository has the submissions by id, the submissions saved are recorded.
+type fakeRepository struct {
+	Repository
+	submissions map[string]*Submission
+	saved       []*Submission
+}
+
+func (f *fakeRepository) Save(_ context.Context, sub *Submission) error {
+	f.saved = append(f.saved, sub)
+	return nil
+}
+
+func (f *fakeRepository) Get(_ context.Context, id string) (*Submission, error) {
+	sub, ok := f.submissions[id]
+	if !ok {
+		return nil, apperrors.NewNotFoundError("submission", id)
+	}
+	return sub, nil
+}
+
+// fakeEventBus records the published events.
+type fakeEventBus struct {
+	eventbus.EventBus
+	published []eventbus.IEvent
+}
+
+func (f *fakeEventBus) Publish(_ context.Context, event eventbus.IEvent) error {
+	f.published = append(f.published, event)
+	return nil
+}
+
+func TestRerunRunHandler(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+	repo := &fakeRepository{submissions: map[string]*Submission{
+		"sub-unlimited": {ID: "sub-unlimited", WorkspaceID: "ws-1", WorkflowID: "wf-1", WorkflowVersionID: "v1"},
+		"sub-limited":   {ID: "sub-limited", WorkspaceID: "ws-1", WorkflowID: "wf-1", WorkflowVersionID: "v1", MaxConcurrentRuns: 1},
+	}}
+
+	// the rerun is submitted at once without concurrency limit
+	bus := &fakeEventBus{}
+	handler := NewRerunRunHandler(repo, bus, &fakeWorkflowClient{})
+	g.Expect(handler.Handle(ctx, NewEventRerunRun("sub-unlimited", "run-1"))).To(gomega.Succeed())
+	g.Expect(bus.published).To(gomega.HaveLen(2))
+	submitEvent, ok := bus.published[0].(*EventSubmitRun)
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(submitEvent.RunID).To(gomega.Equal("run-1"))
+	g.Expect(submitEvent.Queued).To(gomega.BeTrue())
+	g.Expect(submitEvent.RunConfig.MainWorkflowFilePath).To(gomega.Equal("v1.wdl"))
+	g.Expect(bus.published[1].EventType()).To(gomega.Equal(SyncSubmission))
+
+	// the rerun waits in queue to be released while syncing submission
+	bus = &fakeEventBus{}
+	handler = NewRerunRunHandler(repo, bus, &fakeWorkflowClient{})
+	g.Expect(handler.Handle(ctx, NewEventRerunRun("sub-limited", "run-2"))).To(gomega.Succeed())
+	g.Expect(bus.published).To(gomega.HaveLen(1))
+	g.Expect(bus.published[0].EventType()).To(gomega.Equal(SyncSubmission))
+}
+
+func TestRunConfigWithReadFromCache(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	config := &RunConfig{
+		MainWorkflowFilePath:     "main.wdl",
+		WorkflowEngineParameters: exposedOptions2Map(&ExposedOptions{}),
+	}
+	cached := config.WithReadFromCache()
+	g.Expect(cached.MainWorkflowFilePath).To(gomega.Equal("main.wdl"))
+	g.Expect(cached.WorkflowEngineParameters).To(gomega.Equal(map[string]interface{}{"read_from_cache": true}))
+	// the original config is not changed
+	g.Expect(config.WorkflowEngineParameters).To(gomega.Equal(map[string]interface{}{"read_from_cache": false}))
+}
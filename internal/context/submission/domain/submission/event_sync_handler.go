@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Bio-OS/bioos/internal/context/submission/application/query/run"
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
 	workspaceproto "github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
 	"github.com/Bio-OS/bioos/pkg/consts"
 	apperrors "github.com/Bio-OS/bioos/pkg/errors"
@@ -18,15 +19,21 @@ import (
 
 type SyncHandler struct {
 	runReadModel    run.ReadModel
+	runQueue        RunQueue
 	repository      Repository
 	dataModelClient grpc.DataModelClient
+	workflowClient  grpc.WorkflowClient
+	eventbus        eventbus.EventBus
 }
 
-func NewSyncHandler(repository Repository, runReadModel run.ReadModel, dataModelClient grpc.DataModelClient) *SyncHandler {
+func NewSyncHandler(repository Repository, runReadModel run.ReadModel, runQueue RunQueue, dataModelClient grpc.DataModelClient, workflowClient grpc.WorkflowClient, eventbus eventbus.EventBus) *SyncHandler {
 	return &SyncHandler{
 		repository:      repository,
 		runReadModel:    runReadModel,
+		runQueue:        runQueue,
 		dataModelClient: dataModelClient,
+		workflowClient:  workflowClient,
+		eventbus:        eventbus,
 	}
 }
 
@@ -71,6 +78,13 @@ func (h *SyncHandler) Handle(ctx context.Context, event *EventSubmission) (err e
 		sub.Status = consts.SubmissionFinished
 	}
 	if existPending || existRunning || existCancelling {
+		if existPending {
+			if err := h.releaseQueuedRuns(ctx, sub); err != nil {
+				return err
+			}
+		}
+		// runs of finished submission may be rerun
+		sub.FinishTime = nil
 		return h.repository.Save(ctx, sub)
 	}
 
@@ -98,7 +112,7 @@ func (h *SyncHandler) Handle(ctx context.Context, event *EventSubmission) (err e
 				outputsMap[item.Name] = tempOutput
 			}
 		}
-		if err := h.updateDataModelRows(ctx, outputsMap, sub.Outputs, sub.WorkspaceID, *sub.DataModelID); err != nil {
+		if err := h.updateDataModelRows(ctx, outputsMap, sub.Outputs, sub.WorkspaceID, *sub.DataModelID, sub.Name); err != nil {
 			return err
 		}
 	}
@@ -106,7 +120,56 @@ func (h *SyncHandler) Handle(ctx context.Context, event *EventSubmission) (err e
 	return h.repository.Save(ctx, sub)
 }
 
-func (h *SyncHandler) updateDataModelRows(ctx context.Context, outputsMap map[string]map[string]interface{}, outputsCfg map[string]interface{}, workspaceID, dataModelID string) error {
+// releaseQueuedRuns submits queued runs if the running ones are fewer than the concurrency limit of submission.
+// The runs are taken out of the queue before submitting, so that the concurrent or later syncs don't release
+// them again, and they take the slots at once.
+func (h *SyncHandler) releaseQueuedRuns(ctx context.Context, sub *Submission) error {
+	if sub.MaxConcurrentRuns <= 0 {
+		return nil
+	}
+	activeCount, err := h.runReadModel.CountRuns(ctx, sub.ID, &run.ListRunsFilter{
+		Status: []string{consts.RunPending, consts.RunRunning, consts.RunCancelling},
+		Queued: utils.PointBool(false),
+	})
+	if err != nil {
+		return err
+	}
+	if activeCount >= sub.MaxConcurrentRuns {
+		return nil
+	}
+	runIDs, err := h.runReadModel.ListQueuedRunIDs(ctx, sub.ID, sub.MaxConcurrentRuns-activeCount)
+	if err != nil {
+		return err
+	}
+	if len(runIDs) == 0 {
+		return nil
+	}
+	runConfig, err := genRunConfig(ctx, h.workflowClient, sub, &sub.ExposedOptions)
+	if err != nil {
+		return err
+	}
+	released := 0
+	for _, runID := range runIDs {
+		dequeued, err := h.runQueue.Dequeue(ctx, runID)
+		if err != nil {
+			return err
+		}
+		if !dequeued {
+			continue
+		}
+		if err = h.eventbus.Publish(ctx, NewEventSubmitRun(runID, runConfig)); err != nil {
+			if enqueueErr := h.runQueue.Enqueue(ctx, runID); enqueueErr != nil {
+				applog.Errorw("failed to put run back to queue", "runID", runID, "err", enqueueErr)
+			}
+			return apperrors.NewInternalError(err)
+		}
+		released++
+	}
+	applog.Infow("release queued runs", "submission", sub.ID, "count", released)
+	return nil
+}
+
+func (h *SyncHandler) updateDataModelRows(ctx context.Context, outputsMap map[string]map[string]interface{}, outputsCfg map[string]interface{}, workspaceID, dataModelID, submissionName string) error {
 
 	if len(outputsMap) == 0 {
 		return nil
@@ -165,6 +228,17 @@ func (h *SyncHandler) updateDataModelRows(ctx context.Context, outputsMap map[st
 
 	}
 
+	// the patch overwrites the grids in place, so the prior outputs are kept in snapshot
+	if _, err := h.dataModelClient.CreateDataModelSnapshot(ctx, &workspaceproto.CreateDataModelSnapshotRequest{
+		WorkspaceID: workspaceID,
+		DataModelID: dataModelID,
+		Name:        consts.DataModelSnapshotNameBeforeWriteBack,
+		Description: fmt.Sprintf("taken before writing back the outputs of submission %s", submissionName),
+		Auto:        true,
+	}); err != nil {
+		return err
+	}
+
 	req := &workspaceproto.PatchDataModelRequest{
 		WorkspaceID: workspaceID,
 		Name:        dmName,
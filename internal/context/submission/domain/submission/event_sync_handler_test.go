@@ -0,0 +1,179 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/application/query/run"
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	"github.com/Bio-OS/bioos/pkg/consts"
+)
+
+// fakeRunReadModel has the count of active runs and the queued runs of submission.
+type fakeRunReadModel struct {
+	run.ReadModel
+	statusCounts []*run.StatusCount
+	activeCount  int
+	queued       []string
+}
+
+func (f *fakeRunReadModel) CountRunsResult(context.Context, string) ([]*run.StatusCount, error) {
+	return f.statusCounts, nil
+}
+
+func (f *fakeRunReadModel) CountRuns(_ context.Context, _ string, filter *run.ListRunsFilter) (int, error) {
+	// queued runs do not take the slots of concurrency limit
+	if filter.Queued == nil || *filter.Queued {
+		return 0, nil
+	}
+	return f.activeCount, nil
+}
+
+func (f *fakeRunReadModel) ListQueuedRunIDs(_ context.Context, _ string, limit int) ([]string, error) {
+	if limit < len(f.queued) {
+		return f.queued[:limit], nil
+	}
+	return f.queued, nil
+}
+
+// fakeRunQueue has the queued runs, only the queued ones can be dequeued.
+type fakeRunQueue struct {
+	queued map[string]bool
+}
+
+func newFakeRunQueue(ids ...string) *fakeRunQueue {
+	queue := &fakeRunQueue{queued: map[string]bool{}}
+	for _, id := range ids {
+		queue.queued[id] = true
+	}
+	return queue
+}
+
+func (f *fakeRunQueue) Dequeue(_ context.Context, id string) (bool, error) {
+	if !f.queued[id] {
+		return false, nil
+	}
+	f.queued[id] = false
+	return true, nil
+}
+
+func (f *fakeRunQueue) Enqueue(_ context.Context, id string) error {
+	f.queued[id] = true
+	return nil
+}
+
+// releasedRunIDs returns the runs submitted, they are dequeued already.
+func releasedRunIDs(g *gomega.WithT, bus *fakeEventBus) []string {
+	ids := make([]string, 0)
+	for _, event := range bus.published {
+		if submitEvent, ok := event.(*EventSubmitRun); ok {
+			g.Expect(submitEvent.Queued).To(gomega.BeFalse())
+			ids = append(ids, submitEvent.RunID)
+		}
+	}
+	return ids
+}
+
+func TestReleaseQueuedRuns(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+	queued := []string{"run-1", "run-2", "run-3"}
+
+	for _, c := range []struct {
+		name              string
+		maxConcurrentRuns int
+		activeCount       int
+		queued            []string
+		expected          []string
+	}{
+		{name: "unlimited", maxConcurrentRuns: 0, queued: queued, expected: []string{}},
+		{name: "free slots", maxConcurrentRuns: 3, activeCount: 1, queued: queued, expected: []string{"run-1", "run-2"}},
+		{name: "more slots than queued", maxConcurrentRuns: 5, activeCount: 1, queued: queued, expected: queued},
+		{name: "no free slot", maxConcurrentRuns: 2, activeCount: 2, queued: queued, expected: []string{}},
+		{name: "over limit", maxConcurrentRuns: 2, activeCount: 3, queued: queued, expected: []string{}},
+		{name: "nothing queued", maxConcurrentRuns: 2, expected: []string{}},
+	} {
+		bus := &fakeEventBus{}
+		queue := newFakeRunQueue(c.queued...)
+		handler := NewSyncHandler(nil, &fakeRunReadModel{activeCount: c.activeCount, queued: c.queued}, queue, nil, &fakeWorkflowClient{}, bus)
+		sub := &Submission{ID: "sub-1", WorkspaceID: "ws-1", WorkflowID: "wf-1", WorkflowVersionID: "v1", MaxConcurrentRuns: c.maxConcurrentRuns}
+		g.Expect(handler.releaseQueuedRuns(ctx, sub)).To(gomega.Succeed(), c.name)
+		g.Expect(releasedRunIDs(g, bus)).To(gomega.Equal(c.expected), c.name)
+
+		// the released runs are not released again by the later syncs, even if they are listed as queued
+		bus.published = nil
+		g.Expect(handler.releaseQueuedRuns(ctx, sub)).To(gomega.Succeed(), c.name)
+		g.Expect(releasedRunIDs(g, bus)).To(gomega.BeEmpty(), c.name)
+	}
+}
+
+// failedEventBus fails to publish events.
+type failedEventBus struct {
+	fakeEventBus
+}
+
+func (f *failedEventBus) Publish(context.Context, eventbus.IEvent) error {
+	return fmt.Errorf("an error")
+}
+
+func TestReleaseQueuedRunsPublishFailed(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+
+	// the run is put back to the queue to be released by the next sync
+	queue := newFakeRunQueue("run-1", "run-2")
+	handler := NewSyncHandler(nil, &fakeRunReadModel{queued: []string{"run-1", "run-2"}}, queue, nil, &fakeWorkflowClient{}, &failedEventBus{})
+	sub := &Submission{ID: "sub-1", WorkspaceID: "ws-1", WorkflowID: "wf-1", WorkflowVersionID: "v1", MaxConcurrentRuns: 2}
+	g.Expect(handler.releaseQueuedRuns(ctx, sub)).ToNot(gomega.Succeed())
+	g.Expect(queue.queued).To(gomega.Equal(map[string]bool{"run-1": true, "run-2": true}))
+}
+
+func TestSyncHandlerReleaseQueuedRuns(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+	repo := &fakeRepository{submissions: map[string]*Submission{
+		"sub-1": {ID: "sub-1", WorkspaceID: "ws-1", WorkflowID: "wf-1", WorkflowVersionID: "v1", MaxConcurrentRuns: 1},
+	}}
+
+	// queued runs are released while syncing the submission with pending runs
+	bus := &fakeEventBus{}
+	readModel := &fakeRunReadModel{
+		statusCounts: []*run.StatusCount{{Status: consts.RunSucceeded, Count: 1}, {Status: consts.RunPending, Count: 2}},
+		queued:       []string{"run-2", "run-3"},
+	}
+	handler := NewSyncHandler(repo, readModel, newFakeRunQueue("run-2", "run-3"), nil, &fakeWorkflowClient{}, bus)
+	g.Expect(handler.Handle(ctx, NewSyncSubmissionEvent("sub-1"))).To(gomega.Succeed())
+	g.Expect(releasedRunIDs(g, bus)).To(gomega.Equal([]string{"run-2"}))
+	g.Expect(repo.saved).To(gomega.HaveLen(1))
+	g.Expect(repo.saved[0].Status).To(gomega.Equal(consts.SubmissionPending))
+	g.Expect(repo.saved[0].FinishTime).To(gomega.BeNil())
+}
+
+func TestUpdateDataModelRows(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+	outputsMap := map[string]map[string]interface{}{
+		"s1": {"call.score": 2.5},
+		"s2": {"call.score": 3},
+	}
+	outputsCfg := map[string]interface{}{"call.score": consts.DataModelRefPrefix + "score"}
+	storedTypes := map[string]string{"sample_id": consts.DataModelColumnTypeString, "score": consts.DataModelColumnTypeInt}
+
+	// the inferred type is widened by the outputs written back
+	client := &fakeDataModelClient{storedTypes: storedTypes}
+	handler := NewSyncHandler(nil, nil, nil, client, nil, nil)
+	g.Expect(handler.updateDataModelRows(ctx, outputsMap, outputsCfg, "ws-1", "dm-1", "call-samples")).To(gomega.Succeed())
+	g.Expect(client.snapshotRequests).To(gomega.HaveLen(1))
+	g.Expect(client.patched.Headers).To(gomega.Equal([]string{"sample_id", "score"}))
+	g.Expect(client.patched.Rows).To(gomega.ConsistOf([]string{"s1", "2.5"}, []string{"s2", "3"}))
+	g.Expect(client.patched.ColumnTypes).To(gomega.HaveKeyWithValue("score", consts.DataModelColumnTypeFloat))
+
+	// the declared type is enforced
+	client = &fakeDataModelClient{storedTypes: storedTypes, storedDeclared: map[string]bool{"score": true}}
+	handler = NewSyncHandler(nil, nil, nil, client, nil, nil)
+	g.Expect(handler.updateDataModelRows(ctx, outputsMap, outputsCfg, "ws-1", "dm-1", "call-samples")).ToNot(gomega.Succeed())
+	g.Expect(client.patched).To(gomega.BeNil())
+}
@@ -10,17 +10,22 @@ import (
 
 // CreateSubmissionParam use to create Submission
 type CreateSubmissionParam struct {
-	Name              string
-	Description       *string
-	WorkflowID        string
-	WorkflowVersionID string
-	WorkspaceID       string
-	DataModelID       *string
-	DataModelRowIDs   []string
-	Type              string
-	Inputs            map[string]interface{}
-	Outputs           map[string]interface{}
-	ExposedOptions    ExposedOptions
+	Name                string
+	Description         *string
+	WorkflowID          string
+	WorkflowVersionID   string
+	WorkspaceID         string
+	DataModelID         *string
+	DataModelRowIDs     []string
+	DataModelSnapshotID *string
+	Type                string
+	ParentSubmissionID  *string
+	Inputs              map[string]interface{}
+	Outputs             map[string]interface{}
+	ExposedOptions      ExposedOptions
+	// MaxConcurrentRuns 0 means using the default of factory
+	MaxConcurrentRuns int
+	EngineBackend     string
 }
 
 func (p CreateSubmissionParam) validate() error {
@@ -28,11 +33,15 @@ func (p CreateSubmissionParam) validate() error {
 }
 
 // Factory workspace factory.
-type Factory struct{}
+type Factory struct {
+	defaultMaxConcurrentRuns int
+}
 
 // NewSubmissionFactory return a workspace factory.
-func NewSubmissionFactory(_ context.Context) *Factory {
-	return &Factory{}
+func NewSubmissionFactory(_ context.Context, defaultMaxConcurrentRuns int) *Factory {
+	return &Factory{
+		defaultMaxConcurrentRuns: defaultMaxConcurrentRuns,
+	}
 }
 
 // CreateWithSubmissionParam ...
@@ -40,21 +49,28 @@ func (fac *Factory) CreateWithSubmissionParam(param CreateSubmissionParam) (*Sub
 	if err := param.validate(); err != nil {
 		return nil, err
 	}
+	if param.MaxConcurrentRuns == 0 {
+		param.MaxConcurrentRuns = fac.defaultMaxConcurrentRuns
+	}
 
 	return &Submission{
-		ID:                utils.GenSubmissionID(),
-		Name:              param.Name,
-		Description:       param.Description,
-		WorkflowID:        param.WorkflowID,
-		WorkflowVersionID: param.WorkflowVersionID,
-		DataModelID:       param.DataModelID,
-		DataModelRowIDs:   param.DataModelRowIDs,
-		WorkspaceID:       param.WorkspaceID,
-		Type:              param.Type,
-		Inputs:            param.Inputs,
-		Outputs:           param.Outputs,
-		ExposedOptions:    param.ExposedOptions,
-		Status:            consts.SubmissionPending,
-		StartTime:         time.Now(),
+		ID:                  utils.GenSubmissionID(),
+		Name:                param.Name,
+		Description:         param.Description,
+		WorkflowID:          param.WorkflowID,
+		WorkflowVersionID:   param.WorkflowVersionID,
+		DataModelID:         param.DataModelID,
+		DataModelRowIDs:     param.DataModelRowIDs,
+		DataModelSnapshotID: param.DataModelSnapshotID,
+		WorkspaceID:         param.WorkspaceID,
+		Type:                param.Type,
+		ParentSubmissionID:  param.ParentSubmissionID,
+		Inputs:              param.Inputs,
+		Outputs:             param.Outputs,
+		ExposedOptions:      param.ExposedOptions,
+		MaxConcurrentRuns:   param.MaxConcurrentRuns,
+		EngineBackend:       param.EngineBackend,
+		Status:              consts.SubmissionPending,
+		StartTime:           time.Now(),
 	}, nil
 }
@@ -12,13 +12,21 @@ type Submission struct {
 	WorkspaceID       string
 	DataModelID       *string
 	DataModelRowIDs   []string
-	Type              string
-	Inputs            map[string]interface{}
-	Outputs           map[string]interface{}
-	ExposedOptions    ExposedOptions
-	Status            string
-	StartTime         time.Time
-	FinishTime        *time.Time
+	// DataModelSnapshotID is the snapshot of data model the inputs of runs are read from.
+	DataModelSnapshotID *string
+	Type                string
+	// ParentSubmissionID is the submission this one retries, nil if it is not a retry.
+	ParentSubmissionID *string
+	Inputs             map[string]interface{}
+	Outputs            map[string]interface{}
+	ExposedOptions     ExposedOptions
+	Status             string
+	StartTime          time.Time
+	FinishTime         *time.Time
+	// MaxConcurrentRuns limits the runs submitted to workflow engine at the same time, 0 means unlimited.
+	MaxConcurrentRuns int
+	// EngineBackend is the name of WES backend runs are submitted to.
+	EngineBackend string
 }
 
 type ExposedOptions struct {
@@ -9,3 +9,11 @@ type Repository interface {
 	Delete(ctx context.Context, s *Submission) error
 	SoftDelete(ctx context.Context, s *Submission) error
 }
+
+// RunQueue takes the queued runs out of the queue or puts them back, it is implemented by the run repository.
+type RunQueue interface {
+	// Dequeue takes the run out of the queue, returns false if it is not queued any more.
+	Dequeue(ctx context.Context, id string) (bool, error)
+	// Enqueue puts the run taken out of the queue back if it is not submitted yet.
+	Enqueue(ctx context.Context, id string) error
+}
@@ -3,9 +3,11 @@ package submission
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/Bio-OS/bioos/internal/context/submission/application/query/run"
 	submissionquery "github.com/Bio-OS/bioos/internal/context/submission/application/query/submission"
+	"github.com/Bio-OS/bioos/internal/context/submission/infrastructure/client/wes"
 	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workflow"
 	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
@@ -28,9 +30,18 @@ type Service interface {
 	Cancel(ctx context.Context, id string) error
 	CheckWorkspaceExist(ctx context.Context, workspaceID string) error
 	CheckSubmissionExist(ctx context.Context, workspaceID, submissionName string) error
+	GetSubmittableWorkflowVersion(ctx context.Context, workspaceID, workflowID, workflowVersionID string) (string, error)
+	ListRunNames(ctx context.Context, submissionID string, status []string) ([]string, error)
+	// GetEngineBackend returns the WES backend to run submission, falls back to the default backend of workspace
+	// and then the default of server if engineBackend is empty.
+	GetEngineBackend(ctx context.Context, workspaceID, engineBackend string) (string, error)
+	// SnapshotDataModel takes a snapshot of the data model submission reads inputs from and returns its id.
+	SnapshotDataModel(ctx context.Context, workspaceID, dataModelID, submissionName string) (string, error)
+	// DeleteDataModelSnapshot deletes the snapshot taken by SnapshotDataModel.
+	DeleteDataModelSnapshot(ctx context.Context, workspaceID, dataModelID, snapshotID string) error
 }
 
-func NewService(grpcFactory grpc.Factory, repo Repository, eventbus eventbus.EventBus, submissionReadModel submissionquery.ReadModel, runReadModel run.ReadModel) Service {
+func NewService(grpcFactory grpc.Factory, repo Repository, eventbus eventbus.EventBus, submissionReadModel submissionquery.ReadModel, runReadModel run.ReadModel, runQueue RunQueue, wesRegistry wes.Registry) Service {
 	dataModelClient, err := grpcFactory.DataModelClient()
 	if err != nil {
 		log.Fatalf(err.Error())
@@ -48,9 +59,11 @@ func NewService(grpcFactory grpc.Factory, repo Repository, eventbus eventbus.Eve
 		eventbus:            eventbus,
 		submissionReadModel: submissionReadModel,
 		runReadModel:        runReadModel,
+		runQueue:            runQueue,
 		dataModelClient:     dataModelClient,
 		workspaceClient:     workspaceClient,
 		workflowClient:      workflowClient,
+		wesRegistry:         wesRegistry,
 	}
 	svc.subscribeEvents()
 	return svc
@@ -61,9 +74,11 @@ type service struct {
 	eventbus            eventbus.EventBus
 	submissionReadModel submissionquery.ReadModel
 	runReadModel        run.ReadModel
+	runQueue            RunQueue
 	dataModelClient     grpc.DataModelClient
 	workflowClient      grpc.WorkflowClient
 	workspaceClient     grpc.WorkspaceClient
+	wesRegistry         wes.Registry
 }
 
 func (s *service) Get(ctx context.Context, id string) (*Submission, error) {
@@ -152,6 +167,50 @@ func (s *service) CheckWorkspaceExist(ctx context.Context, workspaceID string) e
 	return nil
 }
 
+func (s *service) GetEngineBackend(ctx context.Context, workspaceID, engineBackend string) (string, error) {
+	if engineBackend == "" {
+		resp, err := s.workspaceClient.GetWorkspace(ctx, &workspaceproto.GetWorkspaceRequest{Id: workspaceID})
+		if err != nil {
+			return "", apperrors.NewInternalError(err)
+		}
+		engineBackend = resp.GetWorkspace().GetEngineBackend()
+	}
+	if engineBackend == "" {
+		return s.wesRegistry.Default(), nil
+	}
+	if !s.wesRegistry.Has(engineBackend) {
+		return "", apperrors.NewInvalidError(fmt.Sprintf("wes backend %s is not configured, should be one of %v", engineBackend, s.wesRegistry.Names()))
+	}
+	return engineBackend, nil
+}
+
+func (s *service) SnapshotDataModel(ctx context.Context, workspaceID, dataModelID, submissionName string) (string, error) {
+	resp, err := s.dataModelClient.CreateDataModelSnapshot(ctx, &workspaceproto.CreateDataModelSnapshotRequest{
+		WorkspaceID: workspaceID,
+		DataModelID: dataModelID,
+		Name:        consts.DataModelSnapshotNameSubmission,
+		Description: fmt.Sprintf("taken as the inputs of submission %s", submissionName),
+		// not marked as auto so that it is never pruned, the inputs of submission stay traceable as long
+		// as the submission is kept
+		Auto: false,
+	})
+	if err != nil {
+		return "", apperrors.NewInternalError(err)
+	}
+	return resp.GetId(), nil
+}
+
+func (s *service) DeleteDataModelSnapshot(ctx context.Context, workspaceID, dataModelID, snapshotID string) error {
+	if _, err := s.dataModelClient.DeleteDataModelSnapshot(ctx, &workspaceproto.DeleteDataModelSnapshotRequest{
+		WorkspaceID: workspaceID,
+		DataModelID: dataModelID,
+		Id:          snapshotID,
+	}); err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
 func (s *service) CheckSubmissionExist(ctx context.Context, workspaceID, submissionName string) error {
 	count, err := s.submissionReadModel.CountSubmissions(ctx, workspaceID, &submissionquery.ListSubmissionsFilter{Name: submissionName})
 	if err != nil {
@@ -163,6 +222,42 @@ func (s *service) CheckSubmissionExist(ctx context.Context, workspaceID, submiss
 	return nil
 }
 
+// GetSubmittableWorkflowVersion return the workflow version id to submit, the latest version will be used if
+// workflowVersionID is empty. Only version which has been imported successfully can be submitted.
+func (s *service) GetSubmittableWorkflowVersion(ctx context.Context, workspaceID, workflowID, workflowVersionID string) (string, error) {
+	if workflowVersionID == "" {
+		getWorkflowResp, err := s.workflowClient.GetWorkflow(ctx, &workspaceproto.GetWorkflowRequest{
+			Id:          workflowID,
+			WorkspaceID: workspaceID,
+		})
+		if err != nil {
+			return "", apperrors.NewInternalError(err)
+		}
+		if getWorkflowResp.GetWorkflow().GetLatestVersion() == nil {
+			return "", apperrors.NewInvalidError(fmt.Sprintf("workflow %s has no version", workflowID))
+		}
+		workflowVersionID = getWorkflowResp.Workflow.LatestVersion.Id
+	}
+	getWorkflowVersionResp, err := s.workflowClient.GetWorkflowVersion(ctx, &workspaceproto.GetWorkflowVersionRequest{
+		Id:          workflowVersionID,
+		WorkflowID:  workflowID,
+		WorkspaceID: workspaceID,
+	})
+	if err != nil {
+		return "", apperrors.NewInternalError(err)
+	}
+	if status := getWorkflowVersionResp.GetVersion().GetStatus(); status != workflow.WorkflowVersionSuccessStatus {
+		return "", apperrors.NewInvalidError(fmt.Sprintf("workflow version %s is %s, only %s version can be submitted", workflowVersionID, status, workflow.WorkflowVersionSuccessStatus))
+	}
+	return workflowVersionID, nil
+}
+
+// ListRunNames return names of runs in the submission whose status is one of status, which are the data model
+// row ids of data model submission or the input names of file path submission.
+func (s *service) ListRunNames(ctx context.Context, submissionID string, status []string) ([]string, error) {
+	return s.runReadModel.ListAllRunNames(ctx, submissionID, &run.ListRunsFilter{Status: status})
+}
+
 func (s *service) subscribeEvents() {
 	s.eventbus.Subscribe(CreateSubmission, eventbus.EventHandlerFunc(func(ctx context.Context, payload string) error {
 		applog.Infow("start to consume submission create event", "payload", payload)
@@ -175,6 +270,17 @@ func (s *service) subscribeEvents() {
 		return handler.Handle(ctx, event)
 	}))
 
+	s.eventbus.Subscribe(RerunRun, eventbus.EventHandlerFunc(func(ctx context.Context, payload string) error {
+		applog.Infow("start to consume run rerun event", "payload", payload)
+		event, err := NewEventRerunRunFromPayload([]byte(payload))
+		if err != nil {
+			return err
+		}
+
+		handler := NewRerunRunHandler(s.repository, s.eventbus, s.workflowClient)
+		return handler.Handle(ctx, event)
+	}))
+
 	s.eventbus.Subscribe(CancelSubmission, eventbus.EventHandlerFunc(func(ctx context.Context, payload string) error {
 		applog.Infow("start to consume submission cancel event", "payload", payload)
 		event, err := NewEventFromPayload([]byte(payload))
@@ -204,7 +310,7 @@ func (s *service) subscribeEvents() {
 			return err
 		}
 
-		handler := NewSyncHandler(s.repository, s.runReadModel, s.dataModelClient)
+		handler := NewSyncHandler(s.repository, s.runReadModel, s.runQueue, s.dataModelClient, s.workflowClient, s.eventbus)
 		return handler.Handle(ctx, event)
 	}))
 
@@ -220,6 +326,17 @@ func (s *service) subscribeEvents() {
 		return handler.Handle(ctx, event)
 	}))
 
+	s.eventbus.Subscribe(eventbus.EventDeadLettered, eventbus.EventHandlerFunc(func(ctx context.Context, payload string) error {
+		applog.Infow("start to consume event dead lettered event", "payload", payload)
+		event, err := eventbus.NewDeadLetteredEventFromPayload([]byte(payload))
+		if err != nil {
+			return err
+		}
+
+		handler := NewDeadLetteredHandler(s.repository)
+		return handler.Handle(ctx, event)
+	}))
+
 	s.eventbus.Subscribe(workflow.WorkflowDeleted, eventbus.EventHandlerFunc(func(ctx context.Context, payload string) error {
 		applog.Infow("start to consume workflow deleted event", "payload", payload)
 		event, err := workflow.NewWorkflowEventFromPayload([]byte(payload))
@@ -0,0 +1,161 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	datamodel "github.com/Bio-OS/bioos/internal/context/workspace/domain/data-model"
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workflow"
+	workspaceproto "github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils/grpc"
+)
+
+func TestMain(m *testing.M) {
+	applog.RegisterLogger(&applog.Options{
+		Level: "fatal",
+	})
+	os.Exit(m.Run())
+}
+
+// fakeWorkflowClient has workflow wf-1 whose latest version is v2, and workflow wf-2 without version.
+type fakeWorkflowClient struct {
+	grpc.WorkflowClient
+	// requestedVersions are the versions got by GetWorkflowVersion in order
+	requestedVersions []string
+}
+
+var fakeWorkflowVersions = map[string]*workspaceproto.WorkflowVersion{
+	"v1": {Id: "v1", Status: workflow.WorkflowVersionSuccessStatus, Language: "WDL", MainWorkflowPath: "v1.wdl", Files: []*workspaceproto.WorkflowFileInfo{{Id: "f1"}}},
+	"v2": {Id: "v2", Status: workflow.WorkflowVersionSuccessStatus, Language: "WDL", MainWorkflowPath: "v2.wdl"},
+	"v3": {Id: "v3", Status: "Failed"},
+}
+
+func (f *fakeWorkflowClient) GetWorkflow(_ context.Context, in *workspaceproto.GetWorkflowRequest) (*workspaceproto.GetWorkflowResponse, error) {
+	switch in.Id {
+	case "wf-1":
+		return &workspaceproto.GetWorkflowResponse{Workflow: &workspaceproto.Workflow{Id: in.Id, LatestVersion: fakeWorkflowVersions["v2"]}}, nil
+	case "wf-2":
+		return &workspaceproto.GetWorkflowResponse{Workflow: &workspaceproto.Workflow{Id: in.Id}}, nil
+	}
+	return nil, fmt.Errorf("workflow %s not found", in.Id)
+}
+
+func (f *fakeWorkflowClient) GetWorkflowVersion(_ context.Context, in *workspaceproto.GetWorkflowVersionRequest) (*workspaceproto.GetWorkflowVersionResponse, error) {
+	f.requestedVersions = append(f.requestedVersions, in.Id)
+	version, ok := fakeWorkflowVersions[in.Id]
+	if !ok {
+		return nil, fmt.Errorf("workflow version %s not found", in.Id)
+	}
+	return &workspaceproto.GetWorkflowVersionResponse{Version: version}, nil
+}
+
+func (f *fakeWorkflowClient) ListWorkflowFiles(_ context.Context, in *workspaceproto.ListWorkflowFilesRequest) (*workspaceproto.ListWorkflowFilesResponse, error) {
+	files := make([]*workspaceproto.WorkflowFile, 0, len(in.Ids))
+	for _, id := range in.Ids {
+		files = append(files, &workspaceproto.WorkflowFile{Id: id, Path: *in.WorkflowVersionID + ".wdl", Content: "workflow " + *in.WorkflowVersionID})
+	}
+	return &workspaceproto.ListWorkflowFilesResponse{Files: files}, nil
+}
+
+func TestGetSubmittableWorkflowVersion(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+	svc := &service{workflowClient: &fakeWorkflowClient{}}
+
+	// the requested version is pinned
+	version, err := svc.GetSubmittableWorkflowVersion(ctx, "ws-1", "wf-1", "v1")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(version).To(gomega.Equal("v1"))
+	// the latest version is used if not requested
+	version, err = svc.GetSubmittableWorkflowVersion(ctx, "ws-1", "wf-1", "")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(version).To(gomega.Equal("v2"))
+
+	_, err = svc.GetSubmittableWorkflowVersion(ctx, "ws-1", "wf-1", "v3")
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = svc.GetSubmittableWorkflowVersion(ctx, "ws-1", "wf-1", "v4")
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = svc.GetSubmittableWorkflowVersion(ctx, "ws-1", "wf-2", "")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestGenRunConfig(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+
+	// the version recorded with submission is submitted even if it is not the latest
+	client := &fakeWorkflowClient{}
+	config, err := genRunConfig(ctx, client, &Submission{WorkspaceID: "ws-1", WorkflowID: "wf-1", WorkflowVersionID: "v1"}, &ExposedOptions{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(client.requestedVersions).To(gomega.Equal([]string{"v1"}))
+	g.Expect(config.MainWorkflowFilePath).To(gomega.Equal("v1.wdl"))
+	g.Expect(config.WorkflowContents).To(gomega.Equal(map[string]string{"v1.wdl": "workflow v1"}))
+
+	// submissions created before workflow version was recorded fall back to the latest version
+	client = &fakeWorkflowClient{}
+	config, err = genRunConfig(ctx, client, &Submission{WorkspaceID: "ws-1", WorkflowID: "wf-1"}, &ExposedOptions{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(client.requestedVersions).To(gomega.Equal([]string{"v2"}))
+	g.Expect(config.MainWorkflowFilePath).To(gomega.Equal("v2.wdl"))
+
+	_, err = genRunConfig(ctx, &fakeWorkflowClient{}, &Submission{WorkspaceID: "ws-1", WorkflowID: "wf-2"}, &ExposedOptions{})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+// fakeDataModelClient records the requests to create data model snapshots, and patches the entity
+// data model sample whose columns have the stored types.
+type fakeDataModelClient struct {
+	grpc.DataModelClient
+	snapshotRequests []*workspaceproto.CreateDataModelSnapshotRequest
+	storedTypes      map[string]string
+	storedDeclared   map[string]bool
+	// patched is the data model patched with the column types resolved
+	patched *datamodel.DataModel
+}
+
+func (f *fakeDataModelClient) GetDataModel(_ context.Context, in *workspaceproto.GetDataModelRequest) (*workspaceproto.GetDataModelResponse, error) {
+	return &workspaceproto.GetDataModelResponse{DataModel: &workspaceproto.DataModel{
+		Id:   in.Id,
+		Name: "sample",
+		Type: consts.DataModelTypeEntity,
+	}}, nil
+}
+
+func (f *fakeDataModelClient) PatchDataModel(_ context.Context, in *workspaceproto.PatchDataModelRequest) (*workspaceproto.PatchDataModelResponse, error) {
+	dm := &datamodel.DataModel{Name: in.Name, Type: consts.DataModelTypeEntity, Headers: in.Headers}
+	for _, row := range in.Rows {
+		dm.Rows = append(dm.Rows, row.Grids)
+	}
+	if err := dm.ResolveColumnTypes(in.ColumnTypes, f.storedTypes, f.storedDeclared); err != nil {
+		return nil, err
+	}
+	f.patched = dm
+	return &workspaceproto.PatchDataModelResponse{}, nil
+}
+
+func (f *fakeDataModelClient) CreateDataModelSnapshot(_ context.Context, in *workspaceproto.CreateDataModelSnapshotRequest) (*workspaceproto.CreateDataModelSnapshotResponse, error) {
+	f.snapshotRequests = append(f.snapshotRequests, in)
+	return &workspaceproto.CreateDataModelSnapshotResponse{Id: "snapshot-1"}, nil
+}
+
+func TestSnapshotDataModel(t *testing.T) {
+	g := gomega.NewWithT(t)
+	client := &fakeDataModelClient{}
+	svc := &service{dataModelClient: client}
+
+	id, err := svc.SnapshotDataModel(context.TODO(), "ws-1", "dm-1", "call")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(id).To(gomega.Equal("snapshot-1"))
+	g.Expect(client.snapshotRequests).To(gomega.HaveLen(1))
+	g.Expect(client.snapshotRequests[0].WorkspaceID).To(gomega.Equal("ws-1"))
+	g.Expect(client.snapshotRequests[0].DataModelID).To(gomega.Equal("dm-1"))
+	g.Expect(client.snapshotRequests[0].Name).To(gomega.Equal(consts.DataModelSnapshotNameSubmission))
+	// the inputs snapshot is kept by the pruning of auto snapshots
+	g.Expect(client.snapshotRequests[0].Auto).To(gomega.BeFalse())
+}
@@ -56,7 +56,8 @@ type Client interface {
 
 // NewClient ...
 func NewClient(options *Options) Client {
-	client := resty.NewWithClient(http.DefaultClient).SetTimeout(time.Duration(options.Timeout) * time.Second).SetHeaders(commonClientHeaders).SetRetryCount(options.Retry)
+	// clients of backends do not share the http client, as the timeout is set on it
+	client := resty.NewWithClient(&http.Client{}).SetTimeout(time.Duration(options.Timeout) * time.Second).SetHeaders(commonClientHeaders).SetRetryCount(options.Retry)
 
 	return &impl{
 		endpoint:   options.Endpoint,
@@ -121,6 +122,10 @@ func (i *impl) RunWorkflow(ctx context.Context, req *RunWorkflowRequest) (*RunWo
 	if !strings.HasSuffix(prefix, "/") {
 		prefix = fmt.Sprintf("%s/", path.Dir(prefix))
 	}
+	// files in root dir have no common dir
+	if prefix == "./" {
+		prefix = ""
+	}
 	for _, filePath := range filesPath {
 		decodeContent, err := base64.StdEncoding.DecodeString(req.WorkflowAttachment[filePath])
 		if err != nil {
@@ -128,7 +133,12 @@ func (i *impl) RunWorkflow(ctx context.Context, req *RunWorkflowRequest) (*RunWo
 		}
 		newReq = newReq.SetFileReader(workflowAttachment, filePath[len(prefix):], bytes.NewReader(decodeContent))
 	}
-	formData, err := runRequest2FormData(&req.RunRequest)
+	runRequest := req.RunRequest
+	// workflow url is relative to the attachments
+	if runRequest.WorkflowURL != "" && strings.HasPrefix(runRequest.WorkflowURL, prefix) {
+		runRequest.WorkflowURL = runRequest.WorkflowURL[len(prefix):]
+	}
+	formData, err := runRequest2FormData(&runRequest)
 	if err != nil {
 		return nil, newBadRequestError(err.Error())
 	}
@@ -245,6 +255,9 @@ func runRequest2FormData(req *RunRequest) (map[string]string, error) {
 		}
 		formData[tags] = string(tagsInBytes)
 	}
+	if req.WorkflowURL != "" {
+		formData[workflowURL] = req.WorkflowURL
+	}
 	formData[workflowType] = req.WorkflowType
 	formData[workflowTypeVersion] = req.WorkflowTypeVersion
 	return formData, nil
@@ -0,0 +1,102 @@
+package wes
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+// submittedRun is the multipart form received by the fake wes server.
+type submittedRun struct {
+	form        map[string][]string
+	attachments []string
+}
+
+func newFakeWESServer(g *gomega.WithT, submitted chan<- submittedRun) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reader, err := r.MultipartReader()
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		run := submittedRun{form: map[string][]string{}}
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			// the file name of part is trimmed to base name, the dir of attachment is in the raw header
+			_, params, err := mime.ParseMediaType(part.Header.Get("Content-Disposition"))
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			if part.FormName() == workflowAttachment {
+				run.attachments = append(run.attachments, params["filename"])
+				continue
+			}
+			value, err := io.ReadAll(part)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			run.form[part.FormName()] = append(run.form[part.FormName()], string(value))
+		}
+		sort.Strings(run.attachments)
+		submitted <- run
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"run_id":"engine-1"}`))
+	}))
+}
+
+func TestRunWorkflow(t *testing.T) {
+	g := gomega.NewWithT(t)
+	submitted := make(chan submittedRun, 1)
+	server := newFakeWESServer(g, submitted)
+	defer server.Close()
+	client := NewClient(&Options{Endpoint: server.URL, BasePath: "/api/ga4gh/wes/v1", Timeout: 5})
+	content := base64.StdEncoding.EncodeToString([]byte("workflow"))
+
+	// WDL runs are submitted without workflow url
+	resp, err := client.RunWorkflow(context.TODO(), &RunWorkflowRequest{
+		RunRequest: RunRequest{
+			WorkflowURL:         WorkflowURLOfType(WorkflowTypeFromLanguage("WDL"), "/app/main.wdl"),
+			WorkflowType:        WorkflowTypeFromLanguage("WDL"),
+			WorkflowTypeVersion: "1.0",
+		},
+		WorkflowAttachment: map[string]string{"/app/main.wdl": content, "/app/tasks/align.wdl": content},
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(resp.RunID).To(gomega.Equal("engine-1"))
+	run := <-submitted
+	g.Expect(run.form).ToNot(gomega.HaveKey(workflowURL))
+	g.Expect(run.form[workflowType]).To(gomega.Equal([]string{"WDL"}))
+	g.Expect(run.form[workflowTypeVersion]).To(gomega.Equal([]string{"1.0"}))
+	g.Expect(run.attachments).To(gomega.Equal([]string{"main.wdl", "tasks/align.wdl"}))
+
+	// the workflow url is relative to the attachments
+	_, err = client.RunWorkflow(context.TODO(), &RunWorkflowRequest{
+		RunRequest: RunRequest{
+			WorkflowURL:  WorkflowURLOfType(WorkflowTypeFromLanguage("Nextflow"), "/app/main.nf"),
+			WorkflowType: WorkflowTypeFromLanguage("Nextflow"),
+		},
+		WorkflowAttachment: map[string]string{"/app/main.nf": content, "/app/modules/align.nf": content},
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	run = <-submitted
+	g.Expect(run.form[workflowURL]).To(gomega.Equal([]string{"main.nf"}))
+	g.Expect(run.form[workflowType]).To(gomega.Equal([]string{WorkflowTypeNextflow}))
+	g.Expect(run.attachments).To(gomega.Equal([]string{"main.nf", "modules/align.nf"}))
+
+	// files in root dir are submitted as they are
+	_, err = client.RunWorkflow(context.TODO(), &RunWorkflowRequest{
+		RunRequest: RunRequest{
+			WorkflowURL:  WorkflowURLOfType(WorkflowTypeCWL, "main.cwl"),
+			WorkflowType: WorkflowTypeCWL,
+		},
+		WorkflowAttachment: map[string]string{"main.cwl": content, "tool.cwl": content},
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	run = <-submitted
+	g.Expect(run.form[workflowURL]).To(gomega.Equal([]string{"main.cwl"}))
+	g.Expect(run.attachments).To(gomega.Equal([]string{"main.cwl", "tool.cwl"}))
+}
@@ -60,16 +60,48 @@ type CancelRunResponse struct {
 // RunRequest ...
 type RunRequest struct {
 	WorkflowParams           map[string]interface{} `json:"workflow_params"`
+	WorkflowURL              string                 `json:"workflow_url"`
 	WorkflowType             string                 `json:"workflow_type"`
 	WorkflowTypeVersion      string                 `json:"workflow_type_version"`
 	Tags                     map[string]interface{} `json:"tags"`
 	WorkflowEngineParameters map[string]interface{} `json:"workflow_engine_parameters"`
 }
 
+// workflow type enum
+const (
+	WorkflowTypeWDL      = "WDL"
+	WorkflowTypeCWL      = "CWL"
+	WorkflowTypeNextflow = "NFL"
+)
+
+// WorkflowTypeFromLanguage converts workflow language to the workflow type of wes, unknown language is passed as is.
+func WorkflowTypeFromLanguage(language string) string {
+	switch language {
+	case "WDL":
+		return WorkflowTypeWDL
+	case "CWL":
+		return WorkflowTypeCWL
+	case "Nextflow":
+		return WorkflowTypeNextflow
+	}
+	return language
+}
+
+// WorkflowURLOfType returns the workflow url of the main workflow path submitted with the workflow type.
+// WDL runs are submitted without workflow url as before, the WDL engine finds the main workflow itself.
+func WorkflowURLOfType(workflowType, mainWorkflowPath string) string {
+	if workflowType == WorkflowTypeWDL {
+		return ""
+	}
+	return mainWorkflowPath
+}
+
 // RunStatus ...
 type RunStatus struct {
 	RunID string   `json:"run_id"`
 	State RunState `json:"state"`
+	// Tags are returned by some backends only, nil if not returned
+	Tags map[string]interface{} `json:"tags,omitempty"`
 }
 
 // RunState ...
@@ -89,6 +121,20 @@ const (
 	RunStateCanceling     RunState = "CANCELING"
 )
 
+// IsFinished returns whether the run will not change state any more.
+func (s RunState) IsFinished() bool {
+	switch s {
+	case RunStateComplete, RunStateExecutorError, RunStateSystemError, RunStateCanceled:
+		return true
+	}
+	return false
+}
+
+// TagFilter returns the tag_filter of ListRuns which matches runs tagged with key and value.
+func TagFilter(key, value string) string {
+	return fmt.Sprintf("%s:%s", key, value)
+}
+
 // Log ...
 type Log struct {
 	Name      string   `json:"name"`
@@ -99,6 +145,8 @@ type Log struct {
 	Stderr    string   `json:"stderr"`
 	Log       string   `json:"log"`
 	ExitCode  *int32   `json:"exit_code"`
+	// RuntimeAttributes is an extension reported by some engines, such as cpu, memory, preemptible and vm type of task
+	RuntimeAttributes map[string]interface{} `json:"runtime_attributes,omitempty"`
 }
 
 // ErrorResp ...
@@ -2,6 +2,7 @@ package wes
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/pflag"
 )
@@ -11,6 +12,10 @@ const (
 	DefaultBasePath = "/api/ga4gh/wes/v1"
 	DefaultTimeout  = 10
 	DefaultRetry    = 2
+	// DefaultMaxConcurrentRuns 0 means unlimited
+	DefaultMaxConcurrentRuns = 0
+	// DefaultReconcilePeriod 0 means the reconciler is disabled
+	DefaultReconcilePeriod = 10 * time.Minute
 )
 
 type Options struct {
@@ -18,6 +23,23 @@ type Options struct {
 	BasePath string
 	Timeout  int
 	Retry    int
+	// MaxConcurrentRuns is the default limit of runs submitted at the same time for each submission
+	MaxConcurrentRuns int
+	// DefaultBackend is the backend used if neither submission nor workspace specifies one,
+	// the top level backend named "default" is used if empty
+	DefaultBackend string
+	// Backends are the other named WES backends, they can only be set in config file
+	Backends map[string]*BackendOptions
+	// ReconcilePeriod is the period to reconcile active runs with the runs of WES backends, 0 means disabled
+	ReconcilePeriod time.Duration
+}
+
+// BackendOptions is the options of a named WES backend, the unset ones are the same as the top level backend.
+type BackendOptions struct {
+	Endpoint string
+	BasePath string
+	Timeout  int
+	Retry    int
 }
 
 // NewOptions new an event bus option.
@@ -32,6 +54,28 @@ func (o Options) Validate() error {
 	if o.Retry < 0 {
 		return fmt.Errorf("retry times can not less than 0")
 	}
+	if o.MaxConcurrentRuns < 0 {
+		return fmt.Errorf("max concurrent runs can not less than 0")
+	}
+	if o.ReconcilePeriod < 0 {
+		return fmt.Errorf("reconcile period can not less than 0")
+	}
+	for name, backend := range o.Backends {
+		if name == DefaultBackend {
+			return fmt.Errorf("wes backend name %s is reserved", DefaultBackend)
+		}
+		if backend == nil || backend.Endpoint == "" {
+			return fmt.Errorf("endpoint of wes backend %s can not be empty", name)
+		}
+		if err := backend.toOptions(&o).Validate(); err != nil {
+			return fmt.Errorf("invalid wes backend %s: %w", name, err)
+		}
+	}
+	if o.DefaultBackend != "" && o.DefaultBackend != DefaultBackend {
+		if _, ok := o.Backends[o.DefaultBackend]; !ok {
+			return fmt.Errorf("default wes backend %s is not configured", o.DefaultBackend)
+		}
+	}
 
 	return nil
 }
@@ -42,4 +86,30 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.BasePath, "wes-base-path", DefaultBasePath, "wes client api base path")
 	fs.IntVar(&o.Timeout, "wes-timeout", DefaultTimeout, "wes client timeout")
 	fs.IntVar(&o.Retry, "wes-retry", DefaultRetry, "wes client retry limit")
+	fs.StringVar(&o.DefaultBackend, "wes-default-backend", DefaultBackend, "name of the wes backend used if neither submission nor workspace specifies one")
+	fs.IntVar(&o.MaxConcurrentRuns, "wes-max-concurrent-runs", DefaultMaxConcurrentRuns, "default max concurrent runs of each submission, 0 means unlimited")
+	fs.DurationVar(&o.ReconcilePeriod, "wes-reconcile-period", DefaultReconcilePeriod, "period to reconcile active runs with the runs of wes backends, 0 means disabled")
+}
+
+// toOptions returns the options of backend, the unset ones are filled from the top level backend.
+func (o *BackendOptions) toOptions(defaults *Options) *Options {
+	options := &Options{
+		Endpoint: o.Endpoint,
+		BasePath: o.BasePath,
+		Timeout:  o.Timeout,
+		Retry:    o.Retry,
+	}
+	if options.BasePath == "" {
+		options.BasePath = defaults.BasePath
+	}
+	if options.BasePath == "" {
+		options.BasePath = DefaultBasePath
+	}
+	if options.Timeout == 0 {
+		options.Timeout = defaults.Timeout
+	}
+	if options.Retry == 0 {
+		options.Retry = defaults.Retry
+	}
+	return options
 }
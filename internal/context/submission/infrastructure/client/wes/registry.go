@@ -0,0 +1,73 @@
+package wes
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DefaultBackend is the name of backend configured by the top level wes options.
+const DefaultBackend = "default"
+
+// Registry holds clients of all configured WES backends by name.
+type Registry interface {
+	// Get returns client of the backend, client of the default backend is returned if name is empty.
+	Get(name string) (Client, error)
+	// Has checks if the backend is configured.
+	Has(name string) bool
+	// Default returns name of the default backend.
+	Default() string
+	// Names returns names of all backends.
+	Names() []string
+}
+
+// NewRegistry builds clients of the top level backend and all the named backends.
+func NewRegistry(options *Options) Registry {
+	clients := map[string]Client{
+		DefaultBackend: NewClient(options),
+	}
+	for name, backend := range options.Backends {
+		clients[name] = NewClient(backend.toOptions(options))
+	}
+	defaultBackend := options.DefaultBackend
+	if defaultBackend == "" {
+		defaultBackend = DefaultBackend
+	}
+	return &registry{
+		clients:        clients,
+		defaultBackend: defaultBackend,
+	}
+}
+
+type registry struct {
+	clients        map[string]Client
+	defaultBackend string
+}
+
+func (r *registry) Get(name string) (Client, error) {
+	if name == "" {
+		name = r.defaultBackend
+	}
+	client, ok := r.clients[name]
+	if !ok {
+		return nil, fmt.Errorf("wes backend %s is not configured", name)
+	}
+	return client, nil
+}
+
+func (r *registry) Has(name string) bool {
+	_, ok := r.clients[name]
+	return ok
+}
+
+func (r *registry) Default() string {
+	return r.defaultBackend
+}
+
+func (r *registry) Names() []string {
+	names := make([]string, 0, len(r.clients))
+	for name := range r.clients {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
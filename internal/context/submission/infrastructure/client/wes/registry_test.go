@@ -0,0 +1,90 @@
+package wes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func TestOptionsValidate(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	options := &Options{
+		Endpoint:       "http://cromwell:8000",
+		BasePath:       DefaultBasePath,
+		Timeout:        DefaultTimeout,
+		Retry:          DefaultRetry,
+		DefaultBackend: "hpc",
+		Backends: map[string]*BackendOptions{
+			// timeout and retry are the same as the top level backend
+			"hpc": {Endpoint: "http://cromwell-hpc:8000"},
+		},
+	}
+	g.Expect(options.Validate()).To(gomega.Succeed())
+
+	options.Backends["hpc"].Retry = -1
+	g.Expect(options.Validate()).To(gomega.HaveOccurred())
+	options.Backends["hpc"] = &BackendOptions{}
+	g.Expect(options.Validate()).To(gomega.HaveOccurred())
+	options.Backends = map[string]*BackendOptions{DefaultBackend: {Endpoint: "http://cromwell-hpc:8000"}}
+	g.Expect(options.Validate()).To(gomega.HaveOccurred())
+	// the default backend is not configured
+	options.Backends = nil
+	g.Expect(options.Validate()).To(gomega.HaveOccurred())
+}
+
+func TestRegistry(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	registry := NewRegistry(&Options{
+		Endpoint: "http://cromwell:8000",
+		BasePath: "/wes",
+		Timeout:  10,
+		Retry:    2,
+		Backends: map[string]*BackendOptions{
+			"hpc":   {Endpoint: "http://cromwell-hpc:8000"},
+			"cloud": {Endpoint: "http://cromwell-cloud:8000", BasePath: "/api/wes", Timeout: 30, Retry: 1},
+		},
+	})
+	g.Expect(registry.Default()).To(gomega.Equal(DefaultBackend))
+	g.Expect(registry.Names()).To(gomega.Equal([]string{"cloud", DefaultBackend, "hpc"}))
+	g.Expect(registry.Has("hpc")).To(gomega.BeTrue())
+	g.Expect(registry.Has("dev")).To(gomega.BeFalse())
+
+	for _, c := range []struct {
+		name     string
+		endpoint string
+		basePath string
+		timeout  time.Duration
+		retry    int
+	}{
+		// the default backend is used if not specified
+		{name: "", endpoint: "http://cromwell:8000", basePath: "/wes", timeout: 10 * time.Second, retry: 2},
+		{name: DefaultBackend, endpoint: "http://cromwell:8000", basePath: "/wes", timeout: 10 * time.Second, retry: 2},
+		// unset options are the same as the default backend
+		{name: "hpc", endpoint: "http://cromwell-hpc:8000", basePath: "/wes", timeout: 10 * time.Second, retry: 2},
+		{name: "cloud", endpoint: "http://cromwell-cloud:8000", basePath: "/api/wes", timeout: 30 * time.Second, retry: 1},
+	} {
+		got, err := registry.Get(c.name)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		client := got.(*impl)
+		g.Expect(client.endpoint).To(gomega.Equal(c.endpoint), c.name)
+		g.Expect(client.basePath).To(gomega.Equal(c.basePath), c.name)
+		g.Expect(client.httpClient.GetClient().Timeout).To(gomega.Equal(c.timeout), c.name)
+		g.Expect(client.httpClient.RetryCount).To(gomega.Equal(c.retry), c.name)
+	}
+	_, err := registry.Get("dev")
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	// the named default backend is used if not specified
+	registry = NewRegistry(&Options{
+		Endpoint:       "http://cromwell:8000",
+		DefaultBackend: "hpc",
+		Backends:       map[string]*BackendOptions{"hpc": {Endpoint: "http://cromwell-hpc:8000"}},
+	})
+	g.Expect(registry.Default()).To(gomega.Equal("hpc"))
+	client, err := registry.Get("")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(client.(*impl).endpoint).To(gomega.Equal("http://cromwell-hpc:8000"))
+}
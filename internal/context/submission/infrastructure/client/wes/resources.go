@@ -0,0 +1,112 @@
+package wes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TaskResources is the resources requested by task, zero value means not reported by engine.
+type TaskResources struct {
+	CPU float64
+	// Memory in bytes
+	Memory      int64
+	MachineType string
+	Preemptible bool
+}
+
+var memoryUnits = map[string]float64{
+	"":    1,
+	"B":   1,
+	"K":   1e3,
+	"KB":  1e3,
+	"M":   1e6,
+	"MB":  1e6,
+	"G":   1e9,
+	"GB":  1e9,
+	"T":   1e12,
+	"TB":  1e12,
+	"KI":  1 << 10,
+	"KIB": 1 << 10,
+	"MI":  1 << 20,
+	"MIB": 1 << 20,
+	"GI":  1 << 30,
+	"GIB": 1 << 30,
+	"TI":  1 << 40,
+	"TIB": 1 << 40,
+}
+
+// Resources parses the runtime attributes of task, attributes which can not be parsed are ignored.
+func (l *Log) Resources() TaskResources {
+	var res TaskResources
+	for key, value := range l.RuntimeAttributes {
+		switch strings.ToLower(key) {
+		case "cpu", "cpus":
+			if cpu, err := parseFloat(value); err == nil {
+				res.CPU = cpu
+			}
+		case "memory":
+			if memory, err := parseMemory(value); err == nil {
+				res.Memory = memory
+			}
+		case "preemptible":
+			res.Preemptible = parsePreemptible(value)
+		case "machine_type", "machinetype", "vm_type", "instance_type":
+			res.MachineType = fmt.Sprint(value)
+		}
+	}
+	return res
+}
+
+func parseFloat(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	}
+	return 0, fmt.Errorf("unsupported value %v", value)
+}
+
+// parseMemory parses memory like 2 GB, 512Mi or bytes number.
+func parseMemory(value interface{}) (int64, error) {
+	str, ok := value.(string)
+	if !ok {
+		size, err := parseFloat(value)
+		return int64(size), err
+	}
+	str = strings.TrimSpace(str)
+	i := strings.IndexFunc(str, func(r rune) bool {
+		return !(r >= '0' && r <= '9' || r == '.')
+	})
+	if i == -1 {
+		i = len(str)
+	}
+	size, err := strconv.ParseFloat(str[:i], 64)
+	if err != nil {
+		return 0, err
+	}
+	unit, ok := memoryUnits[strings.ToUpper(strings.TrimSpace(str[i:]))]
+	if !ok {
+		return 0, fmt.Errorf("unsupported memory unit %s", str[i:])
+	}
+	return int64(size * unit), nil
+}
+
+// parsePreemptible parses preemptible of bool or the max preemptible attempts of cromwell.
+func parsePreemptible(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case float64:
+		return v > 0
+	case string:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+		if n, err := strconv.ParseFloat(v, 64); err == nil {
+			return n > 0
+		}
+	}
+	return false
+}
@@ -0,0 +1,265 @@
+package mongo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	query "github.com/Bio-OS/bioos/internal/context/submission/application/query/run"
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/run"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+func runPOToRunDTO(ctx context.Context, r *runPO) *query.RunItem {
+	item := &query.RunItem{
+		ID:            r.ID,
+		Name:          r.Name,
+		Status:        r.Status,
+		StartTime:     r.StartTime.Unix(),
+		EngineRunID:   r.EngineRunID,
+		Log:           r.Log,
+		Message:       r.Message,
+		EngineBackend: r.EngineBackend,
+		Inputs:        r.Inputs,
+	}
+	if r.Outputs != nil {
+		item.Outputs = *r.Outputs
+	}
+	if r.FinishTime != nil {
+		item.FinishTime = utils.PointInt64(r.FinishTime.Unix())
+		item.Duration = r.FinishTime.Unix() - r.StartTime.Unix()
+	} else {
+		item.Duration = time.Now().Unix() - r.StartTime.Unix()
+	}
+	item.Attempts = make([]query.Attempt, len(r.Attempts))
+	for i, attempt := range r.Attempts {
+		item.Attempts[i] = attemptPOToAttemptDTO(attempt)
+	}
+	return item
+}
+
+func attemptPOToAttemptDTO(attempt attempt) query.Attempt {
+	item := query.Attempt{
+		EngineRunID: attempt.EngineRunID,
+		Status:      attempt.Status,
+		Message:     attempt.Message,
+		StartTime:   attempt.StartTime.Unix(),
+	}
+	if attempt.FinishTime != nil {
+		item.FinishTime = utils.PointInt64(attempt.FinishTime.Unix())
+		item.Duration = attempt.FinishTime.Unix() - attempt.StartTime.Unix()
+	}
+	return item
+}
+
+func taskPOToTaskDTO(ctx context.Context, task *taskPO) *query.TaskItem {
+	item := &query.TaskItem{
+		Name:      task.Name,
+		RunID:     task.RunID,
+		Status:    task.Status,
+		StartTime: task.StartTime.Unix(),
+		Stdout:    task.Stdout,
+		Stderr:    task.Stderr,
+		Resources: query.TaskResources{
+			CPU:         task.CPU,
+			Memory:      task.Memory,
+			MachineType: task.MachineType,
+			Preemptible: task.Preemptible,
+		},
+	}
+	if task.FinishTime != nil {
+		item.FinishTime = utils.PointInt64(task.FinishTime.Unix())
+		item.Duration = task.FinishTime.Unix() - task.StartTime.Unix()
+	} else {
+		item.Duration = time.Now().Unix() - task.StartTime.Unix()
+	}
+	return item
+}
+
+func taskPOToTaskUsageDTO(task *taskPO, r *runPO) *query.TaskUsageItem {
+	item := &query.TaskUsageItem{
+		SubmissionID: r.SubmissionID,
+		RunID:        task.RunID,
+		RunName:      r.Name,
+		Resources: query.TaskResources{
+			CPU:         task.CPU,
+			Memory:      task.Memory,
+			MachineType: task.MachineType,
+			Preemptible: task.Preemptible,
+		},
+	}
+	if task.FinishTime != nil {
+		item.Duration = task.FinishTime.Unix() - task.StartTime.Unix()
+	} else {
+		item.Duration = time.Now().Unix() - task.StartTime.Unix()
+	}
+	return item
+}
+
+// attemptTaskPOToTaskUsageDTO converts the task of previous attempt of run to usage.
+func attemptTaskPOToTaskUsageDTO(task *attemptTask, r *runPO) *query.TaskUsageItem {
+	return taskPOToTaskUsageDTO(&taskPO{
+		Name:        task.Name,
+		RunID:       r.ID,
+		StartTime:   task.StartTime,
+		FinishTime:  task.FinishTime,
+		CPU:         task.CPU,
+		Memory:      task.Memory,
+		MachineType: task.MachineType,
+		Preemptible: task.Preemptible,
+	}, r)
+}
+
+func statusCountPOToStatusCountDTO(count *statusCount) *query.StatusCount {
+	return &query.StatusCount{
+		Count:  count.Count,
+		Status: count.Status,
+	}
+}
+
+func runPOToRunDO(r *runPO) (*run.Run, error) {
+	var inputs map[string]interface{}
+	if len(r.Inputs) > 0 {
+		if err := json.Unmarshal([]byte(r.Inputs), &inputs); err != nil {
+			return nil, fmt.Errorf("failed to transform inputs: %w", err)
+		}
+	}
+	var outputs *map[string]interface{}
+	if r.Outputs != nil {
+		outputs = &map[string]interface{}{}
+		if err := json.Unmarshal([]byte(*r.Outputs), outputs); err != nil {
+			return nil, fmt.Errorf("failed to transform outputs: %w", err)
+		}
+	}
+	attempts := make([]*run.Attempt, len(r.Attempts))
+	for i, attempt := range r.Attempts {
+		attempts[i] = &run.Attempt{
+			EngineRunID: attempt.EngineRunID,
+			Status:      attempt.Status,
+			Message:     attempt.Message,
+			StartTime:   attempt.StartTime,
+			FinishTime:  attempt.FinishTime,
+			Tasks:       make([]*run.TaskUsage, len(attempt.Tasks)),
+		}
+		for j, task := range attempt.Tasks {
+			attempts[i].Tasks[j] = &run.TaskUsage{
+				Name:       task.Name,
+				StartTime:  task.StartTime,
+				FinishTime: task.FinishTime,
+				Resources: run.TaskResources{
+					CPU:         task.CPU,
+					Memory:      task.Memory,
+					MachineType: task.MachineType,
+					Preemptible: task.Preemptible,
+				},
+			}
+		}
+	}
+	return &run.Run{
+		ID:            r.ID,
+		Name:          r.Name,
+		SubmissionID:  r.SubmissionID,
+		Inputs:        inputs,
+		Outputs:       outputs,
+		EngineRunID:   r.EngineRunID,
+		Status:        r.Status,
+		Log:           r.Log,
+		Message:       r.Message,
+		StartTime:     r.StartTime,
+		FinishTime:    r.FinishTime,
+		Attempts:      attempts,
+		Queued:        r.Queued,
+		EngineBackend: r.EngineBackend,
+	}, nil
+}
+
+func runDOToRunPO(r *run.Run) (*runPO, error) {
+	inputs, err := json.Marshal(r.Inputs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform inputs: %w", err)
+	}
+	var outputs *string
+	if r.Outputs != nil {
+		outputsInBytes, err := json.Marshal(*r.Outputs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to transform outputs: %w", err)
+		}
+		outputs = utils.PointString(string(outputsInBytes))
+	}
+	attempts := make([]attempt, len(r.Attempts))
+	for i, a := range r.Attempts {
+		attempts[i] = attempt{
+			EngineRunID: a.EngineRunID,
+			Status:      a.Status,
+			Message:     a.Message,
+			StartTime:   a.StartTime,
+			FinishTime:  a.FinishTime,
+		}
+		for _, task := range a.Tasks {
+			attempts[i].Tasks = append(attempts[i].Tasks, attemptTask{
+				Name:        task.Name,
+				StartTime:   task.StartTime,
+				FinishTime:  task.FinishTime,
+				CPU:         task.Resources.CPU,
+				Memory:      task.Resources.Memory,
+				MachineType: task.Resources.MachineType,
+				Preemptible: task.Resources.Preemptible,
+			})
+		}
+	}
+	return &runPO{
+		ID:            r.ID,
+		Name:          r.Name,
+		SubmissionID:  r.SubmissionID,
+		Inputs:        string(inputs),
+		Outputs:       outputs,
+		EngineRunID:   r.EngineRunID,
+		Status:        r.Status,
+		Log:           r.Log,
+		Message:       r.Message,
+		StartTime:     r.StartTime,
+		FinishTime:    r.FinishTime,
+		Attempts:      attempts,
+		Queued:        r.Queued,
+		EngineBackend: r.EngineBackend,
+	}, nil
+}
+
+func taskPOToTaskDO(task *taskPO) *run.Task {
+	return &run.Task{
+		Name:       task.Name,
+		RunID:      task.RunID,
+		Status:     task.Status,
+		Stdout:     task.Stdout,
+		Stderr:     task.Stderr,
+		StartTime:  task.StartTime,
+		FinishTime: task.FinishTime,
+		Resources: run.TaskResources{
+			CPU:         task.CPU,
+			Memory:      task.Memory,
+			MachineType: task.MachineType,
+			Preemptible: task.Preemptible,
+		},
+	}
+}
+
+func runDOToTaskPOList(r *run.Run) []*taskPO {
+	tasks := make([]*taskPO, 0, len(r.Tasks))
+	for _, task := range r.Tasks {
+		tasks = append(tasks, &taskPO{
+			Name:        task.Name,
+			RunID:       task.RunID,
+			Status:      task.Status,
+			Stdout:      task.Stdout,
+			Stderr:      task.Stderr,
+			StartTime:   task.StartTime,
+			FinishTime:  task.FinishTime,
+			CPU:         task.Resources.CPU,
+			Memory:      task.Resources.Memory,
+			MachineType: task.Resources.MachineType,
+			Preemptible: task.Resources.Preemptible,
+		})
+	}
+	return tasks
+}
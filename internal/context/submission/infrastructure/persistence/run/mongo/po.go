@@ -0,0 +1,76 @@
+package mongo
+
+import (
+	"time"
+)
+
+const (
+	RunCollection  = "run"
+	TaskCollection = "task"
+	// LeaseCollection is the collection of leases held by replicas, e.g. the lease of run reconciler
+	LeaseCollection = "lease"
+)
+
+type runPO struct {
+	ID           string `bson:"id"`
+	Name         string `bson:"name"`
+	SubmissionID string `bson:"submissionID"`
+	// Inputs and Outputs are stored in json, nested documents decoded by mongo driver are not plain maps
+	Inputs        string     `bson:"inputs"`
+	Outputs       *string    `bson:"outputs"`
+	EngineRunID   string     `bson:"engineRunID"`
+	Status        string     `bson:"status"`
+	Log           *string    `bson:"log"`
+	Message       *string    `bson:"message"`
+	StartTime     time.Time  `bson:"startTime"`
+	FinishTime    *time.Time `bson:"finishTime"`
+	Attempts      []attempt  `bson:"attempts"`
+	Queued        bool       `bson:"queued"`
+	EngineBackend string     `bson:"engineBackend,omitempty"`
+}
+
+type attempt struct {
+	EngineRunID string     `bson:"engineRunID"`
+	Status      string     `bson:"status"`
+	Message     *string    `bson:"message"`
+	StartTime   time.Time  `bson:"startTime"`
+	FinishTime  *time.Time `bson:"finishTime"`
+	// resources of tasks of attempt
+	Tasks []attemptTask `bson:"tasks,omitempty"`
+}
+
+type attemptTask struct {
+	Name        string     `bson:"name"`
+	StartTime   time.Time  `bson:"startTime"`
+	FinishTime  *time.Time `bson:"finishTime"`
+	CPU         float64    `bson:"cpu"`
+	Memory      int64      `bson:"memory"`
+	MachineType string     `bson:"machineType,omitempty"`
+	Preemptible bool       `bson:"preemptible"`
+}
+
+type taskPO struct {
+	Name       string     `bson:"name"`
+	RunID      string     `bson:"runID"`
+	Status     string     `bson:"status"`
+	Stdout     string     `bson:"stdout"`
+	Stderr     string     `bson:"stderr"`
+	StartTime  time.Time  `bson:"startTime"`
+	FinishTime *time.Time `bson:"finishTime"`
+	// resources of task reported by engine
+	CPU         float64 `bson:"cpu"`
+	Memory      int64   `bson:"memory"`
+	MachineType string  `bson:"machineType,omitempty"`
+	Preemptible bool    `bson:"preemptible"`
+}
+
+type statusCount struct {
+	Count  int64  `bson:"count"`
+	Status string `bson:"_id"`
+}
+
+type leasePO struct {
+	Name     string    `bson:"name"`
+	Owner    string    `bson:"owner"`
+	ExpireAt time.Time `bson:"expireAt"`
+}
@@ -0,0 +1,289 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	query "github.com/Bio-OS/bioos/internal/context/submission/application/query/run"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+type runReadModel struct {
+	runCollection  *mongo.Collection
+	taskCollection *mongo.Collection
+}
+
+var _ query.ReadModel = &runReadModel{}
+
+// NewRunReadModel ...
+func NewRunReadModel(ctx context.Context, mongoDB *mongo.Database) (query.ReadModel, error) {
+	return &runReadModel{
+		runCollection:  mongoDB.Collection(RunCollection),
+		taskCollection: mongoDB.Collection(TaskCollection),
+	}, nil
+}
+
+func (r *runReadModel) ListAllRunIDs(ctx context.Context, submissionID string) ([]string, error) {
+	runs, err := r.findRuns(ctx, bson.M{"submissionID": submissionID}, options.Find().SetProjection(bson.M{"id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(runs))
+	for i, po := range runs {
+		ids[i] = po.ID
+	}
+	return ids, nil
+}
+
+func (r *runReadModel) ListAllRunNames(ctx context.Context, submissionID string, filter *query.ListRunsFilter) ([]string, error) {
+	opts := options.Find().SetProjection(bson.M{"name": 1}).SetSort(bson.D{{Key: "name", Value: 1}})
+	runs, err := r.findRuns(ctx, listRunsFilter(submissionID, filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(runs))
+	for i, po := range runs {
+		names[i] = po.Name
+	}
+	return names, nil
+}
+
+func (r *runReadModel) ListQueuedRunIDs(ctx context.Context, submissionID string, limit int) ([]string, error) {
+	opts := options.Find().SetProjection(bson.M{"id": 1}).SetSort(bson.D{{Key: "name", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	runs, err := r.findRuns(ctx, bson.M{"submissionID": submissionID, "queued": true, "status": consts.RunPending}, opts)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(runs))
+	for i, po := range runs {
+		ids[i] = po.ID
+	}
+	return ids, nil
+}
+
+func (r *runReadModel) ListRuns(ctx context.Context, submissionID string, pg *utils.Pagination, filter *query.ListRunsFilter) ([]*query.RunItem, error) {
+	opts := options.Find().SetLimit(int64(pg.GetLimit())).SetSkip(int64(pg.GetOffset())).SetSort(ordersToSort(pg.Orders))
+	runs, err := r.findRuns(ctx, listRunsFilter(submissionID, filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*query.RunItem, len(runs))
+	for index, po := range runs {
+		ret[index] = runPOToRunDTO(ctx, po)
+	}
+	return ret, nil
+}
+
+func (r *runReadModel) CountRuns(ctx context.Context, submissionID string, filter *query.ListRunsFilter) (int, error) {
+	count, err := r.runCollection.CountDocuments(ctx, listRunsFilter(submissionID, filter))
+	if err != nil {
+		applog.Errorw("failed to count runs", "err", err)
+		return 0, apperrors.NewInternalError(err)
+	}
+	return int(count), nil
+}
+
+func (r *runReadModel) ListTasks(ctx context.Context, runID string, pg *utils.Pagination) ([]*query.TaskItem, error) {
+	opts := options.Find().SetLimit(int64(pg.GetLimit())).SetSkip(int64(pg.GetOffset())).SetSort(ordersToSort(pg.Orders))
+	tasks, err := r.findTasks(ctx, bson.M{"runID": runID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*query.TaskItem, len(tasks))
+	for index, po := range tasks {
+		ret[index] = taskPOToTaskDTO(ctx, po)
+	}
+	return ret, nil
+}
+
+func (r *runReadModel) CountTasks(ctx context.Context, runID string) (int, error) {
+	count, err := r.taskCollection.CountDocuments(ctx, bson.M{"runID": runID})
+	if err != nil {
+		applog.Errorw("failed to count tasks", "err", err)
+		return 0, apperrors.NewInternalError(err)
+	}
+	return int(count), nil
+}
+
+func (r *runReadModel) GetTask(ctx context.Context, runID, name string) (*query.TaskItem, error) {
+	var task taskPO
+	if err := r.taskCollection.FindOne(ctx, bson.M{"runID": runID, "name": name}).Decode(&task); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("task", name)
+		}
+		applog.Errorw("failed to get task", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return taskPOToTaskDTO(ctx, &task), nil
+}
+
+func (r *runReadModel) ListTaskUsages(ctx context.Context, submissionIDs []string, filter *query.TaskUsageFilter) ([]*query.TaskUsageItem, error) {
+	runs, err := r.findRuns(ctx, bson.M{"submissionID": bson.M{"$in": submissionIDs}},
+		options.Find().SetProjection(bson.M{"id": 1, "name": 1, "submissionID": 1, "attempts": 1}))
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return []*query.TaskUsageItem{}, nil
+	}
+	mappedRuns := make(map[string]*runPO, len(runs))
+	runIDs := make([]string, 0, len(runs))
+	for _, po := range runs {
+		mappedRuns[po.ID] = po
+		runIDs = append(runIDs, po.ID)
+	}
+
+	taskFilter := bson.M{"runID": bson.M{"$in": runIDs}}
+	if filter != nil {
+		startTime := bson.M{}
+		if filter.StartTime != nil {
+			startTime["$gte"] = *filter.StartTime
+		}
+		if filter.EndTime != nil {
+			startTime["$lt"] = *filter.EndTime
+		}
+		if len(startTime) > 0 {
+			taskFilter["startTime"] = startTime
+		}
+	}
+	tasks, err := r.findTasks(ctx, taskFilter)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*query.TaskUsageItem, len(tasks))
+	for index, po := range tasks {
+		ret[index] = taskPOToTaskUsageDTO(po, mappedRuns[po.RunID])
+	}
+	// tasks of previous attempts are recorded in run
+	for _, po := range runs {
+		for _, attempt := range po.Attempts {
+			for i := range attempt.Tasks {
+				if filter.Contains(attempt.Tasks[i].StartTime) {
+					ret = append(ret, attemptTaskPOToTaskUsageDTO(&attempt.Tasks[i], po))
+				}
+			}
+		}
+	}
+	return ret, nil
+}
+
+func (r *runReadModel) CountRunsResult(ctx context.Context, submissionID string) ([]*query.StatusCount, error) {
+	counts, err := countByStatus(ctx, r.runCollection, bson.M{"submissionID": submissionID})
+	if err != nil {
+		applog.Errorw("failed to count runs result", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return counts, nil
+}
+
+func (r *runReadModel) CountTasksResult(ctx context.Context, runID string) ([]*query.StatusCount, error) {
+	counts, err := countByStatus(ctx, r.taskCollection, bson.M{"runID": runID})
+	if err != nil {
+		applog.Errorw("failed to count tasks result", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return counts, nil
+}
+
+func (r *runReadModel) findRuns(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*runPO, error) {
+	cursor, err := r.runCollection.Find(ctx, filter, opts...)
+	if err != nil {
+		applog.Errorw("failed to list runs", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var runs []*runPO
+	if err := cursor.All(ctx, &runs); err != nil {
+		applog.Errorw("failed to decode runs", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return runs, nil
+}
+
+func (r *runReadModel) findTasks(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*taskPO, error) {
+	cursor, err := r.taskCollection.Find(ctx, filter, opts...)
+	if err != nil {
+		applog.Errorw("failed to list tasks", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var tasks []*taskPO
+	if err := cursor.All(ctx, &tasks); err != nil {
+		applog.Errorw("failed to decode tasks", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return tasks, nil
+}
+
+func countByStatus(ctx context.Context, collection *mongo.Collection, filter bson.M) ([]*query.StatusCount, error) {
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var counts []*statusCount
+	if err := cursor.All(ctx, &counts); err != nil {
+		return nil, err
+	}
+	ret := make([]*query.StatusCount, len(counts))
+	for index, count := range counts {
+		ret[index] = statusCountPOToStatusCountDTO(count)
+	}
+	return ret, nil
+}
+
+func listRunsFilter(submissionID string, filter *query.ListRunsFilter) bson.M {
+	res := bson.M{"submissionID": submissionID}
+	if filter == nil {
+		return res
+	}
+	if searchFilter := utils.MongoSearchWordFilter(filter.SearchWord, []string{"name"}, filter.Exact); searchFilter != nil {
+		res["$and"] = bson.A{searchFilter}
+	}
+	if len(filter.IDs) != 0 {
+		res["id"] = bson.M{"$in": filter.IDs}
+	}
+	if len(filter.Status) != 0 {
+		res["status"] = bson.M{"$in": filter.Status}
+	}
+	if filter.Queued != nil {
+		if *filter.Queued {
+			res["queued"] = true
+		} else {
+			// runs created before concurrency limit was introduced have no queued
+			res["queued"] = bson.M{"$ne": true}
+		}
+	}
+	return res
+}
+
+func ordersToSort(orders []utils.Order) bson.D {
+	sort := make(bson.D, 0, len(orders))
+	for _, order := range orders {
+		var field string
+		switch order.Field {
+		case query.OrderByName:
+			field = "name"
+		case query.OrderByStartTime:
+			field = "startTime"
+		default:
+			continue
+		}
+		direction := -1
+		if order.Ascending {
+			direction = 1
+		}
+		sort = append(sort, bson.E{Key: field, Value: direction})
+	}
+	return sort
+}
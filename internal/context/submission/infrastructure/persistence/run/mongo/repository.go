@@ -0,0 +1,237 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/run"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+type runRepository struct {
+	client          *mongo.Client
+	runCollection   *mongo.Collection
+	taskCollection  *mongo.Collection
+	leaseCollection *mongo.Collection
+}
+
+var _ run.Repository = &runRepository{}
+
+// NewRunRepository ...
+func NewRunRepository(ctx context.Context, mongoDB *mongo.Database) (run.Repository, error) {
+	r := &runRepository{
+		client:          mongoDB.Client(),
+		runCollection:   mongoDB.Collection(RunCollection),
+		taskCollection:  mongoDB.Collection(TaskCollection),
+		leaseCollection: mongoDB.Collection(LeaseCollection),
+	}
+	if err := utils.EnsureIndex(ctx, r.runCollection, "idx_id", true, primitive.D{{Key: "id", Value: 1}}); err != nil {
+		return nil, err
+	}
+	if err := utils.EnsureIndex(ctx, r.runCollection, "idx_sub_name", true, primitive.D{
+		{Key: "submissionID", Value: 1},
+		{Key: "name", Value: 1},
+	}); err != nil {
+		return nil, err
+	}
+	if err := utils.EnsureIndex(ctx, r.taskCollection, "idx_run_name", true, primitive.D{
+		{Key: "runID", Value: 1},
+		{Key: "name", Value: 1},
+	}); err != nil {
+		return nil, err
+	}
+	if err := utils.EnsureIndex(ctx, r.leaseCollection, "idx_name", true, primitive.D{{Key: "name", Value: 1}}); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *runRepository) Save(ctx context.Context, w *run.Run) error {
+	po, err := runDOToRunPO(w)
+	if err != nil {
+		applog.Errorw("failed to convert run do to po", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if _, err := r.runCollection.UpdateOne(ctx, bson.M{"id": po.ID}, bson.M{"$set": po}, options.Update().SetUpsert(true)); err != nil {
+		applog.Errorw("failed to create/update run", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	tasks := runDOToTaskPOList(w)
+	if len(tasks) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(tasks))
+	for _, task := range tasks {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"runID": task.RunID, "name": task.Name}).
+			SetUpdate(bson.M{"$set": task}).
+			SetUpsert(true))
+	}
+	if _, err := r.taskCollection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		applog.Errorw("failed to create/update task", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *runRepository) Get(ctx context.Context, id string) (*run.Run, error) {
+	var po runPO
+	if err := r.runCollection.FindOne(ctx, bson.M{"id": id}).Decode(&po); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("Run", id)
+		}
+		applog.Errorw("failed to get run", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	res, err := runPOToRunDO(&po)
+	if err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	return res, nil
+}
+
+func (r *runRepository) Delete(ctx context.Context, w *run.Run) error {
+	if _, err := r.runCollection.DeleteOne(ctx, bson.M{"id": w.ID}); err != nil {
+		applog.Errorw("failed to delete run", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return r.DeleteTasks(ctx, w)
+}
+
+func (r *runRepository) DeleteTasks(ctx context.Context, w *run.Run) error {
+	if _, err := r.taskCollection.DeleteMany(ctx, bson.M{"runID": w.ID}); err != nil {
+		applog.Errorw("failed to delete task", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *runRepository) SaveReset(ctx context.Context, w *run.Run) (bool, error) {
+	po, err := runDOToRunPO(w)
+	if err != nil {
+		applog.Errorw("failed to convert run do to po", "err", err)
+		return false, apperrors.NewInternalError(err)
+	}
+	session, err := r.client.StartSession()
+	if err != nil {
+		return false, apperrors.NewInternalError(err)
+	}
+	defer session.EndSession(ctx)
+
+	saved, err := session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		// only one of the concurrent callers can reset the finished run
+		res, err := r.runCollection.UpdateOne(sessCtx, bson.M{
+			"id":     po.ID,
+			"status": bson.M{"$in": consts.FinishedRunStatuses},
+		}, bson.M{"$set": po})
+		if err != nil {
+			return false, err
+		}
+		if res.MatchedCount == 0 {
+			return false, nil
+		}
+		if _, err := r.taskCollection.DeleteMany(sessCtx, bson.M{"runID": po.ID}); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+	if err != nil {
+		applog.Errorw("failed to save reset run", "err", err)
+		return false, apperrors.NewInternalError(err)
+	}
+	return saved.(bool), nil
+}
+
+func (r *runRepository) ListTasks(ctx context.Context, w *run.Run) ([]*run.Task, error) {
+	cursor, err := r.taskCollection.Find(ctx, bson.M{"runID": w.ID})
+	if err != nil {
+		applog.Errorw("failed to list tasks", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var pos []*taskPO
+	if err := cursor.All(ctx, &pos); err != nil {
+		applog.Errorw("failed to decode tasks", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	tasks := make([]*run.Task, len(pos))
+	for i, po := range pos {
+		tasks[i] = taskPOToTaskDO(po)
+	}
+	return tasks, nil
+}
+
+func (r *runRepository) Dequeue(ctx context.Context, id string) (bool, error) {
+	// only one of the concurrent callers can take the run out of the queue
+	res, err := r.runCollection.UpdateOne(ctx, bson.M{"id": id, "queued": true}, bson.M{"$set": bson.M{"queued": false}})
+	if err != nil {
+		applog.Errorw("failed to dequeue run", "err", err)
+		return false, apperrors.NewInternalError(err)
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+func (r *runRepository) Enqueue(ctx context.Context, id string) error {
+	if _, err := r.runCollection.UpdateOne(ctx, bson.M{"id": id, "engineRunID": ""}, bson.M{"$set": bson.M{"queued": true}}); err != nil {
+		applog.Errorw("failed to enqueue run", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *runRepository) ListActive(ctx context.Context) ([]*run.Run, error) {
+	cursor, err := r.runCollection.Find(ctx, bson.M{
+		"status": bson.M{"$in": consts.NonFinishedRunStatuses},
+		"queued": bson.M{"$ne": true},
+	})
+	if err != nil {
+		applog.Errorw("failed to list active runs", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var pos []*runPO
+	if err := cursor.All(ctx, &pos); err != nil {
+		applog.Errorw("failed to decode active runs", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	runs := make([]*run.Run, len(pos))
+	for i, po := range pos {
+		if runs[i], err = runPOToRunDO(po); err != nil {
+			return nil, apperrors.NewInternalError(err)
+		}
+	}
+	return runs, nil
+}
+
+func (r *runRepository) AcquireLease(ctx context.Context, name, owner string, expireAt time.Time) (bool, error) {
+	// take over the lease held by self or expired, or create it if it does not exist. The upsert
+	// fails on the unique name if the lease is held by another owner.
+	filter := bson.M{
+		"name": name,
+		"$or":  bson.A{bson.M{"owner": owner}, bson.M{"expireAt": bson.M{"$lt": time.Now()}}},
+	}
+	update := bson.M{"$set": leasePO{Name: name, Owner: owner, ExpireAt: expireAt}}
+	if _, err := r.leaseCollection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true)); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		applog.Errorw("failed to acquire lease", "err", err)
+		return false, apperrors.NewInternalError(err)
+	}
+	return true, nil
+}
+
+func (r *runRepository) ReleaseLease(ctx context.Context, name, owner string) error {
+	if _, err := r.leaseCollection.DeleteOne(ctx, bson.M{"name": name, "owner": owner}); err != nil {
+		applog.Errorw("failed to release lease", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
@@ -13,13 +13,14 @@ import (
 
 func RunPOToRunDTO(ctx context.Context, run *Run) (*query.RunItem, error) {
 	item := &query.RunItem{
-		ID:          run.ID,
-		Name:        run.Name,
-		Status:      run.Status,
-		StartTime:   run.StartTime.Unix(),
-		EngineRunID: run.EngineRunID,
-		Log:         run.Log,
-		Message:     run.Message,
+		ID:            run.ID,
+		Name:          run.Name,
+		Status:        run.Status,
+		StartTime:     run.StartTime.Unix(),
+		EngineRunID:   run.EngineRunID,
+		Log:           run.Log,
+		Message:       run.Message,
+		EngineBackend: run.EngineBackend,
 	}
 	var inputs, outputs []byte
 	var err error
@@ -41,9 +42,27 @@ func RunPOToRunDTO(ctx context.Context, run *Run) (*query.RunItem, error) {
 	}
 	item.Inputs = string(inputs)
 	item.Outputs = string(outputs)
+	item.Attempts = make([]query.Attempt, len(run.Attempts))
+	for i, attempt := range run.Attempts {
+		item.Attempts[i] = AttemptPOToAttemptDTO(attempt)
+	}
 	return item, nil
 }
 
+func AttemptPOToAttemptDTO(attempt Attempt) query.Attempt {
+	item := query.Attempt{
+		EngineRunID: attempt.EngineRunID,
+		Status:      attempt.Status,
+		Message:     attempt.Message,
+		StartTime:   attempt.StartTime.Unix(),
+	}
+	if attempt.FinishTime != nil {
+		item.FinishTime = utils.PointInt64(attempt.FinishTime.Unix())
+		item.Duration = attempt.FinishTime.Unix() - attempt.StartTime.Unix()
+	}
+	return item
+}
+
 func TaskPOToTaskDTO(ctx context.Context, task *Task) *query.TaskItem {
 	item := &query.TaskItem{
 		Name:      task.Name,
@@ -52,6 +71,12 @@ func TaskPOToTaskDTO(ctx context.Context, task *Task) *query.TaskItem {
 		StartTime: task.StartTime.Unix(),
 		Stdout:    task.Stdout,
 		Stderr:    task.Stderr,
+		Resources: query.TaskResources{
+			CPU:         task.CPU,
+			Memory:      task.Memory,
+			MachineType: task.MachineType,
+			Preemptible: task.Preemptible,
+		},
 	}
 	if task.FinishTime != nil {
 		item.FinishTime = utils.PointInt64(task.FinishTime.Unix())
@@ -70,18 +95,45 @@ func StatusCountPOToStatusCountDTO(count *StatusCount) *query.StatusCount {
 }
 
 func RunPOToRunDO(runPO *Run) *run.Run {
+	attempts := make([]*run.Attempt, len(runPO.Attempts))
+	for i, attempt := range runPO.Attempts {
+		attempts[i] = &run.Attempt{
+			EngineRunID: attempt.EngineRunID,
+			Status:      attempt.Status,
+			Message:     attempt.Message,
+			StartTime:   attempt.StartTime,
+			FinishTime:  attempt.FinishTime,
+			Tasks:       make([]*run.TaskUsage, len(attempt.Tasks)),
+		}
+		for j, task := range attempt.Tasks {
+			attempts[i].Tasks[j] = &run.TaskUsage{
+				Name:       task.Name,
+				StartTime:  task.StartTime,
+				FinishTime: task.FinishTime,
+				Resources: run.TaskResources{
+					CPU:         task.CPU,
+					Memory:      task.Memory,
+					MachineType: task.MachineType,
+					Preemptible: task.Preemptible,
+				},
+			}
+		}
+	}
 	return &run.Run{
-		ID:           runPO.ID,
-		Name:         runPO.Name,
-		SubmissionID: runPO.SubmissionID,
-		Inputs:       runPO.Inputs,
-		Outputs:      runPO.Outputs,
-		EngineRunID:  runPO.EngineRunID,
-		Status:       runPO.Status,
-		Log:          runPO.Log,
-		Message:      runPO.Message,
-		StartTime:    runPO.StartTime,
-		FinishTime:   runPO.FinishTime,
+		ID:            runPO.ID,
+		Name:          runPO.Name,
+		SubmissionID:  runPO.SubmissionID,
+		Inputs:        runPO.Inputs,
+		Outputs:       runPO.Outputs,
+		EngineRunID:   runPO.EngineRunID,
+		Status:        runPO.Status,
+		Log:           runPO.Log,
+		Message:       runPO.Message,
+		StartTime:     runPO.StartTime,
+		FinishTime:    runPO.FinishTime,
+		Attempts:      attempts,
+		Queued:        runPO.Queued,
+		EngineBackend: runPO.EngineBackend,
 	}
 }
 
@@ -89,31 +141,112 @@ func RunDOToTaskPOList(runDO *run.Run) []*Task {
 	taskPOList := make([]*Task, 0)
 	for _, curTask := range runDO.Tasks {
 		taskPO := &Task{
-			Name:       curTask.Name,
-			RunID:      curTask.RunID,
-			Status:     curTask.Status,
-			Stdout:     curTask.Stdout,
-			Stderr:     curTask.Stderr,
-			StartTime:  curTask.StartTime,
-			FinishTime: curTask.FinishTime,
+			Name:        curTask.Name,
+			RunID:       curTask.RunID,
+			Status:      curTask.Status,
+			Stdout:      curTask.Stdout,
+			Stderr:      curTask.Stderr,
+			StartTime:   curTask.StartTime,
+			FinishTime:  curTask.FinishTime,
+			CPU:         curTask.Resources.CPU,
+			Memory:      curTask.Resources.Memory,
+			MachineType: curTask.Resources.MachineType,
+			Preemptible: curTask.Resources.Preemptible,
 		}
 		taskPOList = append(taskPOList, taskPO)
 	}
 	return taskPOList
 }
 
+func TaskPOToTaskDO(task *Task) *run.Task {
+	return &run.Task{
+		Name:       task.Name,
+		RunID:      task.RunID,
+		Status:     task.Status,
+		Stdout:     task.Stdout,
+		Stderr:     task.Stderr,
+		StartTime:  task.StartTime,
+		FinishTime: task.FinishTime,
+		Resources: run.TaskResources{
+			CPU:         task.CPU,
+			Memory:      task.Memory,
+			MachineType: task.MachineType,
+			Preemptible: task.Preemptible,
+		},
+	}
+}
+
 func RunDOToRunPO(runDO *run.Run) *Run {
+	attempts := make([]Attempt, len(runDO.Attempts))
+	for i, attempt := range runDO.Attempts {
+		attempts[i] = Attempt{
+			EngineRunID: attempt.EngineRunID,
+			Status:      attempt.Status,
+			Message:     attempt.Message,
+			StartTime:   attempt.StartTime,
+			FinishTime:  attempt.FinishTime,
+		}
+		for _, task := range attempt.Tasks {
+			attempts[i].Tasks = append(attempts[i].Tasks, AttemptTask{
+				Name:        task.Name,
+				StartTime:   task.StartTime,
+				FinishTime:  task.FinishTime,
+				CPU:         task.Resources.CPU,
+				Memory:      task.Resources.Memory,
+				MachineType: task.Resources.MachineType,
+				Preemptible: task.Resources.Preemptible,
+			})
+		}
+	}
 	return &Run{
-		ID:           runDO.ID,
-		Name:         runDO.Name,
-		SubmissionID: runDO.SubmissionID,
-		Inputs:       runDO.Inputs,
-		Outputs:      runDO.Outputs,
-		EngineRunID:  runDO.EngineRunID,
-		Status:       runDO.Status,
-		Log:          runDO.Log,
-		Message:      runDO.Message,
-		StartTime:    runDO.StartTime,
-		FinishTime:   runDO.FinishTime,
+		ID:            runDO.ID,
+		Name:          runDO.Name,
+		SubmissionID:  runDO.SubmissionID,
+		Inputs:        runDO.Inputs,
+		Outputs:       runDO.Outputs,
+		EngineRunID:   runDO.EngineRunID,
+		Status:        runDO.Status,
+		Log:           runDO.Log,
+		Message:       runDO.Message,
+		StartTime:     runDO.StartTime,
+		FinishTime:    runDO.FinishTime,
+		Attempts:      attempts,
+		Queued:        runDO.Queued,
+		EngineBackend: runDO.EngineBackend,
+	}
+}
+
+func TaskUsagePOToTaskUsageDTO(usage *taskUsage) *query.TaskUsageItem {
+	item := &query.TaskUsageItem{
+		SubmissionID: usage.SubmissionID,
+		RunID:        usage.RunID,
+		RunName:      usage.RunName,
+		Resources: query.TaskResources{
+			CPU:         usage.CPU,
+			Memory:      usage.Memory,
+			MachineType: usage.MachineType,
+			Preemptible: usage.Preemptible,
+		},
 	}
+	if usage.FinishTime != nil {
+		item.Duration = usage.FinishTime.Unix() - usage.StartTime.Unix()
+	} else {
+		item.Duration = time.Now().Unix() - usage.StartTime.Unix()
+	}
+	return item
+}
+
+// AttemptTaskPOToTaskUsageDTO converts the task of previous attempt of run to usage.
+func AttemptTaskPOToTaskUsageDTO(task *AttemptTask, r *Run) *query.TaskUsageItem {
+	return TaskUsagePOToTaskUsageDTO(&taskUsage{
+		SubmissionID: r.SubmissionID,
+		RunID:        r.ID,
+		RunName:      r.Name,
+		StartTime:    task.StartTime,
+		FinishTime:   task.FinishTime,
+		CPU:          task.CPU,
+		Memory:       task.Memory,
+		MachineType:  task.MachineType,
+		Preemptible:  task.Preemptible,
+	})
 }
@@ -6,23 +6,48 @@ import (
 
 // Run ...
 type Run struct {
-	ID           string
-	Name         string                  `gorm:"type:varchar(200);not null;uniqueIndex:sub_run"`
-	SubmissionID string                  `gorm:"type:varchar(32);not null;uniqueIndex:sub_run"`
-	Inputs       map[string]interface{}  `gorm:"serializer:json"`
-	Outputs      *map[string]interface{} `gorm:"serializer:json"`
-	EngineRunID  string                  `gorm:"type:varchar(128);not null"`
-	Status       string                  `gorm:"type:varchar(32);not null"`
-	Log          *string                 `gorm:"type:longtext"`
-	Message      *string                 `gorm:"type:longtext"`
-	StartTime    time.Time
-	FinishTime   *time.Time
+	ID            string
+	Name          string                  `gorm:"type:varchar(200);not null;uniqueIndex:sub_run"`
+	SubmissionID  string                  `gorm:"type:varchar(32);not null;uniqueIndex:sub_run"`
+	Inputs        map[string]interface{}  `gorm:"serializer:json"`
+	Outputs       *map[string]interface{} `gorm:"serializer:json"`
+	EngineRunID   string                  `gorm:"type:varchar(128);not null"`
+	Status        string                  `gorm:"type:varchar(32);not null"`
+	Log           *string                 `gorm:"type:longtext"`
+	Message       *string                 `gorm:"type:longtext"`
+	StartTime     time.Time
+	FinishTime    *time.Time
+	Attempts      []Attempt `gorm:"serializer:json"`
+	Queued        bool
+	EngineBackend string `gorm:"type:varchar(64)"`
 }
 
 func (r *Run) TableName() string {
 	return "run"
 }
 
+// Attempt ...
+type Attempt struct {
+	EngineRunID string     `json:"engineRunID"`
+	Status      string     `json:"status"`
+	Message     *string    `json:"message"`
+	StartTime   time.Time  `json:"startTime"`
+	FinishTime  *time.Time `json:"finishTime"`
+	// Tasks are the resources of tasks of attempt
+	Tasks []AttemptTask `json:"tasks,omitempty"`
+}
+
+// AttemptTask ...
+type AttemptTask struct {
+	Name        string     `json:"name"`
+	StartTime   time.Time  `json:"startTime"`
+	FinishTime  *time.Time `json:"finishTime"`
+	CPU         float64    `json:"cpu"`
+	Memory      int64      `json:"memory"`
+	MachineType string     `json:"machineType,omitempty"`
+	Preemptible bool       `json:"preemptible"`
+}
+
 // Task ...
 type Task struct {
 	Name       string `gorm:"type:varchar(267);primary_key"`
@@ -32,12 +57,41 @@ type Task struct {
 	Stderr     string `gorm:"type:longtext;not null"`
 	StartTime  time.Time
 	FinishTime *time.Time
+	// resources of task reported by engine
+	CPU         float64
+	Memory      int64
+	MachineType string `gorm:"type:varchar(64)"`
+	Preemptible bool
 }
 
 func (t *Task) TableName() string {
 	return "task"
 }
 
+// Lease is held by one of the replicas until it expires, e.g. the lease of run reconciler.
+type Lease struct {
+	Name     string `gorm:"type:varchar(64);primary_key"`
+	Owner    string `gorm:"type:varchar(128);not null"`
+	ExpireAt time.Time
+}
+
+func (l *Lease) TableName() string {
+	return "lease"
+}
+
+// taskUsage is the resources of task joined with its run.
+type taskUsage struct {
+	SubmissionID string
+	RunID        string
+	RunName      string
+	StartTime    time.Time
+	FinishTime   *time.Time
+	CPU          float64
+	Memory       int64
+	MachineType  string
+	Preemptible  bool
+}
+
 // StatusCount ...
 type StatusCount struct {
 	Count  int64
@@ -2,6 +2,7 @@ package sql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	applog "github.com/Bio-OS/bioos/pkg/log"
 
 	query "github.com/Bio-OS/bioos/internal/context/submission/application/query/run"
+	"github.com/Bio-OS/bioos/pkg/consts"
 	apperrors "github.com/Bio-OS/bioos/pkg/errors"
 	"github.com/Bio-OS/bioos/pkg/utils"
 )
@@ -20,7 +22,7 @@ type runReadModel struct {
 
 // NewRunReadModel ...
 func NewRunReadModel(ctx context.Context, db *gorm.DB) (query.ReadModel, error) {
-	if err := db.WithContext(ctx).AutoMigrate(&Run{}, &Task{}); err != nil {
+	if err := db.WithContext(ctx).AutoMigrate(&Run{}, &Task{}, &Lease{}); err != nil {
 		return nil, apperrors.NewInternalError(err)
 	}
 
@@ -36,6 +38,28 @@ func (r *runReadModel) ListAllRunIDs(ctx context.Context, submissionID string) (
 	return ids, nil
 }
 
+func (r *runReadModel) ListAllRunNames(ctx context.Context, submissionID string, filter *query.ListRunsFilter) ([]string, error) {
+	dbChain := r.db.WithContext(ctx).Model(&Run{}).Select("name").Where("submission_id = ?", submissionID)
+	dbChain = listRunsFilter(dbChain, filter)
+	var names []string
+	if err := dbChain.Order("name").Find(&names).Error; err != nil {
+		applog.Errorw("failed to list all run names", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return names, nil
+}
+
+func (r *runReadModel) ListQueuedRunIDs(ctx context.Context, submissionID string, limit int) ([]string, error) {
+	var ids []string
+	if err := r.db.WithContext(ctx).Model(&Run{}).Select("id").
+		Where("submission_id = ? AND queued = ? AND status = ?", submissionID, true, consts.RunPending).
+		Order("name").Limit(limit).Find(&ids).Error; err != nil {
+		applog.Errorw("failed to list queued run ids", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return ids, nil
+}
+
 func (r *runReadModel) ListRuns(ctx context.Context, submissionID string, pg *utils.Pagination, filter *query.ListRunsFilter) ([]*query.RunItem, error) {
 	dbChain := r.db.WithContext(ctx).Model(&Run{}).Where("submission_id = ?", submissionID).Limit(pg.GetLimit()).Offset(pg.GetOffset()).Order(ordersToOrderDB(pg.Orders))
 	dbChain = listRunsFilter(dbChain, filter)
@@ -92,6 +116,59 @@ func (r *runReadModel) CountTasks(ctx context.Context, runID string) (int, error
 	return int(count), nil
 }
 
+func (r *runReadModel) GetTask(ctx context.Context, runID, name string) (*query.TaskItem, error) {
+	var task Task
+	if err := r.db.WithContext(ctx).Where("run_id = ? AND name = ?", runID, name).First(&task).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NewNotFoundError("task", name)
+		}
+		applog.Errorw("failed to get task", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return TaskPOToTaskDTO(ctx, &task), nil
+}
+
+func (r *runReadModel) ListTaskUsages(ctx context.Context, submissionIDs []string, filter *query.TaskUsageFilter) ([]*query.TaskUsageItem, error) {
+	dbChain := r.db.WithContext(ctx).Model(&Task{}).
+		Select("run.submission_id, task.run_id, run.name AS run_name, task.start_time, task.finish_time, task.cpu, task.memory, task.machine_type, task.preemptible").
+		Joins("JOIN run ON run.id = task.run_id").Where("run.submission_id IN ?", submissionIDs)
+	if filter != nil {
+		if filter.StartTime != nil {
+			dbChain = dbChain.Where("task.start_time >= ?", *filter.StartTime)
+		}
+		if filter.EndTime != nil {
+			dbChain = dbChain.Where("task.start_time < ?", *filter.EndTime)
+		}
+	}
+	var usages []*taskUsage
+	if err := dbChain.Scan(&usages).Error; err != nil {
+		applog.Errorw("failed to list task usages", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	ret := make([]*query.TaskUsageItem, len(usages))
+	for index, po := range usages {
+		ret[index] = TaskUsagePOToTaskUsageDTO(po)
+	}
+
+	// tasks of previous attempts are recorded in run
+	var runPOs []*Run
+	if err := r.db.WithContext(ctx).Select("id", "name", "submission_id", "attempts").
+		Where("submission_id IN ?", submissionIDs).Find(&runPOs).Error; err != nil {
+		applog.Errorw("failed to list runs", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	for _, runPO := range runPOs {
+		for _, attempt := range runPO.Attempts {
+			for i := range attempt.Tasks {
+				if filter.Contains(attempt.Tasks[i].StartTime) {
+					ret = append(ret, AttemptTaskPOToTaskUsageDTO(&attempt.Tasks[i], runPO))
+				}
+			}
+		}
+	}
+	return ret, nil
+}
+
 func listRunsFilter(db *gorm.DB, filter *query.ListRunsFilter) *gorm.DB {
 	if filter == nil {
 		return db
@@ -105,6 +182,14 @@ func listRunsFilter(db *gorm.DB, filter *query.ListRunsFilter) *gorm.DB {
 	if len(filter.Status) != 0 {
 		db = db.Where("status IN ?", filter.Status)
 	}
+	if filter.Queued != nil {
+		if *filter.Queued {
+			db = db.Where("queued = ?", true)
+		} else {
+			// runs created before concurrency limit was introduced have null queued
+			db = db.Where("(queued = ? OR queued IS NULL)", false)
+		}
+	}
 
 	return db
 }
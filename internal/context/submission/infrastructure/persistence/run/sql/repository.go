@@ -3,6 +3,7 @@ package sql
 import (
 	"context"
 	"errors"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -10,6 +11,7 @@ import (
 	applog "github.com/Bio-OS/bioos/pkg/log"
 
 	"github.com/Bio-OS/bioos/internal/context/submission/domain/run"
+	"github.com/Bio-OS/bioos/pkg/consts"
 	apperrors "github.com/Bio-OS/bioos/pkg/errors"
 )
 
@@ -71,14 +73,120 @@ func (r *runRepository) Get(ctx context.Context, id string) (*run.Run, error) {
 func (r *runRepository) Delete(ctx context.Context, w *run.Run) error {
 	runPO := RunDOToRunPO(w)
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if err := r.db.WithContext(ctx).Delete(&runPO).Error; err != nil {
+		if err := tx.Delete(&runPO).Error; err != nil {
 			applog.Errorw("failed to delete run", "err", err)
 			return apperrors.NewInternalError(err)
 		}
-		if err := r.db.WithContext(ctx).Where("run_id = ?", runPO.ID).Delete(&Task{}).Error; err != nil {
+		if err := tx.Where("run_id = ?", runPO.ID).Delete(&Task{}).Error; err != nil {
 			applog.Errorw("failed to delete task", "err", err)
 			return apperrors.NewInternalError(err)
 		}
 		return nil
 	})
 }
+
+func (r *runRepository) DeleteTasks(ctx context.Context, w *run.Run) error {
+	if err := r.db.WithContext(ctx).Where("run_id = ?", w.ID).Delete(&Task{}).Error; err != nil {
+		applog.Errorw("failed to delete task", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *runRepository) SaveReset(ctx context.Context, w *run.Run) (bool, error) {
+	runPO := RunDOToRunPO(w)
+	saved := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// only one of the concurrent callers can reset the finished run
+		res := tx.Model(&Run{}).Where("id = ? AND status IN ?", runPO.ID, consts.FinishedRunStatuses).Select("*").Updates(&runPO)
+		if res.Error != nil {
+			applog.Errorw("failed to save reset run", "err", res.Error)
+			return apperrors.NewInternalError(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		if err := tx.Where("run_id = ?", runPO.ID).Delete(&Task{}).Error; err != nil {
+			applog.Errorw("failed to delete task", "err", err)
+			return apperrors.NewInternalError(err)
+		}
+		saved = true
+		return nil
+	})
+	return saved, err
+}
+
+func (r *runRepository) ListTasks(ctx context.Context, w *run.Run) ([]*run.Task, error) {
+	var taskPOs []*Task
+	if err := r.db.WithContext(ctx).Where("run_id = ?", w.ID).Find(&taskPOs).Error; err != nil {
+		applog.Errorw("failed to list tasks", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	tasks := make([]*run.Task, len(taskPOs))
+	for i, taskPO := range taskPOs {
+		tasks[i] = TaskPOToTaskDO(taskPO)
+	}
+	return tasks, nil
+}
+
+func (r *runRepository) Dequeue(ctx context.Context, id string) (bool, error) {
+	// only one of the concurrent callers can take the run out of the queue
+	res := r.db.WithContext(ctx).Model(&Run{}).Where("id = ? AND queued = ?", id, true).Update("queued", false)
+	if res.Error != nil {
+		applog.Errorw("failed to dequeue run", "err", res.Error)
+		return false, apperrors.NewInternalError(res.Error)
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *runRepository) Enqueue(ctx context.Context, id string) error {
+	if err := r.db.WithContext(ctx).Model(&Run{}).Where("id = ? AND engine_run_id = ?", id, "").Update("queued", true).Error; err != nil {
+		applog.Errorw("failed to enqueue run", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *runRepository) ListActive(ctx context.Context) ([]*run.Run, error) {
+	var runPOs []*Run
+	if err := r.db.WithContext(ctx).Where("status IN ?", consts.NonFinishedRunStatuses).
+		Where("queued = ? OR queued IS NULL", false).Find(&runPOs).Error; err != nil {
+		applog.Errorw("failed to list active runs", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	runs := make([]*run.Run, len(runPOs))
+	for i, runPO := range runPOs {
+		runs[i] = RunPOToRunDO(runPO)
+	}
+	return runs, nil
+}
+
+func (r *runRepository) AcquireLease(ctx context.Context, name, owner string, expireAt time.Time) (bool, error) {
+	db := r.db.WithContext(ctx)
+	// take over the lease held by self or expired, or create it if it does not exist
+	if err := db.Model(&Lease{}).Where("name = ? AND (owner = ? OR expire_at < ?)", name, owner, time.Now()).
+		Updates(map[string]interface{}{"owner": owner, "expire_at": expireAt}).Error; err != nil {
+		applog.Errorw("failed to update lease", "err", err)
+		return false, apperrors.NewInternalError(err)
+	}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&Lease{Name: name, Owner: owner, ExpireAt: expireAt}).Error; err != nil {
+		applog.Errorw("failed to create lease", "err", err)
+		return false, apperrors.NewInternalError(err)
+	}
+	// rows affected is not reliable when the lease is renewed with the same values, check the holder instead
+	var lease Lease
+	if err := db.Where("name = ?", name).First(&lease).Error; err != nil {
+		applog.Errorw("failed to get lease", "err", err)
+		return false, apperrors.NewInternalError(err)
+	}
+	return lease.Owner == owner, nil
+}
+
+func (r *runRepository) ReleaseLease(ctx context.Context, name, owner string) error {
+	if err := r.db.WithContext(ctx).Where("name = ? AND owner = ?", name, owner).Delete(&Lease{}).Error; err != nil {
+		applog.Errorw("failed to release lease", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package mongo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	query "github.com/Bio-OS/bioos/internal/context/submission/application/query/submission"
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/submission"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+func submissionPOToSubmissionDTO(ctx context.Context, sb *submissionPO) (*query.SubmissionItem, error) {
+	item := &query.SubmissionItem{
+		ID:                 sb.ID,
+		Name:               sb.Name,
+		Description:        sb.Description,
+		Type:               sb.Type,
+		Status:             sb.Status,
+		StartTime:          sb.StartTime.Unix(),
+		WorkflowID:         sb.WorkflowID,
+		WorkflowVersionID:  sb.WorkflowVersionID,
+		WorkspaceID:        sb.WorkspaceID,
+		ParentSubmissionID: sb.ParentSubmissionID,
+		MaxConcurrentRuns:  sb.MaxConcurrentRuns,
+		EngineBackend:      sb.EngineBackend,
+		ExposedOptions: query.ExposedOptions{
+			ReadFromCache: sb.ExposedOptions.ReadFromCache,
+		},
+	}
+	if sb.FinishTime != nil {
+		item.FinishTime = utils.PointInt64(sb.FinishTime.Unix())
+		item.Duration = sb.FinishTime.Unix() - sb.StartTime.Unix()
+	} else {
+		item.Duration = time.Now().Unix() - sb.StartTime.Unix()
+	}
+
+	switch sb.Type {
+	case consts.DataModelTypeSubmission:
+		if len(sb.DataModelRowIDs) == 0 || sb.DataModelID == nil {
+			return nil, fmt.Errorf("data model id & row ids should not empty")
+		}
+		item.Entity = &query.Entity{
+			DataModelID:     *sb.DataModelID,
+			DataModelRowIDs: sb.DataModelRowIDs,
+			InputsTemplate:  sb.Inputs,
+			OutputsTemplate: sb.Outputs,
+		}
+		if sb.DataModelSnapshotID != nil {
+			item.Entity.DataModelSnapshotID = *sb.DataModelSnapshotID
+		}
+	case consts.FilePathTypeSubmission:
+		outputs, err := unmarshalValues(sb.Outputs)
+		if err != nil {
+			return nil, err
+		}
+		outputsInString, err := utils.MarshalParamValue(outputs)
+		if err != nil {
+			return nil, err
+		}
+		item.InOutMaterial = &query.InOutMaterial{
+			InputsMaterial:  sb.Inputs,
+			OutputsMaterial: outputsInString,
+		}
+	}
+	return item, nil
+}
+
+func submissionPOToSubmissionDO(ctx context.Context, sb *submissionPO) (*submission.Submission, error) {
+	inputs, err := unmarshalValues(sb.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := unmarshalValues(sb.Outputs)
+	if err != nil {
+		return nil, err
+	}
+	return &submission.Submission{
+		ID:                  sb.ID,
+		Name:                sb.Name,
+		Description:         sb.Description,
+		WorkflowID:          sb.WorkflowID,
+		WorkflowVersionID:   sb.WorkflowVersionID,
+		DataModelID:         sb.DataModelID,
+		DataModelRowIDs:     sb.DataModelRowIDs,
+		DataModelSnapshotID: sb.DataModelSnapshotID,
+		WorkspaceID:         sb.WorkspaceID,
+		Type:                sb.Type,
+		ParentSubmissionID:  sb.ParentSubmissionID,
+		MaxConcurrentRuns:   sb.MaxConcurrentRuns,
+		EngineBackend:       sb.EngineBackend,
+		Inputs:              inputs,
+		Outputs:             outputs,
+		ExposedOptions: submission.ExposedOptions{
+			ReadFromCache: sb.ExposedOptions.ReadFromCache,
+		},
+		Status:     sb.Status,
+		StartTime:  sb.StartTime,
+		FinishTime: sb.FinishTime,
+	}, nil
+}
+
+func submissionDOToSubmissionPO(ctx context.Context, sb *submission.Submission) (*submissionPO, error) {
+	inputs, err := json.Marshal(sb.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := json.Marshal(sb.Outputs)
+	if err != nil {
+		return nil, err
+	}
+	return &submissionPO{
+		ID:                  sb.ID,
+		WorkspaceID:         sb.WorkspaceID,
+		Name:                sb.Name,
+		Description:         sb.Description,
+		WorkflowID:          sb.WorkflowID,
+		WorkflowVersionID:   sb.WorkflowVersionID,
+		DataModelID:         sb.DataModelID,
+		DataModelRowIDs:     sb.DataModelRowIDs,
+		DataModelSnapshotID: sb.DataModelSnapshotID,
+		Type:                sb.Type,
+		ParentSubmissionID:  sb.ParentSubmissionID,
+		Inputs:              string(inputs),
+		Outputs:             string(outputs),
+		ExposedOptions: exposedOptions{
+			ReadFromCache: sb.ExposedOptions.ReadFromCache,
+		},
+		MaxConcurrentRuns: sb.MaxConcurrentRuns,
+		EngineBackend:     sb.EngineBackend,
+		Status:            sb.Status,
+		StartTime:         sb.StartTime,
+		FinishTime:        sb.FinishTime,
+	}, nil
+}
+
+func unmarshalValues(values string) (map[string]interface{}, error) {
+	var res map[string]interface{}
+	if len(values) == 0 {
+		return res, nil
+	}
+	if err := json.Unmarshal([]byte(values), &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,36 @@
+package mongo
+
+import (
+	"time"
+)
+
+const SubmissionCollection = "submission"
+
+type submissionPO struct {
+	ID                  string   `bson:"id"`
+	WorkspaceID         string   `bson:"workspaceID"`
+	Name                string   `bson:"name"`
+	Description         *string  `bson:"description"`
+	WorkflowID          string   `bson:"workflowID"`
+	WorkflowVersionID   string   `bson:"workflowVersionID"`
+	DataModelID         *string  `bson:"dataModelID"`
+	DataModelRowIDs     []string `bson:"dataModelRowIDs"`
+	DataModelSnapshotID *string  `bson:"dataModelSnapshotID,omitempty"`
+	Type                string   `bson:"type"`
+	ParentSubmissionID  *string  `bson:"parentSubmissionID"`
+	// Inputs and Outputs are stored in json, nested documents decoded by mongo driver are not plain maps
+	Inputs            string         `bson:"inputs"`
+	Outputs           string         `bson:"outputs"`
+	ExposedOptions    exposedOptions `bson:"exposedOptions"`
+	MaxConcurrentRuns int            `bson:"maxConcurrentRuns"`
+	EngineBackend     string         `bson:"engineBackend,omitempty"`
+	Status            string         `bson:"status"`
+	StartTime         time.Time      `bson:"startTime"`
+	FinishTime        *time.Time     `bson:"finishTime"`
+	// DeletedAt is set by soft deletion, never set by saving
+	DeletedAt *time.Time `bson:"deletedAt,omitempty"`
+}
+
+type exposedOptions struct {
+	ReadFromCache bool `bson:"readFromCache"`
+}
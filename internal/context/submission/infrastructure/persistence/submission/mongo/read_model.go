@@ -0,0 +1,122 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	query "github.com/Bio-OS/bioos/internal/context/submission/application/query/submission"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+type submissionReadModel struct {
+	collection *mongo.Collection
+}
+
+var _ query.ReadModel = &submissionReadModel{}
+
+// NewSubmissionReadModel ...
+func NewSubmissionReadModel(ctx context.Context, mongoDB *mongo.Database) (query.ReadModel, error) {
+	return &submissionReadModel{collection: mongoDB.Collection(SubmissionCollection)}, nil
+}
+
+func (s *submissionReadModel) ListSubmissions(ctx context.Context, workspaceID string, pg *utils.Pagination, filter *query.ListSubmissionsFilter) ([]*query.SubmissionItem, error) {
+	opts := options.Find().SetLimit(int64(pg.GetLimit())).SetSkip(int64(pg.GetOffset())).SetSort(ordersToSort(pg.Orders))
+	cursor, err := s.collection.Find(ctx, listSubmissionsFilter(workspaceID, filter), opts)
+	if err != nil {
+		applog.Errorw("failed to list submissions", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var sbs []*submissionPO
+	if err := cursor.All(ctx, &sbs); err != nil {
+		applog.Errorw("failed to decode submissions", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	ret := make([]*query.SubmissionItem, len(sbs))
+	for index, po := range sbs {
+		item, err := submissionPOToSubmissionDTO(ctx, po)
+		if err != nil {
+			applog.Errorw("failed to convert submission po to DTO", "err", err)
+			return nil, apperrors.NewInternalError(err)
+		}
+		ret[index] = item
+	}
+	return ret, nil
+}
+
+func (s *submissionReadModel) CountSubmissions(ctx context.Context, workspaceID string, filter *query.ListSubmissionsFilter) (int, error) {
+	count, err := s.collection.CountDocuments(ctx, listSubmissionsFilter(workspaceID, filter))
+	if err != nil {
+		applog.Errorw("failed to count submissions", "err", err)
+		return 0, apperrors.NewInternalError(err)
+	}
+	return int(count), nil
+}
+
+func (s *submissionReadModel) ListSubmissionNames(ctx context.Context, workspaceID string, filter *query.ListSubmissionsFilter) (map[string]string, error) {
+	opts := options.Find().SetProjection(bson.M{"id": 1, "name": 1})
+	cursor, err := s.collection.Find(ctx, listSubmissionsFilter(workspaceID, filter), opts)
+	if err != nil {
+		applog.Errorw("failed to list submission names", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var sbs []*submissionPO
+	if err := cursor.All(ctx, &sbs); err != nil {
+		applog.Errorw("failed to decode submission names", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	names := make(map[string]string, len(sbs))
+	for _, po := range sbs {
+		names[po.ID] = po.Name
+	}
+	return names, nil
+}
+
+func listSubmissionsFilter(workspaceID string, filter *query.ListSubmissionsFilter) bson.M {
+	// soft deleted submissions are invisible
+	res := bson.M{"workspaceID": workspaceID, "deletedAt": nil}
+	if filter == nil {
+		return res
+	}
+	if len(filter.IDs) > 0 {
+		res["id"] = bson.M{"$in": filter.IDs}
+	}
+	if len(filter.Name) > 0 {
+		res["name"] = filter.Name
+	}
+	if len(filter.Status) > 0 {
+		res["status"] = bson.M{"$in": filter.Status}
+	}
+	if searchFilter := utils.MongoSearchWordFilter(filter.SearchWord, []string{"name"}, filter.Exact); searchFilter != nil {
+		res["$and"] = bson.A{searchFilter}
+	}
+	if len(filter.WorkflowID) != 0 {
+		res["workflowID"] = filter.WorkflowID
+	}
+	return res
+}
+
+func ordersToSort(orders []utils.Order) bson.D {
+	sort := make(bson.D, 0, len(orders))
+	for _, order := range orders {
+		var field string
+		switch order.Field {
+		case query.OrderByName:
+			field = "name"
+		case query.OrderByStartTime:
+			field = "startTime"
+		default:
+			continue
+		}
+		direction := -1
+		if order.Ascending {
+			direction = 1
+		}
+		sort = append(sort, bson.E{Key: field, Value: direction})
+	}
+	return sort
+}
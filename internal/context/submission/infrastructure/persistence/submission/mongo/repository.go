@@ -0,0 +1,78 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Bio-OS/bioos/internal/context/submission/domain/submission"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+type submissionRepository struct {
+	collection *mongo.Collection
+}
+
+var _ submission.Repository = &submissionRepository{}
+
+// NewSubmissionRepository ...
+func NewSubmissionRepository(ctx context.Context, mongoDB *mongo.Database) (submission.Repository, error) {
+	collection := mongoDB.Collection(SubmissionCollection)
+	if err := utils.EnsureIndex(ctx, collection, "idx_id", true, primitive.D{{Key: "id", Value: 1}}); err != nil {
+		return nil, err
+	}
+	if err := utils.EnsureIndex(ctx, collection, "idx_ws_name", true, primitive.D{
+		{Key: "workspaceID", Value: 1},
+		{Key: "name", Value: 1},
+	}); err != nil {
+		return nil, err
+	}
+	return &submissionRepository{collection: collection}, nil
+}
+
+func (s *submissionRepository) Save(ctx context.Context, sub *submission.Submission) error {
+	po, err := submissionDOToSubmissionPO(ctx, sub)
+	if err != nil {
+		applog.Errorw("failed to convert submission do to po", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if _, err = s.collection.UpdateOne(ctx, bson.M{"id": po.ID}, bson.M{"$set": po}, options.Update().SetUpsert(true)); err != nil {
+		applog.Errorw("failed to create submission", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (s *submissionRepository) Get(ctx context.Context, id string) (*submission.Submission, error) {
+	var po submissionPO
+	if err := s.collection.FindOne(ctx, bson.M{"id": id}).Decode(&po); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("submission", id)
+		}
+		return nil, apperrors.NewInternalError(err)
+	}
+	return submissionPOToSubmissionDO(ctx, &po)
+}
+
+func (s *submissionRepository) Delete(ctx context.Context, sub *submission.Submission) error {
+	if _, err := s.collection.DeleteOne(ctx, bson.M{"id": sub.ID}); err != nil {
+		applog.Errorw("failed to delete submission", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (s *submissionRepository) SoftDelete(ctx context.Context, sub *submission.Submission) error {
+	if _, err := s.collection.UpdateOne(ctx, bson.M{"id": sub.ID}, bson.M{"$set": bson.M{"deletedAt": time.Now()}}); err != nil {
+		applog.Errorw("failed to soft delete submission", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
@@ -14,15 +14,18 @@ import (
 
 func SubmissionPOToSubmissionDTO(ctx context.Context, submission *Submission) (*query.SubmissionItem, error) {
 	item := &query.SubmissionItem{
-		ID:                submission.ID,
-		Name:              submission.Name,
-		Description:       submission.Description,
-		Type:              submission.Type,
-		Status:            submission.Status,
-		StartTime:         submission.StartTime.Unix(),
-		WorkflowID:        submission.WorkflowID,
-		WorkflowVersionID: submission.WorkflowVersionID,
-		WorkspaceID:       submission.WorkspaceID,
+		ID:                 submission.ID,
+		Name:               submission.Name,
+		Description:        submission.Description,
+		Type:               submission.Type,
+		Status:             submission.Status,
+		StartTime:          submission.StartTime.Unix(),
+		WorkflowID:         submission.WorkflowID,
+		WorkflowVersionID:  submission.WorkflowVersionID,
+		WorkspaceID:        submission.WorkspaceID,
+		ParentSubmissionID: submission.ParentSubmissionID,
+		MaxConcurrentRuns:  submission.MaxConcurrentRuns,
+		EngineBackend:      submission.EngineBackend,
 		ExposedOptions: query.ExposedOptions{
 			ReadFromCache: submission.ExposedOptions.ReadFromCache,
 		},
@@ -57,6 +60,9 @@ func SubmissionPOToSubmissionDTO(ctx context.Context, submission *Submission) (*
 			InputsTemplate:  string(inputs),
 			OutputsTemplate: string(outputs),
 		}
+		if submission.DataModelSnapshotID != nil {
+			item.Entity.DataModelSnapshotID = *submission.DataModelSnapshotID
+		}
 	case consts.FilePathTypeSubmission:
 		inputs, err := json.Marshal(submission.Inputs)
 		if err != nil {
@@ -82,17 +88,21 @@ func SubmissionPOToSubmissionDO(ctx context.Context, sb *Submission) (*submissio
 		}
 	}
 	return &submission.Submission{
-		ID:                sb.ID,
-		Name:              sb.Name,
-		Description:       sb.Description,
-		WorkflowID:        sb.WorkflowID,
-		WorkflowVersionID: sb.WorkflowVersionID,
-		DataModelID:       sb.DataModelID,
-		DataModelRowIDs:   rowIDs,
-		WorkspaceID:       sb.WorkspaceID,
-		Type:              sb.Type,
-		Inputs:            sb.Inputs,
-		Outputs:           sb.Outputs,
+		ID:                  sb.ID,
+		Name:                sb.Name,
+		Description:         sb.Description,
+		WorkflowID:          sb.WorkflowID,
+		WorkflowVersionID:   sb.WorkflowVersionID,
+		DataModelID:         sb.DataModelID,
+		DataModelRowIDs:     rowIDs,
+		DataModelSnapshotID: sb.DataModelSnapshotID,
+		WorkspaceID:         sb.WorkspaceID,
+		Type:                sb.Type,
+		ParentSubmissionID:  sb.ParentSubmissionID,
+		MaxConcurrentRuns:   sb.MaxConcurrentRuns,
+		EngineBackend:       sb.EngineBackend,
+		Inputs:              sb.Inputs,
+		Outputs:             sb.Outputs,
 		ExposedOptions: submission.ExposedOptions{
 			ReadFromCache: sb.ExposedOptions.ReadFromCache,
 		},
@@ -112,17 +122,21 @@ func SubmissionDOToSubmissionPO(ctx context.Context, sb *submission.Submission)
 		rowIDs = nil
 	}
 	return &Submission{
-		ID:                sb.ID,
-		Name:              sb.Name,
-		Description:       sb.Description,
-		WorkflowID:        sb.WorkflowID,
-		WorkflowVersionID: sb.WorkflowVersionID,
-		DataModelID:       sb.DataModelID,
-		DataModelRowIDs:   rowIDs,
-		Type:              sb.Type,
-		Inputs:            sb.Inputs,
-		Outputs:           sb.Outputs,
-		WorkspaceID:       sb.WorkspaceID,
+		ID:                  sb.ID,
+		Name:                sb.Name,
+		Description:         sb.Description,
+		WorkflowID:          sb.WorkflowID,
+		WorkflowVersionID:   sb.WorkflowVersionID,
+		DataModelID:         sb.DataModelID,
+		DataModelRowIDs:     rowIDs,
+		DataModelSnapshotID: sb.DataModelSnapshotID,
+		Type:                sb.Type,
+		ParentSubmissionID:  sb.ParentSubmissionID,
+		MaxConcurrentRuns:   sb.MaxConcurrentRuns,
+		EngineBackend:       sb.EngineBackend,
+		Inputs:              sb.Inputs,
+		Outputs:             sb.Outputs,
+		WorkspaceID:         sb.WorkspaceID,
 		ExposedOptions: ExposedOptions{
 			ReadFromCache: sb.ExposedOptions.ReadFromCache,
 		},
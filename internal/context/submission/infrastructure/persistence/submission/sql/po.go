@@ -13,22 +13,26 @@ type SubmissionModel struct {
 }
 
 type Submission struct {
-	ID                string
-	WorkspaceID       string                 `gorm:"type:varchar(32);not null;uniqueIndex:sub_ws"`
-	Name              string                 `gorm:"type:varchar(410) CHARACTER SET gbk COLLATE gbk_bin;not null;uniqueIndex:sub_ws"`
-	Description       *string                `gorm:"type:text"`
-	WorkflowID        string                 `gorm:"type:varchar(32);not null"`
-	WorkflowVersionID string                 `gorm:"type:varchar(32);not null"`
-	DataModelID       *string                `gorm:"type:varchar(32)"`
-	DataModelRowIDs   *string                `gorm:"type:text"`
-	Type              string                 `gorm:"type:varchar(32);not null"`
-	Inputs            map[string]interface{} `gorm:"serializer:json"`
-	Outputs           map[string]interface{} `gorm:"serializer:json"`
-	ExposedOptions    ExposedOptions         `gorm:"serializer:json"`
-	Status            string                 `gorm:"type:varchar(32);not null"`
-	StartTime         time.Time              `gorm:"not null"`
-	FinishTime        *time.Time
-	UserID            *int64
+	ID                  string
+	WorkspaceID         string                 `gorm:"type:varchar(32);not null;uniqueIndex:sub_ws"`
+	Name                string                 `gorm:"type:varchar(410) CHARACTER SET gbk COLLATE gbk_bin;not null;uniqueIndex:sub_ws"`
+	Description         *string                `gorm:"type:text"`
+	WorkflowID          string                 `gorm:"type:varchar(32);not null"`
+	WorkflowVersionID   string                 `gorm:"type:varchar(32);not null"`
+	DataModelID         *string                `gorm:"type:varchar(32)"`
+	DataModelRowIDs     *string                `gorm:"type:text"`
+	DataModelSnapshotID *string                `gorm:"type:varchar(32)"`
+	Type                string                 `gorm:"type:varchar(32);not null"`
+	ParentSubmissionID  *string                `gorm:"type:varchar(32)"`
+	Inputs              map[string]interface{} `gorm:"serializer:json"`
+	Outputs             map[string]interface{} `gorm:"serializer:json"`
+	ExposedOptions      ExposedOptions         `gorm:"serializer:json"`
+	MaxConcurrentRuns   int                    `gorm:"not null;default:0"`
+	EngineBackend       string                 `gorm:"type:varchar(64)"`
+	Status              string                 `gorm:"type:varchar(32);not null"`
+	StartTime           time.Time              `gorm:"not null"`
+	FinishTime          *time.Time
+	UserID              *int64
 }
 
 type ExposedOptions struct {
@@ -58,6 +58,21 @@ func (s *submissionReadModel) CountSubmissions(ctx context.Context, workspaceID
 	return int(count), nil
 }
 
+func (s *submissionReadModel) ListSubmissionNames(ctx context.Context, workspaceID string, filter *query.ListSubmissionsFilter) (map[string]string, error) {
+	dbChain := s.db.WithContext(ctx).Model(&SubmissionModel{}).Select("id", "name").Where("workspace_id = ?", workspaceID)
+	dbChain = listSubmissionsFilter(dbChain, filter)
+	var sbs []*SubmissionModel
+	if err := dbChain.Find(&sbs).Error; err != nil {
+		applog.Errorw("failed to list submission names", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	names := make(map[string]string, len(sbs))
+	for _, po := range sbs {
+		names[po.ID] = po.Name
+	}
+	return names, nil
+}
+
 func listSubmissionsFilter(db *gorm.DB, filter *query.ListSubmissionsFilter) *gorm.DB {
 	if filter == nil {
 		return db
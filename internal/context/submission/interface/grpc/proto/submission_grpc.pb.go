@@ -24,9 +24,13 @@ const (
 	SubmissionService_CreateSubmission_FullMethodName = "/proto.SubmissionService/CreateSubmission"
 	SubmissionService_DeleteSubmission_FullMethodName = "/proto.SubmissionService/DeleteSubmission"
 	SubmissionService_CancelSubmission_FullMethodName = "/proto.SubmissionService/CancelSubmission"
+	SubmissionService_RetrySubmission_FullMethodName  = "/proto.SubmissionService/RetrySubmission"
 	SubmissionService_ListRuns_FullMethodName         = "/proto.SubmissionService/ListRuns"
 	SubmissionService_CancelRun_FullMethodName        = "/proto.SubmissionService/CancelRun"
+	SubmissionService_RerunRun_FullMethodName         = "/proto.SubmissionService/RerunRun"
 	SubmissionService_ListTasks_FullMethodName        = "/proto.SubmissionService/ListTasks"
+	SubmissionService_StreamTaskLog_FullMethodName    = "/proto.SubmissionService/StreamTaskLog"
+	SubmissionService_GetUsage_FullMethodName         = "/proto.SubmissionService/GetUsage"
 )
 
 // SubmissionServiceClient is the client API for SubmissionService service.
@@ -38,9 +42,13 @@ type SubmissionServiceClient interface {
 	CreateSubmission(ctx context.Context, in *CreateSubmissionRequest, opts ...grpc.CallOption) (*CreateSubmissionResponse, error)
 	DeleteSubmission(ctx context.Context, in *DeleteSubmissionRequest, opts ...grpc.CallOption) (*DeleteSubmissionResponse, error)
 	CancelSubmission(ctx context.Context, in *CancelSubmissionRequest, opts ...grpc.CallOption) (*CancelSubmissionResponse, error)
+	RetrySubmission(ctx context.Context, in *RetrySubmissionRequest, opts ...grpc.CallOption) (*RetrySubmissionResponse, error)
 	ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error)
 	CancelRun(ctx context.Context, in *CancelRunRequest, opts ...grpc.CallOption) (*CancelRunResponse, error)
+	RerunRun(ctx context.Context, in *RerunRunRequest, opts ...grpc.CallOption) (*RerunRunResponse, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	StreamTaskLog(ctx context.Context, in *StreamTaskLogRequest, opts ...grpc.CallOption) (SubmissionService_StreamTaskLogClient, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
 }
 
 type submissionServiceClient struct {
@@ -96,6 +104,15 @@ func (c *submissionServiceClient) CancelSubmission(ctx context.Context, in *Canc
 	return out, nil
 }
 
+func (c *submissionServiceClient) RetrySubmission(ctx context.Context, in *RetrySubmissionRequest, opts ...grpc.CallOption) (*RetrySubmissionResponse, error) {
+	out := new(RetrySubmissionResponse)
+	err := c.cc.Invoke(ctx, SubmissionService_RetrySubmission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *submissionServiceClient) ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error) {
 	out := new(ListRunsResponse)
 	err := c.cc.Invoke(ctx, SubmissionService_ListRuns_FullMethodName, in, out, opts...)
@@ -114,6 +131,15 @@ func (c *submissionServiceClient) CancelRun(ctx context.Context, in *CancelRunRe
 	return out, nil
 }
 
+func (c *submissionServiceClient) RerunRun(ctx context.Context, in *RerunRunRequest, opts ...grpc.CallOption) (*RerunRunResponse, error) {
+	out := new(RerunRunResponse)
+	err := c.cc.Invoke(ctx, SubmissionService_RerunRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *submissionServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
 	out := new(ListTasksResponse)
 	err := c.cc.Invoke(ctx, SubmissionService_ListTasks_FullMethodName, in, out, opts...)
@@ -123,6 +149,47 @@ func (c *submissionServiceClient) ListTasks(ctx context.Context, in *ListTasksRe
 	return out, nil
 }
 
+func (c *submissionServiceClient) StreamTaskLog(ctx context.Context, in *StreamTaskLogRequest, opts ...grpc.CallOption) (SubmissionService_StreamTaskLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SubmissionService_ServiceDesc.Streams[0], SubmissionService_StreamTaskLog_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &submissionServiceStreamTaskLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SubmissionService_StreamTaskLogClient interface {
+	Recv() (*StreamTaskLogResponse, error)
+	grpc.ClientStream
+}
+
+type submissionServiceStreamTaskLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *submissionServiceStreamTaskLogClient) Recv() (*StreamTaskLogResponse, error) {
+	m := new(StreamTaskLogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *submissionServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, SubmissionService_GetUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubmissionServiceServer is the server API for SubmissionService service.
 // All implementations must embed UnimplementedSubmissionServiceServer
 // for forward compatibility
@@ -132,9 +199,13 @@ type SubmissionServiceServer interface {
 	CreateSubmission(context.Context, *CreateSubmissionRequest) (*CreateSubmissionResponse, error)
 	DeleteSubmission(context.Context, *DeleteSubmissionRequest) (*DeleteSubmissionResponse, error)
 	CancelSubmission(context.Context, *CancelSubmissionRequest) (*CancelSubmissionResponse, error)
+	RetrySubmission(context.Context, *RetrySubmissionRequest) (*RetrySubmissionResponse, error)
 	ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error)
 	CancelRun(context.Context, *CancelRunRequest) (*CancelRunResponse, error)
+	RerunRun(context.Context, *RerunRunRequest) (*RerunRunResponse, error)
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	StreamTaskLog(*StreamTaskLogRequest, SubmissionService_StreamTaskLogServer) error
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
 	mustEmbedUnimplementedSubmissionServiceServer()
 }
 
@@ -157,15 +228,27 @@ func (UnimplementedSubmissionServiceServer) DeleteSubmission(context.Context, *D
 func (UnimplementedSubmissionServiceServer) CancelSubmission(context.Context, *CancelSubmissionRequest) (*CancelSubmissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSubmission not implemented")
 }
+func (UnimplementedSubmissionServiceServer) RetrySubmission(context.Context, *RetrySubmissionRequest) (*RetrySubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrySubmission not implemented")
+}
 func (UnimplementedSubmissionServiceServer) ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRuns not implemented")
 }
 func (UnimplementedSubmissionServiceServer) CancelRun(context.Context, *CancelRunRequest) (*CancelRunResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelRun not implemented")
 }
+func (UnimplementedSubmissionServiceServer) RerunRun(context.Context, *RerunRunRequest) (*RerunRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunRun not implemented")
+}
 func (UnimplementedSubmissionServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
+func (UnimplementedSubmissionServiceServer) StreamTaskLog(*StreamTaskLogRequest, SubmissionService_StreamTaskLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTaskLog not implemented")
+}
+func (UnimplementedSubmissionServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
 func (UnimplementedSubmissionServiceServer) mustEmbedUnimplementedSubmissionServiceServer() {}
 
 // UnsafeSubmissionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -269,6 +352,24 @@ func _SubmissionService_CancelSubmission_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubmissionService_RetrySubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrySubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).RetrySubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubmissionService_RetrySubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).RetrySubmission(ctx, req.(*RetrySubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SubmissionService_ListRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRunsRequest)
 	if err := dec(in); err != nil {
@@ -305,6 +406,24 @@ func _SubmissionService_CancelRun_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubmissionService_RerunRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerunRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).RerunRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubmissionService_RerunRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).RerunRun(ctx, req.(*RerunRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SubmissionService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTasksRequest)
 	if err := dec(in); err != nil {
@@ -323,6 +442,45 @@ func _SubmissionService_ListTasks_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubmissionService_StreamTaskLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTaskLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SubmissionServiceServer).StreamTaskLog(m, &submissionServiceStreamTaskLogServer{stream})
+}
+
+type SubmissionService_StreamTaskLogServer interface {
+	Send(*StreamTaskLogResponse) error
+	grpc.ServerStream
+}
+
+type submissionServiceStreamTaskLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *submissionServiceStreamTaskLogServer) Send(m *StreamTaskLogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SubmissionService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubmissionService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SubmissionService_ServiceDesc is the grpc.ServiceDesc for SubmissionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -350,6 +508,10 @@ var SubmissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSubmission",
 			Handler:    _SubmissionService_CancelSubmission_Handler,
 		},
+		{
+			MethodName: "RetrySubmission",
+			Handler:    _SubmissionService_RetrySubmission_Handler,
+		},
 		{
 			MethodName: "ListRuns",
 			Handler:    _SubmissionService_ListRuns_Handler,
@@ -358,11 +520,25 @@ var SubmissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelRun",
 			Handler:    _SubmissionService_CancelRun_Handler,
 		},
+		{
+			MethodName: "RerunRun",
+			Handler:    _SubmissionService_RerunRun_Handler,
+		},
 		{
 			MethodName: "ListTasks",
 			Handler:    _SubmissionService_ListTasks_Handler,
 		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _SubmissionService_GetUsage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTaskLog",
+			Handler:       _SubmissionService_StreamTaskLog_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/context/submission/interface/grpc/proto/submission.proto",
 }
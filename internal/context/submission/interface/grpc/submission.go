@@ -107,6 +107,22 @@ func (s *submissionServer) CancelSubmission(ctx context.Context, r *pb.CancelSub
 	}
 	return &pb.CancelSubmissionResponse{}, nil
 }
+func (s *submissionServer) RetrySubmission(ctx context.Context, r *pb.RetrySubmissionRequest) (*pb.RetrySubmissionResponse, error) {
+	applog.Infow("RetrySubmission", "auth", auth.UserFromCtx(ctx))
+
+	id, err := s.submissionService.SubmissionCommands.RetrySubmission.Handle(ctx, &command.RetrySubmissionCommand{
+		WorkspaceID: r.GetWorkspaceID(),
+		ID:          r.GetId(),
+		Name:        r.GetName(),
+		Status:      r.GetStatus(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "retry submission error:%v", err)
+	}
+	return &pb.RetrySubmissionResponse{
+		Id: id,
+	}, nil
+}
 func (s *submissionServer) ListRuns(ctx context.Context, r *pb.ListRunsRequest) (*pb.ListRunsResponse, error) {
 	applog.Infow("ListRuns", "auth", auth.UserFromCtx(ctx))
 
@@ -145,6 +161,19 @@ func (s *submissionServer) CancelRun(ctx context.Context, r *pb.CancelRunRequest
 	}
 	return &pb.CancelRunResponse{}, nil
 }
+func (s *submissionServer) RerunRun(ctx context.Context, r *pb.RerunRunRequest) (*pb.RerunRunResponse, error) {
+	applog.Infow("RerunRun", "auth", auth.UserFromCtx(ctx))
+
+	err := s.submissionService.RunCommands.RerunRun.Handle(ctx, &runcommand.RerunRunCommand{
+		WorkspaceID:  r.GetWorkspaceID(),
+		ID:           r.GetId(),
+		SubmissionID: r.GetSubmissionID(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "rerun run error:%v", err)
+	}
+	return &pb.RerunRunResponse{}, nil
+}
 func (s *submissionServer) ListTasks(ctx context.Context, r *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
 	applog.Infow("ListTasks", "auth", auth.UserFromCtx(ctx))
 
@@ -170,3 +199,36 @@ func (s *submissionServer) ListTasks(ctx context.Context, r *pb.ListTasksRequest
 		Items: submissions,
 	}, nil
 }
+
+func (s *submissionServer) GetUsage(ctx context.Context, r *pb.GetUsageRequest) (*pb.GetUsageResponse, error) {
+	applog.Infow("GetUsage", "auth", auth.UserFromCtx(ctx))
+
+	report, err := s.submissionService.RunQueries.GetUsage.Handle(ctx, getUsageVOToDTO(r))
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "get usage error:%v", err)
+	}
+	return usageReportDTOToVO(report), nil
+}
+
+func (s *submissionServer) StreamTaskLog(r *pb.StreamTaskLogRequest, stream pb.SubmissionService_StreamTaskLogServer) error {
+	ctx := stream.Context()
+	applog.Infow("StreamTaskLog", "auth", auth.UserFromCtx(ctx))
+
+	err := s.submissionService.RunQueries.StreamTaskLog.Handle(ctx, streamTaskLogVOToDTO(r), &taskLogStreamWriter{stream: stream})
+	if err != nil {
+		return status.Errorf(codes.Unknown, "stream task log error:%v", err)
+	}
+	return nil
+}
+
+// taskLogStreamWriter sends each write as a response of stream.
+type taskLogStreamWriter struct {
+	stream pb.SubmissionService_StreamTaskLogServer
+}
+
+func (w *taskLogStreamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pb.StreamTaskLogResponse{Content: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -10,17 +10,19 @@ import (
 
 func submissionsItemDTOToVO(item *query.SubmissionItem) *pb.SubmissionItem {
 	ret := &pb.SubmissionItem{
-		Id:              item.ID,
-		Name:            item.Name,
-		Type:            item.Type,
-		Status:          item.Status,
-		StartTime:       item.StartTime,
-		Duration:        item.Duration,
-		WorkflowVersion: queryWorkflowVersionDTOToVO(item.WorkflowID, item.WorkflowVersionID),
-		RunStatus:       submissionQueryStatusDTOToVO(item.RunStatus),
-		Entity:          queryEntityDTOToVO(item.Entity),
-		ExposedOptions:  queryExposedOptionsDTOToVO(item.ExposedOptions),
-		InOutMaterial:   queryInOutMaterialDTOToVO(item.InOutMaterial),
+		Id:                item.ID,
+		Name:              item.Name,
+		Type:              item.Type,
+		Status:            item.Status,
+		StartTime:         item.StartTime,
+		Duration:          item.Duration,
+		WorkflowVersion:   queryWorkflowVersionDTOToVO(item.WorkflowID, item.WorkflowVersionID),
+		RunStatus:         submissionQueryStatusDTOToVO(item.RunStatus),
+		Entity:            queryEntityDTOToVO(item.Entity),
+		ExposedOptions:    queryExposedOptionsDTOToVO(item.ExposedOptions),
+		InOutMaterial:     queryInOutMaterialDTOToVO(item.InOutMaterial),
+		MaxConcurrentRuns: int32(item.MaxConcurrentRuns),
+		EngineBackend:     item.EngineBackend,
 	}
 
 	if item.Description != nil {
@@ -29,6 +31,9 @@ func submissionsItemDTOToVO(item *query.SubmissionItem) *pb.SubmissionItem {
 	if item.FinishTime != nil {
 		ret.FinishTime = *item.FinishTime
 	}
+	if item.ParentSubmissionID != nil {
+		ret.ParentSubmissionID = *item.ParentSubmissionID
+	}
 
 	return ret
 }
@@ -57,10 +62,11 @@ func queryEntityDTOToVO(entity *query.Entity) *pb.Entity {
 		return nil
 	}
 	return &pb.Entity{
-		DataModelID:     entity.DataModelID,
-		DataModelRowIDs: entity.DataModelRowIDs,
-		InputsTemplate:  entity.InputsTemplate,
-		OutputsTemplate: entity.OutputsTemplate,
+		DataModelID:         entity.DataModelID,
+		DataModelRowIDs:     entity.DataModelRowIDs,
+		DataModelSnapshotID: entity.DataModelSnapshotID,
+		InputsTemplate:      entity.InputsTemplate,
+		OutputsTemplate:     entity.OutputsTemplate,
 	}
 }
 
@@ -82,14 +88,17 @@ func queryInOutMaterialDTOToVO(material *query.InOutMaterial) *pb.InOutMaterial
 
 func createSubmissionVOToDTO(req *pb.CreateSubmissionRequest) *command.CreateSubmissionCommand {
 	return &command.CreateSubmissionCommand{
-		WorkspaceID:    req.WorkspaceID,
-		Name:           req.Name,
-		WorkflowID:     req.WorkflowID,
-		Description:    &req.Description,
-		Type:           req.Type,
-		Entity:         commandEntityVoToDto(req.Entity),
-		ExposedOptions: commandExposedOptionsVoToDto(req.ExposedOptions),
-		InOutMaterial:  commandInOutMaterialVoToDto(req.InOutMaterial),
+		WorkspaceID:       req.WorkspaceID,
+		Name:              req.Name,
+		WorkflowID:        req.WorkflowID,
+		WorkflowVersionID: req.WorkflowVersionID,
+		Description:       &req.Description,
+		Type:              req.Type,
+		Entity:            commandEntityVoToDto(req.Entity),
+		ExposedOptions:    commandExposedOptionsVoToDto(req.ExposedOptions),
+		InOutMaterial:     commandInOutMaterialVoToDto(req.InOutMaterial),
+		MaxConcurrentRuns: int(req.MaxConcurrentRuns),
+		EngineBackend:     req.EngineBackend,
 	}
 }
 
@@ -126,15 +135,16 @@ func commandInOutMaterialVoToDto(material *pb.InOutMaterial) *command.InOutMater
 
 func runItemDTOToVO(item *runquery.RunItem) *pb.RunItem {
 	ret := &pb.RunItem{
-		Id:          item.ID,
-		Name:        item.Name,
-		Status:      item.Status,
-		StartTime:   item.StartTime,
-		Duration:    item.Duration,
-		EngineRunID: item.EngineRunID,
-		Inputs:      item.Inputs,
-		Outputs:     item.Outputs,
-		TaskStatus:  runQueryStatusDtoToVo(item.TaskStatus),
+		Id:            item.ID,
+		Name:          item.Name,
+		Status:        item.Status,
+		StartTime:     item.StartTime,
+		Duration:      item.Duration,
+		EngineRunID:   item.EngineRunID,
+		Inputs:        item.Inputs,
+		Outputs:       item.Outputs,
+		TaskStatus:    runQueryStatusDtoToVo(item.TaskStatus),
+		EngineBackend: item.EngineBackend,
 	}
 	if item.FinishTime != nil {
 		ret.FinishTime = *item.FinishTime
@@ -145,10 +155,30 @@ func runItemDTOToVO(item *runquery.RunItem) *pb.RunItem {
 	if item.Message != nil {
 		ret.Message = *item.Message
 	}
+	ret.Attempts = make([]*pb.RunAttempt, len(item.Attempts))
+	for i, attempt := range item.Attempts {
+		ret.Attempts[i] = runAttemptDTOToVO(attempt)
+	}
 
 	return ret
 }
 
+func runAttemptDTOToVO(attempt runquery.Attempt) *pb.RunAttempt {
+	ret := &pb.RunAttempt{
+		EngineRunID: attempt.EngineRunID,
+		Status:      attempt.Status,
+		StartTime:   attempt.StartTime,
+		Duration:    attempt.Duration,
+	}
+	if attempt.FinishTime != nil {
+		ret.FinishTime = *attempt.FinishTime
+	}
+	if attempt.Message != nil {
+		ret.Message = *attempt.Message
+	}
+	return ret
+}
+
 func runQueryStatusDtoToVo(status runquery.Status) *pb.Status {
 	return &pb.Status{
 		Count:        status.Count,
@@ -171,6 +201,12 @@ func taskItemDTOToVO(item *runquery.TaskItem) *pb.TaskItem {
 		Duration:  item.Duration,
 		Stdout:    item.Stdout,
 		Stderr:    item.Stderr,
+		Resources: &pb.TaskResources{
+			Cpu:         item.Resources.CPU,
+			Memory:      item.Resources.Memory,
+			MachineType: item.Resources.MachineType,
+			Preemptible: item.Resources.Preemptible,
+		},
 	}
 	if item.FinishTime != nil {
 		ret.FinishTime = *item.FinishTime
@@ -178,6 +214,51 @@ func taskItemDTOToVO(item *runquery.TaskItem) *pb.TaskItem {
 	return ret
 }
 
+func getUsageVOToDTO(req *pb.GetUsageRequest) *runquery.GetUsageQuery {
+	return &runquery.GetUsageQuery{
+		WorkspaceID:  req.GetWorkspaceID(),
+		SubmissionID: req.GetSubmissionID(),
+		StartTime:    req.GetStartTime(),
+		EndTime:      req.GetEndTime(),
+	}
+}
+
+func usageReportDTOToVO(report *runquery.UsageReport) *pb.GetUsageResponse {
+	ret := &pb.GetUsageResponse{
+		WorkspaceID: report.WorkspaceID,
+		StartTime:   report.StartTime,
+		EndTime:     report.EndTime,
+		Usage:       usageDTOToVO(report.Usage),
+		Submissions: make([]*pb.SubmissionUsage, len(report.Submissions)),
+	}
+	for i, submission := range report.Submissions {
+		ret.Submissions[i] = &pb.SubmissionUsage{
+			SubmissionID: submission.SubmissionID,
+			Name:         submission.Name,
+			Usage:        usageDTOToVO(submission.Usage),
+			Runs:         make([]*pb.RunUsage, len(submission.Runs)),
+		}
+		for j, run := range submission.Runs {
+			ret.Submissions[i].Runs[j] = &pb.RunUsage{
+				RunID: run.RunID,
+				Name:  run.Name,
+				Usage: usageDTOToVO(run.Usage),
+			}
+		}
+	}
+	return ret
+}
+
+func usageDTOToVO(usage runquery.Usage) *pb.Usage {
+	return &pb.Usage{
+		TaskCount:            int32(usage.TaskCount),
+		PreemptibleTaskCount: int32(usage.PreemptibleTaskCount),
+		WallClock:            usage.WallClock,
+		CpuSeconds:           usage.CPUSeconds,
+		MemoryGBSeconds:      usage.MemoryGBSeconds,
+	}
+}
+
 func listSubmissionsVOToDTO(req *pb.ListSubmissionsRequest) (*query.ListQuery, error) {
 	pg := utils.NewPagination(int(req.GetSize()), int(req.GetPage()))
 	if err := pg.SetOrderBy(req.GetOrderBy()); err != nil {
@@ -239,3 +320,15 @@ func listTasksVOToDTO(req *pb.ListTasksRequest) (*runquery.ListTasksQuery, error
 		Pg:           pg,
 	}, nil
 }
+
+func streamTaskLogVOToDTO(req *pb.StreamTaskLogRequest) *runquery.StreamTaskLogQuery {
+	return &runquery.StreamTaskLogQuery{
+		WorkspaceID:  req.GetWorkspaceID(),
+		SubmissionID: req.GetSubmissionID(),
+		RunID:        req.GetRunID(),
+		TaskName:     req.GetTaskName(),
+		Stream:       req.GetStream(),
+		Tail:         int(req.GetTail()),
+		Follow:       req.GetFollow(),
+	}
+}
@@ -10,14 +10,17 @@ import (
 
 func createSubmissionVoToDto(req CreateSubmissionRequest) *submissioncommand.CreateSubmissionCommand {
 	return &submissioncommand.CreateSubmissionCommand{
-		WorkspaceID:    req.WorkspaceID,
-		Name:           req.Name,
-		WorkflowID:     req.WorkflowID,
-		Description:    req.Description,
-		Type:           req.Type,
-		Entity:         commandEntityVoToDto(req.Entity),
-		ExposedOptions: commandExposedOptionsVoToDto(req.ExposedOptions),
-		InOutMaterial:  commandInOutMaterialVoToDto(req.InOutMaterial),
+		WorkspaceID:       req.WorkspaceID,
+		Name:              req.Name,
+		WorkflowID:        req.WorkflowID,
+		WorkflowVersionID: req.WorkflowVersionID,
+		Description:       req.Description,
+		Type:              req.Type,
+		Entity:            commandEntityVoToDto(req.Entity),
+		ExposedOptions:    commandExposedOptionsVoToDto(req.ExposedOptions),
+		InOutMaterial:     commandInOutMaterialVoToDto(req.InOutMaterial),
+		MaxConcurrentRuns: req.MaxConcurrentRuns,
+		EngineBackend:     req.EngineBackend,
 	}
 }
 
@@ -56,6 +59,15 @@ func cancelSubmissionVoToDto(req CancelSubmissionRequest) *submissioncommand.Can
 	}
 }
 
+func retrySubmissionVoToDto(req RetrySubmissionRequest) *submissioncommand.RetrySubmissionCommand {
+	return &submissioncommand.RetrySubmissionCommand{
+		WorkspaceID: req.WorkspaceID,
+		ID:          req.ID,
+		Name:        req.Name,
+		Status:      req.Status,
+	}
+}
+
 func deleteSubmissionVoToDto(req DeleteSubmissionRequest) *submissioncommand.DeleteSubmissionCommand {
 	return &submissioncommand.DeleteSubmissionCommand{
 		WorkspaceID: req.WorkspaceID,
@@ -98,19 +110,22 @@ func listSubmissionsVoToDto(req ListSubmissionsRequest) (*submissionquery.ListQu
 
 func submissionItemDtoToVo(item *submissionquery.SubmissionItem) SubmissionItem {
 	return SubmissionItem{
-		ID:              item.ID,
-		Name:            item.Name,
-		Description:     item.Description,
-		Type:            item.Type,
-		Status:          item.Status,
-		StartTime:       item.StartTime,
-		FinishTime:      item.FinishTime,
-		Duration:        item.Duration,
-		WorkflowVersion: queryWorkflowVersionDtoToVo(item.WorkflowID, item.WorkflowVersionID),
-		RunStatus:       submissionQueryStatusDtoToVo(item.RunStatus),
-		Entity:          queryEntityDtoToVo(item.Entity),
-		ExposedOptions:  queryExposedOptionsDtoToVo(item.ExposedOptions),
-		InOutMaterial:   queryInOutMaterialDtoToVo(item.InOutMaterial),
+		ID:                 item.ID,
+		Name:               item.Name,
+		Description:        item.Description,
+		Type:               item.Type,
+		Status:             item.Status,
+		StartTime:          item.StartTime,
+		FinishTime:         item.FinishTime,
+		Duration:           item.Duration,
+		WorkflowVersion:    queryWorkflowVersionDtoToVo(item.WorkflowID, item.WorkflowVersionID),
+		RunStatus:          submissionQueryStatusDtoToVo(item.RunStatus),
+		Entity:             queryEntityDtoToVo(item.Entity),
+		ExposedOptions:     queryExposedOptionsDtoToVo(item.ExposedOptions),
+		InOutMaterial:      queryInOutMaterialDtoToVo(item.InOutMaterial),
+		ParentSubmissionID: item.ParentSubmissionID,
+		MaxConcurrentRuns:  item.MaxConcurrentRuns,
+		EngineBackend:      item.EngineBackend,
 	}
 }
 
@@ -138,10 +153,11 @@ func queryEntityDtoToVo(entity *submissionquery.Entity) *Entity {
 		return nil
 	}
 	return &Entity{
-		DataModelID:     entity.DataModelID,
-		DataModelRowIDs: entity.DataModelRowIDs,
-		InputsTemplate:  entity.InputsTemplate,
-		OutputsTemplate: entity.OutputsTemplate,
+		DataModelID:         entity.DataModelID,
+		DataModelRowIDs:     entity.DataModelRowIDs,
+		DataModelSnapshotID: entity.DataModelSnapshotID,
+		InputsTemplate:      entity.InputsTemplate,
+		OutputsTemplate:     entity.OutputsTemplate,
 	}
 }
 
@@ -169,6 +185,41 @@ func cancelRunVoToDto(req CancelRunRequest) *runcommand.CancelRunCommand {
 	}
 }
 
+func rerunRunVoToDto(req RerunRunRequest) *runcommand.RerunRunCommand {
+	return &runcommand.RerunRunCommand{
+		WorkspaceID:  req.WorkspaceID,
+		SubmissionID: req.SubmissionID,
+		ID:           req.ID,
+	}
+}
+
+func reconcileRunsVoToDto(req ReconcileRunsRequest) *runcommand.ReconcileRunsCommand {
+	return &runcommand.ReconcileRunsCommand{
+		DryRun: req.DryRun,
+	}
+}
+
+func reconcileReportDtoToVo(report *runcommand.ReconcileReport) *ReconcileRunsResponse {
+	items := make([]ReconcileItem, len(report.Items))
+	for i, item := range report.Items {
+		items[i] = ReconcileItem{
+			RunID:         item.RunID,
+			SubmissionID:  item.SubmissionID,
+			EngineBackend: item.EngineBackend,
+			EngineRunID:   item.EngineRunID,
+			Action:        item.Action,
+			Message:       item.Message,
+		}
+	}
+	return &ReconcileRunsResponse{
+		DryRun:     report.DryRun,
+		StartTime:  report.StartTime,
+		FinishTime: report.FinishTime,
+		Checked:    report.Checked,
+		Items:      items,
+	}
+}
+
 func listRunsVoToDto(req ListRunsRequest) (*runquery.ListRunsQuery, error) {
 	pg := utils.NewPagination(req.Size, req.Page)
 	if err := pg.SetOrderBy(req.OrderBy); err != nil {
@@ -194,21 +245,38 @@ func listRunsVoToDto(req ListRunsRequest) (*runquery.ListRunsQuery, error) {
 
 func runItemDtoToVo(item *runquery.RunItem) RunItem {
 	return RunItem{
-		ID:          item.ID,
-		Name:        item.Name,
-		Status:      item.Status,
-		StartTime:   item.StartTime,
-		FinishTime:  item.FinishTime,
-		Duration:    item.Duration,
-		EngineRunID: item.EngineRunID,
-		Inputs:      item.Inputs,
-		Outputs:     item.Outputs,
-		TaskStatus:  runQueryStatusDtoToVo(item.TaskStatus),
-		Log:         item.Log,
-		Message:     item.Message,
+		ID:            item.ID,
+		Name:          item.Name,
+		Status:        item.Status,
+		StartTime:     item.StartTime,
+		FinishTime:    item.FinishTime,
+		Duration:      item.Duration,
+		EngineRunID:   item.EngineRunID,
+		Inputs:        item.Inputs,
+		Outputs:       item.Outputs,
+		TaskStatus:    runQueryStatusDtoToVo(item.TaskStatus),
+		Log:           item.Log,
+		Message:       item.Message,
+		Attempts:      runAttemptsDtoToVo(item.Attempts),
+		EngineBackend: item.EngineBackend,
 	}
 }
 
+func runAttemptsDtoToVo(attempts []runquery.Attempt) []RunAttempt {
+	ret := make([]RunAttempt, len(attempts))
+	for i, attempt := range attempts {
+		ret[i] = RunAttempt{
+			EngineRunID: attempt.EngineRunID,
+			Status:      attempt.Status,
+			Message:     attempt.Message,
+			StartTime:   attempt.StartTime,
+			FinishTime:  attempt.FinishTime,
+			Duration:    attempt.Duration,
+		}
+	}
+	return ret
+}
+
 func runQueryStatusDtoToVo(status runquery.Status) Status {
 	return Status{
 		Count:        status.Count,
@@ -235,6 +303,18 @@ func listTasksVoToDto(req ListTasksRequest) (*runquery.ListTasksQuery, error) {
 	}, nil
 }
 
+func streamTaskLogVoToDto(req StreamTaskLogRequest) *runquery.StreamTaskLogQuery {
+	return &runquery.StreamTaskLogQuery{
+		WorkspaceID:  req.WorkspaceID,
+		SubmissionID: req.SubmissionID,
+		RunID:        req.RunID,
+		TaskName:     req.Name,
+		Stream:       req.Stream,
+		Tail:         req.Tail,
+		Follow:       req.Follow,
+	}
+}
+
 func taskItemDtoToVo(item *runquery.TaskItem) TaskItem {
 	return TaskItem{
 		Name:       item.Name,
@@ -245,6 +325,56 @@ func taskItemDtoToVo(item *runquery.TaskItem) TaskItem {
 		Duration:   item.Duration,
 		Stdout:     item.Stdout,
 		Stderr:     item.Stderr,
+		Resources: TaskResources{
+			CPU:         item.Resources.CPU,
+			Memory:      item.Resources.Memory,
+			MachineType: item.Resources.MachineType,
+			Preemptible: item.Resources.Preemptible,
+		},
+	}
+}
+
+func getUsageVoToDto(req GetUsageRequest) *runquery.GetUsageQuery {
+	return &runquery.GetUsageQuery{
+		WorkspaceID:  req.WorkspaceID,
+		SubmissionID: req.SubmissionID,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+	}
+}
+
+func usageReportDtoToVo(report *runquery.UsageReport) *GetUsageResponse {
+	resp := &GetUsageResponse{
+		WorkspaceID: report.WorkspaceID,
+		StartTime:   report.StartTime,
+		EndTime:     report.EndTime,
+		Usage:       usageDtoToVo(report.Usage),
+		Submissions: make([]SubmissionUsage, len(report.Submissions)),
+	}
+	for i, submission := range report.Submissions {
+		resp.Submissions[i] = SubmissionUsage{
+			SubmissionID: submission.SubmissionID,
+			Name:         submission.Name,
+			Usage:        usageDtoToVo(submission.Usage),
+		}
+		for _, run := range submission.Runs {
+			resp.Submissions[i].Runs = append(resp.Submissions[i].Runs, RunUsage{
+				RunID: run.RunID,
+				Name:  run.Name,
+				Usage: usageDtoToVo(run.Usage),
+			})
+		}
+	}
+	return resp
+}
+
+func usageDtoToVo(usage runquery.Usage) Usage {
+	return Usage{
+		TaskCount:            usage.TaskCount,
+		PreemptibleTaskCount: usage.PreemptibleTaskCount,
+		WallClock:            usage.WallClock,
+		CPUSeconds:           usage.CPUSeconds,
+		MemoryGBSeconds:      usage.MemoryGBSeconds,
 	}
 }
 
@@ -2,8 +2,10 @@ package handlers
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/http1/resp"
 
 	command "github.com/Bio-OS/bioos/internal/context/submission/application/command/run"
 	query "github.com/Bio-OS/bioos/internal/context/submission/application/query/run"
@@ -48,6 +50,74 @@ func CancelRun(ctx context.Context, c *app.RequestContext, handler command.Cance
 	utils.WriteHertzAcceptedResponse(c)
 }
 
+// RerunRun rerun run
+//
+//	@Summary		use to rerun finished run with call caching
+//	@Description	rerun finished run with call caching, previous execution is kept in attempts of run
+//	@Tags			submission
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{workspace_id}/submission/{submission_id}/run/{id}/rerun [post]
+//	@Security		basicAuth
+//	@Param			workspace_id	path	string	true	"workspace id"
+//	@Param			submission_id	path	string	true	"submission id"
+//	@Param			id				path	string	true	"run id"
+//	@Success		202
+//	@Failure		400	{object}	apperrors.AppError	"invalid param"
+//	@Failure		401	{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403	{object}	apperrors.AppError	"forbidden"
+//	@Failure		500	{object}	apperrors.AppError	"internal system error"
+func RerunRun(ctx context.Context, c *app.RequestContext, handler command.RerunRunHandler) {
+	var req RerunRunRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	cmd := rerunRunVoToDto(req)
+	err = handler.Handle(ctx, cmd)
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	utils.WriteHertzAcceptedResponse(c)
+}
+
+// ReconcileRuns reconcile runs
+//
+//	@Summary		use to reconcile active runs with the runs of wes backends
+//	@Description	re-attach orphaned engine runs, abort duplicated engine runs and mark runs whose engine run vanished as failed
+//	@Tags			admin
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/admin/run/reconcile [post]
+//	@Security		basicAuth
+//	@Param			dryRun	query		bool	false	"only report what would be done"
+//	@Success		200		{object}	ReconcileRunsResponse
+//	@Failure		400		{object}	apperrors.AppError	"invalid param"
+//	@Failure		401		{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403		{object}	apperrors.AppError	"forbidden"
+//	@Failure		500		{object}	apperrors.AppError	"internal system error"
+func ReconcileRuns(ctx context.Context, c *app.RequestContext, handler command.ReconcileRunsHandler) {
+	var req ReconcileRunsRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	report, err := handler.Handle(ctx, reconcileRunsVoToDto(req))
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+	utils.WriteHertzOKResponse(c, reconcileReportDtoToVo(report))
+}
+
 // ListRuns list runs
 //
 //	@Summary		use to list runs
@@ -153,3 +223,106 @@ func ListTasks(ctx context.Context, c *app.RequestContext, handler query.ListTas
 	}
 	utils.WriteHertzOKResponse(c, resp)
 }
+
+// StreamTaskLog stream task log
+//
+//	@Summary		use to stream the log of task
+//	@Description	stream the stdout or stderr of task on the storage of workspace, keep streaming the appended log until the task finished if follow
+//	@Tags			submission
+//	@Accept			application/json
+//	@Produce		text/plain
+//	@Router			/workspace/{workspace_id}/submission/{submission_id}/run/{run_id}/task/{name}/log [get]
+//	@Security		basicAuth
+//	@Param			workspace_id	path		string	true	"workspace id"
+//	@Param			submission_id	path		string	true	"submission id"
+//	@Param			run_id			path		string	true	"run id"
+//	@Param			name			path		string	true	"task name"
+//	@Param			stream			query		string	false	"stdout or stderr, default stdout"
+//	@Param			tail			query		int		false	"only output the last lines"
+//	@Param			follow			query		bool	false	"keep streaming until the task finished"
+//	@Success		200				{string}	string
+//	@Failure		400				{object}	apperrors.AppError	"invalid param"
+//	@Failure		401				{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403				{object}	apperrors.AppError	"forbidden"
+//	@Failure		404				{object}	apperrors.AppError	"not found"
+//	@Failure		500				{object}	apperrors.AppError	"internal system error"
+func StreamTaskLog(ctx context.Context, c *app.RequestContext, handler query.StreamTaskLogHandler) {
+	var req StreamTaskLogRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	w := &chunkedWriter{c: c}
+	err = handler.Handle(ctx, streamTaskLogVoToDto(req), w)
+	if err != nil {
+		if w.started {
+			// the status is already sent
+			applog.Errorw("failed to stream task log", "err", err)
+			return
+		}
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+	if !w.started {
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", nil)
+	}
+}
+
+// chunkedWriter writes the response in chunked transfer encoding from the first write,
+// so that errors before it can still be responded normally.
+type chunkedWriter struct {
+	c       *app.RequestContext
+	started bool
+}
+
+func (w *chunkedWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.c.SetStatusCode(http.StatusOK)
+		w.c.SetContentType("text/plain; charset=utf-8")
+		w.c.Response.HijackWriter(resp.NewChunkedBodyWriter(&w.c.Response, w.c.GetWriter()))
+		w.started = true
+	}
+	return w.c.Write(p)
+}
+
+func (w *chunkedWriter) Flush() error {
+	return w.c.Flush()
+}
+
+// GetUsage get usage
+//
+//	@Summary		use to get the resource usage of workspace
+//	@Description	sum the cpu, memory and wall-clock of tasks started in the time range by submission, by run if submissionID is specified
+//	@Tags			submission
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{workspace_id}/usage [get]
+//	@Security		basicAuth
+//	@Param			workspace_id	path		string	true	"workspace id"
+//	@Param			submissionID	query		string	false	"submission id"
+//	@Param			startTime		query		int		false	"unix seconds, tasks started from it are counted"
+//	@Param			endTime			query		int		false	"unix seconds, tasks started before it are counted"
+//	@Success		200				{object}	GetUsageResponse
+//	@Failure		400				{object}	apperrors.AppError	"invalid param"
+//	@Failure		401				{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403				{object}	apperrors.AppError	"forbidden"
+//	@Failure		500				{object}	apperrors.AppError	"internal system error"
+func GetUsage(ctx context.Context, c *app.RequestContext, handler query.GetUsageHandler) {
+	var req GetUsageRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	report, err := handler.Handle(ctx, getUsageVoToDto(req))
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+	utils.WriteHertzOKResponse(c, usageReportDtoToVo(report))
+}
@@ -31,6 +31,19 @@ type RunItem struct {
 	TaskStatus  Status  `json:"taskStatus"`
 	Log         *string `json:"log"`
 	Message     *string `json:"message"`
+	// Attempts are previous executions of the run, from oldest
+	Attempts []RunAttempt `json:"attempts"`
+	// EngineBackend is the name of WES backend the run is submitted to
+	EngineBackend string `json:"engineBackend"`
+}
+
+type RunAttempt struct {
+	EngineRunID string  `json:"engineRunID"`
+	Status      string  `json:"status"`
+	Message     *string `json:"message"`
+	StartTime   int64   `json:"startTime"`
+	FinishTime  *int64  `json:"finishTime"`
+	Duration    int64   `json:"duration"`
 }
 
 type CancelRunRequest struct {
@@ -39,6 +52,12 @@ type CancelRunRequest struct {
 	ID           string `path:"id"`
 }
 
+type RerunRunRequest struct {
+	WorkspaceID  string `path:"workspace_id"`
+	SubmissionID string `path:"submission_id"`
+	ID           string `path:"id"`
+}
+
 type ListTasksRequest struct {
 	WorkspaceID  string `path:"workspace_id"`
 	SubmissionID string `path:"submission_id"`
@@ -64,4 +83,86 @@ type TaskItem struct {
 	Duration   int64  `json:"duration"`
 	Stdout     string `json:"stdout"`
 	Stderr     string `json:"stderr"`
+	// Resources are requested by the task, zero value means not reported by engine
+	Resources TaskResources `json:"resources"`
+}
+
+type TaskResources struct {
+	CPU float64 `json:"cpu"`
+	// Memory in bytes
+	Memory      int64  `json:"memory"`
+	MachineType string `json:"machineType"`
+	Preemptible bool   `json:"preemptible"`
+}
+
+type GetUsageRequest struct {
+	WorkspaceID  string `path:"workspace_id"`
+	SubmissionID string `query:"submissionID"`
+	StartTime    int64  `query:"startTime"`
+	EndTime      int64  `query:"endTime"`
+}
+
+type GetUsageResponse struct {
+	WorkspaceID string            `json:"workspaceID"`
+	StartTime   int64             `json:"startTime"`
+	EndTime     int64             `json:"endTime"`
+	Usage       Usage             `json:"usage"`
+	Submissions []SubmissionUsage `json:"submissions"`
+}
+
+type SubmissionUsage struct {
+	SubmissionID string `json:"submissionID"`
+	Name         string `json:"name"`
+	Usage        Usage  `json:"usage"`
+	// Runs is only reported for the usage of one submission
+	Runs []RunUsage `json:"runs,omitempty"`
+}
+
+type RunUsage struct {
+	RunID string `json:"runID"`
+	Name  string `json:"name"`
+	Usage Usage  `json:"usage"`
+}
+
+type Usage struct {
+	TaskCount            int `json:"taskCount"`
+	PreemptibleTaskCount int `json:"preemptibleTaskCount"`
+	// WallClock is the sum of task duration in seconds
+	WallClock int64 `json:"wallClock"`
+	// CPUSeconds is the sum of cpu multiplied by task duration
+	CPUSeconds float64 `json:"cpuSeconds"`
+	// MemoryGBSeconds is the sum of memory in GB multiplied by task duration
+	MemoryGBSeconds float64 `json:"memoryGBSeconds"`
+}
+
+type ReconcileRunsRequest struct {
+	DryRun bool `query:"dryRun"`
+}
+
+type ReconcileRunsResponse struct {
+	DryRun     bool            `json:"dryRun"`
+	StartTime  int64           `json:"startTime"`
+	FinishTime int64           `json:"finishTime"`
+	Checked    int             `json:"checked"`
+	Items      []ReconcileItem `json:"items"`
+}
+
+type ReconcileItem struct {
+	RunID         string `json:"runID"`
+	SubmissionID  string `json:"submissionID"`
+	EngineBackend string `json:"engineBackend"`
+	EngineRunID   string `json:"engineRunID"`
+	// Action is one of Reattach, AbortDuplicate, MarkVanished and Error
+	Action  string `json:"action"`
+	Message string `json:"message"`
+}
+
+type StreamTaskLogRequest struct {
+	WorkspaceID  string `path:"workspace_id"`
+	SubmissionID string `path:"submission_id"`
+	RunID        string `path:"run_id"`
+	Name         string `path:"name"`
+	Stream       string `query:"stream"`
+	Tail         int    `query:"tail"`
+	Follow       bool   `query:"follow"`
 }
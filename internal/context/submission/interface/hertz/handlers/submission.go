@@ -85,6 +85,43 @@ func CancelSubmission(ctx context.Context, c *app.RequestContext, handler comman
 	utils.WriteHertzAcceptedResponse(c)
 }
 
+// RetrySubmission retry submission
+//
+//	@Summary		use to retry runs of submission
+//	@Description	create a new submission from the submission, which only contains runs in the specified status
+//	@Tags			submission
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{workspace_id}/submission/{id}/retry [post]
+//	@Security		basicAuth
+//	@Param			workspace_id	path		string					true	"workspace id"
+//	@Param			id				path		string					true	"submission id"
+//	@Param			request			body		RetrySubmissionRequest	true	"retry submission request"
+//	@Success		201				{object}	RetrySubmissionResponse
+//	@Failure		400				{object}	apperrors.AppError	"invalid param"
+//	@Failure		401				{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403				{object}	apperrors.AppError	"forbidden"
+//	@Failure		500				{object}	apperrors.AppError	"internal system error"
+func RetrySubmission(ctx context.Context, c *app.RequestContext, handler command.RetrySubmissionHandler) {
+	var req RetrySubmissionRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	cmd := retrySubmissionVoToDto(req)
+	id, err := handler.Handle(ctx, cmd)
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	resp := &RetrySubmissionResponse{ID: id}
+	utils.WriteHertzCreatedResponse(c, resp)
+}
+
 // DeleteSubmission delete submission
 //
 //	@Summary		use to delete submission
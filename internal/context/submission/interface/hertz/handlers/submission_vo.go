@@ -1,19 +1,26 @@
 package handlers
 
 type CreateSubmissionRequest struct {
-	WorkspaceID    string         `path:"workspace_id"`
-	Name           string         `json:"name"`
-	WorkflowID     string         `json:"workflowID"`
-	Description    *string        `json:"description"`
-	Type           string         `json:"type"`
-	Entity         *Entity        `json:"entity"`
-	ExposedOptions ExposedOptions `json:"exposedOptions"`
-	InOutMaterial  *InOutMaterial `json:"inOutMaterial"`
+	WorkspaceID       string         `path:"workspace_id"`
+	Name              string         `json:"name"`
+	WorkflowID        string         `json:"workflowID"`
+	WorkflowVersionID string         `json:"workflowVersionID"`
+	Description       *string        `json:"description"`
+	Type              string         `json:"type"`
+	Entity            *Entity        `json:"entity"`
+	ExposedOptions    ExposedOptions `json:"exposedOptions"`
+	InOutMaterial     *InOutMaterial `json:"inOutMaterial"`
+	// MaxConcurrentRuns limits the runs submitted at the same time, 0 means using the default of server
+	MaxConcurrentRuns int `json:"maxConcurrentRuns"`
+	// EngineBackend overrides the default WES backend of workspace
+	EngineBackend string `json:"engineBackend"`
 }
 
 type Entity struct {
 	DataModelID     string   `json:"dataModelID"`
 	DataModelRowIDs []string `json:"dataModelRowIDs"`
+	// DataModelSnapshotID is the snapshot of data model the inputs are read from, ignored on creating
+	DataModelSnapshotID string `json:"dataModelSnapshotID,omitempty"`
 	/** 输入配置，json 序列化后的 string
 	  采用 json 序列化原因基于以下两点考虑：
 	  - thrift/接口设计层面不允许 `Value` 类型不确定
@@ -83,6 +90,12 @@ type SubmissionItem struct {
 	Entity          *Entity         `json:"entity"`
 	ExposedOptions  ExposedOptions  `json:"exposedOptions"`
 	InOutMaterial   *InOutMaterial  `json:"inOutMaterial"`
+	// ParentSubmissionID is the id of submission retried by this one
+	ParentSubmissionID *string `json:"parentSubmissionID"`
+	// MaxConcurrentRuns limits the runs submitted at the same time, 0 means unlimited
+	MaxConcurrentRuns int `json:"maxConcurrentRuns"`
+	// EngineBackend is the name of WES backend runs are submitted to
+	EngineBackend string `json:"engineBackend"`
 }
 
 type WorkflowVersion struct {
@@ -111,3 +124,16 @@ type CancelSubmissionRequest struct {
 	WorkspaceID string `path:"workspace_id"`
 	ID          string `path:"id"`
 }
+
+type RetrySubmissionRequest struct {
+	WorkspaceID string `path:"workspace_id"`
+	ID          string `path:"id"`
+	// Name of the new submission, generated from the retried one if empty
+	Name string `json:"name"`
+	// Status of runs to retry, one or more of Failed and Cancelled, Failed if empty
+	Status []string `json:"status"`
+}
+
+type RetrySubmissionResponse struct {
+	ID string `json:"id"`
+}
@@ -51,6 +51,12 @@ func (r *register) AddRoute(h route.IRouter) {
 		handlers.CancelSubmission(c, ctx, r.svc.SubmissionCommands.CancelSubmission)
 	})
 
+	submission.POST("/:id/retry", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:RetrySubmission", c.Param("workspace_id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.RetrySubmission(c, ctx, r.svc.SubmissionCommands.RetrySubmission)
+	})
+
 	submission.DELETE("/:id", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
 		return fmt.Sprintf("Workspace-%s:DeleteSubmission", c.Param("workspace_id"))
 	}), func(c context.Context, ctx *app.RequestContext) {
@@ -58,6 +64,20 @@ func (r *register) AddRoute(h route.IRouter) {
 	})
 
 	addNotebookRoute(submission, r.svc)
+
+	h.GET("/workspace/:workspace_id/usage", apphertz.Authn(), apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:GetUsage", c.Param("workspace_id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.GetUsage(c, ctx, r.svc.RunQueries.GetUsage)
+	})
+
+	admin := h.Group("/admin")
+	admin.Use(apphertz.Authn())
+	admin.POST("/run/reconcile", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return "Admin:ReconcileRuns"
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.ReconcileRuns(c, ctx, r.svc.RunCommands.ReconcileRuns)
+	})
 	return
 }
 
@@ -68,6 +88,12 @@ func addNotebookRoute(group *route.RouterGroup, submissionService *application.S
 		handlers.CancelRun(c, ctx, submissionService.RunCommands.CancelRun)
 	})
 
+	group.POST("/:submission_id/run/:id/rerun", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:RerunRun", c.Param("workspace_id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.RerunRun(c, ctx, submissionService.RunCommands.RerunRun)
+	})
+
 	group.GET("/:submission_id/run", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
 		return fmt.Sprintf("Workspace-%s:ListRuns", c.Param("workspace_id"))
 	}), func(c context.Context, ctx *app.RequestContext) {
@@ -79,4 +105,10 @@ func addNotebookRoute(group *route.RouterGroup, submissionService *application.S
 	}), func(c context.Context, ctx *app.RequestContext) {
 		handlers.ListTasks(c, ctx, submissionService.RunQueries.ListTasks)
 	})
+
+	group.GET("/:submission_id/run/:run_id/task/:name/log", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:StreamTaskLog", c.Param("workspace_id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.StreamTaskLog(c, ctx, submissionService.RunQueries.StreamTaskLog)
+	})
 }
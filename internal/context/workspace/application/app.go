@@ -8,10 +8,12 @@ import (
 
 	"github.com/Bio-OS/bioos/internal/apiserver/options"
 	datamodelcommand "github.com/Bio-OS/bioos/internal/context/workspace/application/command/data-model"
+	eventcommand "github.com/Bio-OS/bioos/internal/context/workspace/application/command/event"
 	notebookcommand "github.com/Bio-OS/bioos/internal/context/workspace/application/command/notebook"
 	workflowcommand "github.com/Bio-OS/bioos/internal/context/workspace/application/command/workflow"
 	workspacecommand "github.com/Bio-OS/bioos/internal/context/workspace/application/command/workspace"
 	datamodelquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+	eventquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/event"
 	notebookquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/notebook"
 	workflowquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workflow"
 	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
@@ -20,6 +22,7 @@ import (
 	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workflow"
 	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	datamodelmongopo "github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/persistence/data-model/mongo"
 	datamodelsqlpo "github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/persistence/data-model/sql"
 	eventmongopo "github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/persistence/event/mongo"
 	eventsqlpo "github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/persistence/event/sql"
@@ -28,7 +31,9 @@ import (
 	workflowsql "github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/persistence/workflow/sql"
 	workspacemongo "github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/persistence/workspace/mongo"
 	workspacesql "github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/persistence/workspace/sql"
+	"github.com/Bio-OS/bioos/pkg/client"
 	"github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils/grpc"
 )
 
 type closer func(ctx context.Context) error
@@ -42,6 +47,8 @@ type WorkspaceService struct {
 	DataModelQueries  *datamodelquery.Queries
 	WorkflowCommands  *workflowcommand.Commands
 	WorkflowQueries   *workflowquery.Queries
+	EventCommands     *eventcommand.Commands
+	EventQueries      *eventquery.Queries
 
 	closer closer
 }
@@ -62,12 +69,14 @@ func NewWorkspaceService(ctx context.Context, opts *options.Options) (*Workspace
 		dbCloser           closer
 		workspaceRepo      workspace.Repository
 		workspaceReadModel workspacequery.WorkspaceReadModel
+		importJobRepo      workspace.ImportJobRepository
 		dataModelRepo      datamodel.Repository
 		dataModelReadModel datamodelquery.DataModelReadModel
 		workflowRepo       workflow.Repository
 		workflowReadModel  workflowquery.ReadModel
 		eventRepo          eventbus.EventRepository
 		eventBus           eventbus.EventBus
+		grpcFactory        grpc.Factory
 	)
 
 	if opts.DBOption.Mongo != nil && opts.DBOption.Mongo.Enabled() {
@@ -81,12 +90,21 @@ func NewWorkspaceService(ctx context.Context, opts *options.Options) (*Workspace
 		if workspaceReadModel, err = workspacemongo.NewWorkspaceReadModel(ctx, mongoDB); err != nil {
 			return nil, fmt.Errorf("new mongodb read model fail: %w", err)
 		}
+		if importJobRepo, err = workspacemongo.NewImportJobRepository(ctx, mongoDB); err != nil {
+			return nil, fmt.Errorf("new mongodb repository fail: %w", err)
+		}
 		if workflowRepo, err = workflowmongo.NewRepository(ctx, mongoDB, mongoClient); err != nil {
 			return nil, fmt.Errorf("new mongodb repository fail: %w", err)
 		}
 		if workflowReadModel, err = workflowmongo.NewReadModel(ctx, mongoDB); err != nil {
 			return nil, fmt.Errorf("new mongodb read model fail: %w", err)
 		}
+		if dataModelRepo, err = datamodelmongopo.NewDataModelRepository(ctx, mongoDB); err != nil {
+			return nil, fmt.Errorf("new mongodb repository fail: %w", err)
+		}
+		if dataModelReadModel, err = datamodelmongopo.NewDataModelReadModel(ctx, mongoDB); err != nil {
+			return nil, fmt.Errorf("new mongodb read model fail: %w", err)
+		}
 		if eventRepo, err = eventmongopo.NewEventRepository(ctx, mongoDB, opts.EventBusOption.DequeueTimeout, opts.EventBusOption.RunningTimeout); err != nil {
 			return nil, fmt.Errorf("new mongodb event repository fail: %w", err)
 		}
@@ -117,6 +135,9 @@ func NewWorkspaceService(ctx context.Context, opts *options.Options) (*Workspace
 		if workspaceReadModel, err = workspacesql.NewWorkspaceReadModel(ctx, orm); err != nil {
 			return nil, fmt.Errorf("new sql read model fail: %w", err)
 		}
+		if importJobRepo, err = workspacesql.NewImportJobRepository(ctx, orm); err != nil {
+			return nil, fmt.Errorf("new sql repository fail: %w", err)
+		}
 		if workflowRepo, err = workflowsql.NewRepository(ctx, orm); err != nil {
 			return nil, fmt.Errorf("new sql repository fail: %w", err)
 		}
@@ -138,8 +159,10 @@ func NewWorkspaceService(ctx context.Context, opts *options.Options) (*Workspace
 		eventbus.WithSyncPeriod(opts.EventBusOption.SyncPeriod),
 		eventbus.WithBatchSize(opts.EventBusOption.BatchSize),
 		eventbus.WithBatchSize(opts.EventBusOption.BatchSize),
+		eventbus.WithRetryPolicies(opts.EventBusOption.DefaultRetryPolicy(), opts.EventBusOption.RetryPolicies),
+		eventbus.WithLeaseDuration(opts.EventBusOption.LeaseDuration),
 	}
-	if eventBus, err = eventbus.NewEventBus(eventRepo, eOpts...); err != nil {
+	if eventBus, err = eventbus.NewEventBusOfBackend(opts.EventBusOption, eventRepo, "workspace", eOpts...); err != nil {
 		return nil, err
 	}
 	go func() {
@@ -148,6 +171,15 @@ func NewWorkspaceService(ctx context.Context, opts *options.Options) (*Workspace
 			log.Errorw("start event bus failed", "err", err)
 		}
 	}()
+	// the events of all contexts are in the same repository, so only compact them here
+	if retention := opts.EventBusOption.Retention; opts.EventBusOption.Persistent() && retention != nil && retention.CompactPeriod > 0 {
+		go eventbus.NewCompactor(eventRepo, retention.Rules).Start(ctx, retention.CompactPeriod)
+	}
+	// the events delivered by broker are not in the repository to be inspected by the admin api
+	adminEventRepo := eventRepo
+	if !opts.EventBusOption.Persistent() {
+		adminEventRepo = eventbus.NewNotPersistedEventRepository()
+	}
 
 	var notebookRepo notebook.Repository
 	var notebookReadModel notebookquery.ReadModel
@@ -162,13 +194,18 @@ func NewWorkspaceService(ctx context.Context, opts *options.Options) (*Workspace
 		return nil, fmt.Errorf("none storage options")
 	}
 
+	// submissions are exported through the grpc client of submission service
+	if opts.Client.Method == client.GRPCMethod {
+		grpcFactory = grpc.NewFactory(opts.Client)
+	}
+
 	workspaceFactory := workspace.NewWorkspaceFactory(ctx)
 	dataModelFactory := datamodel.NewDataModelFactory()
 	workflowFactory := workflow.NewFactory(ctx)
 	notebookFactory := notebook.NewFactory()
 
 	return &WorkspaceService{
-		WorkspaceCommands: workspacecommand.NewCommands(workspaceRepo, eventRepo, workspaceFactory, eventBus),
+		WorkspaceCommands: workspacecommand.NewCommands(workspaceRepo, importJobRepo, eventRepo, workspaceFactory, eventBus, workspaceReadModel, notebookReadModel, workflowReadModel, dataModelReadModel, grpcFactory),
 		WorkspaceQueries:  workspacequery.NewQueries(workspaceReadModel),
 		WorkflowCommands:  workflowcommand.NewCommands(workflowRepo, workflowReadModel, workflowFactory, workspaceReadModel, eventBus, opts.ServerOption.WomtoolFile),
 		WorkflowQueries:   workflowquery.NewQueries(workflowReadModel, workspaceReadModel),
@@ -176,6 +213,8 @@ func NewWorkspaceService(ctx context.Context, opts *options.Options) (*Workspace
 		NotebookQueries:   notebookquery.NewQueries(notebookReadModel, workspaceReadModel),
 		DataModelCommands: datamodelcommand.NewCommands(dataModelRepo, workspaceReadModel, dataModelFactory, dataModelReadModel, eventBus),
 		DataModelQueries:  datamodelquery.NewQueries(workspaceReadModel, dataModelReadModel),
+		EventCommands:     eventcommand.NewCommands(adminEventRepo),
+		EventQueries:      eventquery.NewQueries(adminEventRepo),
 		closer:            dbCloser,
 	}, nil
 }
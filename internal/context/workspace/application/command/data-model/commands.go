@@ -13,6 +13,8 @@ type PatchDataModelCommand struct {
 	Async       bool
 	Headers     []string   `validate:"required,dataModelHeaders"`
 	Rows        [][]string `validate:"required,dataModelRows"`
+	// ColumnTypes declares the types of entity columns keyed by header, types of the others are inferred
+	ColumnTypes map[string]string
 }
 
 type DeleteDataModelCommand struct {
@@ -22,15 +24,43 @@ type DeleteDataModelCommand struct {
 	RowIDs      []string
 }
 
+type CreateDataModelSnapshotCommand struct {
+	WorkspaceID string `validate:"required"`
+	DataModelID string `validate:"required"`
+	Name        string `validate:"required,max=100"`
+	Description string `validate:"max=1000"`
+	// Auto marks the snapshot taken automatically before the data model is overwritten
+	Auto bool
+}
+
+type RestoreDataModelSnapshotCommand struct {
+	WorkspaceID string `validate:"required"`
+	DataModelID string `validate:"required"`
+	ID          string `validate:"required"`
+}
+
+type DeleteDataModelSnapshotCommand struct {
+	WorkspaceID string `validate:"required"`
+	DataModelID string `validate:"required"`
+	ID          string `validate:"required"`
+}
+
 type Commands struct {
-	PatchDataModel  PatchDataModelHandler
-	DeleteDataModel DeleteDataModelHandler
+	PatchDataModel           PatchDataModelHandler
+	DeleteDataModel          DeleteDataModelHandler
+	CreateDataModelSnapshot  CreateDataModelSnapshotHandler
+	RestoreDataModelSnapshot RestoreDataModelSnapshotHandler
+	DeleteDataModelSnapshot  DeleteDataModelSnapshotHandler
 }
 
 func NewCommands(dataModelRepo datamodel.Repository, workspaceReadModel workspacequery.WorkspaceReadModel, dataModelFactory *datamodel.Factory, dataModelReadModel datamodelquery.DataModelReadModel, eventBus eventbus.EventBus) *Commands {
 	svc := datamodel.NewService(dataModelRepo, eventBus, dataModelFactory)
+	addEventHandle(eventBus, svc, dataModelFactory, workspaceReadModel, dataModelReadModel)
 	return &Commands{
-		PatchDataModel:  NewPatchDataModelHandler(svc, workspaceReadModel, dataModelReadModel),
-		DeleteDataModel: NewDeleteDataModelHandler(svc, workspaceReadModel, dataModelReadModel),
+		PatchDataModel:           NewPatchDataModelHandler(svc, workspaceReadModel, dataModelReadModel),
+		DeleteDataModel:          NewDeleteDataModelHandler(svc, workspaceReadModel, dataModelReadModel),
+		CreateDataModelSnapshot:  NewCreateDataModelSnapshotHandler(svc, workspaceReadModel, dataModelReadModel, dataModelFactory),
+		RestoreDataModelSnapshot: NewRestoreDataModelSnapshotHandler(svc, workspaceReadModel, dataModelReadModel, dataModelFactory),
+		DeleteDataModelSnapshot:  NewDeleteDataModelSnapshotHandler(svc, workspaceReadModel),
 	}
 }
@@ -0,0 +1,106 @@
+package datamodel
+
+import (
+	"context"
+
+	datamodelquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	datamodel "github.com/Bio-OS/bioos/internal/context/workspace/domain/data-model"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/utils"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type CreateDataModelSnapshotHandler interface {
+	Handle(ctx context.Context, cmd *CreateDataModelSnapshotCommand) (string, error)
+}
+
+type createDataModelSnapshotHandler struct {
+	svc                datamodel.Service
+	workspaceReadModel workspacequery.WorkspaceReadModel
+	dataModelReadModel datamodelquery.DataModelReadModel
+	factory            *datamodel.Factory
+}
+
+var _ CreateDataModelSnapshotHandler = &createDataModelSnapshotHandler{}
+
+func NewCreateDataModelSnapshotHandler(svc datamodel.Service, workspaceReadModel workspacequery.WorkspaceReadModel, dataModelReadModel datamodelquery.DataModelReadModel, factory *datamodel.Factory) CreateDataModelSnapshotHandler {
+	return &createDataModelSnapshotHandler{
+		svc,
+		workspaceReadModel,
+		dataModelReadModel,
+		factory,
+	}
+}
+
+func (c *createDataModelSnapshotHandler) Handle(ctx context.Context, cmd *CreateDataModelSnapshotCommand) (string, error) {
+	if err := validator.Validate(cmd); err != nil {
+		return "", err
+	}
+
+	if err := workspacequery.CheckWorkspaceExist(ctx, c.workspaceReadModel, cmd.WorkspaceID); err != nil {
+		return "", err
+	}
+
+	dm, err := c.svc.Get(ctx, cmd.DataModelID)
+	if err != nil {
+		return "", err
+	}
+	if dm.WorkspaceID != cmd.WorkspaceID {
+		return "", apperrors.NewInvalidError("data model[%s] is not belong to workspace[%s]", dm.Name, cmd.WorkspaceID)
+	}
+	snapshot, err := takeSnapshot(ctx, c.svc, c.factory, c.dataModelReadModel, dm, cmd.Name, cmd.Description, cmd.Auto)
+	if err != nil {
+		return "", err
+	}
+	return snapshot.ID, nil
+}
+
+// takeSnapshot saves a snapshot of the current headers, column types and rows of dm, the rows are
+// copied by repository without being read out.
+func takeSnapshot(ctx context.Context, svc datamodel.Service, factory *datamodel.Factory, dataModelReadModel datamodelquery.DataModelReadModel, dm *datamodel.DataModel, name, description string, auto bool) (*datamodel.Snapshot, error) {
+	typ := utils.GetDataModelType(dm.Name)
+	headers, err := dataModelReadModel.ListDataModelHeaders(ctx, dm.ID, dm.Name, typ)
+	if err != nil {
+		return nil, err
+	}
+	headerTypes, err := dataModelReadModel.ListDataModelHeaderTypes(ctx, dm.ID, typ)
+	if err != nil {
+		return nil, err
+	}
+	columnTypes := make(map[string]string, len(headers))
+	for index, header := range headers {
+		if index < len(headerTypes) {
+			columnTypes[header] = headerTypes[index]
+		}
+	}
+	declaredColumns := make(map[string]bool)
+	if typ == consts.DataModelTypeEntity {
+		declaredHeaders, err := dataModelReadModel.ListEntityDataModelDeclaredHeaders(ctx, dm.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, header := range declaredHeaders {
+			declaredColumns[header] = true
+		}
+	}
+	snapshot := factory.NewSnapshot(&datamodel.CreateSnapshotParam{
+		DataModel: &datamodel.DataModel{
+			WorkspaceID:     dm.WorkspaceID,
+			ID:              dm.ID,
+			Name:            dm.Name,
+			Type:            dm.Type,
+			Headers:         headers,
+			ColumnTypes:     columnTypes,
+			DeclaredColumns: declaredColumns,
+		},
+		Name:        name,
+		Description: description,
+		Auto:        auto,
+	})
+	if err = svc.CreateSnapshot(ctx, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,47 @@
+package datamodel
+
+import (
+	"context"
+
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	datamodel "github.com/Bio-OS/bioos/internal/context/workspace/domain/data-model"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type DeleteDataModelSnapshotHandler interface {
+	Handle(ctx context.Context, cmd *DeleteDataModelSnapshotCommand) error
+}
+
+type deleteDataModelSnapshotHandler struct {
+	svc                datamodel.Service
+	workspaceReadModel workspacequery.WorkspaceReadModel
+}
+
+var _ DeleteDataModelSnapshotHandler = &deleteDataModelSnapshotHandler{}
+
+func NewDeleteDataModelSnapshotHandler(svc datamodel.Service, workspaceReadModel workspacequery.WorkspaceReadModel) DeleteDataModelSnapshotHandler {
+	return &deleteDataModelSnapshotHandler{
+		svc,
+		workspaceReadModel,
+	}
+}
+
+func (d *deleteDataModelSnapshotHandler) Handle(ctx context.Context, cmd *DeleteDataModelSnapshotCommand) error {
+	if err := validator.Validate(cmd); err != nil {
+		return err
+	}
+
+	if err := workspacequery.CheckWorkspaceExist(ctx, d.workspaceReadModel, cmd.WorkspaceID); err != nil {
+		return err
+	}
+
+	snapshot, err := d.svc.GetSnapshot(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+	if snapshot.WorkspaceID != cmd.WorkspaceID || snapshot.DataModelID != cmd.DataModelID {
+		return apperrors.NewNotFoundError("data model snapshot", cmd.ID)
+	}
+	return d.svc.DeleteSnapshot(ctx, cmd.ID)
+}
@@ -0,0 +1,118 @@
+package datamodel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	datamodelquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	datamodel "github.com/Bio-OS/bioos/internal/context/workspace/domain/data-model"
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+// clonePageSize is the number of rows read from the source data model each time
+const clonePageSize = 500
+
+// cloneOrder clones entities before the entity sets referring them
+var cloneOrder = map[string]int{
+	consts.DataModelTypeEntity:    0,
+	consts.DataModelTypeEntitySet: 1,
+	consts.DataModelTypeWorkspace: 2,
+}
+
+func addEventHandle(eb eventbus.EventBus, svc datamodel.Service, factory *datamodel.Factory, workspaceReadModel workspacequery.WorkspaceReadModel, dataModelReadModel datamodelquery.DataModelReadModel) {
+	eb.Subscribe(datamodel.CloneDataModels, &cloneDataModelsHandler{
+		service:           svc,
+		factory:           factory,
+		readModel:         dataModelReadModel,
+		listDataModelRows: datamodelquery.NewListDataModelRowsHandler(workspaceReadModel, dataModelReadModel),
+	})
+}
+
+type cloneDataModelsHandler struct {
+	service           datamodel.Service
+	factory           *datamodel.Factory
+	readModel         datamodelquery.DataModelReadModel
+	listDataModelRows datamodelquery.ListDataModelRowsHandler
+}
+
+// Handle copies the data models of source workspace with all rows, the data models already
+// cloned are skipped when retrying.
+func (h *cloneDataModelsHandler) Handle(ctx context.Context, payload string) error {
+	log.Infow("start to consume clone data models event", "payload", payload)
+	event, err := workspace.NewCloneComponentEventFromPayload([]byte(payload))
+	if err != nil {
+		return fmt.Errorf("decode event payload fail: %w", err)
+	}
+
+	dataModels, err := h.readModel.ListDataModels(ctx, event.SourceWorkspaceID, nil)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(dataModels, func(i, j int) bool {
+		return cloneOrder[utils.GetDataModelType(dataModels[i].Name)] < cloneOrder[utils.GetDataModelType(dataModels[j].Name)]
+	})
+	for _, dataModel := range dataModels {
+		_, err := h.readModel.GetDataModelWithName(ctx, event.WorkspaceID, dataModel.Name)
+		if err == nil {
+			continue
+		}
+		var apperror apperrors.Error
+		if !(errors.As(err, &apperror) && apperror.GetCode() == apperrors.NotFoundCode) {
+			return err
+		}
+		headers, headerTypes, rows, err := h.listRows(ctx, event.SourceWorkspaceID, dataModel)
+		if err != nil {
+			return err
+		}
+		newDataModel := h.factory.New(&datamodel.CreateParam{
+			WorkspaceID: event.WorkspaceID,
+			Name:        dataModel.Name,
+			Type:        utils.GetDataModelType(dataModel.Name),
+			Headers:     headers,
+			Rows:        rows,
+			ColumnTypes: headerTypes,
+		})
+		if err := h.service.Create(ctx, newDataModel); err != nil {
+			return err
+		}
+		log.Infow("success clone data model", "workspace", event.WorkspaceID, "source", dataModel.ID, "dataModel", newDataModel.ID)
+	}
+	return nil
+}
+
+// listRows lists all rows of data model, with the column types of entity by header.
+func (h *cloneDataModelsHandler) listRows(ctx context.Context, workspaceID string, dataModel *datamodelquery.DataModel) ([]string, map[string]string, [][]string, error) {
+	var headers, headerTypes []string
+	rows := make([][]string, 0, dataModel.RowCount)
+	for page := 1; ; page++ {
+		pageHeaders, pageHeaderTypes, pageRows, total, err := h.listDataModelRows.Handle(ctx, &datamodelquery.ListDataModelRowsQuery{
+			WorkspaceID: workspaceID,
+			ID:          dataModel.ID,
+			Pagination:  utils.NewPagination(clonePageSize, page),
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		headers, headerTypes = pageHeaders, pageHeaderTypes
+		rows = append(rows, pageRows...)
+		if len(pageRows) == 0 || int64(page*clonePageSize) >= total {
+			columnTypes := make(map[string]string, len(headers))
+			if utils.GetDataModelType(dataModel.Name) == consts.DataModelTypeEntity {
+				for i, header := range headers {
+					if i < len(headerTypes) {
+						columnTypes[header] = headerTypes[i]
+					}
+				}
+			}
+			return headers, columnTypes, rows, nil
+		}
+	}
+}
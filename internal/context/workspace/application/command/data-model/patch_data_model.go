@@ -57,6 +57,9 @@ func (p *patchDataModelHandler) Handle(ctx context.Context, cmd *PatchDataModelC
 				Headers:     cmd.Headers,
 				Rows:        cmd.Rows,
 			})
+			if err = newDataModel.ResolveColumnTypes(cmd.ColumnTypes, nil, nil); err != nil {
+				return "", err
+			}
 			if err = p.svc.Create(ctx, newDataModel); err != nil {
 				return "", err
 			}
@@ -74,6 +77,8 @@ func (p *patchDataModelHandler) Handle(ctx context.Context, cmd *PatchDataModelC
 	}
 	headers := cmd.Headers
 	rows := cmd.Rows
+	var storedTypes map[string]string
+	var storedDeclared map[string]bool
 	if dataModelType == consts.DataModelTypeEntity {
 		rowIDs := getRowIDs(cmd.Rows)
 
@@ -81,6 +86,25 @@ func (p *patchDataModelHandler) Handle(ctx context.Context, cmd *PatchDataModelC
 		if err != nil {
 			return "", err
 		}
+		dbHeaderTypes, err := p.dataModelReadModel.ListDataModelHeaderTypes(ctx, model.ID, dataModelType)
+		if err != nil {
+			return "", err
+		}
+		storedTypes = make(map[string]string, len(dbHeaders))
+		for index, header := range dbHeaders {
+			if index < len(dbHeaderTypes) {
+				storedTypes[header] = dbHeaderTypes[index]
+			}
+		}
+
+		dbDeclaredHeaders, err := p.dataModelReadModel.ListEntityDataModelDeclaredHeaders(ctx, model.ID)
+		if err != nil {
+			return "", err
+		}
+		storedDeclared = make(map[string]bool, len(dbDeclaredHeaders))
+		for _, header := range dbDeclaredHeaders {
+			storedDeclared[header] = true
+		}
 
 		dbColumns, err := p.dataModelReadModel.ListEntityDataModelColumnsWithRowIDs(ctx, model.ID, dbHeaders, rowIDs)
 		if err != nil {
@@ -91,6 +115,9 @@ func (p *patchDataModelHandler) Handle(ctx context.Context, cmd *PatchDataModelC
 	}
 	model.Headers = headers
 	model.Rows = rows
+	if err = model.ResolveColumnTypes(cmd.ColumnTypes, storedTypes, storedDeclared); err != nil {
+		return "", err
+	}
 	if err = p.svc.Upsert(ctx, model); err != nil {
 		return "", err
 	}
@@ -0,0 +1,71 @@
+package datamodel
+
+import (
+	"context"
+	"fmt"
+
+	datamodelquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	datamodel "github.com/Bio-OS/bioos/internal/context/workspace/domain/data-model"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+// RestoreDataModelSnapshotHandler overwrites the data model with the snapshot, the content before
+// restoring is saved to a new snapshot whose id is returned.
+type RestoreDataModelSnapshotHandler interface {
+	Handle(ctx context.Context, cmd *RestoreDataModelSnapshotCommand) (string, error)
+}
+
+type restoreDataModelSnapshotHandler struct {
+	svc                datamodel.Service
+	workspaceReadModel workspacequery.WorkspaceReadModel
+	dataModelReadModel datamodelquery.DataModelReadModel
+	factory            *datamodel.Factory
+}
+
+var _ RestoreDataModelSnapshotHandler = &restoreDataModelSnapshotHandler{}
+
+func NewRestoreDataModelSnapshotHandler(svc datamodel.Service, workspaceReadModel workspacequery.WorkspaceReadModel, dataModelReadModel datamodelquery.DataModelReadModel, factory *datamodel.Factory) RestoreDataModelSnapshotHandler {
+	return &restoreDataModelSnapshotHandler{
+		svc,
+		workspaceReadModel,
+		dataModelReadModel,
+		factory,
+	}
+}
+
+func (r *restoreDataModelSnapshotHandler) Handle(ctx context.Context, cmd *RestoreDataModelSnapshotCommand) (string, error) {
+	if err := validator.Validate(cmd); err != nil {
+		return "", err
+	}
+
+	if err := workspacequery.CheckWorkspaceExist(ctx, r.workspaceReadModel, cmd.WorkspaceID); err != nil {
+		return "", err
+	}
+
+	dm, err := r.svc.Get(ctx, cmd.DataModelID)
+	if err != nil {
+		return "", err
+	}
+	if dm.WorkspaceID != cmd.WorkspaceID {
+		return "", apperrors.NewInvalidError("data model[%s] is not belong to workspace[%s]", dm.Name, cmd.WorkspaceID)
+	}
+	snapshot, err := r.svc.GetSnapshot(ctx, cmd.ID)
+	if err != nil {
+		return "", err
+	}
+	if snapshot.DataModelID != dm.ID {
+		return "", apperrors.NewNotFoundError("data model snapshot", cmd.ID)
+	}
+	backup, err := takeSnapshot(ctx, r.svc, r.factory, r.dataModelReadModel, dm, consts.DataModelSnapshotNameBeforeRestore,
+		fmt.Sprintf("taken before restoring snapshot %s(%s)", snapshot.Name, snapshot.ID), true)
+	if err != nil {
+		return "", err
+	}
+	if err = r.svc.Restore(ctx, dm, snapshot); err != nil {
+		return "", err
+	}
+	return backup.ID, nil
+}
@@ -0,0 +1,27 @@
+package event
+
+import (
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+)
+
+type ReplayEventCommand struct {
+	ID string `validate:"required"`
+}
+
+type PurgeEventsCommand struct {
+	// Before is the unix time, events completed before it are deleted
+	Before int64 `validate:"required,gt=0"`
+	Types  []string
+}
+
+type Commands struct {
+	ReplayEvent ReplayEventHandler
+	PurgeEvents PurgeEventsHandler
+}
+
+func NewCommands(eventRepo eventbus.EventRepository) *Commands {
+	return &Commands{
+		ReplayEvent: NewReplayEventHandler(eventRepo),
+		PurgeEvents: NewPurgeEventsHandler(eventRepo),
+	}
+}
@@ -0,0 +1,23 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+)
+
+func TestCommandsOfNotPersistedEvents(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+	commands := NewCommands(eventbus.NewNotPersistedEventRepository())
+
+	err := commands.ReplayEvent.Handle(ctx, &ReplayEventCommand{ID: "event-1"})
+	g.Expect(err).To(gomega.Equal(apperrors.NewInvalidError(eventbus.ErrEventsNotPersisted.Error())))
+	_, err = commands.PurgeEvents.Handle(ctx, &PurgeEventsCommand{Before: time.Now().Add(-time.Hour).Unix()})
+	g.Expect(err).To(gomega.Equal(apperrors.NewInvalidError(eventbus.ErrEventsNotPersisted.Error())))
+}
@@ -0,0 +1,51 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+// PurgeEventsHandler deletes the completed events, returns the count of deleted events.
+type PurgeEventsHandler interface {
+	Handle(ctx context.Context, cmd *PurgeEventsCommand) (int64, error)
+}
+
+type purgeEventsHandler struct {
+	eventRepo eventbus.EventRepository
+}
+
+func NewPurgeEventsHandler(eventRepo eventbus.EventRepository) PurgeEventsHandler {
+	return &purgeEventsHandler{
+		eventRepo: eventRepo,
+	}
+}
+
+func (h *purgeEventsHandler) Handle(ctx context.Context, cmd *PurgeEventsCommand) (int64, error) {
+	if err := validator.Validate(cmd); err != nil {
+		return 0, err
+	}
+
+	before := time.Unix(cmd.Before, 0)
+	if before.After(time.Now()) {
+		return 0, apperrors.NewInvalidError("before must not be in the future")
+	}
+	count, err := h.eventRepo.Delete(ctx, &eventbus.Filter{
+		Type:          cmd.Types,
+		Status:        []string{eventbus.EventStatusCompleted},
+		UpdatedBefore: before,
+	})
+	if err != nil {
+		if errors.Is(err, eventbus.ErrEventsNotPersisted) {
+			return 0, apperrors.NewInvalidError(err.Error())
+		}
+		return 0, apperrors.NewInternalError(err)
+	}
+	applog.Infow("events purged", "before", before, "types", cmd.Types, "count", count)
+	return count, nil
+}
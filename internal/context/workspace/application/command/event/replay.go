@@ -0,0 +1,52 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+// ReplayEventHandler requeues a failed or dead lettered event to be handled again.
+type ReplayEventHandler interface {
+	Handle(ctx context.Context, cmd *ReplayEventCommand) error
+}
+
+type replayEventHandler struct {
+	eventRepo eventbus.EventRepository
+}
+
+func NewReplayEventHandler(eventRepo eventbus.EventRepository) ReplayEventHandler {
+	return &replayEventHandler{
+		eventRepo: eventRepo,
+	}
+}
+
+func (h *replayEventHandler) Handle(ctx context.Context, cmd *ReplayEventCommand) error {
+	if err := validator.Validate(cmd); err != nil {
+		return err
+	}
+
+	event, err := h.eventRepo.Get(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, eventbus.ErrEventNotFound) {
+			return apperrors.NewNotFoundError("event", cmd.ID)
+		}
+		if errors.Is(err, eventbus.ErrEventsNotPersisted) {
+			return apperrors.NewInvalidError(err.Error())
+		}
+		return apperrors.NewInternalError(err)
+	}
+	if event.Status != eventbus.EventStatusFailed && event.Status != eventbus.EventStatusDeadLetter {
+		return apperrors.NewInvalidError(fmt.Sprintf("event %s is %s, only failed or dead lettered event can be replayed", cmd.ID, event.Status))
+	}
+	if err := h.eventRepo.Requeue(ctx, event); err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	applog.Infow("event replayed", "id", event.EventID, "type", event.Type, "retryCount", event.RetryCount)
+	return nil
+}
@@ -23,6 +23,11 @@ func addEventHandle(eb eventbus.EventBus, readModel query.ReadModel, svc noteboo
 		eventbus: eb,
 		factory:  factory,
 	})
+	eb.Subscribe(notebook.CloneNotebooks, &cloneNotebooksHandler{
+		service:   svc,
+		readModel: readModel,
+		factory:   factory,
+	})
 }
 
 type workspaceDeleteHandler struct {
@@ -90,3 +95,40 @@ func (h *importNotebooksHandler) Handle(ctx context.Context, payload string) err
 	}
 	return nil
 }
+
+type cloneNotebooksHandler struct {
+	service   notebook.Service
+	readModel query.ReadModel
+	factory   *notebook.Factory
+}
+
+func (h *cloneNotebooksHandler) Handle(ctx context.Context, payload string) error {
+	log.Infow("start to consume clone notebooks event", "payload", payload)
+	event, err := workspace.NewCloneComponentEventFromPayload([]byte(payload))
+	if err != nil {
+		return fmt.Errorf("decode event payload fail: %w", err)
+	}
+	list, err := h.readModel.ListByWorkspace(ctx, event.SourceWorkspaceID)
+	if err != nil {
+		return fmt.Errorf("list workspace %s notebook fail: %w", event.SourceWorkspaceID, err)
+	}
+	for _, n := range list {
+		// content is not read when listing
+		source, err := h.readModel.Get(ctx, n.WorkspaceID, n.Name)
+		if err != nil {
+			return fmt.Errorf("get notebook %s/%s fail: %w", n.WorkspaceID, n.Name, err)
+		}
+		newNotebook, err := h.factory.New(&notebook.CreateParam{
+			Name:        source.Name,
+			WorkspaceID: event.WorkspaceID,
+			Content:     source.Content,
+		})
+		if err != nil {
+			return err
+		}
+		if err := h.service.Upsert(ctx, newNotebook); err != nil {
+			return err
+		}
+	}
+	return nil
+}
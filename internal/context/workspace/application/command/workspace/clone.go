@@ -0,0 +1,45 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type CloneWorkspaceHandler interface {
+	// Handle creates the workspace and starts cloning the source into it, returns the id of
+	// workspace and clone job
+	Handle(ctx context.Context, cmd *CloneWorkspaceCommand) (string, string, error)
+}
+
+type cloneWorkspaceHandlerImpl struct {
+	service workspace.Service
+}
+
+var _ CloneWorkspaceHandler = &cloneWorkspaceHandlerImpl{}
+
+func NewCloneWorkspaceHandler(service workspace.Service) CloneWorkspaceHandler {
+	return &cloneWorkspaceHandlerImpl{
+		service: service,
+	}
+}
+
+func (h *cloneWorkspaceHandlerImpl) Handle(ctx context.Context, cmd *CloneWorkspaceCommand) (string, string, error) {
+	if err := validator.Validate(cmd); err != nil {
+		return "", "", err
+	}
+	param := workspace.CreateWorkspaceParam{
+		Name:        cmd.Name,
+		Description: cmd.Description,
+		Owner:       cmd.Owner,
+	}
+	if cmd.Storage.NFS != nil {
+		param.Storage.NFS = &workspace.NFSStorage{MountPath: cmd.Storage.NFS.MountPath}
+	}
+	job, err := h.service.Clone(ctx, cmd.SourceID, param, cmd.IncludeStorage)
+	if err != nil {
+		return "", "", err
+	}
+	return job.WorkspaceID, job.ID, nil
+}
@@ -1,14 +1,23 @@
 package workspace
 
 import (
+	datamodelquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+	notebookquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/notebook"
+	workflowquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workflow"
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
 	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	"github.com/Bio-OS/bioos/pkg/utils/grpc"
 )
 
 type CreateWorkspaceCommand struct {
 	Name        string `validate:"required,resName"`
 	Description string `validate:"required,workspaceDesc"`
 	Storage     WorkspaceStorage
+	// EngineBackend is the default WES backend of workspace, empty means the default of server
+	EngineBackend string `validate:"omitempty,max=64"`
+	// Owner is the user creating the workspace, who becomes the first member with owner role
+	Owner string
 }
 
 type DeleteWorkspaceCommand struct {
@@ -19,6 +28,8 @@ type UpdateWorkspaceCommand struct {
 	ID          string  `validate:"required"`
 	Name        *string `validate:"omitempty,resName"`
 	Description *string `validate:"omitempty,workspaceDesc"`
+	// EngineBackend set to empty string means using the default of server
+	EngineBackend *string `validate:"omitempty,max=64"`
 }
 
 type WorkspaceStorage struct {
@@ -33,22 +44,70 @@ type ImportWorkspaceCommand struct {
 	ID       string `validate:"required"`
 	FileName string `validate:"required"`
 	Storage  WorkspaceStorage
+	// Owner is the user importing the workspace
+	Owner string
+}
+
+type ExportWorkspaceCommand struct {
+	ID string `validate:"required"`
+	// IncludeSubmissions also exports the submissions with the inputs and outputs of their runs
+	IncludeSubmissions bool
+}
+
+type CloneWorkspaceCommand struct {
+	// SourceID is the workspace cloned from
+	SourceID    string `validate:"required"`
+	Name        string `validate:"required,resName"`
+	Description string `validate:"required,workspaceDesc"`
+	Storage     WorkspaceStorage
+	// IncludeStorage also copies the files on the storage of source workspace, the storage must not
+	// be the same or nested with the source
+	IncludeStorage bool
+	// Owner is the user cloning the workspace
+	Owner string
+}
+
+type AddWorkspaceMemberCommand struct {
+	WorkspaceID string `validate:"required"`
+	UserName    string `validate:"required,max=64"`
+	Role        string `validate:"required,oneof=owner writer reader"`
+}
+
+type UpdateWorkspaceMemberCommand struct {
+	WorkspaceID string `validate:"required"`
+	UserName    string `validate:"required"`
+	Role        string `validate:"required,oneof=owner writer reader"`
+}
+
+type DeleteWorkspaceMemberCommand struct {
+	WorkspaceID string `validate:"required"`
+	UserName    string `validate:"required"`
 }
 
 type Commands struct {
 	CreateWorkspace CreateWorkspaceHandler
 	ImportWorkspace ImportWorkspaceHandler
+	ExportWorkspace ExportWorkspaceHandler
+	CloneWorkspace  CloneWorkspaceHandler
 	DeleteWorkspace DeleteWorkspaceHandler
 	UpdateWorkspace UpdateWorkspaceHandler
+	AddMember       AddWorkspaceMemberHandler
+	UpdateMember    UpdateWorkspaceMemberHandler
+	DeleteMember    DeleteWorkspaceMemberHandler
 }
 
-func NewCommands(workspaceRepo workspace.Repository, eventRepo eventbus.EventRepository, workspaceFactory *workspace.Factory, eventBus eventbus.EventBus) *Commands {
-	service := workspace.NewService(workspaceRepo, eventRepo, eventBus, *workspaceFactory)
-	addEventHandle(eventBus, workspaceRepo, eventRepo, workspaceFactory)
+func NewCommands(workspaceRepo workspace.Repository, importJobRepo workspace.ImportJobRepository, eventRepo eventbus.EventRepository, workspaceFactory *workspace.Factory, eventBus eventbus.EventBus, workspaceReadModel workspacequery.WorkspaceReadModel, notebookReadModel notebookquery.ReadModel, workflowReadModel workflowquery.ReadModel, dataModelReadModel datamodelquery.DataModelReadModel, grpcFactory grpc.Factory) *Commands {
+	service := workspace.NewService(workspaceRepo, importJobRepo, eventRepo, eventBus, *workspaceFactory)
+	addEventHandle(eventBus, workspaceRepo, importJobRepo, eventRepo, workspaceFactory)
 	return &Commands{
 		CreateWorkspace: NewCreateWorkspaceHandler(workspaceRepo, workspaceFactory, eventBus),
 		ImportWorkspace: NewImportWorkspaceHandler(service, workspaceFactory),
+		ExportWorkspace: NewExportWorkspaceHandler(workspaceReadModel, notebookReadModel, workflowReadModel, dataModelReadModel, grpcFactory),
+		CloneWorkspace:  NewCloneWorkspaceHandler(service),
 		DeleteWorkspace: NewDeleteWorkspaceHandler(workspaceRepo, eventBus),
 		UpdateWorkspace: NewUpdateWorkspaceHandler(workspaceRepo, eventBus),
+		AddMember:       NewAddWorkspaceMemberHandler(workspaceRepo),
+		UpdateMember:    NewUpdateWorkspaceMemberHandler(workspaceRepo),
+		DeleteMember:    NewDeleteWorkspaceMemberHandler(workspaceRepo),
 	}
 }
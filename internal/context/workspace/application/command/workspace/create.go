@@ -35,8 +35,10 @@ func (h *createWorkspaceHandler) Handle(ctx context.Context, cmd *CreateWorkspac
 	}
 
 	param := workspace.CreateWorkspaceParam{
-		Name:        cmd.Name,
-		Description: cmd.Description,
+		Name:          cmd.Name,
+		Description:   cmd.Description,
+		EngineBackend: cmd.EngineBackend,
+		Owner:         cmd.Owner,
 	}
 	if cmd.Storage.NFS != nil {
 		param.Storage.NFS = &workspace.NFSStorage{MountPath: cmd.Storage.NFS.MountPath}
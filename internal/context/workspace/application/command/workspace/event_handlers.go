@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"time"
 
+	"golang.org/x/exp/maps"
 	"gopkg.in/yaml.v3"
 
 	notebookserver "github.com/Bio-OS/bioos/internal/context/notebookserver/domain"
@@ -18,30 +20,104 @@ import (
 	"github.com/Bio-OS/bioos/pkg/consts"
 	applog "github.com/Bio-OS/bioos/pkg/log"
 	"github.com/Bio-OS/bioos/pkg/schema"
+	"github.com/Bio-OS/bioos/pkg/utils"
 )
 
+// importTimeout is the max time to import the components of workspace
+const importTimeout = 30 * time.Minute
+
+// importCheckInterval is the interval to check the progress of importing components
+const importCheckInterval = 5 * time.Second
+
+// importComponentEvents are the events importing the components of workspace
+var importComponentEvents = map[string]string{
+	notebook.ImportNotebooks:             workspace.ImportComponentNotebooks,
+	workflow.ImportWorkflows:             workspace.ImportComponentWorkflows,
+	datamodel.ImportDataModels:           workspace.ImportComponentDataModels,
+	notebookserver.ImportNotebookServers: workspace.ImportComponentNotebookServers,
+}
+
+// cloneComponentEvents are the events cloning the components of workspace
+var cloneComponentEvents = map[string]string{
+	notebook.CloneNotebooks:   workspace.ImportComponentNotebooks,
+	workflow.CloneWorkflows:   workspace.ImportComponentWorkflows,
+	datamodel.CloneDataModels: workspace.ImportComponentDataModels,
+	workspace.CloneStorage:    workspace.CloneComponentStorage,
+}
+
 func addEventHandle(eb eventbus.EventBus,
 	workspaceRepo workspace.Repository,
+	importJobRepo workspace.ImportJobRepository,
 	eventRepo eventbus.EventRepository,
 	workspaceFactory *workspace.Factory,
 
 ) {
+	tracker := &importTracker{
+		repo:          workspaceRepo,
+		importJobRepo: importJobRepo,
+		eventRepo:     eventRepo,
+		eventbus:      eb,
+	}
 	eb.Subscribe(workspace.ImportWorkspace, &importWorkspaceHandler{
-		repo:     workspaceRepo,
-		eventbus: eb,
-		factory:  workspaceFactory,
-	})
-	eb.Subscribe(workspace.WorkspaceImported, &workspaceImportedHandler{
-		repo:      workspaceRepo,
-		eventbus:  eb,
-		eventRepo: eventRepo,
+		importTracker: tracker,
+		factory:       workspaceFactory,
 	})
+	eb.Subscribe(workspace.WorkspaceImported, &workspaceImportedHandler{importTracker: tracker})
+	eb.Subscribe(workspace.CloneWorkspace, &cloneWorkspaceHandler{importTracker: tracker})
+	eb.Subscribe(workspace.WorkspaceCloned, &workspaceClonedHandler{importTracker: tracker})
+	eb.Subscribe(workspace.CloneStorage, &cloneStorageHandler{repo: workspaceRepo})
+	eb.Subscribe(eventbus.EventDeadLettered, &importDeadLetteredHandler{importTracker: tracker})
+}
+
+// importTracker updates the import or clone job and cleans the workspace if the job failed.
+type importTracker struct {
+	repo          workspace.Repository
+	importJobRepo workspace.ImportJobRepository
+	eventRepo     eventbus.EventRepository
+	eventbus      eventbus.EventBus
+}
+
+// getImportJob returns the import job, the events published before import jobs are tracked without saving.
+func (t *importTracker) getImportJob(ctx context.Context, jobID, workspaceID string) (*workspace.ImportJob, error) {
+	if jobID == "" {
+		job := workspace.NewImportJob(workspaceID)
+		job.ID = ""
+		return job, nil
+	}
+	return t.importJobRepo.Get(ctx, jobID)
+}
+
+func (t *importTracker) saveImportJob(ctx context.Context, job *workspace.ImportJob) error {
+	if job.ID == "" {
+		return nil
+	}
+	return t.importJobRepo.Save(ctx, job)
+}
+
+// fail marks the job failed, and deletes the workspace and the imported files if any.
+func (t *importTracker) fail(ctx context.Context, job *workspace.ImportJob, baseDir, reason string) error {
+	applog.Errorw("workspace job failed", "workspace", job.WorkspaceID, "job", job.ID, "type", job.Type, "reason", reason)
+	if baseDir != "" {
+		defer os.RemoveAll(baseDir)
+	}
+	// the workspace may not be created yet
+	if ws, err := t.repo.Get(ctx, job.WorkspaceID); err != nil {
+		applog.Infow("no workspace to delete", "workspace", job.WorkspaceID, "err", err)
+	} else {
+		if err := t.repo.Delete(ctx, ws); err != nil {
+			return err
+		}
+		if err := t.eventbus.Publish(ctx, workspace.NewWorkspaceDeletedEvent(ws.ID)); err != nil {
+			return err
+		}
+	}
+	job.Fail(reason)
+	return t.saveImportJob(ctx, job)
 }
 
 type importWorkspaceHandler struct {
-	repo     workspace.Repository
-	eventbus eventbus.EventBus
-	factory  *workspace.Factory
+	*importTracker
+	factory *workspace.Factory
 }
 
 func (h *importWorkspaceHandler) Handle(ctx context.Context, payload string) (err error) {
@@ -51,6 +127,17 @@ func (h *importWorkspaceHandler) Handle(ctx context.Context, payload string) (er
 	if err != nil {
 		return err
 	}
+	job, err := h.getImportJob(ctx, event.JobID, event.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if job.IsFinished() {
+		return nil
+	}
+	job.Start()
+	if err := h.saveImportJob(ctx, job); err != nil {
+		return err
+	}
 	baseDir := fmt.Sprintf(path.Join(event.Storage.NFS.MountPath, event.WorkspaceID))
 	bytes, err := os.ReadFile(path.Join(baseDir, consts.WorkspaceYAMLName))
 	if err != nil {
@@ -65,7 +152,7 @@ func (h *importWorkspaceHandler) Handle(ctx context.Context, payload string) (er
 	if err != nil {
 		return err
 	}
-	err = h.eventbus.Publish(ctx, workspace.NewWorkspaceImportedEvent(event.WorkspaceID, baseDir))
+	err = h.eventbus.Publish(ctx, workspace.NewWorkspaceImportedEvent(event.WorkspaceID, event.JobID, baseDir))
 	if err != nil {
 		return err
 	}
@@ -93,6 +180,7 @@ func (h *importWorkspaceHandler) createWorkspace(ctx context.Context, schema *sc
 		ID:          event.WorkspaceID,
 		Name:        schema.Name,
 		Description: schema.Description,
+		Owner:       event.Owner,
 	}
 	if event.Storage.NFS != nil {
 		param.Storage.NFS = &workspace.NFSStorage{MountPath: event.Storage.NFS.MountPath}
@@ -142,40 +230,72 @@ func (h *importWorkspaceHandler) publishNotebookServersEvent(ctx context.Context
 	return nil
 }
 
-func (h *workspaceImportedHandler) checkImportProcess(ctx context.Context, types []string, workspaceID string) (completed bool, hasFailed bool, err error) {
-	events, err := h.eventRepo.Search(ctx, &eventbus.Filter{
-		Type:    types,
-		Status:  []string{eventbus.EventStatusCompleted, eventbus.EventStatusFailed},
-		Payload: workspaceID,
+// track updates the job by the events of components until all of them finished, the event is delayed
+// to check again if any component is in progress.
+func (t *importTracker) track(ctx context.Context, job *workspace.ImportJob, componentEvents map[string]string, baseDir string) error {
+	completed, failure, err := t.checkComponents(ctx, job, componentEvents)
+	//not return error because we don't want to use retry mechanism in eventbus
+	if err != nil {
+		applog.Errorw("fail to check event status", "err", err)
+		return eventbus.NewErrEventRunningDelayed("checking workspace job process", importCheckInterval)
+	}
+	switch {
+	case failure != "":
+		return t.fail(ctx, job, baseDir, failure)
+	case completed:
+		applog.Infow("workspace job completed", "workspace", job.WorkspaceID, "job", job.ID, "type", job.Type)
+		if baseDir != "" {
+			os.RemoveAll(baseDir)
+		}
+		job.Succeed()
+		return t.saveImportJob(ctx, job)
+	case job.ID != "" && time.Since(job.CreatedAt) > importTimeout:
+		// prevent the dead cycle
+		return t.fail(ctx, job, baseDir, fmt.Sprintf("%s workspace timeout", strings.ToLower(job.Type)))
+	}
+	if err := t.saveImportJob(ctx, job); err != nil {
+		applog.Errorw("fail to save import job", "job", job.ID, "err", err)
+	}
+	return eventbus.NewErrEventRunningDelayed("workspace job is running", importCheckInterval)
+}
+
+// checkComponents updates the components of job by the events of them, returns whether all of
+// them completed and the failure of components.
+func (t *importTracker) checkComponents(ctx context.Context, job *workspace.ImportJob, componentEvents map[string]string) (completed bool, failure string, err error) {
+	events, err := t.eventRepo.Search(ctx, &eventbus.Filter{
+		Type: maps.Keys(componentEvents),
+		// the source workspace is also in the payload of clone events
+		Payload: fmt.Sprintf("%q:%q", "WorkspaceID", job.WorkspaceID),
 	})
 	if err != nil {
-		return false, false, err
+		return false, "", err
 	}
-	completedNum := 0
 	//workspaceID is unique in import event among all events, thus we will only get one corresponding event each types
+	failures := make([]string, 0)
 	for _, event := range events {
-		//TODO consider the situation that the event may mark failed in the future
-		// 1) short task and outdated
-		// 2) long time task
-		if event.Status == eventbus.EventStatusFailed {
-			return false, true, nil
+		component := componentEvents[event.Type]
+		state, message := importComponentState(event)
+		job.UpdateComponent(component, state, message)
+		if state == workspace.ImportJobFailed {
+			failures = append(failures, fmt.Sprintf("%s: %s", component, message))
 		}
-		if event.Status == eventbus.EventStatusCompleted {
-			completedNum += 1
-			if completedNum == len(types) {
-				return true, false, nil
-			}
+	}
+	if len(failures) > 0 {
+		return false, strings.Join(failures, "; "), nil
+	}
+	for _, component := range job.Components {
+		if component.State != workspace.ImportJobSucceeded {
+			return false, "", nil
 		}
 	}
-	return false, false, nil
+	return true, "", nil
 }
 
 type workspaceImportedHandler struct {
-	repo      workspace.Repository
-	eventRepo eventbus.EventRepository
-	eventbus  eventbus.EventBus
+	*importTracker
 }
 
+// Handle updates the import job by the events importing components until all of them finished.
 func (h *workspaceImportedHandler) Handle(ctx context.Context, payload string) error {
 	applog.Infow("start to consume workspace imported event", "payload", payload)
 
@@ -183,48 +303,180 @@ func (h *workspaceImportedHandler) Handle(ctx context.Context, payload string) e
 	if err != nil {
 		return err
 	}
-	defer os.RemoveAll(event.ImportBaseDir)
+	job, err := h.getImportJob(ctx, event.JobID, event.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if job.IsFinished() {
+		return nil
+	}
+	return h.track(ctx, job, importComponentEvents, event.ImportBaseDir)
+}
 
-	deadline := time.Now().Add(30 * time.Minute)
-	for {
-		time.Sleep(1 * time.Second)
-		// prevent the dead cycle
-		if time.Now().After(deadline) {
-			return fmt.Errorf("importing workspace timeout")
+type cloneWorkspaceHandler struct {
+	*importTracker
+}
+
+// Handle starts the clone job and publishes the events cloning components into the created workspace.
+func (h *cloneWorkspaceHandler) Handle(ctx context.Context, payload string) error {
+	applog.Infow("start to consume clone workspace event", "payload", payload)
+
+	event, err := workspace.NewCloneWorkspaceEventFromPayload([]byte(payload))
+	if err != nil {
+		return err
+	}
+	job, err := h.importJobRepo.Get(ctx, event.JobID)
+	if err != nil {
+		return err
+	}
+	if job.IsFinished() {
+		return nil
+	}
+	job.Start()
+	if err := h.saveImportJob(ctx, job); err != nil {
+		return err
+	}
+	if err := h.eventbus.Publish(ctx, workspace.NewWorkspaceClonedEvent(event.WorkspaceID, event.JobID)); err != nil {
+		return err
+	}
+	componentEvents := []string{notebook.CloneNotebooks, workflow.CloneWorkflows, datamodel.CloneDataModels}
+	if event.IncludeStorage {
+		componentEvents = append(componentEvents, workspace.CloneStorage)
+	}
+	for _, componentEvent := range componentEvents {
+		if err := h.eventbus.Publish(ctx, workspace.NewCloneComponentEvent(event.WorkspaceID, event.SourceWorkspaceID, componentEvent)); err != nil {
+			return err
 		}
-		completed, failed, err := h.checkImportProcess(ctx, []string{
-			notebook.ImportNotebooks,
-			workflow.ImportWorkflows,
-			notebookserver.ImportNotebookServers,
-			datamodel.ImportDataModels,
-		}, event.WorkspaceID)
-		//not return error because we don't want to use retry mechanism in eventbus
-		if err != nil {
-			applog.Errorw("fail to check event status", "err", err)
-			continue
+	}
+	return nil
+}
+
+type workspaceClonedHandler struct {
+	*importTracker
+}
+
+// Handle updates the clone job by the events cloning components until all of them finished.
+func (h *workspaceClonedHandler) Handle(ctx context.Context, payload string) error {
+	applog.Infow("start to consume workspace cloned event", "payload", payload)
+
+	event, err := workspace.NewWorkspaceClonedEventFromPayload([]byte(payload))
+	if err != nil {
+		return err
+	}
+	job, err := h.importJobRepo.Get(ctx, event.JobID)
+	if err != nil {
+		return err
+	}
+	if job.IsFinished() {
+		return nil
+	}
+	return h.track(ctx, job, cloneComponentEvents, "")
+}
+
+type cloneStorageHandler struct {
+	repo workspace.Repository
+}
+
+// Handle copies the files on the storage of source workspace to the storage of workspace.
+func (h *cloneStorageHandler) Handle(ctx context.Context, payload string) error {
+	applog.Infow("start to consume clone storage event", "payload", payload)
+
+	event, err := workspace.NewCloneComponentEventFromPayload([]byte(payload))
+	if err != nil {
+		return err
+	}
+	source, err := h.repo.Get(ctx, event.SourceWorkspaceID)
+	if err != nil {
+		return err
+	}
+	ws, err := h.repo.Get(ctx, event.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if source.Storage.NFS == nil || ws.Storage.NFS == nil {
+		return fmt.Errorf("workspace %s or %s has no file storage", source.ID, ws.ID)
+	}
+	return utils.CopyDir(source.Storage.NFS.MountPath, ws.Storage.NFS.MountPath)
+}
+
+// importComponentState returns the state of component imported by the event and the error of it.
+func importComponentState(event *eventbus.Event) (string, string) {
+	message := event.LastError
+	if message == "" {
+		message = event.Reason
+	}
+	switch event.Status {
+	case eventbus.EventStatusCompleted:
+		return workspace.ImportJobSucceeded, ""
+	case eventbus.EventStatusFailed, eventbus.EventStatusDeadLetter:
+		return workspace.ImportJobFailed, message
+	case eventbus.EventStatusRunning:
+		return workspace.ImportJobRunning, ""
+	default:
+		// the failed event is pending to retry
+		if event.RetryCount > 0 {
+			return workspace.ImportJobRunning, fmt.Sprintf("retrying: %s", message)
 		}
+		return workspace.ImportJobPending, ""
+	}
+}
+
+// importDeadLetteredHandler fails the import or clone job if the event creating or tracking workspace is dead lettered,
+// otherwise the job will never be finished.
+type importDeadLetteredHandler struct {
+	*importTracker
+}
 
-		if completed {
-			applog.Infof("%s importing completed", event.WorkspaceID)
-			break
-		} else if failed {
-			// clean workspace if import process failed
-			ws, err := h.repo.Get(ctx, event.WorkspaceID)
-			if err != nil {
-				applog.Errorw("fail to get workspace while deleting workspace", "err", err, "workspace", event.WorkspaceID)
-			}
-			err = h.repo.Delete(ctx, ws)
-			if err != nil {
-				applog.Errorw("fail to delete workspace", "err", err, "workspace", event.WorkspaceID)
-			}
-			deletedEvent := workspace.NewWorkspaceDeletedEvent(ws.ID)
-
-			if eventErr := h.eventbus.Publish(ctx, deletedEvent); eventErr != nil {
-				return eventErr
-			}
-			break
+func (h *importDeadLetteredHandler) Handle(ctx context.Context, payload string) error {
+	applog.Infow("start to consume import dead lettered event", "payload", payload)
 
+	deadLettered, err := eventbus.NewDeadLetteredEventFromPayload([]byte(payload))
+	if err != nil {
+		return err
+	}
+	if deadLettered.Event == nil {
+		return nil
+	}
+	var workspaceID, jobID, baseDir string
+	switch deadLettered.Event.Type {
+	case workspace.ImportWorkspace:
+		event, err := workspace.NewImportWorkspaceEventFromPayload([]byte(deadLettered.Event.Payload))
+		if err != nil {
+			return err
+		}
+		workspaceID, jobID = event.WorkspaceID, event.JobID
+		baseDir = path.Join(event.Storage.NFS.MountPath, event.WorkspaceID)
+	case workspace.WorkspaceImported:
+		event, err := workspace.NewWorkspaceImportedEventFromPayload([]byte(deadLettered.Event.Payload))
+		if err != nil {
+			return err
+		}
+		workspaceID, jobID, baseDir = event.WorkspaceID, event.JobID, event.ImportBaseDir
+	case workspace.CloneWorkspace:
+		event, err := workspace.NewCloneWorkspaceEventFromPayload([]byte(deadLettered.Event.Payload))
+		if err != nil {
+			return err
+		}
+		workspaceID, jobID = event.WorkspaceID, event.JobID
+	case workspace.WorkspaceCloned:
+		event, err := workspace.NewWorkspaceClonedEventFromPayload([]byte(deadLettered.Event.Payload))
+		if err != nil {
+			return err
 		}
+		workspaceID, jobID = event.WorkspaceID, event.JobID
+	default:
+		return nil
 	}
-	return nil
+	job, err := h.getImportJob(ctx, jobID, workspaceID)
+	if err != nil {
+		return err
+	}
+	if job.IsFinished() {
+		return nil
+	}
+	reason := deadLettered.Event.LastError
+	if reason == "" {
+		reason = deadLettered.Event.Reason
+	}
+	return h.fail(ctx, job, baseDir, reason)
 }
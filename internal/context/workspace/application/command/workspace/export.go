@@ -0,0 +1,367 @@
+package workspace
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	submissionproto "github.com/Bio-OS/bioos/internal/context/submission/interface/grpc/proto"
+	datamodelquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+	notebookquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/notebook"
+	workflowquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workflow"
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workflow"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/notebook"
+	"github.com/Bio-OS/bioos/pkg/schema"
+	"github.com/Bio-OS/bioos/pkg/utils"
+	"github.com/Bio-OS/bioos/pkg/utils/grpc"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+const exportPageSize = 100
+
+// ExportWorkspaceHandler writes the workspace to w as a zip in the same layout as the one imported,
+// which is workspace.yaml and the files of notebooks, workflows and data models it refers to.
+type ExportWorkspaceHandler interface {
+	Handle(ctx context.Context, cmd *ExportWorkspaceCommand, w io.Writer) error
+}
+
+type exportWorkspaceHandlerImpl struct {
+	workspaceReadModel workspacequery.WorkspaceReadModel
+	notebookReadModel  notebookquery.ReadModel
+	workflowReadModel  workflowquery.ReadModel
+	dataModelReadModel datamodelquery.DataModelReadModel
+	exportDataModel    datamodelquery.ExportDataModelHandler
+	// grpcFactory is nil if the client of server is not grpc, then submissions can not be exported
+	grpcFactory grpc.Factory
+}
+
+var _ ExportWorkspaceHandler = &exportWorkspaceHandlerImpl{}
+
+func NewExportWorkspaceHandler(workspaceReadModel workspacequery.WorkspaceReadModel, notebookReadModel notebookquery.ReadModel, workflowReadModel workflowquery.ReadModel, dataModelReadModel datamodelquery.DataModelReadModel, grpcFactory grpc.Factory) ExportWorkspaceHandler {
+	return &exportWorkspaceHandlerImpl{
+		workspaceReadModel: workspaceReadModel,
+		notebookReadModel:  notebookReadModel,
+		workflowReadModel:  workflowReadModel,
+		dataModelReadModel: dataModelReadModel,
+		exportDataModel:    datamodelquery.NewExportDataModelHandler(workspaceReadModel, dataModelReadModel),
+		grpcFactory:        grpcFactory,
+	}
+}
+
+func (h *exportWorkspaceHandlerImpl) Handle(ctx context.Context, cmd *ExportWorkspaceCommand, w io.Writer) error {
+	if err := validator.Validate(cmd); err != nil {
+		return err
+	}
+	if cmd.IncludeSubmissions && h.grpcFactory == nil {
+		return apperrors.NewInvalidError("submissions can only be exported if the client of server is grpc")
+	}
+	ws, err := h.workspaceReadModel.GetWorkspaceById(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	workspaceSchema := &schema.WorkspaceTypedSchema{
+		Name:        ws.Name,
+		Version:     consts.WorkspaceScopedSchemaVersion,
+		Description: ws.Description,
+	}
+	zipWriter := zip.NewWriter(w)
+	if err := h.exportNotebooks(ctx, ws.ID, workspaceSchema, zipWriter); err != nil {
+		return err
+	}
+	workflowNames, err := h.exportWorkflows(ctx, ws.ID, workspaceSchema, zipWriter)
+	if err != nil {
+		return err
+	}
+	if err := h.exportDataModels(ctx, ws.ID, workspaceSchema, zipWriter); err != nil {
+		return err
+	}
+	if cmd.IncludeSubmissions {
+		if err := h.exportSubmissions(ctx, ws.ID, workflowNames, workspaceSchema, zipWriter); err != nil {
+			return err
+		}
+	}
+
+	if err := writeYAMLToZip(zipWriter, consts.WorkspaceYAMLName, workspaceSchema); err != nil {
+		return err
+	}
+	if err := zipWriter.Close(); err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (h *exportWorkspaceHandlerImpl) exportNotebooks(ctx context.Context, workspaceID string, workspaceSchema *schema.WorkspaceTypedSchema, zipWriter *zip.Writer) error {
+	notebooks, err := h.notebookReadModel.ListByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	artifacts := make([]*schema.Artifact, 0, len(notebooks))
+	for _, nb := range notebooks {
+		artifact := &schema.Artifact{
+			Name: nb.Name,
+			Path: path.Join(consts.NotebookDirName, nb.Name+notebook.NotebookFileExt),
+		}
+		// the content is not read by listing
+		content, err := h.notebookReadModel.Get(ctx, workspaceID, nb.Name)
+		if err != nil {
+			return err
+		}
+		if err := writeFileToZip(zipWriter, artifact.Path, content.Content); err != nil {
+			return err
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	workspaceSchema.Notebooks = schema.NotebookTypedSchema{
+		Artifacts: artifacts,
+	}
+	return nil
+}
+
+// exportWorkflows exports the latest successful version of workflows, returns the name of workflows by id.
+func (h *exportWorkspaceHandlerImpl) exportWorkflows(ctx context.Context, workspaceID string, workspaceSchema *schema.WorkspaceTypedSchema, zipWriter *zip.Writer) (map[string]string, error) {
+	names := make(map[string]string)
+	workflowSchemas := make([]schema.WorkflowTypedSchema, 0)
+	for page := 1; ; page++ {
+		pg := utils.NewPagination(exportPageSize, page)
+		pg.Orders = []utils.Order{{Field: workflowquery.OrderByName, Ascending: true}}
+		workflows, total, err := h.workflowReadModel.List(ctx, workspaceID, pg, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, wf := range workflows {
+			names[wf.ID] = wf.Name
+			if wf.LatestVersion == nil || wf.LatestVersion.Status != workflow.WorkflowVersionSuccessStatus {
+				continue
+			}
+			workflowSchema, err := h.exportWorkflow(ctx, wf, zipWriter)
+			if err != nil {
+				return nil, err
+			}
+			workflowSchemas = append(workflowSchemas, *workflowSchema)
+		}
+		if len(workflows) == 0 || page*exportPageSize >= total {
+			break
+		}
+	}
+	workspaceSchema.Workflows = workflowSchemas
+	return names, nil
+}
+
+func (h *exportWorkspaceHandlerImpl) exportWorkflow(ctx context.Context, wf *workflowquery.Workflow, zipWriter *zip.Writer) (*schema.WorkflowTypedSchema, error) {
+	version := wf.LatestVersion
+	workflowSchema := &schema.WorkflowTypedSchema{
+		Name:             wf.Name,
+		Description:      utils.PointString(wf.Description),
+		Language:         version.Language,
+		Version:          utils.PointString(version.LanguageVersion),
+		MainWorkflowPath: version.MainWorkflowPath,
+		Path:             path.Join(consts.WorkflowDirName, wf.Name),
+	}
+	if version.Source == workflow.WorkflowSourceGit {
+		workflowSchema.Metadata = schema.WorkflowMetadata{
+			Tag:   version.Metadata[workflow.WorkflowGitTag],
+			Token: utils.PointString(version.Metadata[workflow.WorkflowGitToken]),
+		}
+		if gitURL, ok := version.Metadata[workflow.WorkflowGitURL]; ok {
+			parsedURL, err := url.Parse(gitURL)
+			if err != nil {
+				return nil, apperrors.NewInternalError(fmt.Errorf("parse git url of workflow %s: %w", wf.Name, err))
+			}
+			workflowSchema.Metadata.Scheme = parsedURL.Scheme
+			workflowSchema.Metadata.Repo = strings.TrimPrefix(gitURL, fmt.Sprintf("%s://", parsedURL.Scheme))
+		}
+	}
+
+	for page := 1; ; page++ {
+		files, total, err := h.workflowReadModel.ListFiles(ctx, version.ID, utils.NewPagination(exportPageSize, page), nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			content, err := base64.StdEncoding.DecodeString(file.Content)
+			if err != nil {
+				return nil, apperrors.NewInternalError(fmt.Errorf("decode workflow file %s: %w", file.Path, err))
+			}
+			if err := writeFileToZip(zipWriter, path.Join(workflowSchema.Path, file.Path), content); err != nil {
+				return nil, err
+			}
+		}
+		if len(files) == 0 || page*exportPageSize >= total {
+			break
+		}
+	}
+	return workflowSchema, nil
+}
+
+func (h *exportWorkspaceHandlerImpl) exportDataModels(ctx context.Context, workspaceID string, workspaceSchema *schema.WorkspaceTypedSchema, zipWriter *zip.Writer) error {
+	dataModels, err := h.dataModelReadModel.ListDataModels(ctx, workspaceID, nil)
+	if err != nil {
+		return err
+	}
+	dataModelSchemas := make([]schema.DataModelTypedSchema, 0, len(dataModels))
+	for _, dataModel := range dataModels {
+		typ := utils.GetDataModelType(dataModel.Name)
+		dataModelSchema := schema.DataModelTypedSchema{
+			Name: dataModel.Name,
+			Type: dataModelSchemaType(typ),
+			Path: path.Join(consts.DataModelDirName, dataModel.Name+".csv"),
+		}
+		if typ == consts.DataModelTypeEntity {
+			headers, err := h.dataModelReadModel.ListDataModelHeaders(ctx, dataModel.ID, dataModel.Name, typ)
+			if err != nil {
+				return err
+			}
+			headerTypes, err := h.dataModelReadModel.ListDataModelHeaderTypes(ctx, dataModel.ID, typ)
+			if err != nil {
+				return err
+			}
+			declaredHeaders, err := h.dataModelReadModel.ListEntityDataModelDeclaredHeaders(ctx, dataModel.ID)
+			if err != nil {
+				return err
+			}
+			declared := make(map[string]bool, len(declaredHeaders))
+			for _, header := range declaredHeaders {
+				declared[header] = true
+			}
+			// only the declared types are exported, the others are inferred again when imported
+			dataModelSchema.ColumnTypes = make(map[string]string, len(declaredHeaders))
+			for i, header := range headers {
+				if i < len(headerTypes) && declared[header] {
+					dataModelSchema.ColumnTypes[header] = headerTypes[i]
+				}
+			}
+		}
+		file, err := zipWriter.Create(dataModelSchema.Path)
+		if err != nil {
+			return apperrors.NewInternalError(err)
+		}
+		// rows are written to zip page by page
+		if err := h.exportDataModel.Handle(ctx, &datamodelquery.ExportDataModelQuery{
+			WorkspaceID: workspaceID,
+			ID:          dataModel.ID,
+			Format:      consts.DataModelExportFormatCSV,
+		}, file); err != nil {
+			return err
+		}
+		dataModelSchemas = append(dataModelSchemas, dataModelSchema)
+	}
+	workspaceSchema.DataModels = dataModelSchemas
+	return nil
+}
+
+// exportSubmissions exports submissions with the inputs and outputs of their runs, runs of each submission
+// are in a separate yaml file.
+func (h *exportWorkspaceHandlerImpl) exportSubmissions(ctx context.Context, workspaceID string, workflowNames map[string]string, workspaceSchema *schema.WorkspaceTypedSchema, zipWriter *zip.Writer) error {
+	client, err := h.grpcFactory.SubmissionClient()
+	if err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	submissionSchemas := make([]schema.SubmissionTypedSchema, 0)
+	for page := int32(1); ; page++ {
+		resp, err := client.ListSubmissions(ctx, &submissionproto.ListSubmissionsRequest{
+			WorkspaceID: workspaceID,
+			Page:        page,
+			Size:        exportPageSize,
+			OrderBy:     "name:asc",
+		})
+		if err != nil {
+			return apperrors.NewInternalError(err)
+		}
+		for _, item := range resp.GetItems() {
+			submissionSchema := schema.SubmissionTypedSchema{
+				Name:        item.GetName(),
+				Description: utils.PointString(item.GetDescription()),
+				Type:        item.GetType(),
+				Status:      item.GetStatus(),
+				Workflow:    workflowNames[item.GetWorkflowVersion().GetId()],
+				Path:        path.Join(consts.SubmissionDirName, item.GetName()+".yaml"),
+			}
+			runs, err := listAllRuns(ctx, client, workspaceID, item.GetId())
+			if err != nil {
+				return err
+			}
+			if err := writeYAMLToZip(zipWriter, submissionSchema.Path, runs); err != nil {
+				return err
+			}
+			submissionSchemas = append(submissionSchemas, submissionSchema)
+		}
+		if len(resp.GetItems()) == 0 || page*exportPageSize >= resp.GetTotal() {
+			break
+		}
+	}
+	workspaceSchema.Submissions = submissionSchemas
+	return nil
+}
+
+func listAllRuns(ctx context.Context, client grpc.SubmissionClient, workspaceID, submissionID string) ([]schema.RunTypedSchema, error) {
+	runs := make([]schema.RunTypedSchema, 0)
+	for page := int32(1); ; page++ {
+		resp, err := client.ListRuns(ctx, &submissionproto.ListRunsRequest{
+			WorkspaceID:  workspaceID,
+			SubmissionID: submissionID,
+			Page:         page,
+			Size:         exportPageSize,
+			OrderBy:      "name:asc",
+		})
+		if err != nil {
+			return nil, apperrors.NewInternalError(err)
+		}
+		for _, item := range resp.GetItems() {
+			run := schema.RunTypedSchema{
+				Name:    item.GetName(),
+				Status:  item.GetStatus(),
+				Inputs:  item.GetInputs(),
+				Outputs: item.GetOutputs(),
+			}
+			if item.GetMessage() != "" {
+				run.Message = utils.PointString(item.GetMessage())
+			}
+			runs = append(runs, run)
+		}
+		if len(resp.GetItems()) == 0 || page*exportPageSize >= resp.GetTotal() {
+			return runs, nil
+		}
+	}
+}
+
+func writeFileToZip(zipWriter *zip.Writer, name string, content []byte) error {
+	file, err := zipWriter.Create(name)
+	if err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	if _, err := file.Write(content); err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func writeYAMLToZip(zipWriter *zip.Writer, name string, in interface{}) error {
+	content, err := yaml.Marshal(in)
+	if err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	return writeFileToZip(zipWriter, name, content)
+}
+
+// dataModelSchemaType returns the type of data model in workspace.yaml.
+func dataModelSchemaType(dbType string) string {
+	switch dbType {
+	case consts.DataModelTypeEntitySet:
+		return "entitySet"
+	case consts.DataModelTypeWorkspace:
+		return "workspace"
+	default:
+		return "entity"
+	}
+}
@@ -0,0 +1,131 @@
+package workspace
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+
+	datamodelquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+	notebookquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/notebook"
+	workflowquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workflow"
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workflow"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	"github.com/Bio-OS/bioos/pkg/schema"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+type fakeWorkspaceReadModel struct {
+	workspacequery.WorkspaceReadModel
+}
+
+func (f *fakeWorkspaceReadModel) GetWorkspaceById(_ context.Context, id string) (*workspacequery.WorkspaceItem, error) {
+	return &workspacequery.WorkspaceItem{ID: id, Name: "ws", Description: "desc"}, nil
+}
+
+func (f *fakeWorkspaceReadModel) CountWorkspaces(context.Context, *workspacequery.ListWorkspacesFilter) (int, error) {
+	return 1, nil
+}
+
+type fakeNotebookReadModel struct {
+	notebookquery.ReadModel
+}
+
+func (f *fakeNotebookReadModel) ListByWorkspace(_ context.Context, workspaceID string) ([]*notebookquery.Notebook, error) {
+	return []*notebookquery.Notebook{{Name: "nb", WorkspaceID: workspaceID}}, nil
+}
+
+func (f *fakeNotebookReadModel) Get(_ context.Context, workspaceID, name string) (*notebookquery.Notebook, error) {
+	return &notebookquery.Notebook{Name: name, WorkspaceID: workspaceID, Content: []byte("{}")}, nil
+}
+
+type fakeWorkflowReadModel struct {
+	workflowquery.ReadModel
+}
+
+func (f *fakeWorkflowReadModel) List(context.Context, string, *utils.Pagination, *workflowquery.ListWorkflowsFilter) ([]*workflowquery.Workflow, int, error) {
+	return []*workflowquery.Workflow{
+		{ID: "wf-1", Name: "wf", LatestVersion: &workflowquery.WorkflowVersion{ID: "v-1", Status: workflow.WorkflowVersionSuccessStatus, Language: "WDL", MainWorkflowPath: "main.wdl", Source: workflow.WorkflowSourceFile}},
+		{ID: "wf-2", Name: "pending", LatestVersion: &workflowquery.WorkflowVersion{ID: "v-2", Status: workflow.WorkflowVersionPendingStatus}},
+	}, 2, nil
+}
+
+func (f *fakeWorkflowReadModel) ListFiles(_ context.Context, versionID string, _ *utils.Pagination, _ *workflowquery.ListWorkflowFilesFilter) ([]*workflowquery.WorkflowFile, int, error) {
+	return []*workflowquery.WorkflowFile{{WorkflowVersionID: versionID, Path: "main.wdl", Content: base64.StdEncoding.EncodeToString([]byte("version 1.0"))}}, 1, nil
+}
+
+type fakeDataModelReadModel struct {
+	datamodelquery.DataModelReadModel
+}
+
+func (f *fakeDataModelReadModel) ListDataModels(_ context.Context, workspaceID string, _ *datamodelquery.ListDataModelsFilter) ([]*datamodelquery.DataModel, error) {
+	return []*datamodelquery.DataModel{{ID: "dm-1", Name: "sample", RowCount: 1, Type: consts.DataModelTypeEntity, WorkspaceID: workspaceID}}, nil
+}
+
+func (f *fakeDataModelReadModel) GetDataModelName(context.Context, string, string) (string, error) {
+	return "sample", nil
+}
+
+func (f *fakeDataModelReadModel) ListDataModelHeaders(context.Context, string, string, string) ([]string, error) {
+	return []string{"sample_id", "path"}, nil
+}
+
+func (f *fakeDataModelReadModel) ListDataModelHeaderTypes(context.Context, string, string) ([]string, error) {
+	return []string{consts.DataModelColumnTypeString, consts.DataModelColumnTypeFile}, nil
+}
+
+func (f *fakeDataModelReadModel) ListEntityDataModelDeclaredHeaders(context.Context, string) ([]string, error) {
+	return []string{"path"}, nil
+}
+
+func (f *fakeDataModelReadModel) ListDataModelRowsAfter(_ context.Context, _, _, afterRowID string, _ int, _ *datamodelquery.ListDataModelRowsFilter) ([][]string, error) {
+	if afterRowID >= "s1" {
+		return [][]string{}, nil
+	}
+	return [][]string{{"s1", "/a"}}, nil
+}
+
+func TestExportWorkspace(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	handler := NewExportWorkspaceHandler(&fakeWorkspaceReadModel{}, &fakeNotebookReadModel{}, &fakeWorkflowReadModel{}, &fakeDataModelReadModel{}, nil)
+	buf := &bytes.Buffer{}
+	g.Expect(handler.Handle(context.TODO(), &ExportWorkspaceCommand{ID: "ws-1"}, buf)).ToNot(gomega.HaveOccurred())
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	files := make(map[string]string)
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		content, err := io.ReadAll(rc)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		rc.Close()
+		files[file.Name] = string(content)
+	}
+	g.Expect(files).To(gomega.HaveKeyWithValue("notebook/nb.ipynb", "{}"))
+	g.Expect(files).To(gomega.HaveKeyWithValue("workflow/wf/main.wdl", "version 1.0"))
+	g.Expect(files).To(gomega.HaveKey("data/sample.csv"))
+	g.Expect(files["data/sample.csv"]).To(gomega.ContainSubstring("sample_id,path\ns1,/a\n"))
+
+	workspaceSchema := &schema.WorkspaceTypedSchema{}
+	g.Expect(yaml.Unmarshal([]byte(files[consts.WorkspaceYAMLName]), workspaceSchema)).To(gomega.Succeed())
+	g.Expect(workspaceSchema.Name).To(gomega.Equal("ws"))
+	g.Expect(workspaceSchema.Notebooks.Artifacts).To(gomega.HaveLen(1))
+	g.Expect(workspaceSchema.Workflows).To(gomega.HaveLen(1))
+	g.Expect(workspaceSchema.Workflows[0].Path).To(gomega.Equal("workflow/wf"))
+	g.Expect(workspaceSchema.DataModels).To(gomega.HaveLen(1))
+	g.Expect(workspaceSchema.DataModels[0].Type).To(gomega.Equal("entity"))
+	g.Expect(workspaceSchema.DataModels[0].ColumnTypes).To(gomega.Equal(map[string]string{"path": "file"}))
+	g.Expect(workspaceSchema.Submissions).To(gomega.BeEmpty())
+
+	// submissions need the grpc client
+	err = handler.Handle(context.TODO(), &ExportWorkspaceCommand{ID: "ws-1", IncludeSubmissions: true}, io.Discard)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
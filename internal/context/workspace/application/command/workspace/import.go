@@ -8,7 +8,8 @@ import (
 )
 
 type ImportWorkspaceHandler interface {
-	Handle(ctx context.Context, cmd *ImportWorkspaceCommand) error
+	// Handle starts importing the workspace, returns the id of import job
+	Handle(ctx context.Context, cmd *ImportWorkspaceCommand) (string, error)
 }
 
 type importWorkspaceHandlerImpl struct {
@@ -25,16 +26,17 @@ func NewImportWorkspaceHandler(service workspace.Service, workspaceFactory *work
 	}
 }
 
-func (h *importWorkspaceHandlerImpl) Handle(ctx context.Context, cmd *ImportWorkspaceCommand) error {
+func (h *importWorkspaceHandlerImpl) Handle(ctx context.Context, cmd *ImportWorkspaceCommand) (string, error) {
 	if err := validator.Validate(cmd); err != nil {
-		return err
+		return "", err
 	}
-	if err := h.service.Import(ctx, cmd.ID, cmd.FileName, workspace.Storage{
+	job, err := h.service.Import(ctx, cmd.ID, cmd.FileName, workspace.Storage{
 		NFS: &workspace.NFSStorage{
 			MountPath: cmd.Storage.NFS.MountPath,
 		},
-	}); err != nil {
-		return err
+	}, cmd.Owner)
+	if err != nil {
+		return "", err
 	}
-	return nil
+	return job.ID, nil
 }
@@ -0,0 +1,98 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type AddWorkspaceMemberHandler interface {
+	Handle(ctx context.Context, cmd *AddWorkspaceMemberCommand) error
+}
+
+type addWorkspaceMemberHandler struct {
+	workspaceRepo workspace.Repository
+}
+
+var _ AddWorkspaceMemberHandler = &addWorkspaceMemberHandler{}
+
+func NewAddWorkspaceMemberHandler(workspaceRepo workspace.Repository) AddWorkspaceMemberHandler {
+	return &addWorkspaceMemberHandler{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+func (h *addWorkspaceMemberHandler) Handle(ctx context.Context, cmd *AddWorkspaceMemberCommand) error {
+	if err := validator.Validate(cmd); err != nil {
+		return err
+	}
+	ws, err := h.workspaceRepo.Get(ctx, cmd.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if err := ws.AddMember(cmd.UserName, cmd.Role); err != nil {
+		return err
+	}
+	return h.workspaceRepo.Save(ctx, ws)
+}
+
+type UpdateWorkspaceMemberHandler interface {
+	Handle(ctx context.Context, cmd *UpdateWorkspaceMemberCommand) error
+}
+
+type updateWorkspaceMemberHandler struct {
+	workspaceRepo workspace.Repository
+}
+
+var _ UpdateWorkspaceMemberHandler = &updateWorkspaceMemberHandler{}
+
+func NewUpdateWorkspaceMemberHandler(workspaceRepo workspace.Repository) UpdateWorkspaceMemberHandler {
+	return &updateWorkspaceMemberHandler{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+func (h *updateWorkspaceMemberHandler) Handle(ctx context.Context, cmd *UpdateWorkspaceMemberCommand) error {
+	if err := validator.Validate(cmd); err != nil {
+		return err
+	}
+	ws, err := h.workspaceRepo.Get(ctx, cmd.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if err := ws.UpdateMember(cmd.UserName, cmd.Role); err != nil {
+		return err
+	}
+	return h.workspaceRepo.Save(ctx, ws)
+}
+
+type DeleteWorkspaceMemberHandler interface {
+	Handle(ctx context.Context, cmd *DeleteWorkspaceMemberCommand) error
+}
+
+type deleteWorkspaceMemberHandler struct {
+	workspaceRepo workspace.Repository
+}
+
+var _ DeleteWorkspaceMemberHandler = &deleteWorkspaceMemberHandler{}
+
+func NewDeleteWorkspaceMemberHandler(workspaceRepo workspace.Repository) DeleteWorkspaceMemberHandler {
+	return &deleteWorkspaceMemberHandler{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+func (h *deleteWorkspaceMemberHandler) Handle(ctx context.Context, cmd *DeleteWorkspaceMemberCommand) error {
+	if err := validator.Validate(cmd); err != nil {
+		return err
+	}
+	ws, err := h.workspaceRepo.Get(ctx, cmd.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if err := ws.RemoveMember(cmd.UserName); err != nil {
+		return err
+	}
+	return h.workspaceRepo.Save(ctx, ws)
+}
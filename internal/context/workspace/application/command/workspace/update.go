@@ -39,6 +39,9 @@ func (d updateWorkspaceHandler) Handle(ctx context.Context, cmd *UpdateWorkspace
 	if cmd.Description != nil {
 		ws.UpdateDescription(*cmd.Description)
 	}
+	if cmd.EngineBackend != nil {
+		ws.UpdateEngineBackend(*cmd.EngineBackend)
+	}
 
 	return d.workspaceRepo.Save(ctx, ws)
 }
@@ -18,12 +18,25 @@ type DataModelReadModel interface {
 	GetDataModelWithName(ctx context.Context, workspaceID, name string) (*DataModel, error)
 
 	ListDataModelHeaders(ctx context.Context, id, name, _type string) ([]string, error)
+	// ListDataModelHeaderTypes lists the column types aligned with the headers
+	ListDataModelHeaderTypes(ctx context.Context, id, _type string) ([]string, error)
 	ListEntityDataModelHeaders(ctx context.Context, id string) ([]string, error)
+	// ListEntityDataModelDeclaredHeaders lists the headers whose column types are declared rather than inferred
+	ListEntityDataModelDeclaredHeaders(ctx context.Context, id string) ([]string, error)
 
 	ListEntityDataModelColumnsWithRowIDs(ctx context.Context, id string, headers []string, rowIDs []string) (map[string][]string, error)
 
 	ListDataModelRows(ctx context.Context, id, _type string, pagination *utils.Pagination, order *utils.Order, filter *ListDataModelRowsFilter) ([][]string, int64, error)
+	// ListDataModelRowsAfter lists at most size rows whose row id is greater than afterRowID in the order of row id,
+	// which reads the data model page by page without offset
+	ListDataModelRowsAfter(ctx context.Context, id, _type, afterRowID string, size int, filter *ListDataModelRowsFilter) ([][]string, error)
 
 	ListAllDataModelRowIDs(ctx context.Context, id, _type string) ([]string, error)
 	CountDataModelRows(ctx context.Context, id, _type string, filter *ListDataModelRowsFilter) (int64, error)
+
+	// ListDataModelSnapshots lists the snapshots of data model, the latest first
+	ListDataModelSnapshots(ctx context.Context, dataModelID string) ([]*DataModelSnapshot, error)
+	GetDataModelSnapshot(ctx context.Context, id string) (*DataModelSnapshot, error)
+	// ListDataModelSnapshotRows lists the rows of snapshot in the order of row id, all rows are listed if rowIDs is empty
+	ListDataModelSnapshotRows(ctx context.Context, id string, rowIDs []string) ([][]string, error)
 }
@@ -0,0 +1,58 @@
+package datamodel
+
+import (
+	"context"
+
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+// DataModelContent is the headers, column types and all rows of a data model or its snapshot.
+type DataModelContent struct {
+	Headers []string
+	// HeaderTypes are the column types aligned with Headers
+	HeaderTypes []string
+	Rows        [][]string
+}
+
+// listDataModelContent reads the whole content of data model page by page in the order of row id.
+func listDataModelContent(ctx context.Context, dataModelReadModel DataModelReadModel, id, name string) (*DataModelContent, error) {
+	typ := utils.GetDataModelType(name)
+	headers, err := dataModelReadModel.ListDataModelHeaders(ctx, id, name, typ)
+	if err != nil {
+		return nil, err
+	}
+	headerTypes, err := dataModelReadModel.ListDataModelHeaderTypes(ctx, id, typ)
+	if err != nil {
+		return nil, err
+	}
+	content := &DataModelContent{
+		Headers:     headers,
+		HeaderTypes: headerTypes,
+		Rows:        make([][]string, 0),
+	}
+	afterRowID := ""
+	for {
+		rows, err := dataModelReadModel.ListDataModelRowsAfter(ctx, id, typ, afterRowID, exportDataModelPageSize, &ListDataModelRowsFilter{})
+		if err != nil {
+			return nil, err
+		}
+		content.Rows = append(content.Rows, rows...)
+		if len(rows) < exportDataModelPageSize {
+			return content, nil
+		}
+		afterRowID = rows[len(rows)-1][0]
+	}
+}
+
+// getDataModelSnapshot gets the snapshot and checks it is taken from the data model in workspace.
+func getDataModelSnapshot(ctx context.Context, dataModelReadModel DataModelReadModel, workspaceID, dataModelID, id string) (*DataModelSnapshot, error) {
+	snapshot, err := dataModelReadModel.GetDataModelSnapshot(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.WorkspaceID != workspaceID || snapshot.DataModelID != dataModelID {
+		return nil, apperrors.NewNotFoundError("data model snapshot", id)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,153 @@
+package datamodel
+
+import (
+	"context"
+
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+// DiffDataModelSnapshotsHandler compares a snapshot with another snapshot or the current content
+// of data model.
+type DiffDataModelSnapshotsHandler interface {
+	Handle(ctx context.Context, query *DiffDataModelSnapshotsQuery) (*DataModelSnapshotDiff, error)
+}
+
+type diffDataModelSnapshotsHandler struct {
+	workspaceReadModel workspacequery.WorkspaceReadModel
+	dataModelReadModel DataModelReadModel
+}
+
+var _ DiffDataModelSnapshotsHandler = &diffDataModelSnapshotsHandler{}
+
+func NewDiffDataModelSnapshotsHandler(workspaceReadModel workspacequery.WorkspaceReadModel, dataModelReadModel DataModelReadModel) DiffDataModelSnapshotsHandler {
+	return &diffDataModelSnapshotsHandler{
+		workspaceReadModel,
+		dataModelReadModel,
+	}
+}
+
+func (d *diffDataModelSnapshotsHandler) Handle(ctx context.Context, query *DiffDataModelSnapshotsQuery) (*DataModelSnapshotDiff, error) {
+	if err := validator.Validate(query); err != nil {
+		return nil, err
+	}
+
+	if err := workspacequery.CheckWorkspaceExist(ctx, d.workspaceReadModel, query.WorkspaceID); err != nil {
+		return nil, err
+	}
+	from, err := d.snapshotContent(ctx, query.WorkspaceID, query.DataModelID, query.From)
+	if err != nil {
+		return nil, err
+	}
+	var to *DataModelContent
+	if query.To != "" {
+		to, err = d.snapshotContent(ctx, query.WorkspaceID, query.DataModelID, query.To)
+	} else {
+		var name string
+		if name, err = d.dataModelReadModel.GetDataModelName(ctx, query.WorkspaceID, query.DataModelID); err != nil {
+			return nil, err
+		}
+		to, err = listDataModelContent(ctx, d.dataModelReadModel, query.DataModelID, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return diffDataModelContents(from, to), nil
+}
+
+func (d *diffDataModelSnapshotsHandler) snapshotContent(ctx context.Context, workspaceID, dataModelID, id string) (*DataModelContent, error) {
+	snapshot, err := getDataModelSnapshot(ctx, d.dataModelReadModel, workspaceID, dataModelID, id)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.dataModelReadModel.ListDataModelSnapshotRows(ctx, snapshot.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DataModelContent{
+		Headers:     snapshot.Headers,
+		HeaderTypes: snapshot.HeaderTypes,
+		Rows:        rows,
+	}, nil
+}
+
+// diffDataModelContents compares the columns and rows from one content to another, rows are
+// matched by the id in the first grid.
+func diffDataModelContents(from, to *DataModelContent) *DataModelSnapshotDiff {
+	diff := &DataModelSnapshotDiff{
+		AddedColumns:   make([]string, 0),
+		RemovedColumns: make([]string, 0),
+		AddedRowIDs:    make([]string, 0),
+		RemovedRowIDs:  make([]string, 0),
+		ChangedRows:    make([]*DataModelRowDiff, 0),
+	}
+	fromIndexes := headerIndexes(from.Headers)
+	toIndexes := headerIndexes(to.Headers)
+	columns := append([]string{}, from.Headers...)
+	for _, header := range to.Headers {
+		if _, ok := fromIndexes[header]; !ok {
+			diff.AddedColumns = append(diff.AddedColumns, header)
+			columns = append(columns, header)
+		}
+	}
+	for _, header := range from.Headers {
+		if _, ok := toIndexes[header]; !ok {
+			diff.RemovedColumns = append(diff.RemovedColumns, header)
+		}
+	}
+
+	fromRows := make(map[string][]string, len(from.Rows))
+	for _, row := range from.Rows {
+		if len(row) != 0 {
+			fromRows[row[0]] = row
+		}
+	}
+	toRowIDs := make(map[string]struct{}, len(to.Rows))
+	for _, row := range to.Rows {
+		if len(row) == 0 {
+			continue
+		}
+		toRowIDs[row[0]] = struct{}{}
+		fromRow, ok := fromRows[row[0]]
+		if !ok {
+			diff.AddedRowIDs = append(diff.AddedRowIDs, row[0])
+			continue
+		}
+		var grids []*DataModelGridDiff
+		for _, column := range columns {
+			fromGrid, toGrid := gridOfColumn(fromRow, fromIndexes, column), gridOfColumn(row, toIndexes, column)
+			if fromGrid != toGrid {
+				grids = append(grids, &DataModelGridDiff{Column: column, From: fromGrid, To: toGrid})
+			}
+		}
+		if len(grids) != 0 {
+			diff.ChangedRows = append(diff.ChangedRows, &DataModelRowDiff{RowID: row[0], Grids: grids})
+		}
+	}
+	for _, row := range from.Rows {
+		if len(row) == 0 {
+			continue
+		}
+		if _, ok := toRowIDs[row[0]]; !ok {
+			diff.RemovedRowIDs = append(diff.RemovedRowIDs, row[0])
+		}
+	}
+	return diff
+}
+
+func headerIndexes(headers []string) map[string]int {
+	indexes := make(map[string]int, len(headers))
+	for index, header := range headers {
+		indexes[header] = index
+	}
+	return indexes
+}
+
+// gridOfColumn returns the grid of column in row, the absent column or trailing grid is empty.
+func gridOfColumn(row []string, indexes map[string]int, column string) string {
+	index, ok := indexes[column]
+	if !ok || index >= len(row) {
+		return ""
+	}
+	return row[index]
+}
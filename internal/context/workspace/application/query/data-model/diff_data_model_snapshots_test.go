@@ -0,0 +1,48 @@
+package datamodel
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestDiffDataModelContents(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	from := &DataModelContent{
+		Headers: []string{"sample_id", "bam", "vcf"},
+		Rows: [][]string{
+			{"s1", "s3://bucket/1.bam", "s3://bucket/1.vcf"},
+			{"s2", "s3://bucket/2.bam"},
+			{"s3", "s3://bucket/3.bam", ""},
+		},
+	}
+	to := &DataModelContent{
+		Headers: []string{"sample_id", "bam", "qc"},
+		Rows: [][]string{
+			{"s1", "s3://bucket/1.bam", "pass"},
+			{"s2", "s3://bucket/2.bam"},
+			{"s4", "s3://bucket/4.bam", "fail"},
+		},
+	}
+	diff := diffDataModelContents(from, to)
+	g.Expect(diff.AddedColumns).To(gomega.Equal([]string{"qc"}))
+	g.Expect(diff.RemovedColumns).To(gomega.Equal([]string{"vcf"}))
+	g.Expect(diff.AddedRowIDs).To(gomega.Equal([]string{"s4"}))
+	g.Expect(diff.RemovedRowIDs).To(gomega.Equal([]string{"s3"}))
+	// the absent grids of s2 equal to empty ones
+	g.Expect(diff.ChangedRows).To(gomega.Equal([]*DataModelRowDiff{{
+		RowID: "s1",
+		Grids: []*DataModelGridDiff{
+			{Column: "vcf", From: "s3://bucket/1.vcf", To: ""},
+			{Column: "qc", From: "", To: "pass"},
+		},
+	}}))
+
+	diff = diffDataModelContents(from, from)
+	g.Expect(diff.AddedColumns).To(gomega.BeEmpty())
+	g.Expect(diff.RemovedColumns).To(gomega.BeEmpty())
+	g.Expect(diff.AddedRowIDs).To(gomega.BeEmpty())
+	g.Expect(diff.RemovedRowIDs).To(gomega.BeEmpty())
+	g.Expect(diff.ChangedRows).To(gomega.BeEmpty())
+}
@@ -0,0 +1,120 @@
+package datamodel
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/utils"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+// exportDataModelPageSize is the number of rows read from read model for each write, so that the
+// data model is never loaded into memory as a whole.
+const exportDataModelPageSize = 1000
+
+// ExportDataModelHandler writes the rows of data model to w in csv or tsv with headers, page by
+// page in the order of row id, each page starts after the last row id of the previous one.
+type ExportDataModelHandler interface {
+	Handle(ctx context.Context, query *ExportDataModelQuery, w io.Writer) error
+}
+
+type exportDataModelHandler struct {
+	workspaceReadModel workspacequery.WorkspaceReadModel
+	dataModelReadModel DataModelReadModel
+}
+
+var _ ExportDataModelHandler = &exportDataModelHandler{}
+
+func NewExportDataModelHandler(workspaceReadModel workspacequery.WorkspaceReadModel, dataModelReadModel DataModelReadModel) ExportDataModelHandler {
+	return &exportDataModelHandler{
+		workspaceReadModel,
+		dataModelReadModel,
+	}
+}
+
+func (e *exportDataModelHandler) Handle(ctx context.Context, query *ExportDataModelQuery, w io.Writer) error {
+	if err := validator.Validate(query); err != nil {
+		return err
+	}
+
+	if err := workspacequery.CheckWorkspaceExist(ctx, e.workspaceReadModel, query.WorkspaceID); err != nil {
+		return err
+	}
+	dataModelName, err := e.dataModelReadModel.GetDataModelName(ctx, query.WorkspaceID, query.ID)
+	if err != nil {
+		return err
+	}
+	typ := utils.GetDataModelType(dataModelName)
+	headers, err := e.dataModelReadModel.ListDataModelHeaders(ctx, query.ID, dataModelName, typ)
+	if err != nil {
+		return err
+	}
+	filter := query.Filter
+	if filter == nil {
+		filter = &ListDataModelRowsFilter{}
+	}
+	if filter.Where != nil {
+		if err := filter.Where.ResolveColumns(headers); err != nil {
+			return err
+		}
+	}
+	columns, columnIndexes, err := exportColumns(headers, query.Columns)
+	if err != nil {
+		return err
+	}
+
+	writer := utils.NewDataModelWriter(w, query.Format)
+	if err := writer.Write(columns); err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	record := make([]string, len(columnIndexes))
+	// the first column of rows is the row id
+	afterRowID := ""
+	for {
+		rows, err := e.dataModelReadModel.ListDataModelRowsAfter(ctx, query.ID, typ, afterRowID, exportDataModelPageSize, filter)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			for i, index := range columnIndexes {
+				// the trailing empty grids of entity rows may be absent
+				record[i] = ""
+				if index < len(row) {
+					record[i] = row[index]
+				}
+			}
+			if err := writer.Write(record); err != nil {
+				return apperrors.NewInternalError(err)
+			}
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return apperrors.NewInternalError(err)
+		}
+		if len(rows) < exportDataModelPageSize {
+			return nil
+		}
+		afterRowID = rows[len(rows)-1][0]
+	}
+}
+
+// exportColumns returns the exported columns with their indexes in headers, all headers are
+// exported if columns is empty.
+func exportColumns(headers, columns []string) ([]string, []int, error) {
+	if len(columns) == 0 {
+		columns = headers
+	}
+	indexes := headerIndexes(headers)
+	columnIndexes := make([]int, 0, len(columns))
+	for _, column := range columns {
+		index, ok := indexes[column]
+		if !ok {
+			return nil, nil, apperrors.NewInvalidError("columns", fmt.Sprintf("unknown column %s", column))
+		}
+		columnIndexes = append(columnIndexes, index)
+	}
+	return columns, columnIndexes, nil
+}
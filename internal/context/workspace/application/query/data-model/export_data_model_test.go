@@ -0,0 +1,83 @@
+package datamodel
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	"github.com/Bio-OS/bioos/pkg/consts"
+)
+
+type fakeWorkspaceReadModel struct {
+	workspacequery.WorkspaceReadModel
+}
+
+func (f *fakeWorkspaceReadModel) CountWorkspaces(context.Context, *workspacequery.ListWorkspacesFilter) (int, error) {
+	return 1, nil
+}
+
+// fakeDataModelReadModel has an entity data model of rowCount rows, the last column of odd rows
+// is absent.
+type fakeDataModelReadModel struct {
+	DataModelReadModel
+	rowCount int
+	// afterRowIDs are the row ids each page is read after
+	afterRowIDs []string
+}
+
+func (f *fakeDataModelReadModel) GetDataModelName(context.Context, string, string) (string, error) {
+	return "sample", nil
+}
+
+func (f *fakeDataModelReadModel) ListDataModelHeaders(context.Context, string, string, string) ([]string, error) {
+	return []string{"sample_id", "path", "note"}, nil
+}
+
+func (f *fakeDataModelReadModel) ListDataModelRowsAfter(_ context.Context, _, _, afterRowID string, size int, _ *ListDataModelRowsFilter) ([][]string, error) {
+	f.afterRowIDs = append(f.afterRowIDs, afterRowID)
+	rows := make([][]string, 0, size)
+	for i := 0; i < f.rowCount && len(rows) < size; i++ {
+		row := []string{fmt.Sprintf("s%07d", i), fmt.Sprintf("/data/%d.bam", i), "x,y"}
+		if row[0] <= afterRowID {
+			continue
+		}
+		if i%2 == 1 {
+			row = row[:2]
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func TestExportDataModel(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	readModel := &fakeDataModelReadModel{rowCount: 2*exportDataModelPageSize + 1}
+	handler := NewExportDataModelHandler(&fakeWorkspaceReadModel{}, readModel)
+	buf := &bytes.Buffer{}
+	g.Expect(handler.Handle(context.TODO(), &ExportDataModelQuery{WorkspaceID: "ws-1", ID: "dm-1"}, buf)).To(gomega.Succeed())
+	g.Expect(readModel.afterRowIDs).To(gomega.Equal([]string{"", fmt.Sprintf("s%07d", exportDataModelPageSize-1), fmt.Sprintf("s%07d", 2*exportDataModelPageSize-1)}))
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	g.Expect(lines).To(gomega.HaveLen(readModel.rowCount + 1))
+	g.Expect(lines[0]).To(gomega.Equal("\ufeffsample_id,path,note"))
+	g.Expect(lines[1]).To(gomega.Equal(`s0000000,/data/0.bam,"x,y"`))
+	g.Expect(lines[2]).To(gomega.Equal("s0000001,/data/1.bam,"))
+
+	readModel = &fakeDataModelReadModel{rowCount: 2}
+	handler = NewExportDataModelHandler(&fakeWorkspaceReadModel{}, readModel)
+	buf.Reset()
+	g.Expect(handler.Handle(context.TODO(), &ExportDataModelQuery{
+		WorkspaceID: "ws-1",
+		ID:          "dm-1",
+		Format:      consts.DataModelExportFormatTSV,
+		Columns:     []string{"note", "sample_id"},
+	}, buf)).To(gomega.Succeed())
+	g.Expect(buf.String()).To(gomega.Equal("note\tsample_id\nx,y\ts0000000\n\ts0000001\n"))
+
+	g.Expect(handler.Handle(context.TODO(), &ExportDataModelQuery{WorkspaceID: "ws-1", ID: "dm-1", Columns: []string{"size"}}, buf)).ToNot(gomega.Succeed())
+}
@@ -0,0 +1,46 @@
+package datamodel
+
+import (
+	"context"
+
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+// GetDataModelSnapshotHandler returns the snapshot with its rows.
+type GetDataModelSnapshotHandler interface {
+	Handle(ctx context.Context, query *GetDataModelSnapshotQuery) (*DataModelSnapshot, [][]string, error)
+}
+
+type getDataModelSnapshotHandler struct {
+	workspaceReadModel workspacequery.WorkspaceReadModel
+	dataModelReadModel DataModelReadModel
+}
+
+var _ GetDataModelSnapshotHandler = &getDataModelSnapshotHandler{}
+
+func NewGetDataModelSnapshotHandler(workspaceReadModel workspacequery.WorkspaceReadModel, dataModelReadModel DataModelReadModel) GetDataModelSnapshotHandler {
+	return &getDataModelSnapshotHandler{
+		workspaceReadModel,
+		dataModelReadModel,
+	}
+}
+
+func (g *getDataModelSnapshotHandler) Handle(ctx context.Context, query *GetDataModelSnapshotQuery) (*DataModelSnapshot, [][]string, error) {
+	if err := validator.Validate(query); err != nil {
+		return nil, nil, err
+	}
+
+	if err := workspacequery.CheckWorkspaceExist(ctx, g.workspaceReadModel, query.WorkspaceID); err != nil {
+		return nil, nil, err
+	}
+	snapshot, err := getDataModelSnapshot(ctx, g.dataModelReadModel, query.WorkspaceID, query.DataModelID, query.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := g.dataModelReadModel.ListDataModelSnapshotRows(ctx, snapshot.ID, query.RowIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return snapshot, rows, nil
+}
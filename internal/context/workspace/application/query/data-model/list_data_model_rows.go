@@ -6,12 +6,13 @@ import (
 
 	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
 	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
 	"github.com/Bio-OS/bioos/pkg/utils"
 	"github.com/Bio-OS/bioos/pkg/validator"
 )
 
 type ListDataModelRowsHandler interface {
-	Handle(ctx context.Context, query *ListDataModelRowsQuery) ([]string, [][]string, int64, error)
+	Handle(ctx context.Context, query *ListDataModelRowsQuery) (headers []string, headerTypes []string, rows [][]string, total int64, err error)
 }
 
 type listDataModelRowsHandler struct {
@@ -28,22 +29,31 @@ func NewListDataModelRowsHandler(workspaceReadModel workspacequery.WorkspaceRead
 	}
 }
 
-func (l *listDataModelRowsHandler) Handle(ctx context.Context, query *ListDataModelRowsQuery) ([]string, [][]string, int64, error) {
+func (l *listDataModelRowsHandler) Handle(ctx context.Context, query *ListDataModelRowsQuery) (headers []string, headerTypes []string, rows [][]string, total int64, err error) {
 	if err := validator.Validate(query); err != nil {
-		return nil, nil, 0, err
+		return nil, nil, nil, 0, err
 	}
 
 	if err := workspacequery.CheckWorkspaceExist(ctx, l.workspaceReadModel, query.WorkspaceID); err != nil {
-		return nil, nil, 0, err
+		return nil, nil, nil, 0, err
 	}
 	dataModelName, err := l.dataModelReadModel.GetDataModelName(ctx, query.WorkspaceID, query.ID)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, nil, 0, err
 	}
 	typ := utils.GetDataModelType(dataModelName)
-	headers, err := l.dataModelReadModel.ListDataModelHeaders(ctx, query.ID, dataModelName, typ)
+	headers, err = l.dataModelReadModel.ListDataModelHeaders(ctx, query.ID, dataModelName, typ)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, nil, 0, err
+	}
+	headerTypes, err = l.dataModelReadModel.ListDataModelHeaderTypes(ctx, query.ID, typ)
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+	if query.Filter != nil && query.Filter.Where != nil {
+		if err := query.Filter.Where.ResolveColumns(headers); err != nil {
+			return nil, nil, nil, 0, err
+		}
 	}
 	var order *utils.Order
 	switch typ {
@@ -55,11 +65,16 @@ func (l *listDataModelRowsHandler) Handle(ctx context.Context, query *ListDataMo
 		if len(query.Pagination.Orders) != 0 {
 			order = &query.Pagination.Orders[0]
 		}
+		columnIndex := -1
 		for index, header := range headers {
 			if order.Field == header {
-				order.Field = strconv.Itoa(index)
+				columnIndex = index
 			}
 		}
+		if columnIndex < 0 {
+			return nil, nil, nil, 0, apperrors.NewInvalidError("orderBy", order.Field)
+		}
+		order.Field = strconv.Itoa(columnIndex)
 	case consts.DataModelTypeEntitySet:
 		order = &utils.Order{
 			Field:     "row_id",
@@ -71,9 +86,9 @@ func (l *listDataModelRowsHandler) Handle(ctx context.Context, query *ListDataMo
 			}
 		}
 	}
-	rows, count, err := l.dataModelReadModel.ListDataModelRows(ctx, query.ID, typ, query.Pagination, order, query.Filter)
+	rows, total, err = l.dataModelReadModel.ListDataModelRows(ctx, query.ID, typ, query.Pagination, order, query.Filter)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, nil, 0, err
 	}
-	return headers, rows, count, nil
+	return headers, headerTypes, rows, total, nil
 }
@@ -0,0 +1,40 @@
+package datamodel
+
+import (
+	"context"
+
+	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type ListDataModelSnapshotsHandler interface {
+	Handle(ctx context.Context, query *ListDataModelSnapshotsQuery) ([]*DataModelSnapshot, error)
+}
+
+type listDataModelSnapshotsHandler struct {
+	workspaceReadModel workspacequery.WorkspaceReadModel
+	dataModelReadModel DataModelReadModel
+}
+
+var _ ListDataModelSnapshotsHandler = &listDataModelSnapshotsHandler{}
+
+func NewListDataModelSnapshotsHandler(workspaceReadModel workspacequery.WorkspaceReadModel, dataModelReadModel DataModelReadModel) ListDataModelSnapshotsHandler {
+	return &listDataModelSnapshotsHandler{
+		workspaceReadModel,
+		dataModelReadModel,
+	}
+}
+
+func (l *listDataModelSnapshotsHandler) Handle(ctx context.Context, query *ListDataModelSnapshotsQuery) ([]*DataModelSnapshot, error) {
+	if err := validator.Validate(query); err != nil {
+		return nil, err
+	}
+
+	if err := workspacequery.CheckWorkspaceExist(ctx, l.workspaceReadModel, query.WorkspaceID); err != nil {
+		return nil, err
+	}
+	if _, err := l.dataModelReadModel.GetDataModelName(ctx, query.WorkspaceID, query.DataModelID); err != nil {
+		return nil, err
+	}
+	return l.dataModelReadModel.ListDataModelSnapshots(ctx, query.DataModelID)
+}
@@ -1,6 +1,8 @@
 package datamodel
 
 import (
+	"time"
+
 	workspacequery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
 	"github.com/Bio-OS/bioos/pkg/utils"
 )
@@ -22,11 +24,42 @@ type ListDataModelRowsQuery struct {
 	Filter      *ListDataModelRowsFilter
 }
 
+type ExportDataModelQuery struct {
+	WorkspaceID string `validate:"required"`
+	ID          string `validate:"required"`
+	// Format is csv or tsv, csv by default.
+	Format string `validate:"omitempty,oneof=csv tsv"`
+	// Columns are the headers exported in order, all headers are exported if empty.
+	Columns []string
+	Filter  *ListDataModelRowsFilter
+}
+
 type ListAllDataModelRowIDsQuery struct {
 	WorkspaceID string `validate:"required"`
 	ID          string `validate:"required"`
 }
 
+type ListDataModelSnapshotsQuery struct {
+	WorkspaceID string `validate:"required"`
+	DataModelID string `validate:"required"`
+}
+
+type GetDataModelSnapshotQuery struct {
+	WorkspaceID string `validate:"required"`
+	DataModelID string `validate:"required"`
+	ID          string `validate:"required"`
+	// RowIDs are the rows returned, all rows are returned if empty.
+	RowIDs []string
+}
+
+type DiffDataModelSnapshotsQuery struct {
+	WorkspaceID string `validate:"required"`
+	DataModelID string `validate:"required"`
+	From        string `validate:"required"`
+	// To is the snapshot compared with From, the current content of data model is compared if empty.
+	To string
+}
+
 type ListDataModelsFilter struct {
 	Types      []string
 	SearchWord string
@@ -38,6 +71,8 @@ type ListDataModelRowsFilter struct {
 	SearchWord string
 	InSetIDs   []string
 	RowIDs     []string
+	// Where filters the rows by conditions on the columns.
+	Where *RowFilter
 }
 
 type DataModel struct {
@@ -48,11 +83,50 @@ type DataModel struct {
 	WorkspaceID string
 }
 
+type DataModelSnapshot struct {
+	ID          string
+	WorkspaceID string
+	DataModelID string
+	Name        string
+	Description string
+	Auto        bool
+	Headers     []string
+	// HeaderTypes are the column types aligned with Headers
+	HeaderTypes []string
+	RowCount    int64
+	CreatedAt   time.Time
+}
+
+// DataModelSnapshotDiff is the difference of data model from one snapshot to another, rows are
+// identified by the id column and grids of the absent columns are treated as empty.
+type DataModelSnapshotDiff struct {
+	AddedColumns   []string
+	RemovedColumns []string
+	AddedRowIDs    []string
+	RemovedRowIDs  []string
+	ChangedRows    []*DataModelRowDiff
+}
+
+type DataModelRowDiff struct {
+	RowID string
+	Grids []*DataModelGridDiff
+}
+
+type DataModelGridDiff struct {
+	Column string
+	From   string
+	To     string
+}
+
 type Queries struct {
 	GetDataModel           GetDataModelHandler
 	ListDataModels         ListDataModelsHandler
 	ListDataModelRows      ListDataModelRowsHandler
 	ListAllDataModelRowIDs ListAllDataModelRowIDsHandler
+	ExportDataModel        ExportDataModelHandler
+	ListDataModelSnapshots ListDataModelSnapshotsHandler
+	GetDataModelSnapshot   GetDataModelSnapshotHandler
+	DiffDataModelSnapshots DiffDataModelSnapshotsHandler
 }
 
 func NewQueries(workspaceReadModel workspacequery.WorkspaceReadModel, dataModelReadModel DataModelReadModel) *Queries {
@@ -61,5 +135,9 @@ func NewQueries(workspaceReadModel workspacequery.WorkspaceReadModel, dataModelR
 		ListDataModels:         NewListDataModelsHandler(workspaceReadModel, dataModelReadModel),
 		ListDataModelRows:      NewListDataModelRowsHandler(workspaceReadModel, dataModelReadModel),
 		ListAllDataModelRowIDs: NewListAllDataModelRowIDsHandler(workspaceReadModel, dataModelReadModel),
+		ExportDataModel:        NewExportDataModelHandler(workspaceReadModel, dataModelReadModel),
+		ListDataModelSnapshots: NewListDataModelSnapshotsHandler(workspaceReadModel, dataModelReadModel),
+		GetDataModelSnapshot:   NewGetDataModelSnapshotHandler(workspaceReadModel, dataModelReadModel),
+		DiffDataModelSnapshots: NewDiffDataModelSnapshotsHandler(workspaceReadModel, dataModelReadModel),
 	}
 }
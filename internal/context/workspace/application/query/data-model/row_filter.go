@@ -0,0 +1,261 @@
+package datamodel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+)
+
+// Operators of the row filter condition.
+const (
+	RowFilterOperatorEqual        = "="
+	RowFilterOperatorNotEqual     = "!="
+	RowFilterOperatorGreater      = ">"
+	RowFilterOperatorGreaterEqual = ">="
+	RowFilterOperatorLess         = "<"
+	RowFilterOperatorLessEqual    = "<="
+	// RowFilterOperatorLike matches the values containing the condition value.
+	RowFilterOperatorLike = "like"
+)
+
+// Logics to combine the children of row filter.
+const (
+	RowFilterLogicAnd = "and"
+	RowFilterLogicOr  = "or"
+)
+
+// RowFilter is a filter expression of data model rows. It is either a single condition on one
+// column, or the children combined with Logic.
+type RowFilter struct {
+	Logic     string
+	Children  []*RowFilter
+	Condition *RowFilterCondition
+}
+
+// RowFilterCondition compares the value of Column with Value by Operator.
+type RowFilterCondition struct {
+	Column   string
+	Operator string
+	Value    string
+	// ColumnIndex is the index of Column in the data model headers, resolved by ResolveColumns.
+	ColumnIndex int
+}
+
+// Number returns the number of condition value if the column should be compared as number, which
+// is the case when the value is a number and the operator is not like.
+func (c *RowFilterCondition) Number() (float64, bool) {
+	if c.Operator == RowFilterOperatorLike {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(c.Value, 64)
+	if err != nil {
+		return 0, false
+	}
+	return number, true
+}
+
+// ResolveColumns resolves the column index of all conditions from the data model headers.
+func (f *RowFilter) ResolveColumns(headers []string) error {
+	if f.Condition != nil {
+		for index, header := range headers {
+			if header == f.Condition.Column {
+				f.Condition.ColumnIndex = index
+				return nil
+			}
+		}
+		return apperrors.NewInvalidError("where", fmt.Sprintf("unknown column %s", f.Condition.Column))
+	}
+	for _, child := range f.Children {
+		if err := child.ResolveColumns(headers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseRowFilter parses the where expression into RowFilter, it returns nil if where is empty.
+// The expression is made of conditions like `column operator value` combined with AND/OR and
+// parentheses, AND binds tighter than OR. Columns and values containing spaces or special
+// characters can be quoted with ' or ", e.g.
+//
+//	tissue = liver AND (read_count > 1e6 OR "sample name" like 'ctrl')
+func ParseRowFilter(where string) (*RowFilter, error) {
+	tokens, err := tokenizeRowFilter(where)
+	if err != nil {
+		return nil, apperrors.NewInvalidError("where", err.Error())
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	p := &rowFilterParser{tokens: tokens}
+	filter, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	if err != nil {
+		return nil, apperrors.NewInvalidError("where", err.Error())
+	}
+	return filter, nil
+}
+
+type rowFilterTokenKind int
+
+const (
+	rowFilterTokenWord rowFilterTokenKind = iota
+	rowFilterTokenQuoted
+	rowFilterTokenOperator
+	rowFilterTokenLeftParen
+	rowFilterTokenRightParen
+)
+
+type rowFilterToken struct {
+	kind rowFilterTokenKind
+	text string
+}
+
+func (t rowFilterToken) isKeyword(keyword string) bool {
+	return t.kind == rowFilterTokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func tokenizeRowFilter(where string) ([]rowFilterToken, error) {
+	var tokens []rowFilterToken
+	runes := []rune(where)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, rowFilterToken{kind: rowFilterTokenLeftParen, text: "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, rowFilterToken{kind: rowFilterTokenRightParen, text: ")"})
+			i++
+		case r == '\'' || r == '"':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != r; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				sb.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated quote at %d", i)
+			}
+			tokens = append(tokens, rowFilterToken{kind: rowFilterTokenQuoted, text: sb.String()})
+			i = j + 1
+		case strings.ContainsRune("=!<>", r):
+			j := i + 1
+			if j < len(runes) && (runes[j] == '=' || (r == '<' && runes[j] == '>')) {
+				j++
+			}
+			op := string(runes[i:j])
+			switch op {
+			case "==":
+				op = RowFilterOperatorEqual
+			case "<>":
+				op = RowFilterOperatorNotEqual
+			case "!":
+				return nil, fmt.Errorf("unknown operator ! at %d", i)
+			}
+			tokens = append(tokens, rowFilterToken{kind: rowFilterTokenOperator, text: op})
+			i = j
+		default:
+			j := i
+			for ; j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune("()'\"=!<>", runes[j]); j++ {
+			}
+			tokens = append(tokens, rowFilterToken{kind: rowFilterTokenWord, text: string(runes[i:j])})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type rowFilterParser struct {
+	tokens []rowFilterToken
+	pos    int
+}
+
+func (p *rowFilterParser) next() (rowFilterToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return rowFilterToken{}, false
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	return token, true
+}
+
+func (p *rowFilterParser) peekKeyword(keyword string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].isKeyword(keyword)
+}
+
+func (p *rowFilterParser) parseOr() (*RowFilter, error) {
+	return p.parseLogic(RowFilterLogicOr, p.parseAnd)
+}
+
+func (p *rowFilterParser) parseAnd() (*RowFilter, error) {
+	return p.parseLogic(RowFilterLogicAnd, p.parsePrimary)
+}
+
+func (p *rowFilterParser) parseLogic(logic string, parseChild func() (*RowFilter, error)) (*RowFilter, error) {
+	child, err := parseChild()
+	if err != nil {
+		return nil, err
+	}
+	children := []*RowFilter{child}
+	for p.peekKeyword(logic) {
+		p.pos++
+		if child, err = parseChild(); err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	if len(children) == 1 {
+		return children[0], nil
+	}
+	return &RowFilter{Logic: logic, Children: children}, nil
+}
+
+func (p *rowFilterParser) parsePrimary() (*RowFilter, error) {
+	token, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	if token.kind == rowFilterTokenLeftParen {
+		filter, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if token, ok = p.next(); !ok || token.kind != rowFilterTokenRightParen {
+			return nil, fmt.Errorf("missing )")
+		}
+		return filter, nil
+	}
+	if token.kind != rowFilterTokenWord && token.kind != rowFilterTokenQuoted {
+		return nil, fmt.Errorf("unexpected %q", token.text)
+	}
+	cond := &RowFilterCondition{Column: token.text}
+
+	token, ok = p.next()
+	switch {
+	case !ok:
+		return nil, fmt.Errorf("missing operator after %s", cond.Column)
+	case token.kind == rowFilterTokenOperator:
+		cond.Operator = token.text
+	case token.isKeyword(RowFilterOperatorLike):
+		cond.Operator = RowFilterOperatorLike
+	default:
+		return nil, fmt.Errorf("unknown operator %q", token.text)
+	}
+
+	token, ok = p.next()
+	if !ok || (token.kind != rowFilterTokenWord && token.kind != rowFilterTokenQuoted) {
+		return nil, fmt.Errorf("missing value after %s %s", cond.Column, cond.Operator)
+	}
+	cond.Value = token.text
+	return &RowFilter{Condition: cond}, nil
+}
@@ -0,0 +1,66 @@
+package datamodel
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestParseRowFilter(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	filter, err := ParseRowFilter("")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(filter).To(gomega.BeNil())
+
+	filter, err = ParseRowFilter("tissue = liver")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(filter).To(gomega.Equal(&RowFilter{Condition: &RowFilterCondition{Column: "tissue", Operator: "=", Value: "liver"}}))
+
+	// AND binds tighter than OR
+	filter, err = ParseRowFilter(`tissue == liver and read_count>1e6 OR ("sample name" LIKE 'ctrl \'a\'' or size<>0)`)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(filter).To(gomega.Equal(&RowFilter{
+		Logic: RowFilterLogicOr,
+		Children: []*RowFilter{
+			{
+				Logic: RowFilterLogicAnd,
+				Children: []*RowFilter{
+					{Condition: &RowFilterCondition{Column: "tissue", Operator: RowFilterOperatorEqual, Value: "liver"}},
+					{Condition: &RowFilterCondition{Column: "read_count", Operator: RowFilterOperatorGreater, Value: "1e6"}},
+				},
+			},
+			{
+				Logic: RowFilterLogicOr,
+				Children: []*RowFilter{
+					{Condition: &RowFilterCondition{Column: "sample name", Operator: RowFilterOperatorLike, Value: "ctrl 'a'"}},
+					{Condition: &RowFilterCondition{Column: "size", Operator: RowFilterOperatorNotEqual, Value: "0"}},
+				},
+			},
+		},
+	}))
+	number, ok := filter.Children[0].Children[1].Condition.Number()
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(number).To(gomega.BeEquivalentTo(1e6))
+	_, ok = filter.Children[1].Children[0].Condition.Number()
+	g.Expect(ok).To(gomega.BeFalse())
+
+	g.Expect(filter.ResolveColumns([]string{"sample_id", "tissue", "read_count", "sample name", "size"})).To(gomega.Succeed())
+	g.Expect(filter.Children[1].Children[1].Condition.ColumnIndex).To(gomega.Equal(4))
+	g.Expect(filter.ResolveColumns([]string{"sample_id", "tissue"})).ToNot(gomega.Succeed())
+
+	for _, where := range []string{
+		"tissue",
+		"tissue =",
+		"tissue ! liver",
+		"tissue in liver",
+		"(tissue = liver",
+		"tissue = liver)",
+		"tissue = 'liver",
+		"tissue = liver AND",
+		"tissue = liver size > 1",
+	} {
+		_, err = ParseRowFilter(where)
+		g.Expect(err).To(gomega.HaveOccurred(), where)
+	}
+}
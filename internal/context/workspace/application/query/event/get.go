@@ -0,0 +1,46 @@
+package event
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type GetEventQuery struct {
+	ID string `validate:"required"`
+}
+
+type GetEventHandler interface {
+	Handle(ctx context.Context, query *GetEventQuery) (*Event, error)
+}
+
+type getEventHandler struct {
+	eventRepo eventbus.EventRepository
+}
+
+func NewGetEventHandler(eventRepo eventbus.EventRepository) GetEventHandler {
+	return &getEventHandler{
+		eventRepo: eventRepo,
+	}
+}
+
+func (h *getEventHandler) Handle(ctx context.Context, query *GetEventQuery) (*Event, error) {
+	if err := validator.Validate(query); err != nil {
+		return nil, err
+	}
+
+	event, err := h.eventRepo.Get(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, eventbus.ErrEventNotFound) {
+			return nil, apperrors.NewNotFoundError("event", query.ID)
+		}
+		if errors.Is(err, eventbus.ErrEventsNotPersisted) {
+			return nil, apperrors.NewInvalidError(err.Error())
+		}
+		return nil, apperrors.NewInternalError(err)
+	}
+	return eventDOToDTO(event), nil
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/utils"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type ListEventsQuery struct {
+	Pg    *utils.Pagination `validate:"required"`
+	Types []string
+	// Status of events, all status if empty
+	Status []string `validate:"unique,dive,oneof=pending dequeue running completed failed deadletter"`
+	// Payload matches the events whose payload contains it
+	Payload string
+}
+
+type ListEventsHandler interface {
+	Handle(ctx context.Context, query *ListEventsQuery) ([]*Event, error)
+}
+
+type listEventsHandler struct {
+	eventRepo eventbus.EventRepository
+}
+
+func NewListEventsHandler(eventRepo eventbus.EventRepository) ListEventsHandler {
+	return &listEventsHandler{
+		eventRepo: eventRepo,
+	}
+}
+
+func (h *listEventsHandler) Handle(ctx context.Context, query *ListEventsQuery) ([]*Event, error) {
+	if err := validator.Validate(query); err != nil {
+		return nil, err
+	}
+
+	events, err := h.eventRepo.Search(ctx, &eventbus.Filter{
+		Type:    query.Types,
+		Status:  query.Status,
+		Payload: query.Payload,
+		Offset:  query.Pg.GetOffset(),
+		Limit:   query.Pg.GetLimit(),
+	})
+	if err != nil {
+		if errors.Is(err, eventbus.ErrEventsNotPersisted) {
+			return nil, apperrors.NewInvalidError(err.Error())
+		}
+		return nil, apperrors.NewInternalError(err)
+	}
+	items := make([]*Event, len(events))
+	for i, event := range events {
+		items[i] = eventDOToDTO(event)
+	}
+	return items, nil
+}
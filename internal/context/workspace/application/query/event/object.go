@@ -0,0 +1,31 @@
+package event
+
+import "time"
+
+type Event struct {
+	ID          string
+	Type        string
+	Payload     string
+	Status      string
+	Reason      string
+	RetryCount  int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	ScheduledAt time.Time
+	LastError   string
+	Attempts    []EventAttempt
+	Owner       string
+	// LeaseExpireAt is nil if the event was never running
+	LeaseExpireAt *time.Time
+}
+
+type EventAttempt struct {
+	StartTime time.Time
+	Duration  time.Duration
+	Failures  []EventHandlerFailure
+}
+
+type EventHandlerFailure struct {
+	Handler string
+	Error   string
+}
@@ -0,0 +1,56 @@
+package event
+
+import (
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+)
+
+type Queries struct {
+	ListEvents ListEventsHandler
+	GetEvent   GetEventHandler
+}
+
+func NewQueries(eventRepo eventbus.EventRepository) *Queries {
+	return &Queries{
+		ListEvents: NewListEventsHandler(eventRepo),
+		GetEvent:   NewGetEventHandler(eventRepo),
+	}
+}
+
+func eventDOToDTO(event *eventbus.Event) *Event {
+	dto := &Event{
+		ID:          event.EventID,
+		Type:        event.Type,
+		Payload:     event.Payload,
+		Status:      event.Status,
+		Reason:      event.Reason,
+		RetryCount:  event.RetryCount,
+		CreatedAt:   event.CreatedAt,
+		UpdatedAt:   event.UpdatedAt,
+		ScheduledAt: event.ScheduledAt,
+		LastError:   event.LastError,
+		Attempts:    attemptsDOToDTO(event.Attempts),
+		Owner:       event.Owner,
+	}
+	if !event.LeaseExpireAt.IsZero() {
+		dto.LeaseExpireAt = &event.LeaseExpireAt
+	}
+	return dto
+}
+
+func attemptsDOToDTO(attempts []eventbus.EventAttempt) []EventAttempt {
+	result := make([]EventAttempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = EventAttempt{
+			StartTime: attempt.StartTime,
+			Duration:  attempt.Duration,
+			Failures:  make([]EventHandlerFailure, len(attempt.Failures)),
+		}
+		for j, failure := range attempt.Failures {
+			result[i].Failures[j] = EventHandlerFailure{
+				Handler: failure.Handler,
+				Error:   failure.Error,
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,23 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+func TestQueriesOfNotPersistedEvents(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+	queries := NewQueries(eventbus.NewNotPersistedEventRepository())
+
+	_, err := queries.ListEvents.Handle(ctx, &ListEventsQuery{Pg: utils.NewPagination(10, 1)})
+	g.Expect(err).To(gomega.Equal(apperrors.NewInvalidError(eventbus.ErrEventsNotPersisted.Error())))
+	_, err = queries.GetEvent.Handle(ctx, &GetEventQuery{ID: "event-1"})
+	g.Expect(err).To(gomega.Equal(apperrors.NewInvalidError(eventbus.ErrEventsNotPersisted.Error())))
+}
@@ -0,0 +1,60 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type AuthorizeWorkspaceHandler interface {
+	Handle(ctx context.Context, query *AuthorizeWorkspaceQuery) (*WorkspaceAuthorization, error)
+}
+
+type authorizeWorkspaceHandler struct {
+	workspaceReadModel WorkspaceReadModel
+}
+
+func NewAuthorizeWorkspaceHandler(workspaceReadModel WorkspaceReadModel) AuthorizeWorkspaceHandler {
+	return &authorizeWorkspaceHandler{workspaceReadModel: workspaceReadModel}
+}
+
+// Handle authorizes the action by the role of user in workspace. The workspaces without owner are
+// not managed by membership, and neither are the workspaces not exist so that the request fails with
+// not found error if the global authorizer permits it.
+func (q *authorizeWorkspaceHandler) Handle(ctx context.Context, query *AuthorizeWorkspaceQuery) (*WorkspaceAuthorization, error) {
+	if err := validator.Validate(query); err != nil {
+		return nil, err
+	}
+	if err := CheckWorkspaceExist(ctx, q.workspaceReadModel, query.WorkspaceID); err != nil {
+		if isNotFound(err) {
+			return &WorkspaceAuthorization{}, nil
+		}
+		return nil, err
+	}
+	ws, err := q.workspaceReadModel.GetWorkspaceById(ctx, query.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if ws.Owner == "" {
+		return &WorkspaceAuthorization{}, nil
+	}
+	member, err := q.workspaceReadModel.GetWorkspaceMember(ctx, query.WorkspaceID, query.UserName)
+	if err != nil {
+		if isNotFound(err) {
+			return &WorkspaceAuthorization{Managed: true}, nil
+		}
+		return nil, err
+	}
+	return &WorkspaceAuthorization{
+		Managed: true,
+		Allowed: workspace.RoleAllows(member.Role, query.Action),
+	}, nil
+}
+
+func isNotFound(err error) bool {
+	var appErr apperrors.Error
+	return errors.As(err, &appErr) && appErr.GetCode() == apperrors.NotFoundCode
+}
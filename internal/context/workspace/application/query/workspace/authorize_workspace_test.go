@@ -0,0 +1,112 @@
+package workspace
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+)
+
+func TestMain(m *testing.M) {
+	applog.RegisterLogger(&applog.Options{
+		Level: "fatal",
+	})
+	os.Exit(m.Run())
+}
+
+// fakeWorkspaceReadModel has the workspaces by id and the roles of their members by user name.
+type fakeWorkspaceReadModel struct {
+	WorkspaceReadModel
+	workspaces map[string]*WorkspaceItem
+	members    map[string]map[string]string
+}
+
+func (f *fakeWorkspaceReadModel) CountWorkspaces(_ context.Context, filter *ListWorkspacesFilter) (int, error) {
+	count := 0
+	for _, id := range filter.IDs {
+		if _, ok := f.workspaces[id]; ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (f *fakeWorkspaceReadModel) GetWorkspaceById(_ context.Context, id string) (*WorkspaceItem, error) {
+	ws, ok := f.workspaces[id]
+	if !ok {
+		return nil, apperrors.NewNotFoundError("workspace", id)
+	}
+	return ws, nil
+}
+
+func (f *fakeWorkspaceReadModel) GetWorkspaceMember(_ context.Context, workspaceID, userName string) (*WorkspaceMemberItem, error) {
+	role, ok := f.members[workspaceID][userName]
+	if !ok {
+		return nil, apperrors.NewNotFoundError("workspace member", userName)
+	}
+	return &WorkspaceMemberItem{UserName: userName, Role: role}, nil
+}
+
+func TestAuthorizeWorkspace(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+
+	handler := NewAuthorizeWorkspaceHandler(&fakeWorkspaceReadModel{
+		workspaces: map[string]*WorkspaceItem{
+			"legacy": {ID: "legacy"},
+			"owned":  {ID: "owned", Owner: "alice"},
+		},
+		members: map[string]map[string]string{
+			"owned": {"alice": workspace.RoleOwner, "bob": workspace.RoleReader},
+		},
+	})
+	cases := []struct {
+		name          string
+		query         *AuthorizeWorkspaceQuery
+		authorization *WorkspaceAuthorization
+	}{
+		{
+			name:          "workspace without owner is not managed",
+			query:         &AuthorizeWorkspaceQuery{WorkspaceID: "legacy", UserName: "user1", Action: "Delete"},
+			authorization: &WorkspaceAuthorization{},
+		},
+		{
+			name:          "workspace not exist is not managed",
+			query:         &AuthorizeWorkspaceQuery{WorkspaceID: "missing", UserName: "user1", Action: "Get"},
+			authorization: &WorkspaceAuthorization{},
+		},
+		{
+			name:          "owner is permitted to delete",
+			query:         &AuthorizeWorkspaceQuery{WorkspaceID: "owned", UserName: "alice", Action: "Delete"},
+			authorization: &WorkspaceAuthorization{Managed: true, Allowed: true},
+		},
+		{
+			name:          "reader is permitted to get",
+			query:         &AuthorizeWorkspaceQuery{WorkspaceID: "owned", UserName: "bob", Action: "GetWorkspace"},
+			authorization: &WorkspaceAuthorization{Managed: true, Allowed: true},
+		},
+		{
+			name:          "reader is not permitted to write",
+			query:         &AuthorizeWorkspaceQuery{WorkspaceID: "owned", UserName: "bob", Action: "PatchDataModel"},
+			authorization: &WorkspaceAuthorization{Managed: true},
+		},
+		{
+			name:          "non member is not permitted",
+			query:         &AuthorizeWorkspaceQuery{WorkspaceID: "owned", UserName: "user1", Action: "GetWorkspace"},
+			authorization: &WorkspaceAuthorization{Managed: true},
+		},
+	}
+	for _, c := range cases {
+		authorization, err := handler.Handle(ctx, c.query)
+		g.Expect(err).ToNot(gomega.HaveOccurred(), c.name)
+		g.Expect(authorization).To(gomega.Equal(c.authorization), c.name)
+	}
+
+	_, err := handler.Handle(ctx, &AuthorizeWorkspaceQuery{WorkspaceID: "owned", Action: "Get"})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,26 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type GetImportJobHandler interface {
+	Handle(ctx context.Context, query *GetImportJobQuery) (*ImportJobItem, error)
+}
+
+type getImportJobHandler struct {
+	workspaceReadModel WorkspaceReadModel
+}
+
+func NewGetImportJobHandler(workspaceReadModel WorkspaceReadModel) GetImportJobHandler {
+	return &getImportJobHandler{workspaceReadModel: workspaceReadModel}
+}
+
+func (q *getImportJobHandler) Handle(ctx context.Context, query *GetImportJobQuery) (*ImportJobItem, error) {
+	if err := validator.Validate(query); err != nil {
+		return nil, err
+	}
+	return q.workspaceReadModel.GetImportJob(ctx, query.ID)
+}
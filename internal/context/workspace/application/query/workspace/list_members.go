@@ -0,0 +1,29 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/Bio-OS/bioos/pkg/validator"
+)
+
+type ListWorkspaceMembersHandler interface {
+	Handle(ctx context.Context, query *ListWorkspaceMembersQuery) ([]*WorkspaceMemberItem, error)
+}
+
+type listWorkspaceMembersHandler struct {
+	workspaceReadModel WorkspaceReadModel
+}
+
+func NewListWorkspaceMembersHandler(workspaceReadModel WorkspaceReadModel) ListWorkspaceMembersHandler {
+	return &listWorkspaceMembersHandler{workspaceReadModel: workspaceReadModel}
+}
+
+func (q *listWorkspaceMembersHandler) Handle(ctx context.Context, query *ListWorkspaceMembersQuery) ([]*WorkspaceMemberItem, error) {
+	if err := validator.Validate(query); err != nil {
+		return nil, err
+	}
+	if err := CheckWorkspaceExist(ctx, q.workspaceReadModel, query.WorkspaceID); err != nil {
+		return nil, err
+	}
+	return q.workspaceReadModel.ListWorkspaceMembers(ctx, query.WorkspaceID)
+}
@@ -16,12 +16,15 @@ type ListWorkspacesQuery struct {
 }
 
 type WorkspaceItem struct {
-	ID          string
-	Name        string
-	Description string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	Storage     WorkspaceStorage
+	ID            string
+	Name          string
+	Description   string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Storage       WorkspaceStorage
+	EngineBackend string
+	// Owner is the user created the workspace, empty means visible to everyone
+	Owner string
 }
 
 type WorkspaceStorage struct {
@@ -32,10 +35,65 @@ type NFSWorkspaceStorage struct {
 	MountPath string
 }
 
+type GetImportJobQuery struct {
+	ID string `validate:"required"`
+}
+
+type ImportJobItem struct {
+	ID string
+	// Type is Import or Clone
+	Type        string
+	WorkspaceID string
+	// SourceWorkspaceID is the workspace cloned from
+	SourceWorkspaceID string
+	State             string
+	Components        []ImportJobComponent
+	Error             string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	FinishedAt        *time.Time
+}
+
+type ImportJobComponent struct {
+	Name    string
+	State   string
+	Message string
+}
+
 type ListWorkspacesFilter struct {
 	SearchWord string
 	Exact      bool
 	IDs        []string
+	// UserName filters the workspaces visible to the user, which are the workspaces the user is a
+	// member of and the workspaces without owner
+	UserName string
+}
+
+type ListWorkspaceMembersQuery struct {
+	WorkspaceID string `validate:"required"`
+}
+
+type WorkspaceMemberItem struct {
+	UserName  string
+	Role      string
+	CreatedAt time.Time
+}
+
+// AuthorizeWorkspaceQuery checks whether the user can do the action on workspace.
+type AuthorizeWorkspaceQuery struct {
+	WorkspaceID string `validate:"required"`
+	UserName    string `validate:"required"`
+	Action      string `validate:"required"`
+}
+
+// WorkspaceAuthorization is the result of AuthorizeWorkspaceQuery.
+type WorkspaceAuthorization struct {
+	// Managed is whether the workspace is managed by membership, which means it has owner. The
+	// workspaces without owner, e.g. created before membership is introduced, are left to the
+	// global authorizer.
+	Managed bool
+	// Allowed is whether the role of user permits the action, meaningful only if Managed
+	Allowed bool
 }
 
 // Field for order.
@@ -45,13 +103,19 @@ const (
 )
 
 type Queries struct {
-	GetWorkspaceByID GetWorkspaceByIDQueryHandler
-	ListWorkspaces   ListWorkspacesHandler
+	GetWorkspaceByID   GetWorkspaceByIDQueryHandler
+	ListWorkspaces     ListWorkspacesHandler
+	GetImportJob       GetImportJobHandler
+	ListMembers        ListWorkspaceMembersHandler
+	AuthorizeWorkspace AuthorizeWorkspaceHandler
 }
 
 func NewQueries(workspaceReadModel WorkspaceReadModel) *Queries {
 	return &Queries{
-		GetWorkspaceByID: NewGetWorkspaceByIDHandler(workspaceReadModel),
-		ListWorkspaces:   NewListWorkspacesHandler(workspaceReadModel),
+		GetWorkspaceByID:   NewGetWorkspaceByIDHandler(workspaceReadModel),
+		ListWorkspaces:     NewListWorkspacesHandler(workspaceReadModel),
+		GetImportJob:       NewGetImportJobHandler(workspaceReadModel),
+		ListMembers:        NewListWorkspaceMembersHandler(workspaceReadModel),
+		AuthorizeWorkspace: NewAuthorizeWorkspaceHandler(workspaceReadModel),
 	}
 }
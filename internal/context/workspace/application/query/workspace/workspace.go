@@ -10,4 +10,8 @@ type WorkspaceReadModel interface {
 	ListWorkspaces(ctx context.Context, pg utils.Pagination, filter *ListWorkspacesFilter) ([]*WorkspaceItem, error)
 	CountWorkspaces(ctx context.Context, filter *ListWorkspacesFilter) (int, error)
 	GetWorkspaceById(ctx context.Context, id string) (*WorkspaceItem, error)
+	GetImportJob(ctx context.Context, id string) (*ImportJobItem, error)
+	ListWorkspaceMembers(ctx context.Context, workspaceID string) ([]*WorkspaceMemberItem, error)
+	// GetWorkspaceMember returns not found error if the user is not a member of workspace
+	GetWorkspaceMember(ctx context.Context, workspaceID, userName string) (*WorkspaceMemberItem, error)
 }
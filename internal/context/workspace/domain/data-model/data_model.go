@@ -1,17 +1,26 @@
 package datamodel
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
 
 type DataModel struct {
-	WorkspaceID string
-	ID          string
-	Name        string
-	Type        string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	Headers     []string   // all headers in this data model
-	RowIDs      []string   // all rowIDs in this data model, only used in delete data model
-	Rows        [][]string // the row should be saved(create/update)
+	WorkspaceID     string
+	ID              string
+	Name            string
+	Type            string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Headers         []string          // all headers in this data model
+	ColumnTypes     map[string]string // types of entity data model columns, keyed by header
+	DeclaredColumns map[string]bool   // entity columns whose types are declared rather than inferred
+	RowIDs          []string          // all rowIDs in this data model, only used in delete data model
+	Rows            [][]string        // the row should be saved(create/update)
 }
 
 type Row struct {
@@ -22,3 +31,59 @@ type Grid struct {
 	Value []byte `json:"value"`
 	Type  string `json:"type"`
 }
+
+// ResolveColumnTypes resolves the types of all entity columns and checks the rows against them.
+// A declared type is enforced, and the type of an existing column can only be declared wider, e.g.
+// int to float or string. A type not declared is inferred from rows for a new column, and the
+// inferred type of an existing column is widened to fit the rows. The id column is always string.
+func (d *DataModel) ResolveColumnTypes(declared, stored map[string]string, storedDeclared map[string]bool) error {
+	if d.Type != consts.DataModelTypeEntity {
+		return nil
+	}
+	columnTypes := make(map[string]string, len(d.Headers))
+	declaredColumns := make(map[string]bool)
+	for index, header := range d.Headers {
+		typ, isDeclared := declared[header]
+		if isDeclared && !utils.ValidDataModelColumnType(typ) {
+			return apperrors.NewInvalidError("columnTypes", fmt.Sprintf("unsupported type %s of column %s", typ, header))
+		}
+		values := make([]string, 0, len(d.Rows))
+		for _, row := range d.Rows {
+			if index < len(row) {
+				values = append(values, row[index])
+			}
+		}
+		storedType, isStored := stored[header]
+		switch {
+		case index == 0:
+			if isDeclared && typ != consts.DataModelColumnTypeString {
+				return apperrors.NewInvalidError("columnTypes", fmt.Sprintf("id column %s must be string", header))
+			}
+			typ, isDeclared = consts.DataModelColumnTypeString, false
+		case isStored && storedType != "":
+			if isDeclared && !utils.CanWidenDataModelColumnType(storedType, typ) {
+				return apperrors.NewInvalidError("columnTypes", fmt.Sprintf("type of column %s is %s and can only be changed to a wider type", header, storedType))
+			}
+			if !isDeclared {
+				typ, isDeclared = storedType, storedDeclared[header]
+				if !isDeclared {
+					typ = utils.WidenDataModelColumnType(storedType, values)
+				}
+			}
+		case !isDeclared:
+			typ = utils.InferDataModelColumnType(values)
+		}
+		for _, row := range d.Rows {
+			if index < len(row) && !utils.ValidateDataModelValue(typ, row[index]) {
+				return apperrors.NewInvalidError("rows", fmt.Sprintf("value %s of column %s in row %s is not %s", row[index], header, row[0], typ))
+			}
+		}
+		columnTypes[header] = typ
+		if isDeclared {
+			declaredColumns[header] = true
+		}
+	}
+	d.ColumnTypes = columnTypes
+	d.DeclaredColumns = declaredColumns
+	return nil
+}
@@ -0,0 +1,100 @@
+package datamodel
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/pkg/consts"
+)
+
+func TestResolveColumnTypes(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	dm := &DataModel{
+		Name:    "sample",
+		Type:    consts.DataModelTypeEntity,
+		Headers: []string{"sample_id", "read_count", "bam", "tissue", "ratio"},
+		Rows: [][]string{
+			{"1", "100", "s3://bucket/1.bam", "liver", "0.5"},
+			{"2", "", "s3://bucket/2.bam", "lung", "1"},
+		},
+	}
+	g.Expect(dm.ResolveColumnTypes(map[string]string{"ratio": consts.DataModelColumnTypeString}, nil, nil)).To(gomega.Succeed())
+	g.Expect(dm.ColumnTypes).To(gomega.Equal(map[string]string{
+		"sample_id":  consts.DataModelColumnTypeString,
+		"read_count": consts.DataModelColumnTypeInt,
+		"bam":        consts.DataModelColumnTypeFile,
+		"tissue":     consts.DataModelColumnTypeString,
+		"ratio":      consts.DataModelColumnTypeString,
+	}))
+	g.Expect(dm.DeclaredColumns).To(gomega.Equal(map[string]bool{"ratio": true}))
+
+	// stored inferred types are widened to fit the rows
+	stored := dm.ColumnTypes
+	dm.Rows = [][]string{{"3", "15", "s3://bucket/3.bam", "liver", "2"}}
+	g.Expect(dm.ResolveColumnTypes(nil, stored, nil)).To(gomega.Succeed())
+	g.Expect(dm.ColumnTypes["read_count"]).To(gomega.Equal(consts.DataModelColumnTypeInt))
+	dm.Rows = [][]string{{"3", "1.5", "3.bam", "liver", "2"}}
+	g.Expect(dm.ResolveColumnTypes(nil, stored, nil)).To(gomega.Succeed())
+	g.Expect(dm.ColumnTypes["read_count"]).To(gomega.Equal(consts.DataModelColumnTypeFloat))
+	g.Expect(dm.ColumnTypes["bam"]).To(gomega.Equal(consts.DataModelColumnTypeString))
+	g.Expect(dm.DeclaredColumns).To(gomega.BeEmpty())
+
+	// stored declared types are enforced
+	declared := map[string]bool{"read_count": true}
+	g.Expect(dm.ResolveColumnTypes(nil, stored, declared)).ToNot(gomega.Succeed())
+	dm.Rows = [][]string{{"3", "15", "s3://bucket/3.bam", "liver", "2"}}
+	g.Expect(dm.ResolveColumnTypes(nil, stored, declared)).To(gomega.Succeed())
+	g.Expect(dm.ColumnTypes["read_count"]).To(gomega.Equal(consts.DataModelColumnTypeInt))
+	g.Expect(dm.DeclaredColumns).To(gomega.Equal(map[string]bool{"read_count": true}))
+
+	// stored types can only be declared wider
+	g.Expect(dm.ResolveColumnTypes(map[string]string{"read_count": consts.DataModelColumnTypeFloat}, stored, declared)).To(gomega.Succeed())
+	g.Expect(dm.ColumnTypes["read_count"]).To(gomega.Equal(consts.DataModelColumnTypeFloat))
+	g.Expect(dm.ResolveColumnTypes(map[string]string{"bam": consts.DataModelColumnTypeBoolean}, stored, nil)).ToNot(gomega.Succeed())
+	g.Expect(dm.ResolveColumnTypes(map[string]string{"bam": consts.DataModelColumnTypeString}, stored, nil)).To(gomega.Succeed())
+	g.Expect(dm.ColumnTypes["bam"]).To(gomega.Equal(consts.DataModelColumnTypeString))
+	g.Expect(dm.DeclaredColumns).To(gomega.Equal(map[string]bool{"bam": true}))
+
+	g.Expect(dm.ResolveColumnTypes(map[string]string{"sample_id": consts.DataModelColumnTypeInt}, nil, nil)).ToNot(gomega.Succeed())
+	g.Expect(dm.ResolveColumnTypes(map[string]string{"tissue": "date"}, nil, nil)).ToNot(gomega.Succeed())
+	g.Expect(dm.ResolveColumnTypes(map[string]string{"tissue": consts.DataModelColumnTypeBoolean}, nil, nil)).ToNot(gomega.Succeed())
+
+	// only entity columns are typed
+	workspaceData := &DataModel{Type: consts.DataModelTypeWorkspace, Headers: []string{"Key", "Value"}, Rows: [][]string{{"k", "v"}}}
+	g.Expect(workspaceData.ResolveColumnTypes(map[string]string{"Value": consts.DataModelColumnTypeInt}, nil, nil)).To(gomega.Succeed())
+	g.Expect(workspaceData.ColumnTypes).To(gomega.BeNil())
+}
+
+func TestSnapshotRestoreTo(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	snapshot := &Snapshot{
+		ID:              "ds-1",
+		WorkspaceID:     "ws-1",
+		DataModelID:     "dm-1",
+		DataModelType:   consts.DataModelTypeEntity,
+		Headers:         []string{"sample_id", "bam"},
+		ColumnTypes:     map[string]string{"sample_id": consts.DataModelColumnTypeString, "bam": consts.DataModelColumnTypeFile},
+		DeclaredColumns: map[string]bool{"bam": true},
+		Rows:            [][]string{{"1", "s3://bucket/1.bam"}},
+	}
+	dm := &DataModel{
+		ID:          "dm-1",
+		WorkspaceID: "ws-1",
+		Type:        consts.DataModelTypeEntity,
+		Headers:     []string{"sample_id", "bam", "vcf"},
+		Rows:        [][]string{{"2", "s3://bucket/2.bam", "s3://bucket/2.vcf"}},
+		RowIDs:      []string{"2"},
+	}
+	g.Expect(snapshot.RestoreTo(dm)).To(gomega.Succeed())
+	g.Expect(dm.Headers).To(gomega.Equal(snapshot.Headers))
+	g.Expect(dm.ColumnTypes).To(gomega.Equal(snapshot.ColumnTypes))
+	g.Expect(dm.DeclaredColumns).To(gomega.Equal(snapshot.DeclaredColumns))
+	g.Expect(dm.Rows).To(gomega.Equal(snapshot.Rows))
+	g.Expect(dm.RowIDs).To(gomega.BeNil())
+
+	g.Expect(snapshot.RestoreTo(&DataModel{ID: "dm-2", WorkspaceID: "ws-1", Type: consts.DataModelTypeEntity})).ToNot(gomega.Succeed())
+	g.Expect(snapshot.RestoreTo(&DataModel{ID: "dm-1", WorkspaceID: "ws-1", Type: consts.DataModelTypeWorkspace})).ToNot(gomega.Succeed())
+}
@@ -9,6 +9,7 @@ import (
 
 const (
 	ImportDataModels = "ImportDataModels"
+	CloneDataModels  = "CloneDataModels"
 )
 
 type ImportDataModelsEvent struct {
@@ -55,6 +55,9 @@ func (h *ImportDataModelsHandler) Handle(ctx context.Context, event *ImportDataM
 			Headers:     headers,
 			Rows:        rows,
 		})
+		if err = newDataModel.ResolveColumnTypes(dataModel.ColumnTypes, nil, nil); err != nil {
+			return fmt.Errorf("data model[%s] column types invalid: %w", dataModel.Name, err)
+		}
 		err = h.repo.Save(ctx, newDataModel)
 		if err != nil {
 			return err
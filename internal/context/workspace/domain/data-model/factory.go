@@ -19,6 +19,7 @@ type CreateParam struct {
 	Name        string
 	Type        string
 	Headers     []string
+	ColumnTypes map[string]string
 	Rows        [][]string
 }
 
@@ -31,6 +32,33 @@ func (f *Factory) New(param *CreateParam) *DataModel {
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 		Headers:     param.Headers,
+		ColumnTypes: param.ColumnTypes,
 		Rows:        param.Rows,
 	}
 }
+
+type CreateSnapshotParam struct {
+	DataModel   *DataModel
+	Name        string
+	Description string
+	Auto        bool
+}
+
+// NewSnapshot returns a snapshot of the headers and column types in param.DataModel, the rows are
+// copied from the data model by repository when the snapshot is saved.
+func (f *Factory) NewSnapshot(param *CreateSnapshotParam) *Snapshot {
+	return &Snapshot{
+		ID:              utils.GenDataModelSnapshotID(),
+		WorkspaceID:     param.DataModel.WorkspaceID,
+		DataModelID:     param.DataModel.ID,
+		DataModelName:   param.DataModel.Name,
+		DataModelType:   param.DataModel.Type,
+		Name:            param.Name,
+		Description:     param.Description,
+		Auto:            param.Auto,
+		Headers:         param.DataModel.Headers,
+		ColumnTypes:     param.DataModel.ColumnTypes,
+		DeclaredColumns: param.DataModel.DeclaredColumns,
+		CreatedAt:       time.Now(),
+	}
+}
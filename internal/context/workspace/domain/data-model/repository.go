@@ -2,6 +2,7 @@ package datamodel
 
 import (
 	"context"
+	"time"
 )
 
 // Repository allows to get/save events from/to event store.
@@ -9,4 +10,14 @@ type Repository interface {
 	Save(ctx context.Context, dm *DataModel) error
 	Get(ctx context.Context, id string) (*DataModel, error)
 	Delete(ctx context.Context, dm *DataModel) error
+	// Replace overwrites the whole content of data model with dm, unlike Save which merges rows into it.
+	Replace(ctx context.Context, dm *DataModel) error
+	// SaveSnapshot saves the snapshot with a copy of the current rows of its data model, which are
+	// copied inside database, so the rows of snapshot are ignored.
+	SaveSnapshot(ctx context.Context, snapshot *Snapshot) error
+	GetSnapshot(ctx context.Context, id string) (*Snapshot, error)
+	DeleteSnapshot(ctx context.Context, id string) error
+	// DeleteAutoSnapshots deletes the snapshots taken automatically of the data model created before
+	// the given time, except the latest keep ones.
+	DeleteAutoSnapshots(ctx context.Context, dataModelID string, keep int, before time.Time) error
 }
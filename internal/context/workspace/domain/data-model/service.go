@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	"github.com/Bio-OS/bioos/pkg/consts"
 	apperrors "github.com/Bio-OS/bioos/pkg/errors"
 	applog "github.com/Bio-OS/bioos/pkg/log"
 )
@@ -16,6 +18,11 @@ type Service interface {
 	Create(context.Context, *DataModel) error
 	Update(context.Context, *DataModel) error
 	Delete(context.Context, *DataModel) error
+	CreateSnapshot(context.Context, *Snapshot) error
+	GetSnapshot(context.Context, string) (*Snapshot, error)
+	DeleteSnapshot(context.Context, string) error
+	// Restore overwrites the content of data model with the snapshot.
+	Restore(context.Context, *DataModel, *Snapshot) error
 }
 
 func NewService(repo Repository, eventbus eventbus.EventBus, factory *Factory) Service {
@@ -67,6 +74,34 @@ func (s *service) Delete(ctx context.Context, dm *DataModel) error {
 	return s.repository.Delete(ctx, dm)
 }
 
+func (s *service) CreateSnapshot(ctx context.Context, snapshot *Snapshot) error {
+	if err := s.repository.SaveSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+	if snapshot.Auto {
+		// outdated auto snapshots are pruned best effort, the new snapshot is usable anyway
+		if err := s.repository.DeleteAutoSnapshots(ctx, snapshot.DataModelID, consts.DataModelAutoSnapshotsKept, time.Now().Add(-consts.DataModelAutoSnapshotMaxAge)); err != nil {
+			applog.Errorw("failed to delete outdated auto snapshots", "dataModelID", snapshot.DataModelID, "err", err)
+		}
+	}
+	return nil
+}
+
+func (s *service) GetSnapshot(ctx context.Context, id string) (*Snapshot, error) {
+	return s.repository.GetSnapshot(ctx, id)
+}
+
+func (s *service) DeleteSnapshot(ctx context.Context, id string) error {
+	return s.repository.DeleteSnapshot(ctx, id)
+}
+
+func (s *service) Restore(ctx context.Context, dm *DataModel, snapshot *Snapshot) error {
+	if err := snapshot.RestoreTo(dm); err != nil {
+		return err
+	}
+	return s.repository.Replace(ctx, dm)
+}
+
 func (s *service) subscribeEvents() {
 	s.eventbus.Subscribe(ImportDataModels, eventbus.EventHandlerFunc(func(ctx context.Context, payload string) (err error) {
 		applog.Infow("start to consume import data-models event", "payload", payload)
@@ -0,0 +1,43 @@
+package datamodel
+
+import (
+	"fmt"
+	"time"
+
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+)
+
+// Snapshot is an immutable copy of the headers, column types and rows of a data model.
+type Snapshot struct {
+	ID            string
+	WorkspaceID   string
+	DataModelID   string
+	DataModelName string
+	DataModelType string
+	Name          string
+	Description   string
+	// Auto marks the snapshot taken automatically before the data model is overwritten
+	Auto            bool
+	Headers         []string
+	ColumnTypes     map[string]string
+	DeclaredColumns map[string]bool
+	Rows            [][]string
+	CreatedAt       time.Time
+}
+
+// RestoreTo overwrites the headers, column types and rows of dm with the snapshot.
+func (s *Snapshot) RestoreTo(dm *DataModel) error {
+	if s.DataModelID != dm.ID || s.WorkspaceID != dm.WorkspaceID {
+		return apperrors.NewInvalidError("snapshot", fmt.Sprintf("snapshot %s is not belong to data model %s", s.ID, dm.Name))
+	}
+	if s.DataModelType != dm.Type {
+		return apperrors.NewInvalidError("snapshot", fmt.Sprintf("snapshot %s of type %s cannot be restored to data model of type %s", s.ID, s.DataModelType, dm.Type))
+	}
+	dm.Headers = s.Headers
+	dm.ColumnTypes = s.ColumnTypes
+	dm.DeclaredColumns = s.DeclaredColumns
+	dm.Rows = s.Rows
+	dm.RowIDs = nil
+	dm.UpdatedAt = time.Now()
+	return nil
+}
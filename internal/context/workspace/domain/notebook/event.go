@@ -9,6 +9,7 @@ import (
 
 const (
 	ImportNotebooks = "ImportNotebooks"
+	CloneNotebooks  = "CloneNotebooks"
 )
 
 type ImportNotebooksEvent struct {
@@ -17,7 +17,16 @@ const (
 	WorkflowGitToken = "gitToken"
 )
 
+// supported workflow languages
 const (
-	Language         = "WDL"
+	LanguageWDL      = "WDL"
+	LanguageNextflow = "Nextflow"
+	LanguageCWL      = "CWL"
+
+	// Language is the default workflow language
+	Language         = LanguageWDL
 	VersionRegexpStr = "^version\\s+([\\w-._]+)"
 )
+
+// SupportedLanguages lists all workflow languages which have a parser
+var SupportedLanguages = []string{LanguageWDL, LanguageNextflow, LanguageCWL}
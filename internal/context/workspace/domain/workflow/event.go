@@ -14,6 +14,7 @@ const (
 	WorkflowVersionAdded = "WorkflowVersionAdded"
 
 	ImportWorkflows = "ImportWorkflows"
+	CloneWorkflows  = "CloneWorkflows"
 )
 
 type WorkflowEvent struct {
@@ -1,17 +1,11 @@
 package workflow
 
 import (
-	"bufio"
 	"context"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"os"
 	"path"
-	"path/filepath"
-	"regexp"
-	"sort"
-	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -23,7 +17,7 @@ import (
 	apperrors "github.com/Bio-OS/bioos/pkg/errors"
 	applog "github.com/Bio-OS/bioos/pkg/log"
 	"github.com/Bio-OS/bioos/pkg/schema"
-	"github.com/Bio-OS/bioos/pkg/utils/exec"
+	"github.com/Bio-OS/bioos/pkg/utils"
 	"github.com/Bio-OS/bioos/pkg/utils/git"
 	"github.com/Bio-OS/bioos/pkg/validator"
 )
@@ -33,14 +27,14 @@ const (
 )
 
 type WorkflowVersionAddedHandler struct {
-	repo        Repository
-	womtoolPath string
+	repo    Repository
+	parsers Parsers
 }
 
-func NewWorkflowVersionAddedHandler(repo Repository, womtoolPath string) *WorkflowVersionAddedHandler {
+func NewWorkflowVersionAddedHandler(repo Repository, parsers Parsers) *WorkflowVersionAddedHandler {
 	return &WorkflowVersionAddedHandler{
-		repo:        repo,
-		womtoolPath: womtoolPath,
+		repo:    repo,
+		parsers: parsers,
 	}
 }
 
@@ -112,35 +106,39 @@ func (h *WorkflowVersionAddedHandler) handle(ctx context.Context, workflowID str
 		}
 		return apperrors.NewInternalError(err)
 	}
+	parser, err := h.parsers.Get(version.Language)
+	if err != nil {
+		return err
+	}
 	// parse workfile version
-	languageVersion, err := h.parseWorkflowVersion(ctx, mainWorkflowPath)
+	languageVersion, err := parser.ParseVersion(ctx, mainWorkflowPath)
 	if err != nil {
 		return apperrors.NewInternalError(err)
 	}
-	version.Language = Language
+	version.Language = parser.Language()
 	version.LanguageVersion = languageVersion
 
 	// step3: validate and save workflow files
-	if err := h.validateWorkflowFiles(ctx, version, dir, version.MainWorkflowPath); err != nil {
+	if err := h.validateWorkflowFiles(ctx, parser, version, dir, version.MainWorkflowPath); err != nil {
 		return err
 	}
 
 	// step4: get workflow inputs
-	inputs, err := h.getWorkflowInputs(ctx, mainWorkflowPath)
+	inputs, err := parser.GetInputs(ctx, mainWorkflowPath)
 	if err != nil {
 		return err
 	}
 	version.Inputs = inputs
 
 	// step5: get workflow outputs
-	outputs, err := h.getWorkflowOutputs(ctx, mainWorkflowPath)
+	outputs, err := parser.GetOutputs(ctx, mainWorkflowPath)
 	if err != nil {
 		return err
 	}
 	version.Outputs = outputs
 
 	// step6: get workflow graph
-	graph, err := h.getWorkflowGraph(ctx, mainWorkflowPath)
+	graph, err := parser.GetGraph(ctx, mainWorkflowPath)
 	if err != nil {
 		return err
 	}
@@ -148,61 +146,12 @@ func (h *WorkflowVersionAddedHandler) handle(ctx context.Context, workflowID str
 	return nil
 }
 
-func (h *WorkflowVersionAddedHandler) parseWorkflowVersion(_ context.Context, mainWorkflowPath string) (string, error) {
-	versionRegexp := regexp.MustCompile(VersionRegexpStr)
-	file, err := os.Open(mainWorkflowPath)
-	if err != nil {
-		applog.Errorw("fail to open main workflow file", "err", err)
-		return "", err
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		matched := versionRegexp.FindStringSubmatch(line)
-		if matched != nil && len(matched) >= 2 {
-			applog.Infow("version regexp matched", "matched", matched)
-			return matched[1], nil
-		}
-	}
-
-	if err := scanner.Err(); err != nil {
-		return "", err
-	}
-	return "draft-2", nil
-}
-
-func (h *WorkflowVersionAddedHandler) validateWorkflowFiles(ctx context.Context, version *WorkflowVersion, baseDir, mainWorkflowPath string) error {
-	applog.Infow("start to validate files", "mainWorkflowPath", mainWorkflowPath)
-	validateResult, err := exec.Exec(ctx, CommandExecuteTimeout, "java", "-jar", h.womtoolPath, "validate", path.Join(baseDir, mainWorkflowPath), "-l")
+func (h *WorkflowVersionAddedHandler) validateWorkflowFiles(ctx context.Context, parser Parser, version *WorkflowVersion, baseDir, mainWorkflowPath string) error {
+	applog.Infow("start to validate files", "mainWorkflowPath", mainWorkflowPath, "language", parser.Language())
+	workflowFiles, err := parser.Validate(ctx, baseDir, mainWorkflowPath)
 	if err != nil {
-		applog.Errorw("fail to validate workflow", "err", err, "result", string(validateResult))
-		return apperrors.NewInternalError(fmt.Errorf("validate workflow failed"))
-	}
-	validateResultLines := strings.Split(string(validateResult), "\n")
-	applog.Infow("validate result", "result", validateResultLines)
-	// parse and save workflow files
-	if len(validateResultLines) < 2 || strings.ToLower(validateResultLines[0]) != "success!" {
-		return proto.ErrorWorkflowValidateError("fail to validate workflow version:%s", version.ID)
-	}
-	workflowFiles := []string{mainWorkflowPath}
-	// need to start from line 2(start with line 0)
-	for i := 2; i < len(validateResultLines); i++ {
-		absPath := validateResultLines[i]
-		if len(absPath) == 0 {
-			continue
-		}
-		// validate file
-		if _, err := os.Stat(absPath); err == nil {
-			// in mac absPath was prefix with /private
-			relPath, err := filepath.Rel(baseDir, absPath[strings.LastIndex(absPath, baseDir):])
-			if err != nil {
-				return apperrors.NewInternalError(err)
-			}
-			applog.Infow("file path", "baseDir", baseDir, "absPath", absPath, "relPath", relPath)
-			workflowFiles = append(workflowFiles, relPath)
-		}
+		applog.Errorw("fail to validate workflow", "err", err)
+		return proto.ErrorWorkflowValidateError("fail to validate workflow version:%s: %s", version.ID, err)
 	}
 	for _, relPath := range workflowFiles {
 		input, err := os.ReadFile(path.Join(baseDir, relPath))
@@ -225,84 +174,6 @@ func (h *WorkflowVersionAddedHandler) validateWorkflowFiles(ctx context.Context,
 	return nil
 }
 
-func (h *WorkflowVersionAddedHandler) getWorkflowInputs(ctx context.Context, WorkflowFilePath string) ([]WorkflowParam, error) {
-	return h.getWorkflowParams(ctx, "java", "-jar", h.womtoolPath, "inputs", WorkflowFilePath)
-}
-
-func (h *WorkflowVersionAddedHandler) getWorkflowOutputs(ctx context.Context, WorkflowFilePath string) ([]WorkflowParam, error) {
-	return h.getWorkflowParams(ctx, "java", "-jar", h.womtoolPath, "outputs", WorkflowFilePath)
-}
-
-func (h *WorkflowVersionAddedHandler) getWorkflowParams(ctx context.Context, name string, arg ...string) ([]WorkflowParam, error) {
-	params := make([]WorkflowParam, 0)
-	outputsResult, err := exec.Exec(ctx, CommandExecuteTimeout, name, arg...)
-	if err != nil {
-		return params, err
-	}
-	var outputsMap map[string]string
-	if err := json.Unmarshal(outputsResult, &outputsMap); err != nil {
-		return params, err
-	}
-
-	for paramName, value := range outputsMap {
-		paramType, optional, defaultValue := parseWorkflowParamValue(value)
-		param := WorkflowParam{
-			Name:     paramName,
-			Type:     paramType,
-			Optional: optional,
-		}
-		if defaultValue != nil {
-			param.Default = *defaultValue
-		}
-		params = append(params, param)
-	}
-	// keep the return sort stable
-	sort.Slice(params, func(i, j int) bool {
-		return params[i].Name < params[j].Name
-	})
-	return params, nil
-}
-
-func (h *WorkflowVersionAddedHandler) getWorkflowGraph(ctx context.Context, WorkflowFilePath string) (string, error) {
-	graph, err := exec.Exec(ctx, CommandExecuteTimeout, "java", "-jar", h.womtoolPath, "graph", WorkflowFilePath)
-	if err != nil {
-		return "", err
-	}
-
-	return string(graph), nil
-}
-
-func parseWorkflowParamValue(value string) (paramType string, optional bool, defaultValue *string) {
-	splitByLeftBracket := strings.SplitN(value, "(", 2)
-	paramType = strings.TrimSpace(splitByLeftBracket[0])
-	if len(splitByLeftBracket) == 1 {
-		return paramType, false, nil
-	}
-
-	extraInfo := strings.TrimSuffix(splitByLeftBracket[1], ")")
-	splitByComma := strings.SplitN(extraInfo, ",", 2)
-	if strings.TrimSpace(splitByComma[0]) == "optional" {
-		optional = true
-	}
-	if len(splitByComma) == 1 {
-		return paramType, optional, nil
-	}
-
-	defaultInfo := strings.TrimSpace(splitByComma[1])
-	splitByEqual := strings.SplitN(defaultInfo, "=", 2)
-	if len(splitByEqual) != 2 || strings.ToLower(strings.TrimSpace(splitByEqual[0])) != "default" {
-		return paramType, optional, nil
-	}
-	rawDefaultValue := strings.TrimSpace(splitByEqual[1])
-	defaultValue = new(string)
-	if strings.HasPrefix(rawDefaultValue, `"`) && strings.HasSuffix(rawDefaultValue, `"`) { // String type
-		_ = json.Unmarshal([]byte(rawDefaultValue), defaultValue) // escape, never error
-	} else {
-		*defaultValue = rawDefaultValue
-	}
-	return paramType, optional, defaultValue
-}
-
 type WorkspaceDeletedHandler struct {
 	repo Repository
 }
@@ -438,11 +309,89 @@ func (h *ImportWorkflowsHandler) Handle(ctx context.Context, event *ImportWorkfl
 	return nil
 }
 
+type CloneWorkflowsHandler struct {
+	repo    Repository
+	factory *Factory
+}
+
+func NewCloneWorkflowsHandler(repo Repository, factory *Factory) *CloneWorkflowsHandler {
+	return &CloneWorkflowsHandler{
+		repo:    repo,
+		factory: factory,
+	}
+}
+
+// Handle copies the workflows of source workspace with all versions and files, the workflows already
+// cloned are skipped when retrying.
+func (h *CloneWorkflowsHandler) Handle(ctx context.Context, event *workspace.CloneComponentEvent) error {
+	clonedIDs, err := h.repo.List(ctx, event.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	cloned := sets.New[string]()
+	for _, id := range clonedIDs {
+		wf, err := h.repo.Get(ctx, event.WorkspaceID, id)
+		if err != nil {
+			return err
+		}
+		cloned.Insert(wf.Name)
+	}
+	sourceIDs, err := h.repo.List(ctx, event.SourceWorkspaceID)
+	if err != nil {
+		return err
+	}
+	for _, id := range sourceIDs {
+		source, err := h.repo.Get(ctx, event.SourceWorkspaceID, id)
+		if err != nil {
+			return err
+		}
+		if cloned.Has(source.Name) {
+			continue
+		}
+		wf, err := h.cloneWorkflow(event.WorkspaceID, source)
+		if err != nil {
+			return err
+		}
+		if err := h.repo.Save(ctx, wf); err != nil {
+			return err
+		}
+		applog.Infow("success clone workflow", "workspace", event.WorkspaceID, "source", source.ID, "workflow", wf.ID)
+	}
+	return nil
+}
+
+// cloneWorkflow copies the workflow into workspace with new ids of workflow, versions and files.
+func (h *CloneWorkflowsHandler) cloneWorkflow(workspaceID string, source *Workflow) (*Workflow, error) {
+	wf, err := h.factory.NewWorkflow(workspaceID, &WorkflowOption{
+		Name:        source.Name,
+		Description: &source.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	wf.Versions = make(map[string]*WorkflowVersion, len(source.Versions))
+	for _, sourceVersion := range source.Versions {
+		version := *sourceVersion
+		version.ID = utils.GenWorkflowVersionID()
+		version.Files = make(map[string]*WorkflowFile, len(sourceVersion.Files))
+		for _, sourceFile := range sourceVersion.Files {
+			file := *sourceFile
+			file.ID = utils.GenWorkflowFileID()
+			version.Files[file.ID] = &file
+		}
+		wf.Versions[version.ID] = &version
+		if sourceVersion.ID == source.LatestVersion {
+			wf.LatestVersion = version.ID
+		}
+	}
+	return wf, nil
+}
+
 func validateWorkflow(workflow schema.WorkflowTypedSchema) error {
 	if !validator.ValidateResNameInString(workflow.Name) {
 		return fmt.Errorf("workflow name[%s] not passed the validation ", workflow.Name)
 	}
-	if workflow.Language != "WDL" {
+	if !IsSupportedLanguage(workflow.Language) {
 		return fmt.Errorf("workflow language [%s] not passed the validation ", workflow.Language)
 	}
 	//TODO Validation will be consistent with that of commercial version in the future
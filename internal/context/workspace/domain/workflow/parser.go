@@ -0,0 +1,73 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+)
+
+// Parser parses workflow files written in a specific workflow language.
+// All paths passed to a Parser are absolute except where noted.
+type Parser interface {
+	// Language returns the workflow language handled by the parser.
+	Language() string
+	// ParseVersion returns the language version of the main workflow file.
+	ParseVersion(ctx context.Context, mainWorkflowPath string) (string, error)
+	// Validate validates the workflow and returns the paths (relative to baseDir) of
+	// all files it depends on, the main workflow file comes first.
+	Validate(ctx context.Context, baseDir, mainWorkflowPath string) ([]string, error)
+	// GetInputs returns the inputs of the workflow sorted by name.
+	GetInputs(ctx context.Context, mainWorkflowPath string) ([]WorkflowParam, error)
+	// GetOutputs returns the outputs of the workflow sorted by name.
+	GetOutputs(ctx context.Context, mainWorkflowPath string) ([]WorkflowParam, error)
+	// GetGraph returns the graph of the workflow in dot format.
+	GetGraph(ctx context.Context, mainWorkflowPath string) (string, error)
+}
+
+// Parsers holds workflow parsers by language.
+type Parsers map[string]Parser
+
+// NewParsers returns parsers of all supported languages, womtool is used as the fallback of WDL parser if womtoolPath is not empty.
+func NewParsers(womtoolPath string) Parsers {
+	parsers := Parsers{}
+	parsers.Register(NewWDLParser(womtoolPath))
+	parsers.Register(NewNextflowParser())
+	parsers.Register(NewCWLParser())
+	return parsers
+}
+
+// Register adds a parser, replacing the one registered for the same language.
+func (p Parsers) Register(parser Parser) {
+	p[parser.Language()] = parser
+}
+
+// Get returns the parser of language, WDL is used when language is empty.
+func (p Parsers) Get(language string) (Parser, error) {
+	if language == "" {
+		language = Language
+	}
+	parser, ok := p[language]
+	if !ok {
+		return nil, apperrors.NewInvalidError(fmt.Sprintf("unsupported workflow language: %s", language))
+	}
+	return parser, nil
+}
+
+// IsSupportedLanguage returns whether language has a parser.
+func IsSupportedLanguage(language string) bool {
+	for _, l := range SupportedLanguages {
+		if l == language {
+			return true
+		}
+	}
+	return false
+}
+
+// sortWorkflowParams keeps the return sort stable
+func sortWorkflowParams(params []WorkflowParam) {
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].Name < params[j].Name
+	})
+}
@@ -0,0 +1,352 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// cwl document classes accepted as main workflow
+const (
+	cwlClassWorkflow        = "Workflow"
+	cwlClassCommandLineTool = "CommandLineTool"
+	cwlClassExpressionTool  = "ExpressionTool"
+)
+
+// cwlParser parses CWL documents written in yaml or json.
+type cwlParser struct{}
+
+// NewCWLParser returns a CWL parser.
+func NewCWLParser() Parser {
+	return &cwlParser{}
+}
+
+func (p *cwlParser) Language() string {
+	return LanguageCWL
+}
+
+func (p *cwlParser) ParseVersion(_ context.Context, mainWorkflowPath string) (string, error) {
+	doc, err := loadCWLDocument(mainWorkflowPath)
+	if err != nil {
+		return "", err
+	}
+	version, _ := doc["cwlVersion"].(string)
+	if version == "" {
+		return "", fmt.Errorf("cwlVersion is not specified")
+	}
+	return version, nil
+}
+
+func (p *cwlParser) Validate(_ context.Context, baseDir, mainWorkflowPath string) ([]string, error) {
+	files := []string{mainWorkflowPath}
+	visited := map[string]bool{mainWorkflowPath: true}
+	queue := []string{mainWorkflowPath}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		doc, err := loadCWLDocument(path.Join(baseDir, current))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", current, err)
+		}
+		if err := validateCWLDocument(doc, current == mainWorkflowPath); err != nil {
+			return nil, fmt.Errorf("%s: %w", current, err)
+		}
+		runs, imports := collectCWLReferences(doc)
+		for i, ref := range append(runs, imports...) {
+			refPath, err := resolveRelativeFile(baseDir, path.Dir(current), ref)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", current, err)
+			}
+			if visited[refPath] {
+				continue
+			}
+			visited[refPath] = true
+			files = append(files, refPath)
+			// only processes referenced by run need to be followed, imported fragments are kept as is
+			if i < len(runs) {
+				queue = append(queue, refPath)
+			}
+		}
+	}
+	return files, nil
+}
+
+func (p *cwlParser) GetInputs(_ context.Context, mainWorkflowPath string) ([]WorkflowParam, error) {
+	return p.getParams(mainWorkflowPath, "inputs")
+}
+
+func (p *cwlParser) GetOutputs(_ context.Context, mainWorkflowPath string) ([]WorkflowParam, error) {
+	return p.getParams(mainWorkflowPath, "outputs")
+}
+
+func (p *cwlParser) getParams(mainWorkflowPath, field string) ([]WorkflowParam, error) {
+	doc, err := loadCWLDocument(mainWorkflowPath)
+	if err != nil {
+		return nil, err
+	}
+	params := make([]WorkflowParam, 0)
+	for _, entry := range cwlEntries(doc[field]) {
+		param := WorkflowParam{Name: entry.id}
+		var rawType interface{} = entry.value
+		if fields, ok := entry.value.(map[string]interface{}); ok {
+			rawType = fields["type"]
+			if defaultValue, exist := fields["default"]; exist && defaultValue != nil {
+				param.Default = formatDefaultValue(defaultValue)
+				param.Optional = true
+			}
+		}
+		paramType, optional := cwlParamType(rawType)
+		param.Type = paramType
+		param.Optional = param.Optional || optional
+		params = append(params, param)
+	}
+	sortWorkflowParams(params)
+	return params, nil
+}
+
+func (p *cwlParser) GetGraph(_ context.Context, mainWorkflowPath string) (string, error) {
+	doc, err := loadCWLDocument(mainWorkflowPath)
+	if err != nil {
+		return "", err
+	}
+	var graph strings.Builder
+	graph.WriteString("digraph cwl {\n")
+	for _, step := range cwlEntries(doc["steps"]) {
+		graph.WriteString(fmt.Sprintf("  %q;\n", step.id))
+		fields, _ := step.value.(map[string]interface{})
+		sources := make([]string, 0)
+		for _, in := range cwlEntries(fields["in"]) {
+			source := in.value
+			if inFields, ok := in.value.(map[string]interface{}); ok {
+				source = inFields["source"]
+			}
+			for _, s := range toStringSlice(source) {
+				// step output is referenced as step/output, the others are workflow inputs
+				if idx := strings.Index(strings.TrimPrefix(s, "#"), "/"); idx > 0 {
+					sources = append(sources, strings.TrimPrefix(s, "#")[:idx])
+				}
+			}
+		}
+		sort.Strings(sources)
+		for i, source := range sources {
+			if i > 0 && sources[i-1] == source {
+				continue
+			}
+			graph.WriteString(fmt.Sprintf("  %q -> %q;\n", source, step.id))
+		}
+	}
+	graph.WriteString("}\n")
+	return graph.String(), nil
+}
+
+// loadCWLDocument loads a cwl document, the main process is picked out of a packed ($graph) document.
+func loadCWLDocument(filePath string) (map[string]interface{}, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("invalid cwl document: %w", err)
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("empty cwl document")
+	}
+	processes, ok := doc["$graph"].([]interface{})
+	if !ok {
+		return doc, nil
+	}
+	var main map[string]interface{}
+	for _, item := range processes {
+		process, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if main == nil {
+			main = process
+		}
+		if id, _ := process["id"].(string); id == "main" || id == "#main" {
+			main = process
+			break
+		}
+	}
+	if main == nil {
+		return nil, fmt.Errorf("no process found in $graph")
+	}
+	if _, exist := main["cwlVersion"]; !exist {
+		main["cwlVersion"] = doc["cwlVersion"]
+	}
+	return main, nil
+}
+
+func validateCWLDocument(doc map[string]interface{}, isMain bool) error {
+	class, _ := doc["class"].(string)
+	switch class {
+	case cwlClassWorkflow, cwlClassCommandLineTool, cwlClassExpressionTool:
+	default:
+		return fmt.Errorf("unsupported cwl class: %q", class)
+	}
+	if isMain {
+		if version, _ := doc["cwlVersion"].(string); version == "" {
+			return fmt.Errorf("cwlVersion is not specified")
+		}
+	}
+	if class == cwlClassWorkflow {
+		if _, exist := doc["steps"]; !exist {
+			return fmt.Errorf("workflow has no steps")
+		}
+	}
+	return nil
+}
+
+// collectCWLReferences returns relative file references of a document: processes referenced by step run,
+// and fragments referenced by $import or $include.
+func collectCWLReferences(doc map[string]interface{}) (runs []string, imports []string) {
+	for _, step := range cwlEntries(doc["steps"]) {
+		fields, _ := step.value.(map[string]interface{})
+		if run, ok := fields["run"].(string); ok {
+			runs = appendLocalCWLReference(runs, run)
+		}
+	}
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for key, item := range v {
+				if ref, ok := item.(string); ok && (key == "$import" || key == "$include") {
+					imports = appendLocalCWLReference(imports, ref)
+					continue
+				}
+				walk(item)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(doc)
+	sort.Strings(imports)
+	return runs, imports
+}
+
+// appendLocalCWLReference strips the fragment of ref and appends it if it is a local file.
+func appendLocalCWLReference(refs []string, ref string) []string {
+	ref = strings.SplitN(ref, "#", 2)[0]
+	if ref == "" || strings.Contains(ref, "://") {
+		return refs
+	}
+	return append(refs, ref)
+}
+
+type cwlEntry struct {
+	id    string
+	value interface{}
+}
+
+// cwlEntries normalizes the map and list forms of inputs, outputs, steps and step in.
+func cwlEntries(value interface{}) []cwlEntry {
+	entries := make([]cwlEntry, 0)
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for id, item := range v {
+			entries = append(entries, cwlEntry{id: id, value: item})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].id < entries[j].id
+		})
+	case []interface{}:
+		for _, item := range v {
+			fields, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			id, _ := fields["id"].(string)
+			// ids may be written as #main/name
+			id = id[strings.LastIndex(id, "/")+1:]
+			entries = append(entries, cwlEntry{id: strings.TrimPrefix(id, "#"), value: fields})
+		}
+	}
+	return entries
+}
+
+// cwlParamType converts a cwl type to the param type used by workflow versions and reports whether it is optional.
+func cwlParamType(rawType interface{}) (string, bool) {
+	switch t := rawType.(type) {
+	case string:
+		optional := strings.HasSuffix(t, "?")
+		t = strings.TrimSuffix(t, "?")
+		if strings.HasSuffix(t, "[]") {
+			itemType, _ := cwlParamType(strings.TrimSuffix(t, "[]"))
+			return fmt.Sprintf("Array[%s]", itemType), optional
+		}
+		return cwlBasicType(t), optional
+	case []interface{}:
+		optional := false
+		types := make([]string, 0)
+		for _, item := range t {
+			if item == "null" {
+				optional = true
+				continue
+			}
+			itemType, _ := cwlParamType(item)
+			types = append(types, itemType)
+		}
+		if len(types) == 1 {
+			return types[0], optional
+		}
+		return strings.Join(types, "|"), optional
+	case map[string]interface{}:
+		switch t["type"] {
+		case "array":
+			itemType, _ := cwlParamType(t["items"])
+			return fmt.Sprintf("Array[%s]", itemType), false
+		case "enum":
+			return "String", false
+		case "record":
+			return "Object", false
+		}
+	}
+	return "String", false
+}
+
+func cwlBasicType(t string) string {
+	switch t {
+	case "string", "enum":
+		return "String"
+	case "int", "long":
+		return "Int"
+	case "float", "double":
+		return "Float"
+	case "boolean":
+		return "Boolean"
+	case "File", "stdout", "stderr":
+		return "File"
+	case "Directory":
+		return "Directory"
+	case "Any":
+		return "Any"
+	}
+	return t
+}
+
+func toStringSlice(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
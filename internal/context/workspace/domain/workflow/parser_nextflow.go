@@ -0,0 +1,422 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// NextflowConfigFileName is the config file loaded by nextflow beside the main script
+	NextflowConfigFileName = "nextflow.config"
+	// NextflowSchemaFileName is the nf-core parameter schema beside the main script
+	NextflowSchemaFileName = "nextflow_schema.json"
+	// NextflowDefaultDSLVersion is used when the script does not enable a dsl explicitly
+	NextflowDefaultDSLVersion = "DSL2"
+)
+
+var (
+	nextflowDSLRegexp           = regexp.MustCompile(`^\s*nextflow\.enable\.dsl\s*=\s*(\d+)`)
+	nextflowIncludeRegexp       = regexp.MustCompile(`^\s*include\s*\{([^}]*)\}\s*from\s*['"]([^'"]+)['"]`)
+	nextflowIncludeConfigRegexp = regexp.MustCompile(`^\s*includeConfig\s+['"]([^'"]+)['"]`)
+	nextflowBlockRegexp         = regexp.MustCompile(`^\s*(process|workflow)\s*([\w]*)\s*\{`)
+	nextflowParamRegexp         = regexp.MustCompile(`^\s*params\.([\w]+)\s*=\s*(.+?)\s*$`)
+	nextflowCallRegexp          = regexp.MustCompile(`\b([A-Za-z_][\w]*)\s*\(`)
+)
+
+// nextflowParser parses nextflow pipelines without running nextflow.
+type nextflowParser struct{}
+
+// NewNextflowParser returns a Nextflow parser.
+func NewNextflowParser() Parser {
+	return &nextflowParser{}
+}
+
+func (p *nextflowParser) Language() string {
+	return LanguageNextflow
+}
+
+func (p *nextflowParser) ParseVersion(_ context.Context, mainWorkflowPath string) (string, error) {
+	lines, err := readLines(mainWorkflowPath)
+	if err != nil {
+		return "", err
+	}
+	for _, line := range lines {
+		if matched := nextflowDSLRegexp.FindStringSubmatch(line); len(matched) == 2 {
+			return "DSL" + matched[1], nil
+		}
+	}
+	return NextflowDefaultDSLVersion, nil
+}
+
+func (p *nextflowParser) Validate(_ context.Context, baseDir, mainWorkflowPath string) ([]string, error) {
+	lines, err := readLines(path.Join(baseDir, mainWorkflowPath))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBracesBalanced(lines); err != nil {
+		return nil, fmt.Errorf("%s: %w", mainWorkflowPath, err)
+	}
+
+	files := []string{mainWorkflowPath}
+	visited := map[string]bool{mainWorkflowPath: true}
+	add := func(relPath string) bool {
+		if visited[relPath] {
+			return false
+		}
+		visited[relPath] = true
+		files = append(files, relPath)
+		return true
+	}
+
+	// follow module includes recursively
+	queue := []string{mainWorkflowPath}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		lines, err := readLines(path.Join(baseDir, current))
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range lines {
+			matched := nextflowIncludeRegexp.FindStringSubmatch(line)
+			if len(matched) != 3 || !isRelativeSource(matched[2]) {
+				continue
+			}
+			module, err := resolveNextflowModule(baseDir, path.Dir(current), matched[2])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", current, err)
+			}
+			if add(module) {
+				queue = append(queue, module)
+			}
+		}
+	}
+
+	// config and schema files beside main script
+	mainDir := path.Dir(mainWorkflowPath)
+	configs := make([]string, 0)
+	for _, name := range []string{NextflowConfigFileName, NextflowSchemaFileName} {
+		relPath := path.Join(mainDir, name)
+		if fileExists(path.Join(baseDir, relPath)) && add(relPath) && name == NextflowConfigFileName {
+			configs = append(configs, relPath)
+		}
+	}
+	// follow includeConfig recursively
+	for len(configs) > 0 {
+		current := configs[0]
+		configs = configs[1:]
+		lines, err := readLines(path.Join(baseDir, current))
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range lines {
+			matched := nextflowIncludeConfigRegexp.FindStringSubmatch(line)
+			if len(matched) != 2 || strings.Contains(matched[1], "://") {
+				continue
+			}
+			config, err := resolveRelativeFile(baseDir, path.Dir(current), matched[1])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", current, err)
+			}
+			if add(config) {
+				configs = append(configs, config)
+			}
+		}
+	}
+	return files, nil
+}
+
+func (p *nextflowParser) GetInputs(_ context.Context, mainWorkflowPath string) ([]WorkflowParam, error) {
+	mainDir := filepath.Dir(mainWorkflowPath)
+	schemaPath := filepath.Join(mainDir, NextflowSchemaFileName)
+	if fileExists(schemaPath) {
+		return parseNextflowSchema(schemaPath)
+	}
+
+	// fallback to params assignment in config and main script, the latter wins
+	paramsByName := make(map[string]WorkflowParam)
+	for _, file := range []string{filepath.Join(mainDir, NextflowConfigFileName), mainWorkflowPath} {
+		if !fileExists(file) {
+			continue
+		}
+		lines, err := readLines(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range lines {
+			matched := nextflowParamRegexp.FindStringSubmatch(stripLineComment(line))
+			if len(matched) != 3 {
+				continue
+			}
+			paramType, defaultValue := inferNextflowParam(matched[2])
+			paramsByName[matched[1]] = WorkflowParam{
+				Name:     matched[1],
+				Type:     paramType,
+				Optional: true,
+				Default:  defaultValue,
+			}
+		}
+	}
+	params := make([]WorkflowParam, 0, len(paramsByName))
+	for _, param := range paramsByName {
+		params = append(params, param)
+	}
+	sortWorkflowParams(params)
+	return params, nil
+}
+
+// GetOutputs returns no outputs, nextflow pipelines publish results via publishDir instead of declaring them.
+func (p *nextflowParser) GetOutputs(_ context.Context, _ string) ([]WorkflowParam, error) {
+	return make([]WorkflowParam, 0), nil
+}
+
+func (p *nextflowParser) GetGraph(_ context.Context, mainWorkflowPath string) (string, error) {
+	lines, err := readLines(mainWorkflowPath)
+	if err != nil {
+		return "", err
+	}
+
+	// collect callable names: processes, workflows and included modules
+	callables := make(map[string]bool)
+	for _, line := range lines {
+		if matched := nextflowBlockRegexp.FindStringSubmatch(line); len(matched) == 3 && matched[2] != "" {
+			callables[matched[2]] = true
+		}
+		if matched := nextflowIncludeRegexp.FindStringSubmatch(line); len(matched) == 3 {
+			for _, name := range parseNextflowIncludeNames(matched[1]) {
+				callables[name] = true
+			}
+		}
+	}
+
+	edges := make([]string, 0)
+	seen := make(map[string]bool)
+	var workflowName string
+	depth := 0
+	for _, line := range lines {
+		line = stripLineComment(line)
+		if depth == 0 {
+			if matched := nextflowBlockRegexp.FindStringSubmatch(line); len(matched) == 3 && matched[1] == "workflow" {
+				workflowName = matched[2]
+				if workflowName == "" {
+					workflowName = "workflow"
+				}
+			} else {
+				workflowName = ""
+			}
+		} else if workflowName != "" {
+			for _, matched := range nextflowCallRegexp.FindAllStringSubmatch(line, -1) {
+				if !callables[matched[1]] || matched[1] == workflowName {
+					continue
+				}
+				edge := fmt.Sprintf("  %q -> %q;", workflowName, matched[1])
+				if !seen[edge] {
+					seen[edge] = true
+					edges = append(edges, edge)
+				}
+			}
+		}
+		depth += strings.Count(line, "{") - strings.Count(line, "}")
+	}
+
+	var graph strings.Builder
+	graph.WriteString("digraph nextflow {\n")
+	for _, edge := range edges {
+		graph.WriteString(edge)
+		graph.WriteString("\n")
+	}
+	graph.WriteString("}\n")
+	return graph.String(), nil
+}
+
+type nextflowSchemaProperty struct {
+	Type    interface{} `json:"type"`
+	Format  string      `json:"format"`
+	Default interface{} `json:"default"`
+}
+
+type nextflowSchemaGroup struct {
+	Properties map[string]nextflowSchemaProperty `json:"properties"`
+	Required   []string                          `json:"required"`
+}
+
+type nextflowSchema struct {
+	nextflowSchemaGroup
+	Definitions map[string]nextflowSchemaGroup `json:"definitions"`
+	Defs        map[string]nextflowSchemaGroup `json:"$defs"`
+}
+
+func parseNextflowSchema(schemaPath string) ([]WorkflowParam, error) {
+	content, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+	var schema nextflowSchema
+	if err := json.Unmarshal(content, &schema); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", NextflowSchemaFileName, err)
+	}
+
+	groups := []nextflowSchemaGroup{schema.nextflowSchemaGroup}
+	for _, definitions := range []map[string]nextflowSchemaGroup{schema.Definitions, schema.Defs} {
+		for _, group := range definitions {
+			groups = append(groups, group)
+		}
+	}
+
+	params := make([]WorkflowParam, 0)
+	for _, group := range groups {
+		required := make(map[string]bool, len(group.Required))
+		for _, name := range group.Required {
+			required[name] = true
+		}
+		for name, property := range group.Properties {
+			param := WorkflowParam{
+				Name:     name,
+				Type:     nextflowSchemaType(property),
+				Optional: !required[name],
+			}
+			if property.Default != nil {
+				param.Default = formatDefaultValue(property.Default)
+			}
+			params = append(params, param)
+		}
+	}
+	sortWorkflowParams(params)
+	return params, nil
+}
+
+func nextflowSchemaType(property nextflowSchemaProperty) string {
+	schemaType, _ := property.Type.(string)
+	if types, ok := property.Type.([]interface{}); ok && len(types) > 0 {
+		schemaType, _ = types[0].(string)
+	}
+	switch schemaType {
+	case "integer":
+		return "Int"
+	case "number":
+		return "Float"
+	case "boolean":
+		return "Boolean"
+	case "string":
+		switch property.Format {
+		case "file-path", "path":
+			return "File"
+		case "directory-path":
+			return "Directory"
+		}
+	}
+	return "String"
+}
+
+func inferNextflowParam(rawValue string) (paramType, defaultValue string) {
+	rawValue = strings.TrimSpace(rawValue)
+	switch {
+	case rawValue == "null":
+		return "String", ""
+	case rawValue == "true" || rawValue == "false":
+		return "Boolean", rawValue
+	case len(rawValue) >= 2 && (rawValue[0] == '"' || rawValue[0] == '\'') && rawValue[len(rawValue)-1] == rawValue[0]:
+		return "String", rawValue[1 : len(rawValue)-1]
+	}
+	if _, err := strconv.ParseInt(rawValue, 10, 64); err == nil {
+		return "Int", rawValue
+	}
+	if _, err := strconv.ParseFloat(rawValue, 64); err == nil {
+		return "Float", rawValue
+	}
+	return "String", rawValue
+}
+
+func parseNextflowIncludeNames(raw string) []string {
+	names := make([]string, 0)
+	for _, item := range strings.Split(raw, ";") {
+		fields := strings.Fields(item)
+		switch len(fields) {
+		case 1:
+			names = append(names, fields[0])
+		case 3:
+			// NAME as ALIAS
+			names = append(names, fields[2])
+		}
+	}
+	return names
+}
+
+// resolveNextflowModule resolves an include source, which may omit the .nf extension or point to a module directory.
+func resolveNextflowModule(baseDir, currentDir, source string) (string, error) {
+	candidates := []string{source, source + ".nf", path.Join(source, "main.nf")}
+	for _, candidate := range candidates {
+		relPath, err := resolveRelativeFile(baseDir, currentDir, candidate)
+		if err == nil {
+			return relPath, nil
+		}
+	}
+	return "", fmt.Errorf("included module %s not exist", source)
+}
+
+// resolveRelativeFile returns the path relative to baseDir of a file referenced from currentDir, the file must stay in baseDir.
+func resolveRelativeFile(baseDir, currentDir, source string) (string, error) {
+	relPath := path.Clean(path.Join(currentDir, source))
+	if relPath == ".." || strings.HasPrefix(relPath, "../") || path.IsAbs(source) {
+		return "", fmt.Errorf("file %s is outside of workflow directory", source)
+	}
+	info, err := os.Stat(path.Join(baseDir, relPath))
+	if err != nil || info.IsDir() {
+		return "", fmt.Errorf("file %s not exist", source)
+	}
+	return relPath, nil
+}
+
+func isRelativeSource(source string) bool {
+	return strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")
+}
+
+func checkBracesBalanced(lines []string) error {
+	depth := 0
+	for i, line := range lines {
+		depth += strings.Count(stripLineComment(line), "{") - strings.Count(stripLineComment(line), "}")
+		if depth < 0 {
+			return fmt.Errorf("unexpected '}' at line %d", i+1)
+		}
+	}
+	if depth != 0 {
+		return fmt.Errorf("unclosed '{'")
+	}
+	return nil
+}
+
+func stripLineComment(line string) string {
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "*") || strings.HasPrefix(trimmed, "/*") {
+		return ""
+	}
+	return line
+}
+
+func formatDefaultValue(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+	content, _ := json.Marshal(value) // decoded from json or yaml, never error
+	return string(content)
+}
+
+func readLines(filePath string) ([]string, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(string(content), "\n"), nil
+}
+
+func fileExists(filePath string) bool {
+	info, err := os.Stat(filePath)
+	return err == nil && !info.IsDir()
+}
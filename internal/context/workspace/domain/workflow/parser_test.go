@@ -0,0 +1,269 @@
+package workflow
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/wdl"
+)
+
+func TestMain(m *testing.M) {
+	applog.RegisterLogger(&applog.Options{
+		Level: "fatal",
+	})
+	os.Exit(m.Run())
+}
+
+func writeWorkflowFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		filePath := path.Join(dir, name)
+		if err := os.MkdirAll(path.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestNextflowParser(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	dir := writeWorkflowFiles(t, map[string]string{
+		"main.nf": `nextflow.enable.dsl = 2
+include { FASTQC } from './modules/fastqc'
+include { ALIGN as BWA } from './modules/align.nf'
+
+params.reads = "data/*.fq"
+
+process MULTIQC {
+    script:
+    "multiqc ."
+}
+
+workflow {
+    FASTQC(params.reads)
+    BWA(FASTQC.out)
+    MULTIQC(BWA.out.collect())
+}
+`,
+		"modules/fastqc.nf":    "process FASTQC {\n}\n",
+		"modules/align.nf":     "process ALIGN {\n}\n",
+		"nextflow.config":      "includeConfig 'conf/base.config'\nparams.threads = 4\n",
+		"conf/base.config":     "process.cpus = 1\n",
+		"nextflow_schema.json": `{"definitions": {"input": {"required": ["reads"], "properties": {"reads": {"type": "string", "format": "file-path"}, "threads": {"type": "integer", "default": 4}}}}}`,
+	})
+	parser := NewNextflowParser()
+
+	version, err := parser.ParseVersion(ctx, path.Join(dir, "main.nf"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(version).To(gomega.Equal("DSL2"))
+
+	files, err := parser.Validate(ctx, dir, "main.nf")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(files).To(gomega.Equal([]string{"main.nf", "modules/fastqc.nf", "modules/align.nf", "nextflow.config", "nextflow_schema.json", "conf/base.config"}))
+
+	inputs, err := parser.GetInputs(ctx, path.Join(dir, "main.nf"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(inputs).To(gomega.Equal([]WorkflowParam{
+		{Name: "reads", Type: "File"},
+		{Name: "threads", Type: "Int", Optional: true, Default: "4"},
+	}))
+
+	graph, err := parser.GetGraph(ctx, path.Join(dir, "main.nf"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(graph).To(gomega.Equal("digraph nextflow {\n  \"workflow\" -> \"FASTQC\";\n  \"workflow\" -> \"BWA\";\n  \"workflow\" -> \"MULTIQC\";\n}\n"))
+
+	_, err = parser.Validate(ctx, writeWorkflowFiles(t, map[string]string{"main.nf": "include { A } from './missing'\n"}), "main.nf")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestCWLParser(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	dir := writeWorkflowFiles(t, map[string]string{
+		"main.cwl": `cwlVersion: v1.2
+class: Workflow
+inputs:
+  reads: File
+  threads:
+    type: int?
+    default: 2
+  samples: string[]
+outputs:
+  bam:
+    type: File
+    outputSource: align/bam
+steps:
+  trim:
+    run: tools/trim.cwl
+    in:
+      reads: reads
+    out: [trimmed]
+  align:
+    run: tools/align.cwl
+    in:
+      reads: trim/trimmed
+      threads: threads
+    out: [bam]
+`,
+		"tools/trim.cwl":   "cwlVersion: v1.2\nclass: CommandLineTool\ninputs: []\noutputs: []\n",
+		"tools/align.cwl":  "cwlVersion: v1.2\nclass: CommandLineTool\ninputs: []\noutputs: []\nrequirements:\n  - $import: common.yml\n",
+		"tools/common.yml": "class: DockerRequirement\n",
+	})
+	parser := NewCWLParser()
+
+	version, err := parser.ParseVersion(ctx, path.Join(dir, "main.cwl"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(version).To(gomega.Equal("v1.2"))
+
+	files, err := parser.Validate(ctx, dir, "main.cwl")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(files).To(gomega.Equal([]string{"main.cwl", "tools/align.cwl", "tools/trim.cwl", "tools/common.yml"}))
+
+	_, err = parser.Validate(ctx, writeWorkflowFiles(t, map[string]string{"main.cwl": "cwlVersion: v1.2\nclass: Workflow\nsteps:\n  trim:\n    run: missing.cwl\n"}), "main.cwl")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestCWLParserParams(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	dir := writeWorkflowFiles(t, map[string]string{
+		"main.cwl": `cwlVersion: v1.2
+class: Workflow
+inputs:
+  reads: File
+  threads:
+    type: int?
+    default: 2
+  samples: string[]
+outputs:
+  - id: bam
+    type: ["null", File]
+    outputSource: align/bam
+steps:
+  trim:
+    run: trim.cwl
+    in:
+      reads: reads
+    out: [trimmed]
+  align:
+    run: align.cwl
+    in:
+      reads: trim/trimmed
+      threads: threads
+    out: [bam]
+`,
+	})
+	parser := NewCWLParser()
+	mainWorkflowPath := path.Join(dir, "main.cwl")
+
+	inputs, err := parser.GetInputs(ctx, mainWorkflowPath)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(inputs).To(gomega.Equal([]WorkflowParam{
+		{Name: "reads", Type: "File"},
+		{Name: "samples", Type: "Array[String]"},
+		{Name: "threads", Type: "Int", Optional: true, Default: "2"},
+	}))
+
+	outputs, err := parser.GetOutputs(ctx, mainWorkflowPath)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(outputs).To(gomega.Equal([]WorkflowParam{{Name: "bam", Type: "File", Optional: true}}))
+
+	graph, err := parser.GetGraph(ctx, mainWorkflowPath)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(graph).To(gomega.Equal("digraph cwl {\n  \"align\";\n  \"trim\" -> \"align\";\n  \"trim\";\n}\n"))
+}
+
+func TestWDLParser(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	dir := writeWorkflowFiles(t, map[string]string{
+		"wf/main.wdl": `version 1.0
+import "../tasks/hello.wdl" as tasks
+
+workflow main {
+    input {
+        String name = "world"
+    }
+    call tasks.hello { input: name = name }
+    output {
+        File out = hello.out
+    }
+}
+`,
+		"tasks/hello.wdl": `version 1.0
+task hello {
+    input {
+        String name
+        Int? cpu
+    }
+    command <<< echo "hello ~{name}" >>>
+    output {
+        File out = stdout()
+    }
+}
+`,
+	})
+	parser := NewWDLParser("")
+	mainWorkflowPath := path.Join(dir, "wf/main.wdl")
+
+	version, err := parser.ParseVersion(ctx, mainWorkflowPath)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(version).To(gomega.Equal("1.0"))
+
+	files, err := parser.Validate(ctx, dir, "wf/main.wdl")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(files).To(gomega.Equal([]string{"wf/main.wdl", "tasks/hello.wdl"}))
+
+	_, err = parser.Validate(ctx, path.Join(dir, "wf"), "main.wdl")
+	g.Expect(err).To(gomega.HaveOccurred(), "import outside of base dir")
+
+	inputs, err := parser.GetInputs(ctx, mainWorkflowPath)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(inputs).To(gomega.Equal([]WorkflowParam{
+		{Name: "main.hello.cpu", Type: "Int?", Optional: true},
+		{Name: "main.name", Type: "String", Optional: true, Default: "world"},
+	}))
+
+	outputs, err := parser.GetOutputs(ctx, mainWorkflowPath)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(outputs).To(gomega.Equal([]WorkflowParam{{Name: "main.out", Type: "File"}}))
+}
+
+func TestWDLParserRemoteImport(t *testing.T) {
+	g := gomega.NewWithT(t)
+	dir := writeWorkflowFiles(t, map[string]string{
+		"main.wdl": "version 1.0\nimport \"https://example.com/tasks.wdl\" as tasks\nworkflow main {\n}\n",
+	})
+
+	// the error tells to configure womtool, which resolves the http(s) imports
+	_, err := NewWDLParser("").Validate(context.Background(), dir, "main.wdl")
+	g.Expect(err).To(gomega.MatchError(wdl.ErrRemoteImport))
+	g.Expect(err.Error()).To(gomega.ContainSubstring("womtool-file"))
+}
+
+func TestParsersGet(t *testing.T) {
+	g := gomega.NewWithT(t)
+	parsers := NewParsers("womtool.jar")
+
+	parser, err := parsers.Get("")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(parser.Language()).To(gomega.Equal(LanguageWDL))
+
+	for _, language := range SupportedLanguages {
+		parser, err = parsers.Get(language)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(parser.Language()).To(gomega.Equal(language))
+	}
+
+	_, err = parsers.Get("Snakemake")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,167 @@
+package workflow
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/wdl"
+)
+
+// wdlParser parses WDL workflows in process, and falls back to womtool on failure if configured.
+type wdlParser struct {
+	fallback Parser
+}
+
+// NewWDLParser returns a WDL parser, womtool is used as the fallback if womtoolPath is not empty.
+func NewWDLParser(womtoolPath string) Parser {
+	parser := &wdlParser{}
+	if womtoolPath != "" {
+		parser.fallback = NewWomtoolParser(womtoolPath)
+	}
+	return parser
+}
+
+func (p *wdlParser) Language() string {
+	return LanguageWDL
+}
+
+func (p *wdlParser) ParseVersion(_ context.Context, mainWorkflowPath string) (string, error) {
+	return parseWDLVersion(mainWorkflowPath)
+}
+
+func (p *wdlParser) Validate(ctx context.Context, baseDir, mainWorkflowPath string) ([]string, error) {
+	doc, err := p.load(filepath.Join(baseDir, mainWorkflowPath))
+	if err != nil {
+		if p.useFallback(err) {
+			return p.fallback.Validate(ctx, baseDir, mainWorkflowPath)
+		}
+		return nil, err
+	}
+	absBaseDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0)
+	for _, file := range doc.Files() {
+		relPath, err := filepath.Rel(absBaseDir, file)
+		if err != nil || relPath == ".." || strings.HasPrefix(relPath, "../") {
+			return nil, fmt.Errorf("imported file %s is outside of workflow directory", file)
+		}
+		files = append(files, filepath.ToSlash(relPath))
+	}
+	return files, nil
+}
+
+func (p *wdlParser) GetInputs(ctx context.Context, mainWorkflowPath string) ([]WorkflowParam, error) {
+	doc, err := p.load(mainWorkflowPath)
+	if err != nil {
+		if p.useFallback(err) {
+			return p.fallback.GetInputs(ctx, mainWorkflowPath)
+		}
+		return nil, err
+	}
+	inputs, err := doc.Inputs()
+	if err != nil {
+		return nil, err
+	}
+	return toWorkflowParams(inputs), nil
+}
+
+func (p *wdlParser) GetOutputs(ctx context.Context, mainWorkflowPath string) ([]WorkflowParam, error) {
+	doc, err := p.load(mainWorkflowPath)
+	if err != nil {
+		if p.useFallback(err) {
+			return p.fallback.GetOutputs(ctx, mainWorkflowPath)
+		}
+		return nil, err
+	}
+	outputs, err := doc.Outputs()
+	if err != nil {
+		return nil, err
+	}
+	return toWorkflowParams(outputs), nil
+}
+
+func (p *wdlParser) GetGraph(ctx context.Context, mainWorkflowPath string) (string, error) {
+	doc, err := p.load(mainWorkflowPath)
+	if err != nil {
+		if p.useFallback(err) {
+			return p.fallback.GetGraph(ctx, mainWorkflowPath)
+		}
+		return "", err
+	}
+	return doc.Graph()
+}
+
+// load loads the main workflow file, which must define a workflow.
+func (p *wdlParser) load(mainWorkflowPath string) (*wdl.Document, error) {
+	doc, err := wdl.Load(mainWorkflowPath)
+	if err != nil {
+		// http(s) imports were resolved by womtool before the builtin parser, tell how to get it back
+		if errors.Is(err, wdl.ErrRemoteImport) && p.fallback == nil {
+			return nil, fmt.Errorf("%w by the builtin wdl parser, set the womtool-file option of apiserver to parse workflows with http(s) imports by womtool", err)
+		}
+		return nil, err
+	}
+	if doc.Workflow == nil {
+		return nil, fmt.Errorf("no workflow found in %s", filepath.Base(mainWorkflowPath))
+	}
+	return doc, nil
+}
+
+func (p *wdlParser) useFallback(err error) bool {
+	if p.fallback == nil {
+		return false
+	}
+	applog.Warnw("fail to parse wdl in process, fallback to womtool", "err", err)
+	return true
+}
+
+func toWorkflowParams(params []*wdl.Parameter) []WorkflowParam {
+	workflowParams := make([]WorkflowParam, 0, len(params))
+	for _, param := range params {
+		workflowParam := WorkflowParam{
+			Name:     param.Name,
+			Type:     param.Type.String(),
+			Optional: param.Optional,
+		}
+		if defaultValue, ok := param.DefaultValue(); ok {
+			workflowParam.Default = defaultValue
+		}
+		workflowParams = append(workflowParams, workflowParam)
+	}
+	return workflowParams
+}
+
+// parseWDLVersion returns the version declared by the main workflow file, draft-2 if absent.
+func parseWDLVersion(mainWorkflowPath string) (string, error) {
+	versionRegexp := regexp.MustCompile(VersionRegexpStr)
+	file, err := os.Open(mainWorkflowPath)
+	if err != nil {
+		applog.Errorw("fail to open main workflow file", "err", err)
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		matched := versionRegexp.FindStringSubmatch(line)
+		if matched != nil && len(matched) >= 2 {
+			applog.Infow("version regexp matched", "matched", matched)
+			return matched[1], nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return wdl.VersionDraft2, nil
+}
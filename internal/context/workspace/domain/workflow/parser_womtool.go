@@ -0,0 +1,139 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils/exec"
+)
+
+// womtoolParser parses WDL workflows with womtool.
+type womtoolParser struct {
+	womtoolPath string
+}
+
+// NewWomtoolParser returns a WDL parser backed by the womtool jar.
+func NewWomtoolParser(womtoolPath string) Parser {
+	return &womtoolParser{womtoolPath: womtoolPath}
+}
+
+func (p *womtoolParser) Language() string {
+	return LanguageWDL
+}
+
+func (p *womtoolParser) ParseVersion(_ context.Context, mainWorkflowPath string) (string, error) {
+	return parseWDLVersion(mainWorkflowPath)
+}
+
+func (p *womtoolParser) Validate(ctx context.Context, baseDir, mainWorkflowPath string) ([]string, error) {
+	validateResult, err := exec.Exec(ctx, CommandExecuteTimeout, "java", "-jar", p.womtoolPath, "validate", path.Join(baseDir, mainWorkflowPath), "-l")
+	if err != nil {
+		applog.Errorw("fail to validate workflow", "err", err, "result", string(validateResult))
+		return nil, fmt.Errorf("validate workflow failed")
+	}
+	validateResultLines := strings.Split(string(validateResult), "\n")
+	applog.Infow("validate result", "result", validateResultLines)
+	if len(validateResultLines) < 2 || strings.ToLower(validateResultLines[0]) != "success!" {
+		return nil, fmt.Errorf("womtool validate failed: %s", validateResultLines[0])
+	}
+	workflowFiles := []string{mainWorkflowPath}
+	// need to start from line 2(start with line 0)
+	for i := 2; i < len(validateResultLines); i++ {
+		absPath := validateResultLines[i]
+		if len(absPath) == 0 {
+			continue
+		}
+		// validate file
+		if _, err := os.Stat(absPath); err == nil {
+			// in mac absPath was prefix with /private
+			relPath, err := filepath.Rel(baseDir, absPath[strings.LastIndex(absPath, baseDir):])
+			if err != nil {
+				return nil, err
+			}
+			applog.Infow("file path", "baseDir", baseDir, "absPath", absPath, "relPath", relPath)
+			workflowFiles = append(workflowFiles, relPath)
+		}
+	}
+	return workflowFiles, nil
+}
+
+func (p *womtoolParser) GetInputs(ctx context.Context, mainWorkflowPath string) ([]WorkflowParam, error) {
+	return p.getWorkflowParams(ctx, "inputs", mainWorkflowPath)
+}
+
+func (p *womtoolParser) GetOutputs(ctx context.Context, mainWorkflowPath string) ([]WorkflowParam, error) {
+	return p.getWorkflowParams(ctx, "outputs", mainWorkflowPath)
+}
+
+func (p *womtoolParser) getWorkflowParams(ctx context.Context, subCommand, mainWorkflowPath string) ([]WorkflowParam, error) {
+	params := make([]WorkflowParam, 0)
+	outputsResult, err := exec.Exec(ctx, CommandExecuteTimeout, "java", "-jar", p.womtoolPath, subCommand, mainWorkflowPath)
+	if err != nil {
+		return params, err
+	}
+	var outputsMap map[string]string
+	if err := json.Unmarshal(outputsResult, &outputsMap); err != nil {
+		return params, err
+	}
+
+	for paramName, value := range outputsMap {
+		paramType, optional, defaultValue := parseWorkflowParamValue(value)
+		param := WorkflowParam{
+			Name:     paramName,
+			Type:     paramType,
+			Optional: optional,
+		}
+		if defaultValue != nil {
+			param.Default = *defaultValue
+		}
+		params = append(params, param)
+	}
+	sortWorkflowParams(params)
+	return params, nil
+}
+
+func (p *womtoolParser) GetGraph(ctx context.Context, mainWorkflowPath string) (string, error) {
+	graph, err := exec.Exec(ctx, CommandExecuteTimeout, "java", "-jar", p.womtoolPath, "graph", mainWorkflowPath)
+	if err != nil {
+		return "", err
+	}
+
+	return string(graph), nil
+}
+
+func parseWorkflowParamValue(value string) (paramType string, optional bool, defaultValue *string) {
+	splitByLeftBracket := strings.SplitN(value, "(", 2)
+	paramType = strings.TrimSpace(splitByLeftBracket[0])
+	if len(splitByLeftBracket) == 1 {
+		return paramType, false, nil
+	}
+
+	extraInfo := strings.TrimSuffix(splitByLeftBracket[1], ")")
+	splitByComma := strings.SplitN(extraInfo, ",", 2)
+	if strings.TrimSpace(splitByComma[0]) == "optional" {
+		optional = true
+	}
+	if len(splitByComma) == 1 {
+		return paramType, optional, nil
+	}
+
+	defaultInfo := strings.TrimSpace(splitByComma[1])
+	splitByEqual := strings.SplitN(defaultInfo, "=", 2)
+	if len(splitByEqual) != 2 || strings.ToLower(strings.TrimSpace(splitByEqual[0])) != "default" {
+		return paramType, optional, nil
+	}
+	rawDefaultValue := strings.TrimSpace(splitByEqual[1])
+	defaultValue = new(string)
+	if strings.HasPrefix(rawDefaultValue, `"`) && strings.HasSuffix(rawDefaultValue, `"`) { // String type
+		_ = json.Unmarshal([]byte(rawDefaultValue), defaultValue) // escape, never error
+	} else {
+		*defaultValue = rawDefaultValue
+	}
+	return paramType, optional, defaultValue
+}
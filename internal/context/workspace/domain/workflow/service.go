@@ -33,7 +33,7 @@ func NewService(repo Repository, readModel workflow.ReadModel, bus eventbus.Even
 		eventbus:   bus,
 		factory:    factory,
 	}
-	svc.subscribeEvents(womtoolPath)
+	svc.subscribeEvents(NewParsers(womtoolPath))
 	return svc
 }
 
@@ -200,7 +200,7 @@ func (s *service) UpdateVersion(ctx context.Context, workspaceID string, workflo
 	return nil
 }
 
-func (s *service) subscribeEvents(womtoolPath string) {
+func (s *service) subscribeEvents(parsers Parsers) {
 	s.eventbus.Subscribe(WorkflowVersionAdded, eventbus.EventHandlerFunc(func(ctx context.Context, payload string) (err error) {
 		applog.Infow("start to consume workflow version added event", "payload", payload)
 
@@ -209,7 +209,7 @@ func (s *service) subscribeEvents(womtoolPath string) {
 			return err
 		}
 
-		handler := NewWorkflowVersionAddedHandler(s.repository, womtoolPath)
+		handler := NewWorkflowVersionAddedHandler(s.repository, parsers)
 		return handler.Handle(ctx, event)
 	}))
 
@@ -237,4 +237,16 @@ func (s *service) subscribeEvents(womtoolPath string) {
 		return handler.Handle(ctx, event)
 	}))
 
+	s.eventbus.Subscribe(CloneWorkflows, eventbus.EventHandlerFunc(func(ctx context.Context, payload string) (err error) {
+		applog.Infow("start to consume clone workflows event", "payload", payload)
+
+		event, err := workspace.NewCloneComponentEventFromPayload([]byte(payload))
+		if err != nil {
+			return err
+		}
+
+		handler := NewCloneWorkflowsHandler(s.repository, s.factory)
+		return handler.Handle(ctx, event)
+	}))
+
 }
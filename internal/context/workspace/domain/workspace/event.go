@@ -9,8 +9,11 @@ const (
 	WorkspaceCreated  string = "WorkspaceCreated"
 	WorkspaceDeleted  string = "WorkspaceDeleted"
 	WorkspaceImported string = "WorkspaceImported"
+	WorkspaceCloned   string = "WorkspaceCloned"
 
 	ImportWorkspace string = "ImportWorkspace"
+	CloneWorkspace  string = "CloneWorkspace"
+	CloneStorage    string = "CloneStorage"
 )
 
 type WorkspaceEvent struct {
@@ -55,16 +58,22 @@ func NewWorkspaceEventFromPayload(data []byte) (*WorkspaceEvent, error) {
 
 type ImportWorkspaceEvent struct {
 	WorkspaceID string
-	FileName    string
-	Storage     Storage
-	Event       string
+	// JobID is the import job tracking the progress
+	JobID    string
+	FileName string
+	Storage  Storage
+	// Owner is the user importing the workspace
+	Owner string
+	Event string
 }
 
-func NewImportWorkspaceEvent(workspaceID, fileName string, storage Storage) *ImportWorkspaceEvent {
+func NewImportWorkspaceEvent(workspaceID, jobID, fileName string, storage Storage, owner string) *ImportWorkspaceEvent {
 	return &ImportWorkspaceEvent{
 		WorkspaceID: workspaceID,
+		JobID:       jobID,
 		FileName:    fileName,
 		Storage:     storage,
+		Owner:       owner,
 		Event:       ImportWorkspace,
 	}
 }
@@ -92,12 +101,14 @@ func NewImportWorkspaceEventFromPayload(data []byte) (*ImportWorkspaceEvent, err
 
 type WorkspaceImportedEvent struct {
 	WorkspaceID   string
+	JobID         string
 	ImportBaseDir string
 }
 
-func NewWorkspaceImportedEvent(workspaceID, baseDir string) *WorkspaceImportedEvent {
+func NewWorkspaceImportedEvent(workspaceID, jobID, baseDir string) *WorkspaceImportedEvent {
 	return &WorkspaceImportedEvent{
 		WorkspaceID:   workspaceID,
+		JobID:         jobID,
 		ImportBaseDir: baseDir,
 	}
 }
@@ -122,3 +133,112 @@ func NewWorkspaceImportedEventFromPayload(data []byte) (*WorkspaceImportedEvent,
 	}
 	return ret, nil
 }
+
+type CloneWorkspaceEvent struct {
+	WorkspaceID       string
+	SourceWorkspaceID string
+	// JobID is the import job tracking the progress
+	JobID string
+	// IncludeStorage also clones the files on the storage of source workspace
+	IncludeStorage bool
+}
+
+func NewCloneWorkspaceEvent(workspaceID, sourceWorkspaceID, jobID string, includeStorage bool) *CloneWorkspaceEvent {
+	return &CloneWorkspaceEvent{
+		WorkspaceID:       workspaceID,
+		SourceWorkspaceID: sourceWorkspaceID,
+		JobID:             jobID,
+		IncludeStorage:    includeStorage,
+	}
+}
+
+func (e *CloneWorkspaceEvent) EventType() string {
+	return CloneWorkspace
+}
+
+func (e *CloneWorkspaceEvent) Payload() []byte {
+	payload, _ := json.Marshal(e)
+	return payload
+}
+
+func (e *CloneWorkspaceEvent) Delay() time.Duration {
+	return 0
+}
+
+func NewCloneWorkspaceEventFromPayload(data []byte) (*CloneWorkspaceEvent, error) {
+	ret := &CloneWorkspaceEvent{}
+	if err := json.Unmarshal(data, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+type WorkspaceClonedEvent struct {
+	WorkspaceID string
+	JobID       string
+}
+
+func NewWorkspaceClonedEvent(workspaceID, jobID string) *WorkspaceClonedEvent {
+	return &WorkspaceClonedEvent{
+		WorkspaceID: workspaceID,
+		JobID:       jobID,
+	}
+}
+
+func (e *WorkspaceClonedEvent) EventType() string {
+	return WorkspaceCloned
+}
+
+func (e *WorkspaceClonedEvent) Payload() []byte {
+	payload, _ := json.Marshal(e)
+	return payload
+}
+
+func (e *WorkspaceClonedEvent) Delay() time.Duration {
+	return 0
+}
+
+func NewWorkspaceClonedEventFromPayload(data []byte) (*WorkspaceClonedEvent, error) {
+	ret := &WorkspaceClonedEvent{}
+	if err := json.Unmarshal(data, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CloneComponentEvent clones a component of the source workspace into the workspace,
+// the type of event is defined by the context owning the component.
+type CloneComponentEvent struct {
+	WorkspaceID       string
+	SourceWorkspaceID string
+	Event             string
+}
+
+func NewCloneComponentEvent(workspaceID, sourceWorkspaceID, event string) *CloneComponentEvent {
+	return &CloneComponentEvent{
+		WorkspaceID:       workspaceID,
+		SourceWorkspaceID: sourceWorkspaceID,
+		Event:             event,
+	}
+}
+
+func (e *CloneComponentEvent) EventType() string {
+	return e.Event
+}
+
+func (e *CloneComponentEvent) Payload() []byte {
+	payload, _ := json.Marshal(e)
+	return payload
+}
+
+func (e *CloneComponentEvent) Delay() time.Duration {
+	return 0
+}
+
+func NewCloneComponentEventFromPayload(data []byte) (*CloneComponentEvent, error) {
+	ret := &CloneComponentEvent{}
+	if err := json.Unmarshal(data, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
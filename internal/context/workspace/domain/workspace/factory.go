@@ -9,12 +9,16 @@ import (
 
 // CreateWorkspaceParam use to create Workspace
 type CreateWorkspaceParam struct {
-	ID          string
-	Name        string
-	Description string
-	Storage     Storage
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID            string
+	Name          string
+	Description   string
+	Storage       Storage
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	EngineBackend string
+	// Owner is added as the first member with owner role if no members given
+	Owner   string
+	Members []*Member
 }
 
 func (p CreateWorkspaceParam) validate() error {
@@ -45,12 +49,23 @@ func (fac *Factory) CreateWithWorkspaceParam(param CreateWorkspaceParam) (*Works
 		param.UpdatedAt = time.Now()
 	}
 
+	if param.Owner != "" && len(param.Members) == 0 {
+		param.Members = []*Member{{
+			UserName:  param.Owner,
+			Role:      RoleOwner,
+			CreatedAt: param.CreatedAt,
+		}}
+	}
+
 	return &Workspace{
-		ID:          param.ID,
-		Name:        param.Name,
-		Description: param.Description,
-		CreatedAt:   param.CreatedAt,
-		UpdatedAt:   param.UpdatedAt,
-		Storage:     param.Storage,
+		ID:            param.ID,
+		Name:          param.Name,
+		Description:   param.Description,
+		CreatedAt:     param.CreatedAt,
+		UpdatedAt:     param.UpdatedAt,
+		Storage:       param.Storage,
+		EngineBackend: param.EngineBackend,
+		Owner:         param.Owner,
+		Members:       param.Members,
 	}, nil
 }
@@ -0,0 +1,146 @@
+package workspace
+
+import (
+	"time"
+
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+// types of import job
+const (
+	ImportJobTypeImport = "Import"
+	ImportJobTypeClone  = "Clone"
+)
+
+// states of import job and its components
+const (
+	ImportJobPending   = "Pending"
+	ImportJobRunning   = "Running"
+	ImportJobSucceeded = "Succeeded"
+	ImportJobFailed    = "Failed"
+)
+
+// components of workspace imported by import job
+const (
+	ImportComponentNotebooks       = "notebooks"
+	ImportComponentWorkflows       = "workflows"
+	ImportComponentDataModels      = "dataModels"
+	ImportComponentNotebookServers = "notebookServers"
+)
+
+// ImportComponents are imported concurrently after the workspace is created.
+var ImportComponents = []string{
+	ImportComponentNotebooks,
+	ImportComponentWorkflows,
+	ImportComponentDataModels,
+	ImportComponentNotebookServers,
+}
+
+// CloneComponentStorage is the files on the storage of workspace, only cloned if requested.
+const CloneComponentStorage = "storage"
+
+// CloneComponents are cloned concurrently after the workspace is created.
+var CloneComponents = []string{
+	ImportComponentNotebooks,
+	ImportComponentWorkflows,
+	ImportComponentDataModels,
+}
+
+// ImportJob tracks the progress of importing or cloning a workspace.
+type ImportJob struct {
+	ID string
+	// Type is Import or Clone
+	Type        string
+	WorkspaceID string
+	// SourceWorkspaceID is the workspace cloned from
+	SourceWorkspaceID string
+	State             string
+	Components        []*ImportJobComponent
+	// Error is why the job failed
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+type ImportJobComponent struct {
+	Name  string
+	State string
+	// Message is the error of the component if failed
+	Message string
+}
+
+// NewImportJob new a pending import job of workspace.
+func NewImportJob(workspaceID string) *ImportJob {
+	return newImportJob(ImportJobTypeImport, workspaceID, ImportComponents)
+}
+
+// NewCloneJob new a pending job cloning the source workspace into workspace.
+func NewCloneJob(workspaceID, sourceWorkspaceID string, includeStorage bool) *ImportJob {
+	components := CloneComponents
+	if includeStorage {
+		components = append(components[:len(components):len(components)], CloneComponentStorage)
+	}
+	job := newImportJob(ImportJobTypeClone, workspaceID, components)
+	job.SourceWorkspaceID = sourceWorkspaceID
+	return job
+}
+
+func newImportJob(jobType, workspaceID string, components []string) *ImportJob {
+	now := time.Now()
+	job := &ImportJob{
+		ID:          utils.GenImportJobID(),
+		Type:        jobType,
+		WorkspaceID: workspaceID,
+		State:       ImportJobPending,
+		Components:  make([]*ImportJobComponent, len(components)),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	for i, name := range components {
+		job.Components[i] = &ImportJobComponent{Name: name, State: ImportJobPending}
+	}
+	return job
+}
+
+// IsFinished returns true if the job succeeded or failed.
+func (j *ImportJob) IsFinished() bool {
+	return j.State == ImportJobSucceeded || j.State == ImportJobFailed
+}
+
+// Start marks the job running.
+func (j *ImportJob) Start() {
+	if j.State == ImportJobPending {
+		j.State = ImportJobRunning
+		j.UpdatedAt = time.Now()
+	}
+}
+
+// UpdateComponent updates the state of component.
+func (j *ImportJob) UpdateComponent(name, state, message string) {
+	for _, component := range j.Components {
+		if component.Name == name && (component.State != state || component.Message != message) {
+			component.State = state
+			component.Message = message
+			j.UpdatedAt = time.Now()
+		}
+	}
+}
+
+// Succeed marks the job succeeded.
+func (j *ImportJob) Succeed() {
+	j.finish(ImportJobSucceeded, "")
+}
+
+// Fail marks the job failed with the error.
+func (j *ImportJob) Fail(err string) {
+	j.finish(ImportJobFailed, err)
+}
+
+func (j *ImportJob) finish(state, err string) {
+	now := time.Now()
+	j.State = state
+	j.Error = err
+	j.UpdatedAt = now
+	j.FinishedAt = &now
+}
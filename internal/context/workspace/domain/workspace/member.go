@@ -0,0 +1,160 @@
+package workspace
+
+import (
+	"strings"
+	"time"
+
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+)
+
+// Roles of the workspace member.
+const (
+	// RoleOwner can do everything on the workspace, including deleting it and managing the members.
+	RoleOwner = "owner"
+	// RoleWriter can read and change the resources in the workspace.
+	RoleWriter = "writer"
+	// RoleReader can only read the resources in the workspace.
+	RoleReader = "reader"
+)
+
+var roleLevels = map[string]int{
+	RoleReader: 1,
+	RoleWriter: 2,
+	RoleOwner:  3,
+}
+
+// ownerActions are the actions only permitted to owner, the names are the same as the authorized
+// actions of hertz routers and grpc methods.
+var ownerActions = map[string]struct{}{
+	"Delete":                {},
+	"Patch":                 {},
+	"DeleteWorkspace":       {},
+	"UpdateWorkspace":       {},
+	"AddMember":             {},
+	"UpdateMember":          {},
+	"DeleteMember":          {},
+	"AddWorkspaceMember":    {},
+	"UpdateWorkspaceMember": {},
+	"DeleteWorkspaceMember": {},
+}
+
+// readActionPrefixes are the prefixes of the actions which only read the workspace.
+var readActionPrefixes = []string{"Get", "List", "Check", "Stream", "Export", "Clone", "Diff"}
+
+// Member is a user can access the workspace with role.
+type Member struct {
+	UserName  string
+	Role      string
+	CreatedAt time.Time
+}
+
+// ValidRole returns whether the role is one of owner, writer and reader.
+func ValidRole(role string) bool {
+	_, ok := roleLevels[role]
+	return ok
+}
+
+// RequiredRole returns the lowest role permitted to do the action on workspace.
+func RequiredRole(action string) string {
+	if _, ok := ownerActions[action]; ok {
+		return RoleOwner
+	}
+	for _, prefix := range readActionPrefixes {
+		if strings.HasPrefix(action, prefix) {
+			return RoleReader
+		}
+	}
+	return RoleWriter
+}
+
+// RoleAllows returns whether the role is permitted to do the action on workspace.
+func RoleAllows(role, action string) bool {
+	level, ok := roleLevels[role]
+	if !ok {
+		return false
+	}
+	return level >= roleLevels[RequiredRole(action)]
+}
+
+// GetMember returns the member of user name, nil if the user is not a member.
+func (w *Workspace) GetMember(userName string) *Member {
+	for _, member := range w.Members {
+		if member.UserName == userName {
+			return member
+		}
+	}
+	return nil
+}
+
+// AddMember adds the user as a member with role. Adding an owner to the workspace without owner
+// makes it only visible to the members.
+func (w *Workspace) AddMember(userName, role string) error {
+	if userName == "" {
+		return apperrors.NewInvalidError("userName")
+	}
+	if !ValidRole(role) {
+		return apperrors.NewInvalidError("role", role)
+	}
+	if w.GetMember(userName) != nil {
+		return apperrors.NewAlreadyExistError("workspace member", userName)
+	}
+	w.Members = append(w.Members, &Member{
+		UserName:  userName,
+		Role:      role,
+		CreatedAt: time.Now(),
+	})
+	if w.Owner == "" && role == RoleOwner {
+		w.Owner = userName
+	}
+	w.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateMember changes the role of member, the last owner can not be changed.
+func (w *Workspace) UpdateMember(userName, role string) error {
+	if !ValidRole(role) {
+		return apperrors.NewInvalidError("role", role)
+	}
+	member := w.GetMember(userName)
+	if member == nil {
+		return apperrors.NewNotFoundError("workspace member", userName)
+	}
+	if member.Role == role {
+		return nil
+	}
+	if member.Role == RoleOwner && w.countOwners() == 1 {
+		return apperrors.NewInvalidError("the last owner of workspace can not be changed")
+	}
+	member.Role = role
+	if w.Owner == "" && role == RoleOwner {
+		w.Owner = userName
+	}
+	w.UpdatedAt = time.Now()
+	return nil
+}
+
+// RemoveMember removes the member, the last owner can not be removed.
+func (w *Workspace) RemoveMember(userName string) error {
+	for i, member := range w.Members {
+		if member.UserName != userName {
+			continue
+		}
+		if member.Role == RoleOwner && w.countOwners() == 1 {
+			return apperrors.NewInvalidError("the last owner of workspace can not be removed")
+		}
+		w.Members = append(w.Members[:i], w.Members[i+1:]...)
+		w.UpdatedAt = time.Now()
+		return nil
+	}
+	return apperrors.NewNotFoundError("workspace member", userName)
+}
+
+func (w *Workspace) countOwners() int {
+	count := 0
+	for _, member := range w.Members {
+		if member.Role == RoleOwner {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,65 @@
+package workspace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestRoleAllows(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	cases := []struct {
+		role    string
+		action  string
+		allowed bool
+	}{
+		{RoleReader, "Get", true},
+		{RoleReader, "ListWorkflow", true},
+		{RoleReader, "ExportWorkspace", true},
+		{RoleReader, "CreateSubmission", false},
+		{RoleReader, "PatchDataModel", false},
+		{RoleWriter, "CreateSubmission", true},
+		{RoleWriter, "DeleteWorkflow", true},
+		{RoleWriter, "Delete", false},
+		{RoleWriter, "AddMember", false},
+		{RoleOwner, "Delete", true},
+		{RoleOwner, "DeleteWorkspaceMember", true},
+		{"unknown", "Get", false},
+	}
+	for _, c := range cases {
+		g.Expect(RoleAllows(c.role, c.action)).To(gomega.Equal(c.allowed), "%s %s", c.role, c.action)
+	}
+}
+
+func TestWorkspaceMembers(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	ws, err := NewWorkspaceFactory(context.TODO()).CreateWithWorkspaceParam(CreateWorkspaceParam{Name: "ws", Owner: "alice"})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(ws.Members).To(gomega.HaveLen(1))
+	g.Expect(ws.GetMember("alice").Role).To(gomega.Equal(RoleOwner))
+
+	g.Expect(ws.AddMember("bob", RoleReader)).To(gomega.Succeed())
+	g.Expect(ws.AddMember("bob", RoleWriter)).ToNot(gomega.Succeed())
+	g.Expect(ws.AddMember("carol", "admin")).ToNot(gomega.Succeed())
+	g.Expect(ws.UpdateMember("bob", RoleWriter)).To(gomega.Succeed())
+	g.Expect(ws.GetMember("bob").Role).To(gomega.Equal(RoleWriter))
+	g.Expect(ws.UpdateMember("carol", RoleWriter)).ToNot(gomega.Succeed())
+
+	// the last owner must be kept
+	g.Expect(ws.UpdateMember("alice", RoleReader)).ToNot(gomega.Succeed())
+	g.Expect(ws.RemoveMember("alice")).ToNot(gomega.Succeed())
+	g.Expect(ws.UpdateMember("bob", RoleOwner)).To(gomega.Succeed())
+	g.Expect(ws.RemoveMember("alice")).To(gomega.Succeed())
+	g.Expect(ws.GetMember("alice")).To(gomega.BeNil())
+	g.Expect(ws.RemoveMember("alice")).ToNot(gomega.Succeed())
+
+	// the workspace without owner is claimed by the first owner added
+	legacy := &Workspace{ID: "legacy"}
+	g.Expect(legacy.AddMember("bob", RoleWriter)).To(gomega.Succeed())
+	g.Expect(legacy.Owner).To(gomega.BeEmpty())
+	g.Expect(legacy.AddMember("alice", RoleOwner)).To(gomega.Succeed())
+	g.Expect(legacy.Owner).To(gomega.Equal("alice"))
+}
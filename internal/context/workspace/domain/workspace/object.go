@@ -13,6 +13,12 @@ type Workspace struct {
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	Storage     Storage
+	// EngineBackend is the default WES backend to run submissions of the workspace, empty means the default of server.
+	EngineBackend string
+	// Owner is the user created the workspace, empty for the workspaces created before membership
+	// supported which are visible to everyone.
+	Owner   string
+	Members []*Member
 }
 
 type Storage struct {
@@ -57,10 +63,29 @@ func (w *Workspace) UpdateDescription(description string) {
 	}
 }
 
+func (w *Workspace) GetEngineBackend() string {
+	return w.EngineBackend
+}
+
+func (w *Workspace) UpdateEngineBackend(engineBackend string) {
+	if w.EngineBackend != engineBackend {
+		w.UpdatedAt = time.Now()
+		w.EngineBackend = engineBackend
+	}
+}
+
 func (w *Workspace) GetStorage() Storage {
 	return w.Storage
 }
 
+func (w *Workspace) GetOwner() string {
+	return w.Owner
+}
+
+func (w *Workspace) GetMembers() []*Member {
+	return w.Members
+}
+
 func (w *Workspace) String() string {
 	return fmt.Sprintf("workspace id:%s name:%s description:%s", w.ID, w.Name, w.Description)
 }
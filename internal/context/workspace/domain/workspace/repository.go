@@ -10,3 +10,9 @@ type Repository interface {
 	Get(ctx context.Context, id string) (*Workspace, error)
 	Delete(ctx context.Context, w *Workspace) error
 }
+
+// ImportJobRepository allows to get/save import jobs.
+type ImportJobRepository interface {
+	Save(ctx context.Context, job *ImportJob) error
+	Get(ctx context.Context, id string) (*ImportJob, error)
+}
@@ -2,26 +2,34 @@ package workspace
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path"
+	"path/filepath"
 
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
 	applog "github.com/Bio-OS/bioos/pkg/log"
 	"github.com/Bio-OS/bioos/pkg/utils"
 )
 
 type Service interface {
-	Import(ctx context.Context, workspaceID string, fileName string, storage Storage) error
+	// Import imports the workspace owned by owner from the zip file asynchronously, returns the import job.
+	Import(ctx context.Context, workspaceID string, fileName string, storage Storage, owner string) (*ImportJob, error)
+	// Clone creates the workspace and clones the components of source workspace into it asynchronously,
+	// returns the clone job.
+	Clone(ctx context.Context, sourceWorkspaceID string, param CreateWorkspaceParam, includeStorage bool) (*ImportJob, error)
 }
 
 type service struct {
-	eventRepo  eventbus.EventRepository
-	repository Repository
-	eventbus   eventbus.EventBus
-	factory    Factory
+	eventRepo     eventbus.EventRepository
+	repository    Repository
+	importJobRepo ImportJobRepository
+	eventbus      eventbus.EventBus
+	factory       Factory
 }
 
-func (s *service) Import(ctx context.Context, workspaceID string, fileName string, storage Storage) error {
+func (s *service) Import(ctx context.Context, workspaceID string, fileName string, storage Storage, owner string) (*ImportJob, error) {
 	baseDir := path.Join(storage.NFS.MountPath, workspaceID)
 	zipFilePath := path.Join(baseDir, fileName)
 	err := utils.Unzip(zipFilePath, baseDir)
@@ -29,18 +37,81 @@ func (s *service) Import(ctx context.Context, workspaceID string, fileName strin
 	if err != nil {
 		applog.Errorw("unzip failed, clean all zipped files now", "err", err)
 		os.RemoveAll(baseDir)
-		return err
+		return nil, err
 	}
-	event := NewImportWorkspaceEvent(workspaceID, fileName, storage)
-	return s.eventbus.Publish(ctx, event)
+	job := NewImportJob(workspaceID)
+	if err := s.importJobRepo.Save(ctx, job); err != nil {
+		os.RemoveAll(baseDir)
+		return nil, err
+	}
+	event := NewImportWorkspaceEvent(workspaceID, job.ID, fileName, storage, owner)
+	if err := s.eventbus.Publish(ctx, event); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (s *service) Clone(ctx context.Context, sourceWorkspaceID string, param CreateWorkspaceParam, includeStorage bool) (*ImportJob, error) {
+	source, err := s.repository.Get(ctx, sourceWorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if includeStorage {
+		if err := validateCloneStorage(source.Storage, param.Storage); err != nil {
+			return nil, err
+		}
+	}
+	param.ID = ""
+	if param.EngineBackend == "" {
+		param.EngineBackend = source.EngineBackend
+	}
+	ws, err := s.factory.CreateWithWorkspaceParam(param)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.Save(ctx, ws); err != nil {
+		return nil, err
+	}
+	job := NewCloneJob(ws.ID, source.ID, includeStorage)
+	if err := s.importJobRepo.Save(ctx, job); err != nil {
+		if err := s.repository.Delete(ctx, ws); err != nil {
+			applog.Errorw("fail to delete cloned workspace", "workspace", ws.ID, "err", err)
+		}
+		return nil, err
+	}
+	event := NewCloneWorkspaceEvent(ws.ID, source.ID, job.ID, includeStorage)
+	if err := s.eventbus.Publish(ctx, event); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// validateCloneStorage validates the files on source storage can be cloned to the target storage,
+// which must not be the same or nested with source.
+func validateCloneStorage(source, target Storage) error {
+	if source.NFS == nil || source.NFS.MountPath == "" {
+		return apperrors.NewInvalidError("source workspace has no file storage")
+	}
+	if target.NFS == nil || target.NFS.MountPath == "" {
+		return apperrors.NewInvalidError("storage is required to clone the files of source workspace")
+	}
+	sourcePath, targetPath := filepath.Clean(source.NFS.MountPath), filepath.Clean(target.NFS.MountPath)
+	if _, nested := utils.GetSubPath(sourcePath, targetPath); nested {
+		return apperrors.NewInvalidError(fmt.Sprintf("storage %s is in the storage of source workspace", targetPath))
+	}
+	if _, nested := utils.GetSubPath(targetPath, sourcePath); nested {
+		return apperrors.NewInvalidError(fmt.Sprintf("storage %s contains the storage of source workspace", targetPath))
+	}
+	return nil
 }
 
-func NewService(repo Repository, eventRepo eventbus.EventRepository, bus eventbus.EventBus, factory Factory) Service {
+func NewService(repo Repository, importJobRepo ImportJobRepository, eventRepo eventbus.EventRepository, bus eventbus.EventBus, factory Factory) Service {
 	svc := &service{
-		eventRepo:  eventRepo,
-		repository: repo,
-		eventbus:   bus,
-		factory:    factory,
+		eventRepo:     eventRepo,
+		repository:    repo,
+		importJobRepo: importJobRepo,
+		eventbus:      bus,
+		factory:       factory,
 	}
 	return svc
 }
@@ -0,0 +1,39 @@
+package eventbus
+
+import (
+	"context"
+	"time"
+)
+
+// Message is the message delivered by broker, whose topic is the event type.
+type Message struct {
+	ID    string
+	Topic string
+	Body  []byte
+}
+
+// Delivery is a message delivered to a consumer of group.
+type Delivery interface {
+	Message() *Message
+	// Ack marks the message consumed by the group.
+	Ack(ctx context.Context) error
+	// Requeue acks the message and delivers body as a new message of the same topic
+	// only to the group after delay.
+	Requeue(ctx context.Context, body []byte, delay time.Duration) error
+}
+
+// DeliveryHandler handles the delivery, the delivery neither acked nor requeued is redelivered later.
+type DeliveryHandler func(ctx context.Context, delivery Delivery)
+
+// Broker is a message broker with consumer groups, e.g. NATS JetStream or Redis streams.
+type Broker interface {
+	// Publish delivers the message to every group subscribing its topic after delay.
+	Publish(ctx context.Context, msg *Message, delay time.Duration) error
+	// Subscribe declares the group subscribes the topic, the messages published afterwards
+	// are kept for the group until consumed.
+	Subscribe(ctx context.Context, group, topic string) error
+	// Consume delivers the messages of group to handler until ctx is done or the broker is closed,
+	// each message is delivered to only one of the consumers of the group.
+	Consume(ctx context.Context, group string, handler DeliveryHandler) error
+	Close(ctx context.Context) error
+}
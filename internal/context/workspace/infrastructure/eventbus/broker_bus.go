@@ -0,0 +1,178 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/runtime"
+
+	applog "github.com/Bio-OS/bioos/pkg/log"
+)
+
+// BrokerBus is the event bus delivering events by a message broker instead of polling the events in db.
+// The event is carried by the message, so that the retry count and attempts are kept across redeliveries.
+type BrokerBus struct {
+	sync.RWMutex
+	config
+	broker Broker
+	// group is the consumer group of the event bus, the replicas of the same group compete for events
+	group       string
+	subscribers map[string][]EventHandler
+}
+
+var _ EventBus = &BrokerBus{}
+
+// NewBrokerBus new an event bus consuming the events of group from broker.
+func NewBrokerBus(broker Broker, group string, options ...Option) (EventBus, error) {
+	return &BrokerBus{
+		config:      newConfig(options...),
+		broker:      broker,
+		group:       group,
+		subscribers: make(map[string][]EventHandler),
+	}, nil
+}
+
+// Publish publish an event with payload and delay.
+func (bus *BrokerBus) Publish(ctx context.Context, iEvent IEvent) error {
+	return bus.publish(ctx, newEvent(iEvent), iEvent.Delay())
+}
+
+// Subscribe register a handler for the event type.
+func (bus *BrokerBus) Subscribe(eventType string, handler EventHandler) {
+	bus.Lock()
+	defer bus.Unlock()
+	if _, ok := bus.subscribers[eventType]; !ok {
+		if err := bus.broker.Subscribe(context.Background(), bus.group, eventType); err != nil {
+			applog.Errorw("failed to subscribe event type from broker", "group", bus.group, "eventType", eventType, "err", err)
+		}
+	}
+	bus.subscribers[eventType] = append(bus.subscribers[eventType], handler)
+}
+
+// Start start event bus
+func (bus *BrokerBus) Start(ctx context.Context, workers int) error {
+	// don't let panics crash the process
+	runtime.ReallyCrash = false
+	defer runtime.HandleCrash()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer runtime.HandleCrash()
+			if err := bus.broker.Consume(ctx, bus.group, bus.handleDelivery); err != nil {
+				applog.Errorw("failed to consume events from broker", "group", bus.group, "err", err)
+			}
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// Close exit event bus, the broker is closed by its creator because it may be shared.
+func (bus *BrokerBus) Close(ctx context.Context) error {
+	return nil
+}
+
+func (bus *BrokerBus) publish(ctx context.Context, event *Event, delay time.Duration) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return bus.broker.Publish(ctx, &Message{ID: event.EventID, Topic: event.Type, Body: body}, delay)
+}
+
+// handleDelivery handles the event carried by the delivery, the delivery is requeued with
+// the updated event if it is failed or delayed by handlers.
+func (bus *BrokerBus) handleDelivery(ctx context.Context, delivery Delivery) {
+	event := &Event{}
+	if err := json.Unmarshal(delivery.Message().Body, event); err != nil {
+		applog.Errorw("drop malformed event from broker", "messageID", delivery.Message().ID, "err", err)
+		bus.ack(ctx, delivery)
+		return
+	}
+	bus.RLock()
+	handlers := bus.subscribers[event.Type]
+	handles := handlesDeadLettered(bus.subscribers, event)
+	bus.RUnlock()
+	if len(handlers) == 0 { // no handlers just return
+		applog.Infow("no handlers for event", "eventType", event.Type)
+		bus.ack(ctx, delivery)
+		return
+	}
+	// every group receives EventDeadLettered, only the group subscribing the dead lettered event type handles it
+	if !handles {
+		bus.ack(ctx, delivery)
+		return
+	}
+
+	policy := bus.retryPolicyOf(event.Type)
+	event.Status = EventStatusRunning
+	event.Owner = bus.owner
+	attempt, errs, delay, runningFlag := runHandlers(ctx, handlers, event.Payload)
+	// the attempts only checking the delayed event are not recorded, they are too many
+	if len(errs) > 0 || !runningFlag {
+		event.AddAttempt(attempt)
+	}
+	event.UpdatedAt = time.Now()
+
+	if len(errs) > 0 {
+		applog.Infow("failed to handle event", "eventID", event.EventID, "eventType", event.Type, "err", errors.NewAggregate(errs))
+		event.Reason = truncateReason(errors.NewAggregate(errs).Error())
+		event.RetryCount++
+		// if ready reach max retry times, move it to dead letter
+		if event.RetryCount >= policy.MaxRetries {
+			bus.deadLetter(ctx, delivery, event)
+			return
+		}
+		// else let it retry after backoff
+		bus.requeue(ctx, delivery, event, backoff(policy, event.RetryCount))
+		return
+	}
+	if runningFlag {
+		// If the event is still running and needs to be delayed, redeliver it after the specified delay
+		bus.requeue(ctx, delivery, event, delay)
+		return
+	}
+	bus.ack(ctx, delivery)
+}
+
+// requeue redelivers the updated event to the group after delay.
+func (bus *BrokerBus) requeue(ctx context.Context, delivery Delivery, event *Event, delay time.Duration) {
+	event.Status = EventStatusPending
+	event.ScheduledAt = time.Now().Add(delay)
+	body, err := json.Marshal(event)
+	if err != nil {
+		applog.Errorw("failed to marshal event", "eventID", event.EventID, "err", err)
+		return
+	}
+	// the delivery is redelivered by broker if failed to requeue
+	if err := delivery.Requeue(ctx, body, delay); err != nil {
+		applog.Errorw("failed to requeue event", "eventID", event.EventID, "err", err)
+	}
+}
+
+// deadLetter publishes EventDeadLettered for the event and acks it.
+func (bus *BrokerBus) deadLetter(ctx context.Context, delivery Delivery, event *Event) {
+	event.Status = EventStatusDeadLetter
+	applog.Errorw("event is dead lettered", "eventID", event.EventID, "eventType", event.Type, "reason", event.Reason)
+	// never dead letter the dead lettered events again
+	if event.Type != EventDeadLettered {
+		if err := bus.Publish(ctx, NewDeadLetteredEvent(event)); err != nil {
+			// the delivery is redelivered by broker and dead lettered again
+			applog.Errorw("failed to publish dead lettered event", "eventID", event.EventID, "err", err)
+			return
+		}
+	}
+	bus.ack(ctx, delivery)
+}
+
+func (bus *BrokerBus) ack(ctx context.Context, delivery Delivery) {
+	if err := delivery.Ack(ctx); err != nil {
+		applog.Errorw("failed to ack message", "messageID", delivery.Message().ID, "err", err)
+	}
+}
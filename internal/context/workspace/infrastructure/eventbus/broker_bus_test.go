@@ -0,0 +1,162 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	eventbusoptions "github.com/Bio-OS/bioos/pkg/eventbus"
+	"github.com/Bio-OS/bioos/pkg/log"
+)
+
+type fakeEvent struct {
+	eventType string
+	payload   string
+	delay     time.Duration
+}
+
+func (e fakeEvent) EventType() string {
+	return e.eventType
+}
+
+func (e fakeEvent) Payload() []byte {
+	return []byte(e.payload)
+}
+
+func (e fakeEvent) Delay() time.Duration {
+	return e.delay
+}
+
+func newTestBrokerBus(g *gomega.WithT, broker Broker, group string) EventBus {
+	logOpts := log.NewOptions()
+	// only log to stdout
+	logOpts.OutputPath = ""
+	log.RegisterLogger(logOpts)
+	policy := eventbusoptions.RetryPolicy{
+		MaxRetries:     2,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+		Multiplier:     1,
+	}
+	bus, err := NewBrokerBus(broker, group, WithRetryPolicies(policy, nil))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	return bus
+}
+
+func TestBrokerBusDelay(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	broker := NewEmbeddedBroker()
+	defer broker.Close(ctx)
+	bus := newTestBrokerBus(g, broker, "test")
+
+	received := make(chan time.Time, 1)
+	bus.Subscribe("delay", EventHandlerFunc(func(ctx context.Context, payload string) error {
+		received <- time.Now()
+		return nil
+	}))
+	go bus.Start(ctx, 1)
+
+	start := time.Now()
+	g.Expect(bus.Publish(ctx, fakeEvent{eventType: "delay", payload: "a", delay: 200 * time.Millisecond})).To(gomega.Succeed())
+	g.Eventually(received, time.Second).Should(gomega.Receive(gomega.BeTemporally(">=", start.Add(200*time.Millisecond))))
+}
+
+func TestBrokerBusRunningDelayed(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	broker := NewEmbeddedBroker()
+	defer broker.Close(ctx)
+	bus := newTestBrokerBus(g, broker, "test")
+
+	var calls int32
+	done := make(chan struct{})
+	bus.Subscribe("running", EventHandlerFunc(func(ctx context.Context, payload string) error {
+		// delayed more than max retries, which must not be counted as retries
+		if atomic.AddInt32(&calls, 1) <= 3 {
+			return NewErrEventRunningDelayed("still running", 10*time.Millisecond)
+		}
+		close(done)
+		return nil
+	}))
+	deadLettered := make(chan string, 1)
+	bus.Subscribe(EventDeadLettered, EventHandlerFunc(func(ctx context.Context, payload string) error {
+		deadLettered <- payload
+		return nil
+	}))
+	go bus.Start(ctx, 1)
+
+	g.Expect(bus.Publish(ctx, fakeEvent{eventType: "running", payload: "a"})).To(gomega.Succeed())
+	g.Eventually(done, time.Second).Should(gomega.BeClosed())
+	g.Consistently(deadLettered, 100*time.Millisecond).ShouldNot(gomega.Receive())
+}
+
+func TestBrokerBusDeadLetter(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	broker := NewEmbeddedBroker()
+	defer broker.Close(ctx)
+	bus := newTestBrokerBus(g, broker, "test")
+
+	var calls int32
+	bus.Subscribe("failed", EventHandlerFunc(func(ctx context.Context, payload string) error {
+		atomic.AddInt32(&calls, 1)
+		return fmt.Errorf("an error")
+	}))
+	deadLettered := make(chan string, 1)
+	bus.Subscribe(EventDeadLettered, EventHandlerFunc(func(ctx context.Context, payload string) error {
+		deadLettered <- payload
+		return nil
+	}))
+	go bus.Start(ctx, 2)
+
+	g.Expect(bus.Publish(ctx, fakeEvent{eventType: "failed", payload: "a"})).To(gomega.Succeed())
+	var payload string
+	g.Eventually(deadLettered, time.Second).Should(gomega.Receive(&payload))
+	event, err := NewDeadLetteredEventFromPayload([]byte(payload))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(event.Event.Type).To(gomega.Equal("failed"))
+	g.Expect(event.Event.Payload).To(gomega.Equal("a"))
+	g.Expect(event.Event.RetryCount).To(gomega.Equal(2))
+	g.Expect(event.Event.Attempts).To(gomega.HaveLen(2))
+	g.Expect(event.Event.LastError).To(gomega.ContainSubstring("an error"))
+	g.Expect(atomic.LoadInt32(&calls)).To(gomega.Equal(int32(2)))
+}
+
+func TestBrokerBusDeadLetterGroup(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	broker := NewEmbeddedBroker()
+	defer broker.Close(ctx)
+	bus := newTestBrokerBus(g, broker, "test")
+	otherBus := newTestBrokerBus(g, broker, "other")
+
+	bus.Subscribe("failed", EventHandlerFunc(func(ctx context.Context, payload string) error {
+		return fmt.Errorf("an error")
+	}))
+	deadLettered := make(chan string, 1)
+	bus.Subscribe(EventDeadLettered, EventHandlerFunc(func(ctx context.Context, payload string) error {
+		deadLettered <- payload
+		return nil
+	}))
+	// the other group only handles the dead lettered events of the types it subscribes
+	otherDeadLettered := make(chan string, 1)
+	otherBus.Subscribe(EventDeadLettered, EventHandlerFunc(func(ctx context.Context, payload string) error {
+		otherDeadLettered <- payload
+		return nil
+	}))
+	go bus.Start(ctx, 1)
+	go otherBus.Start(ctx, 1)
+
+	g.Expect(bus.Publish(ctx, fakeEvent{eventType: "failed", payload: "a"})).To(gomega.Succeed())
+	g.Eventually(deadLettered, time.Second).Should(gomega.Receive())
+	g.Consistently(otherDeadLettered, 100*time.Millisecond).ShouldNot(gomega.Receive())
+}
@@ -0,0 +1,107 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	eventbusoptions "github.com/Bio-OS/bioos/pkg/eventbus"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+)
+
+// allEventTypes is the type label of events reclaimed by the rule without types
+const allEventTypes = "*"
+
+var reclaimedEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bioos",
+	Subsystem: "eventbus",
+	Name:      "reclaimed_events_total",
+	Help:      "The count of expired events deleted by the compactor.",
+}, []string{"type", "status"})
+
+func init() {
+	prometheus.MustRegister(reclaimedEvents)
+}
+
+// Compactor deletes the finished events expired by the retention rules, so that the
+// event repository doesn't grow forever.
+type Compactor struct {
+	repository EventRepository
+	rules      []eventbusoptions.RetentionRule
+
+	// mutex makes sure only one round of compaction runs at the same time
+	mutex sync.Mutex
+}
+
+// NewCompactor new a compactor of the event repository.
+func NewCompactor(repository EventRepository, rules []eventbusoptions.RetentionRule) *Compactor {
+	return &Compactor{
+		repository: repository,
+		rules:      rules,
+	}
+}
+
+// Start compacts the events periodically until ctx is done.
+func (c *Compactor) Start(ctx context.Context, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := c.Compact(ctx)
+			if err != nil {
+				applog.Errorw("failed to compact events", "err", err)
+				continue
+			}
+			applog.Infow("events compacted", "reclaimed", count)
+		}
+	}
+}
+
+// Compact deletes the expired events once, returns the count of deleted events.
+func (c *Compactor) Compact(ctx context.Context) (int64, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	// the events of types with their own rules are excluded from the rule without types
+	typedStatus := make(map[string][]string)
+	for _, rule := range c.rules {
+		typedStatus[rule.Status] = append(typedStatus[rule.Status], rule.Types...)
+	}
+
+	var total int64
+	for _, rule := range c.rules {
+		before := now.Add(-rule.MaxAge)
+		if len(rule.Types) == 0 {
+			count, err := c.repository.Delete(ctx, &Filter{
+				ExcludeTypes:  typedStatus[rule.Status],
+				Status:        []string{rule.Status},
+				UpdatedBefore: before,
+			})
+			if err != nil {
+				return total, err
+			}
+			reclaimedEvents.WithLabelValues(allEventTypes, rule.Status).Add(float64(count))
+			total += count
+			continue
+		}
+		for _, eventType := range rule.Types {
+			count, err := c.repository.Delete(ctx, &Filter{
+				Type:          []string{eventType},
+				Status:        []string{rule.Status},
+				UpdatedBefore: before,
+			})
+			if err != nil {
+				return total, err
+			}
+			reclaimedEvents.WithLabelValues(eventType, rule.Status).Add(float64(count))
+			total += count
+		}
+	}
+	return total, nil
+}
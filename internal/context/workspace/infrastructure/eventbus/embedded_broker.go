@@ -0,0 +1,158 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// embeddedRedeliveryDelay is the delay to redeliver the message neither acked nor requeued
+const embeddedRedeliveryDelay = time.Second
+
+// embeddedBroker is an in-process broker, the messages are lost if the process exits.
+type embeddedBroker struct {
+	sync.Mutex
+	groups map[string]*embeddedGroup
+	closed bool
+}
+
+type embeddedGroup struct {
+	topics sets.Set[string]
+	queue  workqueue.DelayingInterface
+	// messages are handed over to the consumers by the pump of group
+	messages chan *Message
+}
+
+var _ Broker = &embeddedBroker{}
+
+// NewEmbeddedBroker new an in-process broker, which is only used by tests since the messages are
+// neither persisted nor shared by replicas.
+func NewEmbeddedBroker() Broker {
+	return &embeddedBroker{
+		groups: make(map[string]*embeddedGroup),
+	}
+}
+
+func (b *embeddedBroker) Publish(_ context.Context, msg *Message, delay time.Duration) error {
+	b.Lock()
+	defer b.Unlock()
+	if b.closed {
+		return fmt.Errorf("broker is closed")
+	}
+	if msg.ID == "" {
+		msg.ID = uuid.New().String()
+	}
+	for _, group := range b.groups {
+		if group.topics.Has(msg.Topic) {
+			group.queue.AddAfter(msg, delay)
+		}
+	}
+	return nil
+}
+
+func (b *embeddedBroker) Subscribe(_ context.Context, group, topic string) error {
+	b.Lock()
+	defer b.Unlock()
+	if b.closed {
+		return fmt.Errorf("broker is closed")
+	}
+	b.group(group).topics.Insert(topic)
+	return nil
+}
+
+func (b *embeddedBroker) Consume(ctx context.Context, group string, handler DeliveryHandler) error {
+	b.Lock()
+	if b.closed {
+		b.Unlock()
+		return fmt.Errorf("broker is closed")
+	}
+	g := b.group(group)
+	b.Unlock()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-g.messages:
+			if !ok {
+				return nil
+			}
+			delivery := &embeddedDelivery{group: g, message: msg}
+			handler(ctx, delivery)
+			if !delivery.settled {
+				g.queue.AddAfter(msg, embeddedRedeliveryDelay)
+			}
+		}
+	}
+}
+
+func (b *embeddedBroker) Close(_ context.Context) error {
+	b.Lock()
+	defer b.Unlock()
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+	for _, group := range b.groups {
+		group.queue.ShutDown()
+	}
+	return nil
+}
+
+// group returns the group by name, which is created if not exist.
+func (b *embeddedBroker) group(name string) *embeddedGroup {
+	if group, ok := b.groups[name]; ok {
+		return group
+	}
+	group := &embeddedGroup{
+		topics:   sets.New[string](),
+		queue:    workqueue.NewNamedDelayingQueue("embedded-broker-" + name),
+		messages: make(chan *Message),
+	}
+	go group.pump()
+	b.groups[name] = group
+	return group
+}
+
+// pump hands over the messages to the consumers until the queue is shutdown.
+func (g *embeddedGroup) pump() {
+	defer close(g.messages)
+	for {
+		item, quit := g.queue.Get()
+		if quit {
+			return
+		}
+		g.messages <- item.(*Message)
+		g.queue.Done(item)
+	}
+}
+
+type embeddedDelivery struct {
+	group   *embeddedGroup
+	message *Message
+	settled bool
+}
+
+func (d *embeddedDelivery) Message() *Message {
+	return d.message
+}
+
+func (d *embeddedDelivery) Ack(_ context.Context) error {
+	d.settled = true
+	return nil
+}
+
+func (d *embeddedDelivery) Requeue(_ context.Context, body []byte, delay time.Duration) error {
+	d.settled = true
+	d.group.queue.AddAfter(&Message{
+		ID:    uuid.New().String(),
+		Topic: d.message.Topic,
+		Body:  body,
+	}, delay)
+	return nil
+}
@@ -3,3 +3,7 @@ package eventbus
 import "fmt"
 
 var ErrEventNotFound = fmt.Errorf("event not found")
+
+// ErrEventsNotPersisted is returned by the event repository of backend carrying the events by broker messages,
+// the events can't be searched, requeued or deleted by operators.
+var ErrEventsNotPersisted = fmt.Errorf("events are not persisted by the event bus backend")
@@ -2,6 +2,8 @@ package eventbus
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -14,12 +16,66 @@ type Event struct {
 	UpdatedAt   time.Time
 	ScheduledAt time.Time
 	RetryCount  int // The number of times the events has been retried
+	// Reason is why the event is in current status, e.g. the error of the last handling
+	Reason string
+	// LastError is the error of the last failed attempt, it is kept after the event completed
+	LastError string
+	// Attempts are the latest attempts to handle the event, from oldest
+	Attempts []EventAttempt
+	// Owner is the event bus handling or last handled the event
+	Owner string
+	// LeaseExpireAt is when the running event can be taken over by other event bus,
+	// the owner renews it by heartbeat until the event is handled
+	LeaseExpireAt time.Time
+}
+
+// MaxEventAttempts is the max count of attempts kept with event
+const MaxEventAttempts = 10
+
+// EventAttempt is an attempt to handle the event by all of its handlers.
+type EventAttempt struct {
+	StartTime time.Time
+	Duration  time.Duration
+	// Failures are the handlers failed in the attempt, empty if all succeeded
+	Failures []EventHandlerFailure
+}
+
+type EventHandlerFailure struct {
+	Handler string
+	Error   string
+}
+
+// AddAttempt appends the attempt and drops the oldest ones beyond MaxEventAttempts.
+func (e *Event) AddAttempt(attempt EventAttempt) {
+	e.Attempts = append(e.Attempts, attempt)
+	if len(e.Attempts) > MaxEventAttempts {
+		e.Attempts = e.Attempts[len(e.Attempts)-MaxEventAttempts:]
+	}
+	if len(attempt.Failures) > 0 {
+		e.LastError = attempt.Error()
+	}
+}
+
+// Error returns the errors of failed handlers, empty if all succeeded.
+func (a EventAttempt) Error() string {
+	errs := make([]string, len(a.Failures))
+	for i, failure := range a.Failures {
+		errs[i] = fmt.Sprintf("%s: %s", failure.Handler, failure.Error)
+	}
+	return truncateReason(strings.Join(errs, "; "))
 }
 
 type Filter struct {
-	Type    []string
-	Payload string
-	Status  []string
+	Type []string
+	// ExcludeTypes excludes the events of these types
+	ExcludeTypes []string
+	Payload      string
+	Status       []string
+	// UpdatedBefore only matches the events updated before it if not zero
+	UpdatedBefore time.Time
+	// Offset and Limit page the events ordered by created time desc, no limit if Limit is 0
+	Offset int
+	Limit  int
 }
 
 const (
@@ -28,6 +84,8 @@ const (
 	EventStatusRunning   = "running"
 	EventStatusCompleted = "completed"
 	EventStatusFailed    = "failed"
+	// EventStatusDeadLetter is the status of events failed after all retries
+	EventStatusDeadLetter = "deadletter"
 )
 
 // ErrEventRunningDelayed stand for long-run event that need to delay add back.
@@ -68,5 +126,22 @@ type EventRepository interface {
 	ListAndLockUnfinishedEvents(ctx context.Context, limit int, eventTypes []string) ([]*Event, error)
 	UpdateStatus(ctx context.Context, event *Event, status string) error
 	UpdateRetryCount(ctx context.Context, event *Event, retryCount int) error
+	// Acquire updates the pending or dequeued event to running with the lease of owner until leaseExpireAt,
+	// the running event can only be acquired by its owner, returns false if the event is not acquired.
+	Acquire(ctx context.Context, event *Event, owner string, leaseExpireAt time.Time) (bool, error)
+	// RenewLease extends the lease of running event to leaseExpireAt if it is still owned by owner,
+	// returns false if the lease is lost.
+	RenewLease(ctx context.Context, event *Event, owner string, leaseExpireAt time.Time) (bool, error)
+	// Reschedule updates the event to pending with its RetryCount, Reason and ScheduledAt,
+	// so that it will be retried at ScheduledAt.
+	Reschedule(ctx context.Context, event *Event) error
 	Search(ctx context.Context, filter *Filter) ([]*Event, error)
+	// Requeue resets the event to pending without retries, so that it will be handled again.
+	Requeue(ctx context.Context, event *Event) error
+	// Delete deletes the events matching filter permanently in bounded batches until none matches,
+	// regardless of Offset and Limit. Returns the count of deleted events.
+	Delete(ctx context.Context, filter *Filter) (int64, error)
+	// AddAttempt appends the attempt to the event and keeps the latest MaxEventAttempts attempts,
+	// LastError of the event is updated as well if the attempt failed.
+	AddAttempt(ctx context.Context, event *Event, attempt EventAttempt) error
 }
@@ -2,6 +2,11 @@ package eventbus
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"reflect"
+	goruntime "runtime"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,9 +19,13 @@ import (
 	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 
+	eventbusoptions "github.com/Bio-OS/bioos/pkg/eventbus"
 	applog "github.com/Bio-OS/bioos/pkg/log"
 )
 
+// maxReasonLength is the max length of reason persisted with event
+const maxReasonLength = 1024
+
 // EventBus stands for event bus.
 type EventBus interface {
 	Publish(ctx context.Context, event IEvent) error
@@ -31,14 +40,43 @@ type IEvent interface {
 	Delay() time.Duration
 }
 
+// config is shared by the event bus implementations
+type config struct {
+	maxRetries int
+	syncPeriod time.Duration
+	batchSize  int
+	// owner identifies the event bus among the replicas
+	owner string
+	// leaseDuration is the time an event is owned without heartbeat
+	leaseDuration time.Duration
+	// defaultRetryPolicy is used by the event types without retry policies
+	defaultRetryPolicy eventbusoptions.RetryPolicy
+	retryPolicies      map[string]eventbusoptions.RetryPolicy
+}
+
+func newConfig(options ...Option) config {
+	c := config{}
+	for _, option := range options {
+		option(&c)
+	}
+	if c.owner == "" {
+		c.owner = newOwner()
+	}
+	if c.leaseDuration <= 0 {
+		c.leaseDuration = eventbusoptions.DefaultLeaseDuration
+	}
+	if c.defaultRetryPolicy.MaxRetries == 0 {
+		c.defaultRetryPolicy = (&eventbusoptions.Options{MaxRetries: c.maxRetries}).DefaultRetryPolicy()
+	}
+	return c
+}
+
 // Impl implement event bus
 type Impl struct {
 	sync.Mutex
+	config
 	repository  EventRepository
 	subscribers map[string][]EventHandler
-	maxRetries  int
-	syncPeriod  time.Duration
-	batchSize   int
 	queue       workqueue.RateLimitingInterface
 	runningSet  sets.Set[string]
 }
@@ -47,34 +85,36 @@ var _ EventBus = &Impl{}
 
 // NewEventBus new an event bus.
 func NewEventBus(repository EventRepository, options ...Option) (EventBus, error) {
-	impl := &Impl{
+	return &Impl{
+		config:      newConfig(options...),
 		repository:  repository,
 		subscribers: make(map[string][]EventHandler),
 		queue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "event-bus"),
 		runningSet:  sets.New[string](),
+	}, nil
+}
+
+// NewEventBusOfBackend new an event bus of the backend, the events are consumed as group
+// if they are delivered by broker.
+func NewEventBusOfBackend(opts *eventbusoptions.Options, repository EventRepository, group string, options ...Option) (EventBus, error) {
+	switch opts.Backend {
+	case "", eventbusoptions.BackendDatabase:
+		return NewEventBus(repository, options...)
+	case eventbusoptions.BackendRedis:
+		broker, err := DefaultRedisBroker(opts.Redis, opts.LeaseDuration)
+		if err != nil {
+			return nil, err
+		}
+		return NewBrokerBus(broker, group, options...)
+	default:
+		return nil, fmt.Errorf("unsupported event bus backend %q", opts.Backend)
 	}
-	for _, option := range options {
-		option(impl)
-	}
-	return impl, nil
 }
 
 // Publish publish an event with payload and delay.
 func (engine *Impl) Publish(ctx context.Context, iEvent IEvent) error {
-	now := time.Now()
-	event := &Event{
-		EventID:     uuid.New().String(),
-		Type:        iEvent.EventType(),
-		Payload:     string(iEvent.Payload()),
-		Status:      EventStatusPending,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-		ScheduledAt: now,
-	}
-	if iEvent.Delay() > 0 {
-		event.ScheduledAt = now.Add(iEvent.Delay())
-	}
-	// TODO need to gc events in repository
+	event := newEvent(iEvent)
+	// the finished events are deleted by Compactor
 	return retry.OnError(retry.DefaultRetry, func(err error) bool {
 		return err != nil
 	}, func() error {
@@ -110,12 +150,15 @@ func (engine *Impl) Close(ctx context.Context) error {
 }
 
 func (engine *Impl) processEvent(ctx context.Context, event *Event) error {
-	errs := make([]error, 0)
 	handlers := engine.subscribers[event.Type]
 	if len(handlers) == 0 { // no handlers just return
 		applog.Infow("no handlers for event", "eventType", event.Type)
 		return nil
 	}
+	if !handlesDeadLettered(engine.subscribers, event) {
+		// release it to the event bus subscribing the dead lettered event type
+		return engine.repository.UpdateStatus(ctx, event, EventStatusPending)
+	}
 
 	// Check if the event is scheduled and if its scheduled time has passed before executing it
 	if !event.ScheduledAt.IsZero() && event.ScheduledAt.After(time.Now()) {
@@ -124,65 +167,86 @@ func (engine *Impl) processEvent(ctx context.Context, event *Event) error {
 		return nil
 	}
 
-	// if ready reach max retry times, mark it to failed
-	if event.RetryCount >= engine.maxRetries {
-		return engine.repository.UpdateStatus(ctx, event, EventStatusFailed)
-	}
-	// if it is already running, but can take it, have two circumstance:
-	// 1) short task and outdated
-	// 2) long time task
-	if event.Status == EventStatusRunning && !engine.runningSet.Has(event.EventID) {
-		return engine.repository.UpdateStatus(ctx, event, EventStatusFailed)
-	}
-
 	// mark event running to prevent handle concurrently
 	if marked := engine.markEventRunning(event); !marked { // event is running, skip
 		return nil
 	}
 	defer engine.unmarkEventRunning(event)
-	// Set the status of the event to "running" in db
-	if err := engine.repository.UpdateStatus(ctx, event, EventStatusRunning); err != nil {
+	// Set the status of the event to "running" in db with the lease of this event bus,
+	// the event running with the lease of other event bus is skipped, it will be taken over
+	// after the lease expired if the other event bus is gone.
+	acquired, err := engine.repository.Acquire(ctx, event, engine.owner, time.Now().Add(engine.leaseDuration))
+	if err != nil {
 		return err
 	}
+	if !acquired {
+		applog.Infow("event is owned by other event bus", "eventID", event.EventID, "owner", event.Owner)
+		return nil
+	}
 
-	var wg sync.WaitGroup
-	runningFlag := false
-	for _, h := range handlers {
-		wg.Add(1)
-		handler := h
-		func() { // 同一个任务多个handler不要并发 避免锁
-			defer wg.Done()
-			if err := handler.Handle(ctx, event.Payload); err != nil {
-				if delayedErr, ok := err.(ErrEventRunningDelayed); ok {
-					// If the event is still running and needs to be delayed, schedule it for execution after the specified delay
-					engine.queue.AddAfter(event.EventID, delayedErr.Delay())
-					runningFlag = true
-				} else {
-					errs = append(errs, err)
-				}
-			}
-		}()
+	// if ready reach max retry times, move it to dead letter
+	policy := engine.retryPolicyOf(event.Type)
+	if event.RetryCount >= policy.MaxRetries {
+		return engine.deadLetter(ctx, event)
+	}
+
+	// renew the lease until all handlers return, and cancel them if the lease is lost
+	handleCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	heartbeatDone := make(chan struct{})
+	leaseLost := false
+	go func() {
+		defer close(heartbeatDone)
+		leaseLost = engine.heartbeat(handleCtx, event)
+		if leaseLost {
+			cancel()
+		}
+	}()
+
+	attempt, errs, delay, runningFlag := runHandlers(handleCtx, handlers, event.Payload)
+	if runningFlag {
+		// If the event is still running and needs to be delayed, schedule it for execution after the specified delay
+		engine.queue.AddAfter(event.EventID, delay)
+	}
+	cancel()
+	<-heartbeatDone
+	// the event may be handling by the new owner
+	if leaseLost {
+		applog.Errorw("lease of event is lost", "eventID", event.EventID, "owner", engine.owner)
+		return nil
+	}
+
+	// the attempts only checking the delayed event are not recorded, they are too many
+	if len(errs) > 0 || !runningFlag {
+		if err := engine.repository.AddAttempt(ctx, event, attempt); err != nil {
+			applog.Errorw("failed to add attempt of event", "eventID", event.EventID, "err", err)
+		}
 	}
-	wg.Wait()
 
 	// Update the status of the event based on the result of the handler
 	if len(errs) > 0 {
+		applog.Infow("failed to handle event", "eventID", event.EventID, "eventType", event.Type, "err", errors.NewAggregate(errs))
+		event.Reason = truncateReason(errors.NewAggregate(errs).Error())
 		event.RetryCount++ // Increment the retry count
-		if err := engine.repository.UpdateRetryCount(ctx, event, event.RetryCount); err != nil {
-			errs = append(errs, err)
-		}
-		// if ready reach max retry times, mark it to failed
-		if event.RetryCount >= engine.maxRetries {
-			return engine.repository.UpdateStatus(ctx, event, EventStatusFailed)
+		// if ready reach max retry times, move it to dead letter
+		if event.RetryCount >= policy.MaxRetries {
+			if err := engine.repository.UpdateRetryCount(ctx, event, event.RetryCount); err != nil {
+				return err
+			}
+			return engine.deadLetter(ctx, event)
 		}
-		// else update task status to pending let it retry
-		if err := engine.repository.UpdateStatus(ctx, event, EventStatusPending); err != nil {
+		// else update task status to pending let it retry after backoff
+		delay := backoff(policy, event.RetryCount)
+		event.ScheduledAt = time.Now().Add(delay)
+		if err := engine.repository.Reschedule(ctx, event); err != nil {
 			return err
 		}
-		return errors.NewAggregate(errs)
+		engine.queue.AddAfter(event.EventID, delay)
+		return nil
 	} else {
 		// avoid change running event to completed
 		if !runningFlag {
+			event.Reason = ""
 			if err := engine.repository.UpdateStatus(ctx, event, EventStatusCompleted); err != nil {
 				return err
 			}
@@ -192,6 +256,89 @@ func (engine *Impl) processEvent(ctx context.Context, event *Event) error {
 	}
 }
 
+// newEvent new a pending event scheduled after the delay of iEvent.
+func newEvent(iEvent IEvent) *Event {
+	now := time.Now()
+	event := &Event{
+		EventID:     uuid.New().String(),
+		Type:        iEvent.EventType(),
+		Payload:     string(iEvent.Payload()),
+		Status:      EventStatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		ScheduledAt: now,
+	}
+	if iEvent.Delay() > 0 {
+		event.ScheduledAt = now.Add(iEvent.Delay())
+	}
+	return event
+}
+
+// runHandlers calls the handlers one by one, returns the attempt, the errors of handlers and
+// the min delay if any handler returns ErrEventRunningDelayed.
+func runHandlers(ctx context.Context, handlers []EventHandler, payload string) (EventAttempt, []error, time.Duration, bool) {
+	errs := make([]error, 0)
+	attempt := EventAttempt{StartTime: time.Now()}
+	var delay time.Duration
+	delayed := false
+	// 同一个任务多个handler不要并发 避免锁
+	for _, handler := range handlers {
+		err := handler.Handle(ctx, payload)
+		if err == nil {
+			continue
+		}
+		if delayedErr, ok := err.(ErrEventRunningDelayed); ok {
+			if !delayed || delayedErr.Delay() < delay {
+				delay = delayedErr.Delay()
+			}
+			delayed = true
+			continue
+		}
+		errs = append(errs, err)
+		attempt.Failures = append(attempt.Failures, EventHandlerFailure{
+			Handler: handlerName(handler),
+			Error:   truncateReason(err.Error()),
+		})
+	}
+	attempt.Duration = time.Since(attempt.StartTime)
+	return attempt, errs, delay, delayed
+}
+
+// heartbeat renews the lease of event periodically until ctx is done, returns true if the lease is lost.
+func (engine *Impl) heartbeat(ctx context.Context, event *Event) bool {
+	ticker := time.NewTicker(engine.leaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			renewed, err := engine.repository.RenewLease(ctx, event, engine.owner, time.Now().Add(engine.leaseDuration))
+			if err != nil {
+				// retry in the next heartbeat before the lease expired
+				applog.Errorw("failed to renew lease of event", "eventID", event.EventID, "err", err)
+				continue
+			}
+			if !renewed {
+				return true
+			}
+		}
+	}
+}
+
+// deadLetter moves the event to dead letter and publishes EventDeadLettered for it.
+func (engine *Impl) deadLetter(ctx context.Context, event *Event) error {
+	if err := engine.repository.UpdateStatus(ctx, event, EventStatusDeadLetter); err != nil {
+		return err
+	}
+	applog.Errorw("event is dead lettered", "eventID", event.EventID, "eventType", event.Type, "reason", event.Reason)
+	// never dead letter the dead lettered events again
+	if event.Type == EventDeadLettered {
+		return nil
+	}
+	return engine.Publish(ctx, NewDeadLetteredEvent(event))
+}
+
 func (engine *Impl) processPendingEvents(ctx context.Context) {
 	ticker := time.Tick(engine.syncPeriod)
 	for {
@@ -294,26 +441,71 @@ func (engine *Impl) unmarkEventRunning(event *Event) {
 	engine.runningSet.Delete(event.EventID)
 }
 
-// Option options of impl
-type Option func(impl *Impl)
+// newOwner returns an unique owner of event bus, which is readable for operators.
+func newOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return hostname + "-" + uuid.New().String()[:8]
+}
+
+// handlerName returns the type of handler, or the function name if it is an EventHandlerFunc.
+func handlerName(handler EventHandler) string {
+	if f, ok := handler.(EventHandlerFunc); ok {
+		name := goruntime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+		return name[strings.LastIndex(name, "/")+1:]
+	}
+	return fmt.Sprintf("%T", handler)
+}
+
+func truncateReason(reason string) string {
+	if len(reason) > maxReasonLength {
+		return reason[:maxReasonLength]
+	}
+	return reason
+}
+
+// Option options of event bus
+type Option func(impl *config)
 
 // WithMaxRetries set max retry
 func WithMaxRetries(retry int) Option {
-	return func(impl *Impl) {
+	return func(impl *config) {
 		impl.maxRetries = retry
 	}
 }
 
+// WithRetryPolicies set the default retry policy and the policies of event types
+func WithRetryPolicies(defaultPolicy eventbusoptions.RetryPolicy, policies []eventbusoptions.RetryPolicy) Option {
+	return func(impl *config) {
+		impl.defaultRetryPolicy = defaultPolicy
+		impl.retryPolicies = make(map[string]eventbusoptions.RetryPolicy)
+		for _, policy := range policies {
+			for _, eventType := range policy.Types {
+				impl.retryPolicies[eventType] = policy
+			}
+		}
+	}
+}
+
+// WithLeaseDuration set the lease duration of running events
+func WithLeaseDuration(duration time.Duration) Option {
+	return func(impl *config) {
+		impl.leaseDuration = duration
+	}
+}
+
 // WithSyncPeriod set sync period
 func WithSyncPeriod(duration time.Duration) Option {
-	return func(impl *Impl) {
+	return func(impl *config) {
 		impl.syncPeriod = duration
 	}
 }
 
 // WithBatchSize set batch size
 func WithBatchSize(batchSize int) Option {
-	return func(impl *Impl) {
+	return func(impl *config) {
 		impl.batchSize = batchSize
 	}
 }
@@ -0,0 +1,65 @@
+package eventbus
+
+import (
+	"context"
+	"time"
+)
+
+// notPersistedEventRepository is the event repository of backend delivering events by broker, the events are
+// carried by the broker messages rather than saved in db, so all the operations are rejected.
+type notPersistedEventRepository struct{}
+
+var _ EventRepository = notPersistedEventRepository{}
+
+// NewNotPersistedEventRepository new an event repository rejecting all operations with ErrEventsNotPersisted.
+func NewNotPersistedEventRepository() EventRepository {
+	return notPersistedEventRepository{}
+}
+
+func (notPersistedEventRepository) Get(context.Context, string) (*Event, error) {
+	return nil, ErrEventsNotPersisted
+}
+
+func (notPersistedEventRepository) Save(context.Context, *Event) error {
+	return ErrEventsNotPersisted
+}
+
+func (notPersistedEventRepository) ListAndLockUnfinishedEvents(context.Context, int, []string) ([]*Event, error) {
+	return nil, ErrEventsNotPersisted
+}
+
+func (notPersistedEventRepository) UpdateStatus(context.Context, *Event, string) error {
+	return ErrEventsNotPersisted
+}
+
+func (notPersistedEventRepository) UpdateRetryCount(context.Context, *Event, int) error {
+	return ErrEventsNotPersisted
+}
+
+func (notPersistedEventRepository) Acquire(context.Context, *Event, string, time.Time) (bool, error) {
+	return false, ErrEventsNotPersisted
+}
+
+func (notPersistedEventRepository) RenewLease(context.Context, *Event, string, time.Time) (bool, error) {
+	return false, ErrEventsNotPersisted
+}
+
+func (notPersistedEventRepository) Reschedule(context.Context, *Event) error {
+	return ErrEventsNotPersisted
+}
+
+func (notPersistedEventRepository) Search(context.Context, *Filter) ([]*Event, error) {
+	return nil, ErrEventsNotPersisted
+}
+
+func (notPersistedEventRepository) Requeue(context.Context, *Event) error {
+	return ErrEventsNotPersisted
+}
+
+func (notPersistedEventRepository) Delete(context.Context, *Filter) (int64, error) {
+	return 0, ErrEventsNotPersisted
+}
+
+func (notPersistedEventRepository) AddAttempt(context.Context, *Event, EventAttempt) error {
+	return ErrEventsNotPersisted
+}
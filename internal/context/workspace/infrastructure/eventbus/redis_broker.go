@@ -0,0 +1,345 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	eventbusoptions "github.com/Bio-OS/bioos/pkg/eventbus"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+)
+
+const (
+	// redisPollInterval is the longest time to block reading the stream, the delayed messages are
+	// moved to the stream at least once per interval
+	redisPollInterval = time.Second
+	// redisDelayedBatchSize is the max number of due delayed messages moved to the stream at once
+	redisDelayedBatchSize = 100
+	// redisMessageField is the field of stream entry carrying the message
+	redisMessageField = "message"
+)
+
+// redisMoveDelayedScript moves the delayed message to the stream if it is not moved by others yet.
+var redisMoveDelayedScript = redis.NewScript(`
+if redis.call('ZREM', KEYS[1], ARGV[1]) == 1 then
+	return redis.call('XADD', KEYS[2], '*', '` + redisMessageField + `', ARGV[1])
+end
+return false
+`)
+
+var (
+	defaultRedisBroker     Broker
+	defaultRedisBrokerErr  error
+	defaultRedisBrokerOnce sync.Once
+)
+
+// DefaultRedisBroker returns the redis broker shared in the process.
+func DefaultRedisBroker(opts *eventbusoptions.RedisOptions, leaseDuration time.Duration) (Broker, error) {
+	defaultRedisBrokerOnce.Do(func() {
+		defaultRedisBroker, defaultRedisBrokerErr = NewRedisBroker(context.Background(), opts, leaseDuration)
+	})
+	return defaultRedisBroker, defaultRedisBrokerErr
+}
+
+// redisBroker delivers the messages by redis streams, so that they survive the restart of apiserver and
+// are shared by the replicas. Each group has its own stream consumed by the consumer group of the same name:
+//
+//	<prefix>:topic:<topic>    set of the groups subscribing the topic
+//	<prefix>:group:<group>    stream of the messages of group
+//	<prefix>:delayed:<group>  sorted set of the delayed messages of group scored by the time to deliver
+//
+// The message delivered but neither acked nor requeued is claimed by other consumers after the lease
+// expired, the lease is renewed by heartbeat while the message is being handled.
+type redisBroker struct {
+	client        redis.UniversalClient
+	prefix        string
+	consumer      string
+	leaseDuration time.Duration
+	pollInterval  time.Duration
+	closed        chan struct{}
+	closeOnce     sync.Once
+}
+
+var _ Broker = &redisBroker{}
+
+// NewRedisBroker new a broker delivering messages by redis streams.
+func NewRedisBroker(ctx context.Context, opts *eventbusoptions.RedisOptions, leaseDuration time.Duration) (Broker, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     opts.Addr,
+		Username: opts.Username,
+		Password: opts.Password,
+		DB:       opts.DB,
+	})
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("connect redis %s fail: %w", opts.Addr, err)
+	}
+	return newRedisBroker(client, opts.KeyPrefix, leaseDuration), nil
+}
+
+func newRedisBroker(client redis.UniversalClient, prefix string, leaseDuration time.Duration) *redisBroker {
+	if prefix == "" {
+		prefix = eventbusoptions.DefaultRedisKeyPrefix
+	}
+	if leaseDuration <= 0 {
+		leaseDuration = eventbusoptions.DefaultLeaseDuration
+	}
+	hostname, _ := os.Hostname()
+	return &redisBroker{
+		client:        client,
+		prefix:        prefix,
+		consumer:      fmt.Sprintf("%s-%s", hostname, uuid.New().String()),
+		leaseDuration: leaseDuration,
+		pollInterval:  redisPollInterval,
+		closed:        make(chan struct{}),
+	}
+}
+
+func (b *redisBroker) Publish(ctx context.Context, msg *Message, delay time.Duration) error {
+	if msg.ID == "" {
+		msg.ID = uuid.New().String()
+	}
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	groups, err := b.client.SMembers(ctx, b.topicKey(msg.Topic)).Result()
+	if err != nil {
+		return err
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+	_, err = b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, group := range groups {
+			b.deliver(ctx, pipe, group, data, delay)
+		}
+		return nil
+	})
+	return err
+}
+
+func (b *redisBroker) Subscribe(ctx context.Context, group, topic string) error {
+	if err := b.client.XGroupCreateMkStream(ctx, b.groupKey(group), group, "0").Err(); err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	return b.client.SAdd(ctx, b.topicKey(topic), group).Err()
+}
+
+func (b *redisBroker) Consume(ctx context.Context, group string, handler DeliveryHandler) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-b.closed:
+			return nil
+		default:
+		}
+		if err := b.consumeOnce(ctx, group, handler); err != nil {
+			if errors.Is(err, redis.ErrClosed) {
+				return nil
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+			applog.Errorw("failed to consume messages from redis", "group", group, "err", err)
+			select {
+			case <-ctx.Done():
+			case <-b.closed:
+			case <-time.After(b.pollInterval):
+			}
+		}
+	}
+}
+
+func (b *redisBroker) Close(_ context.Context) error {
+	var err error
+	b.closeOnce.Do(func() {
+		close(b.closed)
+		err = b.client.Close()
+	})
+	return err
+}
+
+// consumeOnce moves the due delayed messages to the stream, then handles one message whose lease
+// expired or one new message.
+func (b *redisBroker) consumeOnce(ctx context.Context, group string, handler DeliveryHandler) error {
+	if err := b.moveDelayed(ctx, group); err != nil {
+		return err
+	}
+	stream := b.groupKey(group)
+	claimed, _, err := b.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   stream,
+		Group:    group,
+		MinIdle:  b.leaseDuration,
+		Start:    "0-0",
+		Count:    1,
+		Consumer: b.consumer,
+	}).Result()
+	if err != nil {
+		return err
+	}
+	if len(claimed) > 0 {
+		b.handle(ctx, group, claimed[0], handler)
+		return nil
+	}
+	streams, err := b.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    group,
+		Consumer: b.consumer,
+		Streams:  []string{stream, ">"},
+		Count:    1,
+		Block:    b.pollInterval,
+	}).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil
+		}
+		return err
+	}
+	for _, s := range streams {
+		for _, entry := range s.Messages {
+			b.handle(ctx, group, entry, handler)
+		}
+	}
+	return nil
+}
+
+// moveDelayed moves the delayed messages of group which are due to the stream.
+func (b *redisBroker) moveDelayed(ctx context.Context, group string) error {
+	delayedKey := b.delayedKey(group)
+	members, err := b.client.ZRangeByScore(ctx, delayedKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(time.Now().UnixMilli(), 10),
+		Count: redisDelayedBatchSize,
+	}).Result()
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		if err := redisMoveDelayedScript.Run(ctx, b.client, []string{delayedKey, b.groupKey(group)}, member).Err(); err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+	}
+	return nil
+}
+
+// handle delivers the stream entry to handler, and renews the lease of it until handled.
+func (b *redisBroker) handle(ctx context.Context, group string, entry redis.XMessage, handler DeliveryHandler) {
+	delivery := &redisDelivery{broker: b, group: group, entryID: entry.ID}
+	data, _ := entry.Values[redisMessageField].(string)
+	if err := json.Unmarshal([]byte(data), &delivery.message); err != nil {
+		applog.Errorw("drop malformed message from redis", "group", group, "entryID", entry.ID, "err", err)
+		if err := delivery.Ack(ctx); err != nil {
+			applog.Errorw("failed to ack message", "entryID", entry.ID, "err", err)
+		}
+		return
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go b.heartbeat(ctx, group, entry.ID, stop)
+	handler(ctx, delivery)
+}
+
+// heartbeat renews the lease of stream entry by claiming it again until stopped.
+func (b *redisBroker) heartbeat(ctx context.Context, group, entryID string, stop <-chan struct{}) {
+	ticker := time.NewTicker(b.leaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := b.client.XClaimJustID(ctx, &redis.XClaimArgs{
+				Stream:   b.groupKey(group),
+				Group:    group,
+				Consumer: b.consumer,
+				Messages: []string{entryID},
+			}).Err(); err != nil {
+				applog.Errorw("failed to renew lease of message", "group", group, "entryID", entryID, "err", err)
+			}
+		}
+	}
+}
+
+// deliver adds the message to the stream of group, or to the delayed messages of group if delay is positive.
+func (b *redisBroker) deliver(ctx context.Context, pipe redis.Pipeliner, group string, data []byte, delay time.Duration) {
+	if delay <= 0 {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: b.groupKey(group),
+			Values: map[string]interface{}{redisMessageField: string(data)},
+		})
+		return
+	}
+	pipe.ZAdd(ctx, b.delayedKey(group), redis.Z{
+		Score:  float64(time.Now().Add(delay).UnixMilli()),
+		Member: string(data),
+	})
+}
+
+func (b *redisBroker) topicKey(topic string) string {
+	return b.prefix + ":topic:" + topic
+}
+
+func (b *redisBroker) groupKey(group string) string {
+	return b.prefix + ":group:" + group
+}
+
+func (b *redisBroker) delayedKey(group string) string {
+	return b.prefix + ":delayed:" + group
+}
+
+type redisDelivery struct {
+	broker  *redisBroker
+	group   string
+	entryID string
+	message Message
+}
+
+func (d *redisDelivery) Message() *Message {
+	return &d.message
+}
+
+func (d *redisDelivery) Ack(ctx context.Context) error {
+	_, err := d.broker.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		d.settle(ctx, pipe)
+		return nil
+	})
+	return err
+}
+
+func (d *redisDelivery) Requeue(ctx context.Context, body []byte, delay time.Duration) error {
+	data, err := json.Marshal(&Message{
+		ID:    uuid.New().String(),
+		Topic: d.message.Topic,
+		Body:  body,
+	})
+	if err != nil {
+		return err
+	}
+	// the message is replaced atomically, so that it is neither lost nor duplicated
+	_, err = d.broker.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		d.settle(ctx, pipe)
+		d.broker.deliver(ctx, pipe, d.group, data, delay)
+		return nil
+	})
+	return err
+}
+
+// settle acks and deletes the stream entry, it is never delivered again.
+func (d *redisDelivery) settle(ctx context.Context, pipe redis.Pipeliner) {
+	stream := d.broker.groupKey(d.group)
+	pipe.XAck(ctx, stream, d.group, d.entryID)
+	pipe.XDel(ctx, stream, d.entryID)
+}
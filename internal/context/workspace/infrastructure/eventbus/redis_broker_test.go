@@ -0,0 +1,201 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/onsi/gomega"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Bio-OS/bioos/pkg/log"
+)
+
+// newTestRedisBroker new a broker on the redis server, the brokers on the same server act as replicas.
+func newTestRedisBroker(t *testing.T, server *miniredis.Miniredis, leaseDuration time.Duration) *redisBroker {
+	logOpts := log.NewOptions()
+	logOpts.OutputPath = ""
+	log.RegisterLogger(logOpts)
+	broker := newRedisBroker(redis.NewClient(&redis.Options{Addr: server.Addr()}), "test", leaseDuration)
+	broker.pollInterval = 10 * time.Millisecond
+	t.Cleanup(func() {
+		_ = broker.Close(context.Background())
+	})
+	return broker
+}
+
+// collectBodies consumes the messages of group and acks them, the bodies are sent to the returned channel.
+func collectBodies(ctx context.Context, broker Broker, group string) <-chan string {
+	bodies := make(chan string, 100)
+	go broker.Consume(ctx, group, func(ctx context.Context, delivery Delivery) {
+		bodies <- string(delivery.Message().Body)
+		_ = delivery.Ack(ctx)
+	})
+	return bodies
+}
+
+func TestRedisBrokerGroups(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := miniredis.RunT(t)
+	replica1 := newTestRedisBroker(t, server, time.Minute)
+	replica2 := newTestRedisBroker(t, server, time.Minute)
+
+	g.Expect(replica1.Subscribe(ctx, "a", "topic")).To(gomega.Succeed())
+	g.Expect(replica2.Subscribe(ctx, "a", "topic")).To(gomega.Succeed())
+	g.Expect(replica1.Subscribe(ctx, "b", "topic")).To(gomega.Succeed())
+	// messages of the topics not subscribed are dropped
+	g.Expect(replica1.Publish(ctx, &Message{Topic: "other", Body: []byte("x")}, 0)).To(gomega.Succeed())
+	for i := 0; i < 10; i++ {
+		g.Expect(replica1.Publish(ctx, &Message{Topic: "topic", Body: []byte(fmt.Sprint(i))}, 0)).To(gomega.Succeed())
+	}
+
+	// each message is delivered to only one replica of a group, and to every group
+	received := make(chan string, 100)
+	for _, bodies := range []<-chan string{collectBodies(ctx, replica1, "a"), collectBodies(ctx, replica2, "a")} {
+		go func(bodies <-chan string) {
+			for body := range bodies {
+				received <- body
+			}
+		}(bodies)
+	}
+	bodiesOfB := collectBodies(ctx, replica2, "b")
+	var gotA, gotB []string
+	for len(gotA) < 10 || len(gotB) < 10 {
+		select {
+		case body := <-received:
+			gotA = append(gotA, body)
+		case body := <-bodiesOfB:
+			gotB = append(gotB, body)
+		case <-time.After(time.Second):
+			t.Fatalf("messages not delivered, group a: %v, group b: %v", gotA, gotB)
+		}
+	}
+	expected := []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}
+	g.Expect(gotA).To(gomega.ConsistOf(expected))
+	g.Expect(gotB).To(gomega.Equal(expected))
+	g.Consistently(received, 100*time.Millisecond).ShouldNot(gomega.Receive())
+}
+
+func TestRedisBrokerDelayAndRequeue(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := miniredis.RunT(t)
+	broker := newTestRedisBroker(t, server, time.Minute)
+	g.Expect(broker.Subscribe(ctx, "group", "topic")).To(gomega.Succeed())
+
+	type delivered struct {
+		body string
+		at   time.Time
+	}
+	received := make(chan delivered, 10)
+	go broker.Consume(ctx, "group", func(ctx context.Context, delivery Delivery) {
+		body := string(delivery.Message().Body)
+		received <- delivered{body: body, at: time.Now()}
+		if body == "first" {
+			_ = delivery.Requeue(ctx, []byte("second"), 100*time.Millisecond)
+			return
+		}
+		_ = delivery.Ack(ctx)
+	})
+
+	start := time.Now()
+	g.Expect(broker.Publish(ctx, &Message{Topic: "topic", Body: []byte("first")}, 200*time.Millisecond)).To(gomega.Succeed())
+	var first, second delivered
+	g.Eventually(received, time.Second).Should(gomega.Receive(&first))
+	g.Expect(first.body).To(gomega.Equal("first"))
+	// the time to deliver is stored in milliseconds
+	g.Expect(first.at).To(gomega.BeTemporally(">=", start.Add(199*time.Millisecond)))
+	g.Eventually(received, time.Second).Should(gomega.Receive(&second))
+	g.Expect(second.body).To(gomega.Equal("second"))
+	g.Expect(second.at).To(gomega.BeTemporally(">=", first.at.Add(99*time.Millisecond)))
+	g.Consistently(received, 100*time.Millisecond).ShouldNot(gomega.Receive())
+}
+
+func TestRedisBrokerRedeliverUnsettled(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := miniredis.RunT(t)
+	broker := newTestRedisBroker(t, server, 100*time.Millisecond)
+	g.Expect(broker.Subscribe(ctx, "group", "topic")).To(gomega.Succeed())
+
+	var lock sync.Mutex
+	calls := 0
+	done := make(chan struct{})
+	go broker.Consume(ctx, "group", func(ctx context.Context, delivery Delivery) {
+		lock.Lock()
+		defer lock.Unlock()
+		calls++
+		switch calls {
+		case 1:
+			// neither acked nor requeued, it is redelivered after the lease expired
+		case 2:
+			// the lease is renewed while the message is handled longer than the lease
+			time.Sleep(300 * time.Millisecond)
+			_ = delivery.Ack(ctx)
+			close(done)
+		}
+	})
+
+	g.Expect(broker.Publish(ctx, &Message{Topic: "topic", Body: []byte("a")}, 0)).To(gomega.Succeed())
+	g.Eventually(done, 2*time.Second).Should(gomega.BeClosed())
+	time.Sleep(200 * time.Millisecond)
+	lock.Lock()
+	defer lock.Unlock()
+	g.Expect(calls).To(gomega.Equal(2))
+}
+
+func TestRedisBrokerRestart(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := miniredis.RunT(t)
+
+	// the messages published before restart are kept in redis
+	broker := newTestRedisBroker(t, server, time.Minute)
+	g.Expect(broker.Subscribe(ctx, "group", "topic")).To(gomega.Succeed())
+	g.Expect(broker.Publish(ctx, &Message{Topic: "topic", Body: []byte("a")}, 0)).To(gomega.Succeed())
+	g.Expect(broker.Publish(ctx, &Message{Topic: "topic", Body: []byte("b")}, 50*time.Millisecond)).To(gomega.Succeed())
+	g.Expect(broker.Close(ctx)).To(gomega.Succeed())
+
+	restarted := newTestRedisBroker(t, server, time.Minute)
+	g.Expect(restarted.Subscribe(ctx, "group", "topic")).To(gomega.Succeed())
+	bodies := collectBodies(ctx, restarted, "group")
+	g.Eventually(bodies, time.Second).Should(gomega.Receive(gomega.Equal("a")))
+	g.Eventually(bodies, time.Second).Should(gomega.Receive(gomega.Equal("b")))
+}
+
+func TestBrokerBusWithRedis(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := miniredis.RunT(t)
+	bus := newTestBrokerBus(g, newTestRedisBroker(t, server, time.Minute), "test")
+
+	calls := make(chan string, 10)
+	bus.Subscribe("failed", EventHandlerFunc(func(ctx context.Context, payload string) error {
+		calls <- payload
+		return fmt.Errorf("an error")
+	}))
+	deadLettered := make(chan string, 1)
+	bus.Subscribe(EventDeadLettered, EventHandlerFunc(func(ctx context.Context, payload string) error {
+		deadLettered <- payload
+		return nil
+	}))
+	go bus.Start(ctx, 2)
+
+	g.Expect(bus.Publish(ctx, fakeEvent{eventType: "failed", payload: "a"})).To(gomega.Succeed())
+	var payload string
+	g.Eventually(deadLettered, 2*time.Second).Should(gomega.Receive(&payload))
+	event, err := NewDeadLetteredEventFromPayload([]byte(payload))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(event.Event.Type).To(gomega.Equal("failed"))
+	g.Expect(event.Event.RetryCount).To(gomega.Equal(2))
+	g.Expect(calls).To(gomega.HaveLen(2))
+}
@@ -0,0 +1,82 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"time"
+
+	eventbusoptions "github.com/Bio-OS/bioos/pkg/eventbus"
+)
+
+// EventDeadLettered is published when an event is dead lettered
+const EventDeadLettered = "EventDeadLettered"
+
+// DeadLetteredEvent is the payload of EventDeadLettered, so that the handlers can react to
+// the event which is never handled successfully.
+type DeadLetteredEvent struct {
+	Event *Event
+}
+
+func NewDeadLetteredEvent(event *Event) *DeadLetteredEvent {
+	return &DeadLetteredEvent{
+		Event: event,
+	}
+}
+
+func NewDeadLetteredEventFromPayload(data []byte) (*DeadLetteredEvent, error) {
+	res := &DeadLetteredEvent{}
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (e *DeadLetteredEvent) EventType() string {
+	return EventDeadLettered
+}
+
+func (e *DeadLetteredEvent) Payload() []byte {
+	payload, _ := json.Marshal(e)
+	return payload
+}
+
+func (e *DeadLetteredEvent) Delay() time.Duration {
+	return 0
+}
+
+// handlesDeadLettered returns false if the event is an EventDeadLettered of the event type not subscribed
+// by subscribers. The events of all event buses are in the same repository, so EventDeadLettered is left
+// to the event bus subscribing the dead lettered event type, which has the handlers reacting to it.
+func handlesDeadLettered(subscribers map[string][]EventHandler, event *Event) bool {
+	if event.Type != EventDeadLettered {
+		return true
+	}
+	deadLettered, err := NewDeadLetteredEventFromPayload([]byte(event.Payload))
+	if err != nil || deadLettered.Event == nil {
+		// let the handlers report the malformed payload
+		return true
+	}
+	_, ok := subscribers[deadLettered.Event.Type]
+	return ok
+}
+
+// retryPolicyOf returns the retry policy of the event type.
+func (c *config) retryPolicyOf(eventType string) eventbusoptions.RetryPolicy {
+	if policy, ok := c.retryPolicies[eventType]; ok {
+		return policy
+	}
+	return c.defaultRetryPolicy
+}
+
+// backoff returns the delay before the nth retry.
+func backoff(policy eventbusoptions.RetryPolicy, retryCount int) time.Duration {
+	delay := float64(policy.InitialBackoff) * math.Pow(policy.Multiplier, float64(retryCount-1))
+	if delay > float64(policy.MaxBackoff) {
+		delay = float64(policy.MaxBackoff)
+	}
+	if policy.Jitter > 0 {
+		delay *= 1 + policy.Jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(delay)
+}
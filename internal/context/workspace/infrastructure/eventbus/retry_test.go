@@ -0,0 +1,65 @@
+package eventbus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	eventbusoptions "github.com/Bio-OS/bioos/pkg/eventbus"
+)
+
+func TestBackoff(t *testing.T) {
+	g := gomega.NewWithT(t)
+	policy := eventbusoptions.RetryPolicy{
+		MaxRetries:     5,
+		InitialBackoff: 10 * time.Second,
+		MaxBackoff:     time.Minute,
+		Multiplier:     2,
+	}
+
+	for retryCount, expected := range map[int]time.Duration{
+		1: 10 * time.Second,
+		2: 20 * time.Second,
+		3: 40 * time.Second,
+		// capped by max backoff
+		4: time.Minute,
+		5: time.Minute,
+	} {
+		g.Expect(backoff(policy, retryCount)).To(gomega.Equal(expected), "retry %d", retryCount)
+	}
+
+	policy.Jitter = 0.2
+	for i := 0; i < 100; i++ {
+		g.Expect(backoff(policy, 2)).To(gomega.BeNumerically("~", 20*time.Second, 4*time.Second))
+	}
+}
+
+func TestRetryPolicyOf(t *testing.T) {
+	g := gomega.NewWithT(t)
+	defaultPolicy := eventbusoptions.RetryPolicy{MaxRetries: 3, InitialBackoff: time.Second, MaxBackoff: time.Second, Multiplier: 1}
+	importPolicy := eventbusoptions.RetryPolicy{Types: []string{"ImportWorkflows"}, MaxRetries: 10, InitialBackoff: time.Second, MaxBackoff: time.Minute, Multiplier: 2}
+
+	c := newConfig(WithRetryPolicies(defaultPolicy, []eventbusoptions.RetryPolicy{importPolicy}))
+	g.Expect(c.retryPolicyOf("ImportWorkflows")).To(gomega.Equal(importPolicy))
+	g.Expect(c.retryPolicyOf("CreateRuns")).To(gomega.Equal(defaultPolicy))
+
+	// the default policy retries max retries times without retry policies
+	c = newConfig(WithMaxRetries(4))
+	g.Expect(c.retryPolicyOf("CreateRuns").MaxRetries).To(gomega.Equal(4))
+	g.Expect(c.retryPolicyOf("CreateRuns").InitialBackoff).To(gomega.Equal(eventbusoptions.DefaultInitialBackoff))
+}
+
+func TestHandlesDeadLettered(t *testing.T) {
+	g := gomega.NewWithT(t)
+	subscribers := map[string][]EventHandler{"CreateRuns": nil}
+	deadLettered := func(eventType string) *Event {
+		return &Event{Type: EventDeadLettered, Payload: string(NewDeadLetteredEvent(&Event{Type: eventType}).Payload())}
+	}
+
+	g.Expect(handlesDeadLettered(subscribers, &Event{Type: "ImportWorkflows"})).To(gomega.BeTrue())
+	g.Expect(handlesDeadLettered(subscribers, deadLettered("CreateRuns"))).To(gomega.BeTrue())
+	// left to the event bus subscribing ImportWorkflows
+	g.Expect(handlesDeadLettered(subscribers, deadLettered("ImportWorkflows"))).To(gomega.BeFalse())
+	g.Expect(handlesDeadLettered(subscribers, &Event{Type: EventDeadLettered, Payload: "malformed"})).To(gomega.BeTrue())
+}
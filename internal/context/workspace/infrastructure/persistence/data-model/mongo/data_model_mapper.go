@@ -0,0 +1,236 @@
+package mongo
+
+import (
+	"context"
+	"encoding/json"
+
+	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+	datamodel "github.com/Bio-OS/bioos/internal/context/workspace/domain/data-model"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	"github.com/Bio-OS/bioos/pkg/log"
+)
+
+func dataModelPOToDataModelDO(ctx context.Context, model *dataModelPO) *datamodel.DataModel {
+	return &datamodel.DataModel{
+		WorkspaceID: model.WorkspaceID,
+		ID:          model.ID,
+		Name:        model.Name,
+		Type:        model.Type,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+}
+
+func dataModelDOToDataModelPO(ctx context.Context, model *datamodel.DataModel) *dataModelPO {
+	po := &dataModelPO{
+		ID:          model.ID,
+		WorkspaceID: model.WorkspaceID,
+		Name:        model.Name,
+		Type:        model.Type,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+	// headers of entity_set and workspace type data model are fixed
+	if model.Type == consts.DataModelTypeEntity {
+		po.Headers = model.Headers
+		po.HeaderTypes = make([]string, 0, len(model.Headers))
+		for _, header := range model.Headers {
+			typ, ok := model.ColumnTypes[header]
+			if !ok {
+				typ = consts.DataModelColumnTypeString
+			}
+			po.HeaderTypes = append(po.HeaderTypes, typ)
+		}
+		po.DeclaredHeaders = declaredHeaders(model.Headers, model.DeclaredColumns)
+	}
+	return po
+}
+
+// declaredHeaders returns the headers whose column types are declared in header order.
+func declaredHeaders(headers []string, declaredColumns map[string]bool) []string {
+	res := make([]string, 0, len(declaredColumns))
+	for _, header := range headers {
+		if declaredColumns[header] {
+			res = append(res, header)
+		}
+	}
+	return res
+}
+
+// declaredColumns returns the set of headers whose column types are declared.
+func declaredColumns(headers []string) map[string]bool {
+	res := make(map[string]bool, len(headers))
+	for _, header := range headers {
+		res[header] = true
+	}
+	return res
+}
+
+func dataModelPOToDataModelDTO(ctx context.Context, d *dataModelPO, count int64) *query.DataModel {
+	return &query.DataModel{
+		ID:          d.ID,
+		Name:        d.Name,
+		Type:        d.Type,
+		WorkspaceID: d.WorkspaceID,
+		RowCount:    count,
+	}
+}
+
+func dataModelDOToEntityRowsPO(ctx context.Context, model *datamodel.DataModel) []*dataModelRowPO {
+	rows := make([]*dataModelRowPO, 0, len(model.Rows))
+	for _, row := range model.Rows {
+		rows = append(rows, &dataModelRowPO{
+			DataModelID: model.ID,
+			RowID:       row[0],
+			Grids:       row,
+		})
+	}
+	return rows
+}
+
+func dataModelDOToEntitySetRowsPO(ctx context.Context, model *datamodel.DataModel) ([]*dataModelRowPO, error) {
+	rows := make([]*dataModelRowPO, 0, len(model.Rows))
+	for _, row := range model.Rows {
+		var refRowIDs []string
+		if err := json.Unmarshal([]byte(row[1]), &refRowIDs); err != nil {
+			return nil, err
+		}
+		// entity set row without any referenced row does not exist
+		if len(refRowIDs) == 0 {
+			continue
+		}
+		rows = append(rows, &dataModelRowPO{
+			DataModelID: model.ID,
+			RowID:       row[0],
+			RefRowIDs:   refRowIDs,
+		})
+	}
+	return rows, nil
+}
+
+func dataModelDOToWorkspaceRowsPO(ctx context.Context, model *datamodel.DataModel) []*dataModelRowPO {
+	rows := make([]*dataModelRowPO, 0, len(model.Rows))
+	for _, row := range model.Rows {
+		rows = append(rows, &dataModelRowPO{
+			DataModelID: model.ID,
+			RowID:       row[0],
+			Value:       row[1],
+		})
+	}
+	return rows
+}
+
+func dataModelRowPOToRowDTO(ctx context.Context, row *dataModelRowPO, _type string) []string {
+	switch _type {
+	case consts.DataModelTypeEntitySet:
+		refRowIDs, err := json.Marshal(row.RefRowIDs)
+		if err != nil {
+			log.Fatalf(err.Error())
+		}
+		return []string{row.RowID, string(refRowIDs)}
+	case consts.DataModelTypeWorkspace:
+		return []string{row.RowID, row.Value}
+	default:
+		return row.Grids
+	}
+}
+
+func dataModelRowsPOToRowIDsDTO(ctx context.Context, rows []*dataModelRowPO) []string {
+	res := make([]string, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, row.RowID)
+	}
+	return res
+}
+
+func dataModelRowsPOToColumnDTO(ctx context.Context, rows []*dataModelRowPO, columnIndex int, rowIDs []string) []string {
+	mappedRows := make(map[string]*dataModelRowPO, len(rows))
+	for _, row := range rows {
+		mappedRows[row.RowID] = row
+	}
+	res := make([]string, len(rowIDs))
+	for index, rowID := range rowIDs {
+		if row, ok := mappedRows[rowID]; ok && columnIndex < len(row.Grids) {
+			res[index] = row.Grids[columnIndex]
+		}
+	}
+	return res
+}
+
+// snapshotDOToSnapshotPO leaves the row count to the repository which copies the rows.
+func snapshotDOToSnapshotPO(ctx context.Context, snapshot *datamodel.Snapshot) *dataModelSnapshotPO {
+	headerTypes := make([]string, 0, len(snapshot.Headers))
+	for _, header := range snapshot.Headers {
+		typ, ok := snapshot.ColumnTypes[header]
+		if !ok {
+			typ = consts.DataModelColumnTypeString
+		}
+		headerTypes = append(headerTypes, typ)
+	}
+	return &dataModelSnapshotPO{
+		ID:              snapshot.ID,
+		WorkspaceID:     snapshot.WorkspaceID,
+		DataModelID:     snapshot.DataModelID,
+		DataModelName:   snapshot.DataModelName,
+		DataModelType:   snapshot.DataModelType,
+		Name:            snapshot.Name,
+		Description:     snapshot.Description,
+		Auto:            snapshot.Auto,
+		Headers:         snapshot.Headers,
+		HeaderTypes:     headerTypes,
+		DeclaredHeaders: declaredHeaders(snapshot.Headers, snapshot.DeclaredColumns),
+		CreatedAt:       snapshot.CreatedAt,
+	}
+}
+
+func snapshotPOToSnapshotDO(ctx context.Context, snapshot *dataModelSnapshotPO, rows []*dataModelSnapshotRowPO) *datamodel.Snapshot {
+	columnTypes := make(map[string]string, len(snapshot.Headers))
+	for index, header := range snapshot.Headers {
+		if index < len(snapshot.HeaderTypes) {
+			columnTypes[header] = snapshot.HeaderTypes[index]
+		}
+	}
+	return &datamodel.Snapshot{
+		ID:              snapshot.ID,
+		WorkspaceID:     snapshot.WorkspaceID,
+		DataModelID:     snapshot.DataModelID,
+		DataModelName:   snapshot.DataModelName,
+		DataModelType:   snapshot.DataModelType,
+		Name:            snapshot.Name,
+		Description:     snapshot.Description,
+		Auto:            snapshot.Auto,
+		Headers:         snapshot.Headers,
+		ColumnTypes:     columnTypes,
+		DeclaredColumns: declaredColumns(snapshot.DeclaredHeaders),
+		Rows:            snapshotRowsPOToRowsDTO(ctx, rows, snapshot.DataModelType),
+		CreatedAt:       snapshot.CreatedAt,
+	}
+}
+
+func snapshotPOToSnapshotDTO(ctx context.Context, snapshot *dataModelSnapshotPO) *query.DataModelSnapshot {
+	return &query.DataModelSnapshot{
+		ID:          snapshot.ID,
+		WorkspaceID: snapshot.WorkspaceID,
+		DataModelID: snapshot.DataModelID,
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+		Auto:        snapshot.Auto,
+		Headers:     snapshot.Headers,
+		HeaderTypes: snapshot.HeaderTypes,
+		RowCount:    snapshot.RowCount,
+		CreatedAt:   snapshot.CreatedAt,
+	}
+}
+
+func snapshotRowsPOToRowsDTO(ctx context.Context, rows []*dataModelSnapshotRowPO, _type string) [][]string {
+	res := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, dataModelRowPOToRowDTO(ctx, &dataModelRowPO{
+			RowID:     row.RowID,
+			Grids:     row.Grids,
+			RefRowIDs: row.RefRowIDs,
+			Value:     row.Value,
+		}, _type))
+	}
+	return res
+}
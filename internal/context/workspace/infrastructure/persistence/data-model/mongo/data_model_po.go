@@ -0,0 +1,63 @@
+package mongo
+
+import "time"
+
+const (
+	DataModelCollection    = "dataModel"
+	DataModelRowCollection = "dataModelRow"
+
+	DataModelSnapshotCollection    = "dataModelSnapshot"
+	DataModelSnapshotRowCollection = "dataModelSnapshotRow"
+)
+
+type dataModelPO struct {
+	ID          string   `bson:"id"`
+	WorkspaceID string   `bson:"workspaceID"`
+	Name        string   `bson:"name"`
+	Type        string   `bson:"type"`
+	Headers     []string `bson:"headers,omitempty"`
+	// HeaderTypes are the column types aligned with Headers
+	HeaderTypes []string `bson:"headerTypes,omitempty"`
+	// DeclaredHeaders are the headers whose column types are declared rather than inferred
+	DeclaredHeaders []string  `bson:"declaredHeaders,omitempty"`
+	CreatedAt       time.Time `bson:"createdAt"`
+	UpdatedAt       time.Time `bson:"updatedAt"`
+}
+
+// dataModelRowPO is a row of data model, which fields are used depends on the data model type:
+// entity rows store the grids in header order, entity_set rows store the referenced entity row ids,
+// and workspace rows store the value of key RowID.
+type dataModelRowPO struct {
+	DataModelID string   `bson:"dataModelID"`
+	RowID       string   `bson:"rowID"`
+	Grids       []string `bson:"grids,omitempty"`
+	RefRowIDs   []string `bson:"refRowIDs,omitempty"`
+	Value       string   `bson:"value,omitempty"`
+}
+
+type dataModelSnapshotPO struct {
+	ID            string   `bson:"id"`
+	WorkspaceID   string   `bson:"workspaceID"`
+	DataModelID   string   `bson:"dataModelID"`
+	DataModelName string   `bson:"dataModelName"`
+	DataModelType string   `bson:"dataModelType"`
+	Name          string   `bson:"name"`
+	Description   string   `bson:"description,omitempty"`
+	Auto          bool     `bson:"auto"`
+	Headers       []string `bson:"headers"`
+	// HeaderTypes are the column types aligned with Headers
+	HeaderTypes []string `bson:"headerTypes"`
+	// DeclaredHeaders are the headers whose column types are declared rather than inferred
+	DeclaredHeaders []string  `bson:"declaredHeaders,omitempty"`
+	RowCount        int64     `bson:"rowCount"`
+	CreatedAt       time.Time `bson:"createdAt"`
+}
+
+// dataModelSnapshotRowPO is a row of snapshot, which is copied from the row of data model as it is.
+type dataModelSnapshotRowPO struct {
+	SnapshotID string   `bson:"snapshotID"`
+	RowID      string   `bson:"rowID"`
+	Grids      []string `bson:"grids,omitempty"`
+	RefRowIDs  []string `bson:"refRowIDs,omitempty"`
+	Value      string   `bson:"value,omitempty"`
+}
@@ -0,0 +1,455 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+type dataModelReadModel struct {
+	collection            *mongo.Collection
+	rowCollection         *mongo.Collection
+	snapshotCollection    *mongo.Collection
+	snapshotRowCollection *mongo.Collection
+}
+
+var _ query.DataModelReadModel = &dataModelReadModel{}
+
+// NewDataModelReadModel ...
+func NewDataModelReadModel(ctx context.Context, mongoDB *mongo.Database) (query.DataModelReadModel, error) {
+	return &dataModelReadModel{
+		collection:            mongoDB.Collection(DataModelCollection),
+		rowCollection:         mongoDB.Collection(DataModelRowCollection),
+		snapshotCollection:    mongoDB.Collection(DataModelSnapshotCollection),
+		snapshotRowCollection: mongoDB.Collection(DataModelSnapshotRowCollection),
+	}, nil
+}
+
+func (d *dataModelReadModel) ListDataModels(ctx context.Context, workspaceID string, filter *query.ListDataModelsFilter) ([]*query.DataModel, error) {
+	cursor, err := d.collection.Find(ctx, listDataModelsFilter(workspaceID, filter), options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		applog.Errorw("failed to list data model", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var pos []*dataModelPO
+	if err := cursor.All(ctx, &pos); err != nil {
+		applog.Errorw("failed to decode data model", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	ret := make([]*query.DataModel, len(pos))
+	for index, po := range pos {
+		count, err := d.CountDataModelRows(ctx, po.ID, po.Type, &query.ListDataModelRowsFilter{})
+		if err != nil {
+			return nil, err
+		}
+		ret[index] = dataModelPOToDataModelDTO(ctx, po, count)
+	}
+	return ret, nil
+}
+
+func (d *dataModelReadModel) CountDataModel(ctx context.Context, workspaceID string, filter *query.ListDataModelsFilter) (int64, error) {
+	count, err := d.collection.CountDocuments(ctx, listDataModelsFilter(workspaceID, filter))
+	if err != nil {
+		applog.Errorw("failed to count data model", "err", err)
+		return 0, apperrors.NewInternalError(err)
+	}
+	return count, nil
+}
+
+func (d *dataModelReadModel) GetDataModelName(ctx context.Context, workspaceID, id string) (string, error) {
+	dm, err := d.getDataModel(ctx, bson.M{"workspaceID": workspaceID, "id": id}, id)
+	if err != nil {
+		return "", err
+	}
+	return dm.Name, nil
+}
+
+func (d *dataModelReadModel) GetDataModelWithID(ctx context.Context, id string) (*query.DataModel, error) {
+	dm, err := d.getDataModel(ctx, bson.M{"id": id}, id)
+	if err != nil {
+		return nil, err
+	}
+	return d.dataModelPOToDataModelDTOWithCount(ctx, dm)
+}
+
+func (d *dataModelReadModel) GetDataModelWithName(ctx context.Context, workspaceID, name string) (*query.DataModel, error) {
+	dm, err := d.getDataModel(ctx, bson.M{"workspaceID": workspaceID, "name": name}, name)
+	if err != nil {
+		return nil, err
+	}
+	return d.dataModelPOToDataModelDTOWithCount(ctx, dm)
+}
+
+func (d *dataModelReadModel) getDataModel(ctx context.Context, filter bson.M, key string) (*dataModelPO, error) {
+	var dm dataModelPO
+	if err := d.collection.FindOne(ctx, filter).Decode(&dm); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("data model", key)
+		}
+		applog.Errorw("failed to get data model", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return &dm, nil
+}
+
+func (d *dataModelReadModel) dataModelPOToDataModelDTOWithCount(ctx context.Context, dm *dataModelPO) (*query.DataModel, error) {
+	count, err := d.CountDataModelRows(ctx, dm.ID, dm.Type, &query.ListDataModelRowsFilter{})
+	if err != nil {
+		return nil, err
+	}
+	return dataModelPOToDataModelDTO(ctx, dm, count), nil
+}
+
+func (d *dataModelReadModel) ListDataModelHeaders(ctx context.Context, id, name, _type string) ([]string, error) {
+	switch _type {
+	case consts.DataModelTypeEntitySet:
+		return []string{utils.GenDataModelHeaderOfID(name), strings.TrimSuffix(name, consts.DataModelEntitySetNameSuffix)}, nil
+	case consts.DataModelTypeWorkspace:
+		return []string{consts.WorkspaceTypeDataModelHeaderKey, consts.WorkspaceTypeDataModelHeaderValue}, nil
+	case consts.DataModelTypeEntity:
+		return d.ListEntityDataModelHeaders(ctx, id)
+	default:
+		return nil, apperrors.NewInvalidError("unsupport data model type")
+	}
+}
+
+func (d *dataModelReadModel) ListDataModelHeaderTypes(ctx context.Context, id, _type string) ([]string, error) {
+	switch _type {
+	case consts.DataModelTypeEntitySet:
+		return []string{consts.DataModelColumnTypeString, consts.DataModelColumnTypeArray}, nil
+	case consts.DataModelTypeWorkspace:
+		return []string{consts.DataModelColumnTypeString, consts.DataModelColumnTypeString}, nil
+	case consts.DataModelTypeEntity:
+		var dm dataModelPO
+		if err := d.collection.FindOne(ctx, bson.M{"id": id}).Decode(&dm); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return []string{}, nil
+			}
+			applog.Errorw("failed to list data model entity header types", "err", err)
+			return nil, apperrors.NewInternalError(err)
+		}
+		// data models saved before column types are introduced have no header types
+		types := make([]string, len(dm.Headers))
+		for index := range dm.Headers {
+			types[index] = consts.DataModelColumnTypeString
+			if index < len(dm.HeaderTypes) {
+				types[index] = dm.HeaderTypes[index]
+			}
+		}
+		return types, nil
+	default:
+		return nil, apperrors.NewInvalidError("unsupport data model type")
+	}
+}
+
+func (d *dataModelReadModel) ListEntityDataModelHeaders(ctx context.Context, id string) ([]string, error) {
+	var dm dataModelPO
+	if err := d.collection.FindOne(ctx, bson.M{"id": id}).Decode(&dm); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return []string{}, nil
+		}
+		applog.Errorw("failed to list data model entity headers", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	if dm.Headers == nil {
+		return []string{}, nil
+	}
+	return dm.Headers, nil
+}
+
+func (d *dataModelReadModel) ListEntityDataModelDeclaredHeaders(ctx context.Context, id string) ([]string, error) {
+	var dm dataModelPO
+	if err := d.collection.FindOne(ctx, bson.M{"id": id}).Decode(&dm); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return []string{}, nil
+		}
+		applog.Errorw("failed to list data model entity declared headers", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	if dm.DeclaredHeaders == nil {
+		return []string{}, nil
+	}
+	return dm.DeclaredHeaders, nil
+}
+
+func (d *dataModelReadModel) ListEntityDataModelColumnsWithRowIDs(ctx context.Context, id string, headers []string, rowIDs []string) (map[string][]string, error) {
+	dbHeaders, err := d.ListEntityDataModelHeaders(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"dataModelID": id}
+	if len(rowIDs) > 0 {
+		filter["rowID"] = bson.M{"$in": rowIDs}
+	}
+	rows, err := d.findRows(ctx, filter, options.Find().SetSort(bson.D{{Key: "rowID", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	if len(rowIDs) == 0 {
+		rowIDs = dataModelRowsPOToRowIDsDTO(ctx, rows)
+	}
+	wanted := make(map[string]struct{}, len(headers))
+	for _, header := range headers {
+		wanted[header] = struct{}{}
+	}
+	res := make(map[string][]string)
+	for index, header := range dbHeaders {
+		if _, ok := wanted[header]; len(headers) > 0 && !ok {
+			continue
+		}
+		res[header] = dataModelRowsPOToColumnDTO(ctx, rows, index, rowIDs)
+	}
+	return res, nil
+}
+
+func (d *dataModelReadModel) ListDataModelRows(ctx context.Context, id, _type string, pagination *utils.Pagination, order *utils.Order, filter *query.ListDataModelRowsFilter) ([][]string, int64, error) {
+	rowsFilter, err := listDataModelRowsFilter(id, _type, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := d.rowCollection.CountDocuments(ctx, rowsFilter)
+	if err != nil {
+		applog.Errorw("failed to count data model rows", "err", err)
+		return nil, 0, apperrors.NewInternalError(err)
+	}
+	if count == 0 {
+		return nil, 0, nil
+	}
+
+	if order == nil {
+		order = &utils.Order{Ascending: true}
+	}
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: rowsFilter}}}
+	sortStage := bson.D{}
+	switch _type {
+	case consts.DataModelTypeEntity:
+		// order.Field is the index of header to sort by
+		if columnIndex, err := strconv.Atoi(order.Field); err == nil {
+			sortValue := bson.M{"$arrayElemAt": bson.A{"$grids", columnIndex}}
+			// sort the numbers after the other values, and by number instead of string
+			pipeline = append(pipeline, bson.D{{Key: "$addFields", Value: bson.M{
+				"sortNumber": toDoubleExpr(sortValue),
+				"sortValue":  sortValue,
+			}}})
+			sortStage = append(sortStage,
+				bson.E{Key: "sortNumber", Value: sortDirection(order.Ascending)},
+				bson.E{Key: "sortValue", Value: sortDirection(order.Ascending)},
+			)
+		}
+		sortStage = append(sortStage, bson.E{Key: "rowID", Value: 1})
+	case consts.DataModelTypeEntitySet:
+		sortStage = append(sortStage, bson.E{Key: "rowID", Value: sortDirection(order.Ascending)})
+	case consts.DataModelTypeWorkspace:
+		for _, pgOrder := range pagination.Orders {
+			switch strings.ToLower(pgOrder.Field) {
+			case "key":
+				sortStage = append(sortStage, bson.E{Key: "rowID", Value: sortDirection(pgOrder.Ascending)})
+			case "value":
+				sortStage = append(sortStage, bson.E{Key: "value", Value: sortDirection(pgOrder.Ascending)})
+			}
+		}
+		if len(sortStage) == 0 {
+			sortStage = append(sortStage, bson.E{Key: "rowID", Value: 1})
+		}
+	}
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: sortStage}},
+		bson.D{{Key: "$skip", Value: int64(pagination.GetOffset())}},
+		bson.D{{Key: "$limit", Value: int64(pagination.GetLimit())}},
+	)
+	cursor, err := d.rowCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		applog.Errorw("failed to list data model rows", "err", err)
+		return nil, 0, apperrors.NewInternalError(err)
+	}
+	var pos []*dataModelRowPO
+	if err := cursor.All(ctx, &pos); err != nil {
+		applog.Errorw("failed to decode data model rows", "err", err)
+		return nil, 0, apperrors.NewInternalError(err)
+	}
+	rows := make([][]string, 0, len(pos))
+	for _, po := range pos {
+		rows = append(rows, dataModelRowPOToRowDTO(ctx, po, _type))
+	}
+	return rows, count, nil
+}
+
+func (d *dataModelReadModel) ListDataModelRowsAfter(ctx context.Context, id, _type, afterRowID string, size int, filter *query.ListDataModelRowsFilter) ([][]string, error) {
+	rowsFilter, err := listDataModelRowsFilter(id, _type, filter)
+	if err != nil {
+		return nil, err
+	}
+	pos, err := d.findRows(ctx,
+		bson.M{"$and": bson.A{rowsFilter, bson.M{"rowID": bson.M{"$gt": afterRowID}}}},
+		options.Find().SetSort(bson.D{{Key: "rowID", Value: 1}}).SetLimit(int64(size)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([][]string, 0, len(pos))
+	for _, po := range pos {
+		rows = append(rows, dataModelRowPOToRowDTO(ctx, po, _type))
+	}
+	return rows, nil
+}
+
+func (d *dataModelReadModel) ListAllDataModelRowIDs(ctx context.Context, id, _type string) ([]string, error) {
+	rowsFilter, err := listDataModelRowsFilter(id, _type, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.findRows(ctx, rowsFilter, options.Find().SetProjection(bson.M{"rowID": 1}))
+	if err != nil {
+		return nil, err
+	}
+	return dataModelRowsPOToRowIDsDTO(ctx, rows), nil
+}
+
+func (d *dataModelReadModel) CountDataModelRows(ctx context.Context, id, _type string, filter *query.ListDataModelRowsFilter) (int64, error) {
+	rowsFilter, err := listDataModelRowsFilter(id, _type, filter)
+	if err != nil {
+		return 0, err
+	}
+	count, err := d.rowCollection.CountDocuments(ctx, rowsFilter)
+	if err != nil {
+		applog.Errorw("failed to count data model rows", "err", err)
+		return 0, apperrors.NewInternalError(err)
+	}
+	return count, nil
+}
+
+func (d *dataModelReadModel) ListDataModelSnapshots(ctx context.Context, dataModelID string) ([]*query.DataModelSnapshot, error) {
+	cursor, err := d.snapshotCollection.Find(ctx, bson.M{"dataModelID": dataModelID}, options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}))
+	if err != nil {
+		applog.Errorw("failed to list data model snapshots", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var pos []*dataModelSnapshotPO
+	if err := cursor.All(ctx, &pos); err != nil {
+		applog.Errorw("failed to decode data model snapshots", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	ret := make([]*query.DataModelSnapshot, len(pos))
+	for index, po := range pos {
+		ret[index] = snapshotPOToSnapshotDTO(ctx, po)
+	}
+	return ret, nil
+}
+
+func (d *dataModelReadModel) GetDataModelSnapshot(ctx context.Context, id string) (*query.DataModelSnapshot, error) {
+	var po dataModelSnapshotPO
+	if err := d.snapshotCollection.FindOne(ctx, bson.M{"id": id}).Decode(&po); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("data model snapshot", id)
+		}
+		applog.Errorw("failed to get data model snapshot", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return snapshotPOToSnapshotDTO(ctx, &po), nil
+}
+
+func (d *dataModelReadModel) ListDataModelSnapshotRows(ctx context.Context, id string, rowIDs []string) ([][]string, error) {
+	var snapshot dataModelSnapshotPO
+	if err := d.snapshotCollection.FindOne(ctx, bson.M{"id": id}, options.FindOne().SetProjection(bson.M{"dataModelType": 1})).Decode(&snapshot); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("data model snapshot", id)
+		}
+		applog.Errorw("failed to get data model snapshot", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	filter := bson.M{"snapshotID": id}
+	if len(rowIDs) != 0 {
+		filter["rowID"] = bson.M{"$in": rowIDs}
+	}
+	cursor, err := d.snapshotRowCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "rowID", Value: 1}}))
+	if err != nil {
+		applog.Errorw("failed to list data model snapshot rows", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var pos []*dataModelSnapshotRowPO
+	if err := cursor.All(ctx, &pos); err != nil {
+		applog.Errorw("failed to decode data model snapshot rows", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return snapshotRowsPOToRowsDTO(ctx, pos, snapshot.DataModelType), nil
+}
+
+func (d *dataModelReadModel) findRows(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*dataModelRowPO, error) {
+	cursor, err := d.rowCollection.Find(ctx, filter, opts...)
+	if err != nil {
+		applog.Errorw("failed to list data model rows", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var rows []*dataModelRowPO
+	if err := cursor.All(ctx, &rows); err != nil {
+		applog.Errorw("failed to decode data model rows", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return rows, nil
+}
+
+func sortDirection(ascending bool) int {
+	if ascending {
+		return 1
+	}
+	return -1
+}
+
+func listDataModelsFilter(workspaceID string, filter *query.ListDataModelsFilter) bson.M {
+	res := bson.M{"workspaceID": workspaceID}
+	if filter == nil {
+		return res
+	}
+	if searchFilter := utils.MongoSearchWordFilter(filter.SearchWord, []string{"name"}, false); searchFilter != nil {
+		res["$and"] = bson.A{searchFilter}
+	}
+	if len(filter.IDs) > 0 {
+		res["id"] = bson.M{"$in": filter.IDs}
+	}
+	if len(filter.Types) > 0 {
+		res["type"] = bson.M{"$in": filter.Types}
+	}
+	return res
+}
+
+func listDataModelRowsFilter(id, _type string, filter *query.ListDataModelRowsFilter) (bson.M, error) {
+	var searchFields []string
+	switch _type {
+	case consts.DataModelTypeEntity:
+		searchFields = []string{"grids"}
+	case consts.DataModelTypeEntitySet:
+		searchFields = []string{"refRowIDs", "rowID"}
+	case consts.DataModelTypeWorkspace:
+		searchFields = []string{"rowID", "value"}
+	default:
+		return nil, apperrors.NewInvalidError("unsupported data model type")
+	}
+	res := bson.M{"dataModelID": id}
+	if filter == nil {
+		return res, nil
+	}
+	if searchFilter := utils.MongoSearchWordFilter(filter.SearchWord, searchFields, false); searchFilter != nil {
+		res["$and"] = bson.A{searchFilter}
+	}
+	if len(filter.InSetIDs) > 0 && _type == consts.DataModelTypeEntitySet {
+		res["refRowIDs"] = bson.M{"$in": filter.InSetIDs}
+	}
+	if len(filter.RowIDs) > 0 {
+		res["rowID"] = bson.M{"$in": filter.RowIDs}
+	}
+	if filter.Where != nil {
+		res["$expr"] = rowFilterToExpr(_type, filter.Where)
+	}
+	return res, nil
+}
@@ -0,0 +1,446 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	datamodel "github.com/Bio-OS/bioos/internal/context/workspace/domain/data-model"
+	"github.com/Bio-OS/bioos/pkg/consts"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+type dataModelRepository struct {
+	collection            *mongo.Collection
+	rowCollection         *mongo.Collection
+	snapshotCollection    *mongo.Collection
+	snapshotRowCollection *mongo.Collection
+}
+
+var _ datamodel.Repository = &dataModelRepository{}
+
+// NewDataModelRepository ...
+func NewDataModelRepository(ctx context.Context, mongoDB *mongo.Database) (datamodel.Repository, error) {
+	collection := mongoDB.Collection(DataModelCollection)
+	rowCollection := mongoDB.Collection(DataModelRowCollection)
+	if err := utils.EnsureIndex(ctx, collection, "idx_id", true, primitive.D{{Key: "id", Value: 1}}); err != nil {
+		return nil, err
+	}
+	if err := utils.EnsureIndex(ctx, collection, "idx_ws_name", true, primitive.D{
+		{Key: "workspaceID", Value: 1},
+		{Key: "name", Value: 1},
+	}); err != nil {
+		return nil, err
+	}
+	if err := utils.EnsureIndex(ctx, rowCollection, "idx_data_model_row", true, primitive.D{
+		{Key: "dataModelID", Value: 1},
+		{Key: "rowID", Value: 1},
+	}); err != nil {
+		return nil, err
+	}
+	snapshotCollection := mongoDB.Collection(DataModelSnapshotCollection)
+	snapshotRowCollection := mongoDB.Collection(DataModelSnapshotRowCollection)
+	if err := utils.EnsureIndex(ctx, snapshotCollection, "idx_id", true, primitive.D{{Key: "id", Value: 1}}); err != nil {
+		return nil, err
+	}
+	if err := utils.EnsureIndex(ctx, snapshotCollection, "idx_data_model", false, primitive.D{
+		{Key: "dataModelID", Value: 1},
+		{Key: "createdAt", Value: -1},
+	}); err != nil {
+		return nil, err
+	}
+	if err := utils.EnsureIndex(ctx, snapshotRowCollection, "idx_snapshot_row", true, primitive.D{
+		{Key: "snapshotID", Value: 1},
+		{Key: "rowID", Value: 1},
+	}); err != nil {
+		return nil, err
+	}
+	return &dataModelRepository{
+		collection:            collection,
+		rowCollection:         rowCollection,
+		snapshotCollection:    snapshotCollection,
+		snapshotRowCollection: snapshotRowCollection,
+	}, nil
+}
+
+func (r *dataModelRepository) Save(ctx context.Context, dm *datamodel.DataModel) error {
+	var rowsUpdate []mongo.WriteModel
+	switch dm.Type {
+	case consts.DataModelTypeEntity:
+		for _, row := range dataModelDOToEntityRowsPO(ctx, dm) {
+			rowsUpdate = append(rowsUpdate, newRowUpsertModel(row, bson.M{"$set": bson.M{"grids": row.Grids}}))
+		}
+	case consts.DataModelTypeEntitySet:
+		rows, err := dataModelDOToEntitySetRowsPO(ctx, dm)
+		if err != nil {
+			return apperrors.NewInvalidError(err.Error())
+		}
+		if !reflect.DeepEqual(dm.Headers, []string{utils.GenDataModelHeaderOfID(dm.Name), strings.TrimSuffix(dm.Name, consts.DataModelEntitySetNameSuffix)}) {
+			return apperrors.NewInvalidError("should not update entity set type data model's headers")
+		}
+		for _, row := range rows {
+			rowsUpdate = append(rowsUpdate, newRowUpsertModel(row, bson.M{"$addToSet": bson.M{"refRowIDs": bson.M{"$each": row.RefRowIDs}}}))
+		}
+	case consts.DataModelTypeWorkspace:
+		if !reflect.DeepEqual(dm.Headers, []string{consts.WorkspaceTypeDataModelHeaderKey, consts.WorkspaceTypeDataModelHeaderValue}) {
+			return apperrors.NewInvalidError("should not update workspace type data model's headers")
+		}
+		for _, row := range dataModelDOToWorkspaceRowsPO(ctx, dm) {
+			rowsUpdate = append(rowsUpdate, newRowUpsertModel(row, bson.M{"$set": bson.M{"value": row.Value}}))
+		}
+	default:
+		return apperrors.NewInvalidError("unsupported data model type")
+	}
+
+	dataModel := dataModelDOToDataModelPO(ctx, dm)
+	set := bson.M{
+		"workspaceID": dataModel.WorkspaceID,
+		"name":        dataModel.Name,
+		"type":        dataModel.Type,
+		"updatedAt":   time.Now(),
+	}
+	if dataModel.Headers != nil {
+		set["headers"] = dataModel.Headers
+		set["headerTypes"] = dataModel.HeaderTypes
+		set["declaredHeaders"] = dataModel.DeclaredHeaders
+	}
+	update := bson.M{
+		"$set":         set,
+		"$setOnInsert": bson.M{"createdAt": dataModel.CreatedAt},
+	}
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"id": dataModel.ID}, update, options.Update().SetUpsert(true)); err != nil {
+		applog.Errorw("failed to save data model", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if len(rowsUpdate) == 0 {
+		return nil
+	}
+	if _, err := r.rowCollection.BulkWrite(ctx, rowsUpdate, options.BulkWrite().SetOrdered(false)); err != nil {
+		applog.Errorw("failed to save data model rows", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func newRowUpsertModel(row *dataModelRowPO, update bson.M) mongo.WriteModel {
+	return mongo.NewUpdateOneModel().
+		SetFilter(bson.M{"dataModelID": row.DataModelID, "rowID": row.RowID}).
+		SetUpdate(update).
+		SetUpsert(true)
+}
+
+func (r *dataModelRepository) Get(ctx context.Context, id string) (*datamodel.DataModel, error) {
+	var dm dataModelPO
+	if err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&dm); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("data model", id)
+		}
+		applog.Errorw("failed to get data model", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return dataModelPOToDataModelDO(ctx, &dm), nil
+}
+
+func (r *dataModelRepository) Delete(ctx context.Context, dm *datamodel.DataModel) error {
+	switch dm.Type {
+	case consts.DataModelTypeEntity:
+		if dm.Headers == nil && dm.RowIDs == nil {
+			if err := r.deleteDataModel(ctx, dm); err != nil {
+				return err
+			}
+			return r.deleteEntitySetWhenEntityDeleted(ctx, dm)
+		}
+		if len(dm.Headers) != 0 {
+			if err := r.deleteEntityColumns(ctx, dm); err != nil {
+				return err
+			}
+		}
+		if len(dm.RowIDs) != 0 {
+			if err := r.deleteRowsNotIn(ctx, dm); err != nil {
+				return err
+			}
+			return r.updateEntitySetWhenEntityRowDeleted(ctx, dm)
+		}
+	case consts.DataModelTypeEntitySet:
+		if dm.RowIDs == nil {
+			if err := r.deleteDataModel(ctx, dm); err != nil {
+				return err
+			}
+			return r.deleteEntitySetWhenEntityDeleted(ctx, dm)
+		} else if len(dm.RowIDs) != 0 {
+			if err := r.deleteRowsNotIn(ctx, dm); err != nil {
+				return err
+			}
+			return r.updateEntitySetWhenEntityRowDeleted(ctx, dm)
+		}
+	case consts.DataModelTypeWorkspace:
+		if dm.RowIDs == nil {
+			return r.deleteDataModel(ctx, dm)
+		} else if len(dm.RowIDs) != 0 {
+			return r.deleteRowsNotIn(ctx, dm)
+		}
+	default:
+		return apperrors.NewInvalidError("unsupported data model type")
+	}
+	return nil
+}
+
+func (r *dataModelRepository) deleteDataModel(ctx context.Context, dm *datamodel.DataModel) error {
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"id": dm.ID}); err != nil {
+		applog.Errorw("failed to delete data model", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if _, err := r.rowCollection.DeleteMany(ctx, bson.M{"dataModelID": dm.ID}); err != nil {
+		applog.Errorw("failed to delete data model rows", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return r.deleteSnapshots(ctx, dm)
+}
+
+func (r *dataModelRepository) deleteSnapshots(ctx context.Context, dm *datamodel.DataModel) error {
+	snapshotIDs, err := r.snapshotCollection.Distinct(ctx, "id", bson.M{"dataModelID": dm.ID})
+	if err != nil {
+		applog.Errorw("failed to list data model snapshots", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if len(snapshotIDs) == 0 {
+		return nil
+	}
+	return r.deleteSnapshotsWithIDs(ctx, snapshotIDs)
+}
+
+func (r *dataModelRepository) DeleteSnapshot(ctx context.Context, id string) error {
+	return r.deleteSnapshotsWithIDs(ctx, []interface{}{id})
+}
+
+func (r *dataModelRepository) DeleteAutoSnapshots(ctx context.Context, dataModelID string, keep int, before time.Time) error {
+	cursor, err := r.snapshotCollection.Find(ctx, bson.M{"dataModelID": dataModelID, "auto": true}, options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: -1}}).
+		SetProjection(bson.M{"id": 1, "createdAt": 1}))
+	if err != nil {
+		applog.Errorw("failed to list data model snapshots", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	var snapshots []*dataModelSnapshotPO
+	if err := cursor.All(ctx, &snapshots); err != nil {
+		applog.Errorw("failed to decode data model snapshots", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	snapshotIDs := make([]interface{}, 0)
+	for index, snapshot := range snapshots {
+		if index >= keep && snapshot.CreatedAt.Before(before) {
+			snapshotIDs = append(snapshotIDs, snapshot.ID)
+		}
+	}
+	if len(snapshotIDs) == 0 {
+		return nil
+	}
+	return r.deleteSnapshotsWithIDs(ctx, snapshotIDs)
+}
+
+func (r *dataModelRepository) deleteSnapshotsWithIDs(ctx context.Context, snapshotIDs []interface{}) error {
+	if _, err := r.snapshotRowCollection.DeleteMany(ctx, bson.M{"snapshotID": bson.M{"$in": snapshotIDs}}); err != nil {
+		applog.Errorw("failed to delete data model snapshot rows", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if _, err := r.snapshotCollection.DeleteMany(ctx, bson.M{"id": bson.M{"$in": snapshotIDs}}); err != nil {
+		applog.Errorw("failed to delete data model snapshots", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *dataModelRepository) Replace(ctx context.Context, dm *datamodel.DataModel) error {
+	if _, err := r.rowCollection.DeleteMany(ctx, bson.M{"dataModelID": dm.ID}); err != nil {
+		applog.Errorw("failed to delete data model rows", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if err := r.Save(ctx, dm); err != nil {
+		return err
+	}
+	if dm.Type != consts.DataModelTypeEntity {
+		return nil
+	}
+	// the entity set rows refer to the entity rows not restored are removed
+	rowIDs := make([]string, 0, len(dm.Rows))
+	for _, row := range dm.Rows {
+		rowIDs = append(rowIDs, row[0])
+	}
+	return r.updateEntitySetWhenEntityRowDeleted(ctx, &datamodel.DataModel{
+		WorkspaceID: dm.WorkspaceID,
+		Name:        dm.Name,
+		RowIDs:      rowIDs,
+	})
+}
+
+func (r *dataModelRepository) SaveSnapshot(ctx context.Context, snapshot *datamodel.Snapshot) error {
+	snapshotPO := snapshotDOToSnapshotPO(ctx, snapshot)
+	// the rows are copied inside database instead of being read out and written back
+	cursor, err := r.rowCollection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"dataModelID": snapshot.DataModelID}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":        0,
+			"snapshotID": bson.M{"$literal": snapshot.ID},
+			"rowID":      1,
+			"grids":      1,
+			"refRowIDs":  1,
+			"value":      1,
+		}}},
+		{{Key: "$merge", Value: bson.M{"into": DataModelSnapshotRowCollection}}},
+	})
+	if err != nil {
+		applog.Errorw("failed to copy data model snapshot rows", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if err := cursor.Close(ctx); err != nil {
+		applog.Errorw("failed to copy data model snapshot rows", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if snapshotPO.RowCount, err = r.snapshotRowCollection.CountDocuments(ctx, bson.M{"snapshotID": snapshot.ID}); err != nil {
+		applog.Errorw("failed to count data model snapshot rows", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if _, err := r.snapshotCollection.InsertOne(ctx, snapshotPO); err != nil {
+		applog.Errorw("failed to create data model snapshot", "err", err)
+		if _, err := r.snapshotRowCollection.DeleteMany(ctx, bson.M{"snapshotID": snapshot.ID}); err != nil {
+			applog.Errorw("failed to delete data model snapshot rows", "err", err)
+		}
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *dataModelRepository) GetSnapshot(ctx context.Context, id string) (*datamodel.Snapshot, error) {
+	var snapshot dataModelSnapshotPO
+	if err := r.snapshotCollection.FindOne(ctx, bson.M{"id": id}).Decode(&snapshot); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("data model snapshot", id)
+		}
+		applog.Errorw("failed to get data model snapshot", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	cursor, err := r.snapshotRowCollection.Find(ctx, bson.M{"snapshotID": id}, options.Find().SetSort(bson.D{{Key: "rowID", Value: 1}}))
+	if err != nil {
+		applog.Errorw("failed to list data model snapshot rows", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var rows []*dataModelSnapshotRowPO
+	if err := cursor.All(ctx, &rows); err != nil {
+		applog.Errorw("failed to decode data model snapshot rows", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return snapshotPOToSnapshotDO(ctx, &snapshot, rows), nil
+}
+
+func (r *dataModelRepository) deleteRowsNotIn(ctx context.Context, dm *datamodel.DataModel) error {
+	if _, err := r.rowCollection.DeleteMany(ctx, bson.M{"dataModelID": dm.ID, "rowID": bson.M{"$nin": dm.RowIDs}}); err != nil {
+		applog.Errorw("failed to delete data model rows", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+// deleteEntityColumns keeps the columns in dm.Headers only, the grids of rows are
+// rewritten to keep them aligned with the remaining headers.
+func (r *dataModelRepository) deleteEntityColumns(ctx context.Context, dm *datamodel.DataModel) error {
+	var dataModel dataModelPO
+	if err := r.collection.FindOne(ctx, bson.M{"id": dm.ID}).Decode(&dataModel); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return apperrors.NewNotFoundError("data model", dm.ID)
+		}
+		applog.Errorw("failed to get data model", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	keep := make(map[string]struct{}, len(dm.Headers))
+	for _, header := range dm.Headers {
+		keep[header] = struct{}{}
+	}
+	headers := make([]string, 0, len(dm.Headers))
+	headerTypes := make([]string, 0, len(dm.Headers))
+	indexes := make(bson.A, 0, len(dm.Headers))
+	for index, header := range dataModel.Headers {
+		if _, ok := keep[header]; ok {
+			headers = append(headers, header)
+			if index < len(dataModel.HeaderTypes) {
+				headerTypes = append(headerTypes, dataModel.HeaderTypes[index])
+			}
+			indexes = append(indexes, index)
+		}
+	}
+	if len(headers) == len(dataModel.Headers) {
+		return nil
+	}
+	declared := make([]string, 0, len(dataModel.DeclaredHeaders))
+	for _, header := range dataModel.DeclaredHeaders {
+		if _, ok := keep[header]; ok {
+			declared = append(declared, header)
+		}
+	}
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"id": dm.ID}, bson.M{"$set": bson.M{"headers": headers, "headerTypes": headerTypes, "declaredHeaders": declared}}); err != nil {
+		applog.Errorw("failed to delete entity data model headers", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	// rows patched before the latest columns added are shorter than headers, the missing grids are dropped
+	pickGrids := mongo.Pipeline{{{Key: "$set", Value: bson.M{"grids": bson.M{"$filter": bson.M{
+		"input": bson.M{"$map": bson.M{
+			"input": indexes,
+			"as":    "index",
+			"in":    bson.M{"$arrayElemAt": bson.A{"$grids", "$$index"}},
+		}},
+		"as":   "grid",
+		"cond": bson.M{"$ne": bson.A{"$$grid", nil}},
+	}}}}}}
+	if _, err := r.rowCollection.UpdateMany(ctx, bson.M{"dataModelID": dm.ID}, pickGrids); err != nil {
+		applog.Errorw("failed to delete entity data model grids", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *dataModelRepository) getEntitySet(ctx context.Context, dm *datamodel.DataModel) (*dataModelPO, error) {
+	var entitySet dataModelPO
+	filter := bson.M{"workspaceID": dm.WorkspaceID, "name": utils.GenDataModelEntitySetName(dm.Name)}
+	if err := r.collection.FindOne(ctx, filter).Decode(&entitySet); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		applog.Errorw("failed to get data model by name", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return &entitySet, nil
+}
+
+func (r *dataModelRepository) updateEntitySetWhenEntityRowDeleted(ctx context.Context, dm *datamodel.DataModel) error {
+	entitySet, err := r.getEntitySet(ctx, dm)
+	if err != nil || entitySet == nil {
+		return err
+	}
+	update := bson.M{"$pull": bson.M{"refRowIDs": bson.M{"$nin": dm.RowIDs}}}
+	if _, err := r.rowCollection.UpdateMany(ctx, bson.M{"dataModelID": entitySet.ID}, update); err != nil {
+		applog.Errorw("failed to delete entity set data model row", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if _, err := r.rowCollection.DeleteMany(ctx, bson.M{"dataModelID": entitySet.ID, "refRowIDs": bson.M{"$size": 0}}); err != nil {
+		applog.Errorw("failed to delete entity set data model row", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (r *dataModelRepository) deleteEntitySetWhenEntityDeleted(ctx context.Context, dm *datamodel.DataModel) error {
+	entitySet, err := r.getEntitySet(ctx, dm)
+	if err != nil || entitySet == nil {
+		return err
+	}
+	// deleting the entity set also deletes the entity set of it
+	return r.Delete(ctx, dataModelPOToDataModelDO(ctx, entitySet))
+}
@@ -0,0 +1,88 @@
+package mongo
+
+import (
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+	"github.com/Bio-OS/bioos/pkg/consts"
+)
+
+var rowFilterOperators = map[string]string{
+	query.RowFilterOperatorEqual:        "$eq",
+	query.RowFilterOperatorNotEqual:     "$eq",
+	query.RowFilterOperatorGreater:      "$gt",
+	query.RowFilterOperatorGreaterEqual: "$gte",
+	query.RowFilterOperatorLess:         "$lt",
+	query.RowFilterOperatorLessEqual:    "$lte",
+}
+
+// rowFilterToExpr translates the row filter into aggregation expression used by $expr.
+func rowFilterToExpr(_type string, filter *query.RowFilter) bson.M {
+	if filter.Condition != nil {
+		return rowFilterConditionToExpr(_type, filter.Condition)
+	}
+	children := make(bson.A, 0, len(filter.Children))
+	for _, child := range filter.Children {
+		children = append(children, rowFilterToExpr(_type, child))
+	}
+	if filter.Logic == query.RowFilterLogicOr {
+		return bson.M{"$or": children}
+	}
+	return bson.M{"$and": children}
+}
+
+func rowFilterConditionToExpr(_type string, cond *query.RowFilterCondition) bson.M {
+	var expr bson.M
+	switch _type {
+	case consts.DataModelTypeEntity:
+		expr = compareExpr(bson.M{"$arrayElemAt": bson.A{"$grids", cond.ColumnIndex}}, cond)
+	case consts.DataModelTypeEntitySet:
+		// the first column is the id of entity set, and the second is the reffed entity row ids
+		if cond.ColumnIndex == 0 {
+			expr = compareExpr("$rowID", cond)
+		} else {
+			expr = bson.M{"$anyElementTrue": bson.A{bson.M{"$map": bson.M{
+				"input": bson.M{"$ifNull": bson.A{"$refRowIDs", bson.A{}}},
+				"as":    "ref",
+				"in":    compareExpr("$$ref", cond),
+			}}}}
+		}
+	default:
+		if cond.ColumnIndex == 0 {
+			expr = compareExpr("$rowID", cond)
+		} else {
+			expr = compareExpr("$value", cond)
+		}
+	}
+	if cond.Operator == query.RowFilterOperatorNotEqual {
+		return bson.M{"$not": bson.A{expr}}
+	}
+	return expr
+}
+
+// compareExpr compares input with the condition value, as number if the condition value is a
+// number. The not equal operator is compared as equal, callers should negate the result.
+func compareExpr(input interface{}, cond *query.RowFilterCondition) bson.M {
+	if cond.Operator == query.RowFilterOperatorLike {
+		return bson.M{"$regexMatch": bson.M{"input": input, "regex": regexp.QuoteMeta(cond.Value), "options": "i"}}
+	}
+	operator := rowFilterOperators[cond.Operator]
+	if number, ok := cond.Number(); ok {
+		converted := toDoubleExpr(input)
+		return bson.M{"$and": bson.A{
+			bson.M{"$ne": bson.A{converted, nil}},
+			bson.M{operator: bson.A{converted, number}},
+		}}
+	}
+	return bson.M{"$and": bson.A{
+		bson.M{"$eq": bson.A{bson.M{"$type": input}, "string"}},
+		bson.M{operator: bson.A{input, cond.Value}},
+	}}
+}
+
+// toDoubleExpr converts input to double, or null if it is not a number.
+func toDoubleExpr(input interface{}) bson.M {
+	return bson.M{"$convert": bson.M{"input": input, "to": "double", "onError": nil, "onNull": nil}}
+}
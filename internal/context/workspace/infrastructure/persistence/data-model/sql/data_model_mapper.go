@@ -6,6 +6,7 @@ import (
 
 	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
 	datamodel "github.com/Bio-OS/bioos/internal/context/workspace/domain/data-model"
+	"github.com/Bio-OS/bioos/pkg/consts"
 	"github.com/Bio-OS/bioos/pkg/log"
 )
 
@@ -182,7 +183,7 @@ func DataModelDOtoWorkspaceRowsPO(ctx context.Context, model *datamodel.DataMode
 			Key:         row[0],
 			DataModelID: model.ID,
 			Value:       row[1],
-			Type:        "string",
+			Type:        consts.DataModelColumnTypeString,
 		}
 		workspaceRows = append(workspaceRows, workspaceRow)
 	}
@@ -192,11 +193,16 @@ func DataModelDOtoWorkspaceRowsPO(ctx context.Context, model *datamodel.DataMode
 func DataModelDOtoEntityHeadersPO(ctx context.Context, model *datamodel.DataModel) []*EntityHeader {
 	entityHeaders := make([]*EntityHeader, 0, len(model.Headers))
 	for index, header := range model.Headers {
+		typ, ok := model.ColumnTypes[header]
+		if !ok {
+			typ = consts.DataModelColumnTypeString
+		}
 		entityHeaders = append(entityHeaders, &EntityHeader{
 			ColumnIndex: index,
 			DataModelID: model.ID,
 			Name:        header,
-			Type:        "string",
+			Type:        typ,
+			Declared:    model.DeclaredColumns[header],
 		})
 	}
 	return entityHeaders
@@ -217,3 +223,137 @@ func DataModelDOtoEntityGridsPO(ctx context.Context, model *datamodel.DataModel)
 	}
 	return entityGrid
 }
+
+// SnapshotDOToSnapshotPO leaves the row count to the repository which copies the rows.
+func SnapshotDOToSnapshotPO(ctx context.Context, snapshot *datamodel.Snapshot) (*DataModelSnapshot, error) {
+	headerTypes := make([]string, 0, len(snapshot.Headers))
+	for _, header := range snapshot.Headers {
+		typ, ok := snapshot.ColumnTypes[header]
+		if !ok {
+			typ = consts.DataModelColumnTypeString
+		}
+		headerTypes = append(headerTypes, typ)
+	}
+	headersInBytes, err := json.Marshal(snapshot.Headers)
+	if err != nil {
+		return nil, err
+	}
+	headerTypesInBytes, err := json.Marshal(headerTypes)
+	if err != nil {
+		return nil, err
+	}
+	declaredHeaders := make([]string, 0, len(snapshot.DeclaredColumns))
+	for _, header := range snapshot.Headers {
+		if snapshot.DeclaredColumns[header] {
+			declaredHeaders = append(declaredHeaders, header)
+		}
+	}
+	declaredHeadersInBytes, err := json.Marshal(declaredHeaders)
+	if err != nil {
+		return nil, err
+	}
+	return &DataModelSnapshot{
+		ID:              snapshot.ID,
+		DataModelID:     snapshot.DataModelID,
+		WorkspaceID:     snapshot.WorkspaceID,
+		DataModelName:   snapshot.DataModelName,
+		DataModelType:   snapshot.DataModelType,
+		Name:            snapshot.Name,
+		Description:     snapshot.Description,
+		Auto:            snapshot.Auto,
+		Headers:         string(headersInBytes),
+		HeaderTypes:     string(headerTypesInBytes),
+		DeclaredHeaders: string(declaredHeadersInBytes),
+		CreatedAt:       snapshot.CreatedAt,
+	}, nil
+}
+
+func SnapshotPOToSnapshotDO(ctx context.Context, snapshot *DataModelSnapshot, grids []*DataModelSnapshotGrid) (*datamodel.Snapshot, error) {
+	dto, err := SnapshotPOToSnapshotDTO(ctx, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	columnTypes := make(map[string]string, len(dto.Headers))
+	for index, header := range dto.Headers {
+		if index < len(dto.HeaderTypes) {
+			columnTypes[header] = dto.HeaderTypes[index]
+		}
+	}
+	rows, err := SnapshotGridsPOToRowsDTO(ctx, grids, snapshot.DataModelType)
+	if err != nil {
+		return nil, err
+	}
+	// snapshots taken before column types are declared have no declared headers
+	var declaredHeaders []string
+	if snapshot.DeclaredHeaders != "" {
+		if err := json.Unmarshal([]byte(snapshot.DeclaredHeaders), &declaredHeaders); err != nil {
+			return nil, err
+		}
+	}
+	declaredColumns := make(map[string]bool, len(declaredHeaders))
+	for _, header := range declaredHeaders {
+		declaredColumns[header] = true
+	}
+	return &datamodel.Snapshot{
+		ID:              snapshot.ID,
+		WorkspaceID:     snapshot.WorkspaceID,
+		DataModelID:     snapshot.DataModelID,
+		DataModelName:   snapshot.DataModelName,
+		DataModelType:   snapshot.DataModelType,
+		Name:            snapshot.Name,
+		Description:     snapshot.Description,
+		Auto:            snapshot.Auto,
+		Headers:         dto.Headers,
+		ColumnTypes:     columnTypes,
+		DeclaredColumns: declaredColumns,
+		Rows:            rows,
+		CreatedAt:       snapshot.CreatedAt,
+	}, nil
+}
+
+func SnapshotPOToSnapshotDTO(ctx context.Context, snapshot *DataModelSnapshot) (*query.DataModelSnapshot, error) {
+	var headers, headerTypes []string
+	if err := json.Unmarshal([]byte(snapshot.Headers), &headers); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(snapshot.HeaderTypes), &headerTypes); err != nil {
+		return nil, err
+	}
+	return &query.DataModelSnapshot{
+		ID:          snapshot.ID,
+		WorkspaceID: snapshot.WorkspaceID,
+		DataModelID: snapshot.DataModelID,
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+		Auto:        snapshot.Auto,
+		Headers:     headers,
+		HeaderTypes: headerTypes,
+		RowCount:    snapshot.RowCount,
+		CreatedAt:   snapshot.CreatedAt,
+	}, nil
+}
+
+// SnapshotGridsPOToRowsDTO groups the grids ordered by row id into the rows of data model type.
+func SnapshotGridsPOToRowsDTO(ctx context.Context, grids []*DataModelSnapshotGrid, _type string) ([][]string, error) {
+	res := make([][]string, 0)
+	for index := 0; index < len(grids); {
+		rowID := grids[index].RowID
+		values := make([]string, 0)
+		for ; index < len(grids) && grids[index].RowID == rowID; index++ {
+			values = append(values, grids[index].Value)
+		}
+		switch _type {
+		case consts.DataModelTypeEntitySet:
+			refRowIDs, err := json.Marshal(values)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, []string{rowID, string(refRowIDs)})
+		case consts.DataModelTypeWorkspace:
+			res = append(res, []string{rowID, values[0]})
+		default:
+			res = append(res, values)
+		}
+	}
+	return res, nil
+}
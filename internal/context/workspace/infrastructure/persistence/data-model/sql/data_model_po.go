@@ -20,6 +20,8 @@ type EntityHeader struct {
 	DataModelID string `gorm:"primaryKey;type:varchar(32);not null;index"`
 	Name        string `gorm:"type:varchar(100) CHARACTER SET gbk COLLATE gbk_bin;not null"`
 	Type        string `gorm:"type:varchar(32);not null"`
+	// Declared marks the column type declared rather than inferred from values
+	Declared bool `gorm:"not null;default:false"`
 }
 
 func (d *EntityHeader) TableName() string {
@@ -58,3 +60,40 @@ type WorkspaceRow struct {
 func (d *WorkspaceRow) TableName() string {
 	return "data_model_workspace_row"
 }
+
+type DataModelSnapshot struct {
+	ID            string `gorm:"primaryKey;type:varchar(32);not null"`
+	DataModelID   string `gorm:"type:varchar(32);not null;index"`
+	WorkspaceID   string `gorm:"type:varchar(32);not null"`
+	DataModelName string `gorm:"type:varchar(50) CHARACTER SET gbk COLLATE gbk_bin;not null"`
+	DataModelType string `gorm:"type:varchar(32);not null"`
+	Name          string `gorm:"type:varchar(100) CHARACTER SET gbk COLLATE gbk_bin;not null"`
+	Description   string `gorm:"type:varchar(1000) CHARACTER SET gbk COLLATE gbk_bin"`
+	Auto          bool   `gorm:"not null"`
+	// Headers and HeaderTypes are json arrays aligned with each other
+	Headers     string `gorm:"type:longtext CHARACTER SET gbk COLLATE gbk_bin;not null"`
+	HeaderTypes string `gorm:"type:longtext;not null"`
+	// DeclaredHeaders is the json array of headers whose column types are declared, it is empty in
+	// the snapshots taken before column types are declared
+	DeclaredHeaders string `gorm:"type:longtext CHARACTER SET gbk COLLATE gbk_bin"`
+	RowCount        int64  `gorm:"not null"`
+	CreatedAt       time.Time
+}
+
+func (d *DataModelSnapshot) TableName() string {
+	return "data_model_snapshot"
+}
+
+// DataModelSnapshotGrid is a grid of snapshot copied from the data model, the grids of entity set
+// rows are the reffed entity row ids, and that of workspace rows are the values.
+type DataModelSnapshotGrid struct {
+	ID          uint
+	SnapshotID  string `gorm:"type:varchar(32);not null;index:snapshot_row"`
+	RowID       string `gorm:"type:varchar(100) CHARACTER SET gbk COLLATE gbk_bin;not null;index:snapshot_row"`
+	ColumnIndex int    `gorm:"not null"`
+	Value       string `gorm:"type:longtext CHARACTER SET gbk COLLATE gbk_bin;not null"`
+}
+
+func (d *DataModelSnapshotGrid) TableName() string {
+	return "data_model_snapshot_grid"
+}
@@ -21,7 +21,7 @@ type dataModelReadModel struct {
 
 // NewDataModelReadModel ...
 func NewDataModelReadModel(ctx context.Context, db *gorm.DB) (query.DataModelReadModel, error) {
-	if err := db.WithContext(ctx).AutoMigrate(&DataModel{}, &EntityHeader{}, &EntityGrid{}, &EntitySetRow{}, &WorkspaceRow{}); err != nil {
+	if err := db.WithContext(ctx).AutoMigrate(&DataModel{}, &EntityHeader{}, &EntityGrid{}, &EntitySetRow{}, &WorkspaceRow{}, &DataModelSnapshot{}, &DataModelSnapshotGrid{}); err != nil {
 		return nil, apperrors.NewInternalError(err)
 	}
 	return &dataModelReadModel{db: db}, nil
@@ -53,7 +53,7 @@ func (d *dataModelReadModel) ListDataModels(ctx context.Context, workspaceID str
 }
 
 func (d *dataModelReadModel) CountDataModel(ctx context.Context, workspaceID string, filter *query.ListDataModelsFilter) (int64, error) {
-	db := d.db.WithContext(ctx).Where("workspace_id = ?", workspaceID).Order(ordersToOrderDB([]utils.Order{{
+	db := d.db.WithContext(ctx).Model(&DataModel{}).Where("workspace_id = ?", workspaceID).Order(ordersToOrderDB([]utils.Order{{
 		Field:     "name",
 		Ascending: true,
 	}}))
@@ -125,6 +125,30 @@ func (d *dataModelReadModel) ListDataModelHeaders(ctx context.Context, id, name,
 	}
 }
 
+func (d *dataModelReadModel) ListDataModelHeaderTypes(ctx context.Context, id, _type string) ([]string, error) {
+	switch _type {
+	case consts.DataModelTypeEntitySet:
+		return []string{consts.DataModelColumnTypeString, consts.DataModelColumnTypeArray}, nil
+	case consts.DataModelTypeWorkspace:
+		return []string{consts.DataModelColumnTypeString, consts.DataModelColumnTypeString}, nil
+	case consts.DataModelTypeEntity:
+		ehs, err := d.listEntityDataModelHeaders(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		types := make([]string, len(ehs))
+		for index, eh := range ehs {
+			types[index] = eh.Type
+			if types[index] == "" {
+				types[index] = consts.DataModelColumnTypeString
+			}
+		}
+		return types, nil
+	default:
+		return nil, apperrors.NewInvalidError("unsupport data model type")
+	}
+}
+
 func (d *dataModelReadModel) ListEntityDataModelHeaders(ctx context.Context, id string) ([]string, error) {
 	ws, err := d.listEntityDataModelHeaders(ctx, id)
 	if err != nil {
@@ -137,6 +161,20 @@ func (d *dataModelReadModel) ListEntityDataModelHeaders(ctx context.Context, id
 	return ret, nil
 }
 
+func (d *dataModelReadModel) ListEntityDataModelDeclaredHeaders(ctx context.Context, id string) ([]string, error) {
+	ehs, err := d.listEntityDataModelHeaders(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]string, 0, len(ehs))
+	for _, eh := range ehs {
+		if eh.Declared {
+			ret = append(ret, eh.Name)
+		}
+	}
+	return ret, nil
+}
+
 func (d *dataModelReadModel) listEntityDataModelHeaders(ctx context.Context, id string) ([]*EntityHeader, error) {
 	db := d.db.WithContext(ctx).Where("data_model_id = ?", id).Order(ordersToOrderDB([]utils.Order{{
 		Field:     "column_index",
@@ -221,7 +259,7 @@ func (d *dataModelReadModel) ListDataModelRows(ctx context.Context, id, _type st
 
 func (d *dataModelReadModel) getEntityDataModelRowIDsWithFilter(ctx context.Context, id string, filter *query.ListDataModelRowsFilter) ([]string, error) {
 	db := d.db.WithContext(ctx).Where("data_model_id = ?", id)
-	db = listEntityDataModelRowsFilter(db, filter)
+	db = listEntityDataModelRowsFilter(db, id, filter)
 	var egs []*EntityGrid
 	if err := db.Select("row_id").Find(&egs).Error; err != nil {
 		applog.Errorw("failed to list entity data model headers", "err", err)
@@ -232,6 +270,13 @@ func (d *dataModelReadModel) getEntityDataModelRowIDsWithFilter(ctx context.Cont
 
 func (d *dataModelReadModel) getEntityDataModelRowIDsWithPagination(ctx context.Context, id string, rowIdsWithFilter []string, pagination *utils.Pagination, order *utils.Order) ([]string, error) {
 	db := d.db.WithContext(ctx).Where("data_model_id = ? AND column_index = ? AND row_id IN ?", id, order.Field, rowIdsWithFilter).Limit(pagination.GetLimit()).Offset(pagination.GetOffset()).Order(ordersToOrderDB([]utils.Order{{
+		// sort the numbers after the other values, and by number instead of string
+		Field:     "`value` REGEXP '" + numberPattern + "'",
+		Ascending: order.Ascending,
+	}, {
+		Field:     "`value` + 0",
+		Ascending: order.Ascending,
+	}, {
 		Field:     "`value`",
 		Ascending: order.Ascending,
 	}, {
@@ -265,6 +310,11 @@ func (d *dataModelReadModel) listEntityDataModelRows(ctx context.Context, id str
 		applog.Errorw("failed to list entity data model ids", "err", err)
 		return nil, apperrors.NewInternalError(err)
 	}
+	return d.listEntityDataModelRowsWithIDs(ctx, id, rowIDs)
+}
+
+// listEntityDataModelRowsWithIDs lists the entity rows in the order of rowIDs.
+func (d *dataModelReadModel) listEntityDataModelRowsWithIDs(ctx context.Context, id string, rowIDs []string) ([][]string, error) {
 	rows := make([][]string, 0)
 	var eg []*EntityGrid
 	if err := d.db.WithContext(ctx).Where("data_model_id = ? AND row_id IN  ?", id, rowIDs).Order(ordersToOrderDB([]utils.Order{{
@@ -293,7 +343,7 @@ func (d *dataModelReadModel) listEntityDataModelRows(ctx context.Context, id str
 
 func (d *dataModelReadModel) getEntitySetDataModelRowIDsWithFilter(ctx context.Context, id string, filter *query.ListDataModelRowsFilter) ([]string, error) {
 	db := d.db.WithContext(ctx).Where("data_model_id = ?", id)
-	db = listEntitySetDataModelRowsFilter(db, filter)
+	db = listEntitySetDataModelRowsFilter(db, id, filter)
 	var eg []*EntitySetRow
 	if err := db.Find(&eg).Error; err != nil {
 		applog.Errorw("failed to list entity_set data model rows", "err", err)
@@ -351,6 +401,51 @@ func (d *dataModelReadModel) listWorkspaceDataModelRows(ctx context.Context, id
 	return WorkspaceRowsPOToWorkspaceRowsDTO(ctx, eg), nil
 }
 
+func (d *dataModelReadModel) ListDataModelRowsAfter(ctx context.Context, id, _type, afterRowID string, size int, filter *query.ListDataModelRowsFilter) ([][]string, error) {
+	switch _type {
+	case consts.DataModelTypeEntity:
+		db := d.db.WithContext(ctx).Model(&EntityGrid{}).Where("data_model_id = ? AND row_id > ?", id, afterRowID)
+		rowIDs, err := listRowIDsAfter(listEntityDataModelRowsFilter(db, id, filter), size)
+		if err != nil || len(rowIDs) == 0 {
+			return nil, err
+		}
+		return d.listEntityDataModelRowsWithIDs(ctx, id, rowIDs)
+	case consts.DataModelTypeEntitySet:
+		db := d.db.WithContext(ctx).Model(&EntitySetRow{}).Where("data_model_id = ? AND row_id > ?", id, afterRowID)
+		rowIDs, err := listRowIDsAfter(listEntitySetDataModelRowsFilter(db, id, filter), size)
+		if err != nil || len(rowIDs) == 0 {
+			return nil, err
+		}
+		var eg []*EntitySetRow
+		if err := d.db.WithContext(ctx).Where("data_model_id = ? AND row_id IN ?", id, rowIDs).Find(&eg).Error; err != nil {
+			applog.Errorw("failed to list entity_set data model rows", "err", err)
+			return nil, apperrors.NewInternalError(err)
+		}
+		return EntitySetRowsPOToEntitySetRowsDTO(ctx, eg, rowIDs), nil
+	case consts.DataModelTypeWorkspace:
+		db := d.db.WithContext(ctx).Where("data_model_id = ? AND `key` > ?", id, afterRowID).Order("`key`").Limit(size)
+		var eg []*WorkspaceRow
+		if err := listWorkspaceDataModelRowsFilter(db, filter).Find(&eg).Error; err != nil {
+			applog.Errorw("failed to list workspace data model rows", "err", err)
+			return nil, apperrors.NewInternalError(err)
+		}
+		return WorkspaceRowsPOToWorkspaceRowsDTO(ctx, eg), nil
+	default:
+		return nil, apperrors.NewInvalidError("unsupported data model type")
+	}
+}
+
+// listRowIDsAfter lists at most size distinct row ids of db in order, the rows of page are then
+// read by the ids instead of all ids matching the filter.
+func listRowIDsAfter(db *gorm.DB, size int) ([]string, error) {
+	var rowIDs []string
+	if err := db.Distinct("row_id").Order("row_id").Limit(size).Pluck("row_id", &rowIDs).Error; err != nil {
+		applog.Errorw("failed to list data model row ids", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return rowIDs, nil
+}
+
 func (d *dataModelReadModel) CountDataModelRows(ctx context.Context, id, _type string, filter *query.ListDataModelRowsFilter) (int64, error) {
 	switch _type {
 	case consts.DataModelTypeEntity:
@@ -409,7 +504,7 @@ func (d *dataModelReadModel) listAllWorkspaceDataModelRowIDs(ctx context.Context
 
 func (d *dataModelReadModel) countEntityDataModelRows(ctx context.Context, id string, filter *query.ListDataModelRowsFilter) (int64, error) {
 	db := d.db.WithContext(ctx).Model(EntityGrid{}).Where("data_model_id = ?", id).Distinct("row_id")
-	db = listEntityDataModelRowsFilter(db, filter)
+	db = listEntityDataModelRowsFilter(db, id, filter)
 	var count int64
 	if err := db.Count(&count).Error; err != nil {
 		applog.Errorw("failed to count entity data model rows", "err", err)
@@ -420,7 +515,7 @@ func (d *dataModelReadModel) countEntityDataModelRows(ctx context.Context, id st
 
 func (d *dataModelReadModel) countEntitySetDataModelRows(ctx context.Context, id string, filter *query.ListDataModelRowsFilter) (int64, error) {
 	db := d.db.WithContext(ctx).Model(EntitySetRow{}).Where("data_model_id = ?", id).Distinct("row_id")
-	db = listEntitySetDataModelRowsFilter(db, filter)
+	db = listEntitySetDataModelRowsFilter(db, id, filter)
 	var count int64
 	if err := db.Count(&count).Error; err != nil {
 		applog.Errorw("failed to count entity_set data model rows", "err", err)
@@ -470,26 +565,34 @@ func listDataModelsFilter(db *gorm.DB, filter *query.ListDataModelsFilter) *gorm
 	return db
 }
 
-func listEntityDataModelRowsFilter(db *gorm.DB, filter *query.ListDataModelRowsFilter) *gorm.DB {
+func listEntityDataModelRowsFilter(db *gorm.DB, id string, filter *query.ListDataModelRowsFilter) *gorm.DB {
 	if filter == nil {
 		return db
 	}
 	if len(filter.SearchWord) > 0 {
 		db = utils.SearchWordFilter(db, filter.SearchWord, []string{"value"}, false)
 	}
+	if filter.Where != nil {
+		sql, vars := entityRowFilterToSQL(id, filter.Where)
+		db = db.Where(sql, vars...)
+	}
 	if len(filter.RowIDs) > 0 {
 		db = db.Where("row_id IN ?", filter.RowIDs)
 	}
 	return db
 }
 
-func listEntitySetDataModelRowsFilter(db *gorm.DB, filter *query.ListDataModelRowsFilter) *gorm.DB {
+func listEntitySetDataModelRowsFilter(db *gorm.DB, id string, filter *query.ListDataModelRowsFilter) *gorm.DB {
 	if filter == nil {
 		return db
 	}
 	if len(filter.SearchWord) > 0 {
 		db = utils.SearchWordFilter(db, filter.SearchWord, []string{"ref_row_id", "row_id"}, false)
 	}
+	if filter.Where != nil {
+		sql, vars := entitySetRowFilterToSQL(id, filter.Where)
+		db = db.Where(sql, vars...)
+	}
 	if len(filter.InSetIDs) > 0 {
 		db = db.Where("ref_row_id IN ?", filter.InSetIDs)
 	}
@@ -506,8 +609,70 @@ func listWorkspaceDataModelRowsFilter(db *gorm.DB, filter *query.ListDataModelRo
 	if len(filter.SearchWord) > 0 {
 		db = utils.SearchWordFilter(db, filter.SearchWord, []string{"`key`", "`value`"}, false)
 	}
+	if filter.Where != nil {
+		sql, vars := workspaceRowFilterToSQL(filter.Where)
+		db = db.Where(sql, vars...)
+	}
 	if len(filter.RowIDs) > 0 {
 		db = db.Where("`key` IN ?", filter.RowIDs)
 	}
 	return db
 }
+
+func (d *dataModelReadModel) ListDataModelSnapshots(ctx context.Context, dataModelID string) ([]*query.DataModelSnapshot, error) {
+	var pos []*DataModelSnapshot
+	if err := d.db.WithContext(ctx).Where("data_model_id = ?", dataModelID).Order("created_at DESC").Find(&pos).Error; err != nil {
+		applog.Errorw("failed to list data model snapshots", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	ret := make([]*query.DataModelSnapshot, len(pos))
+	for index, po := range pos {
+		snapshot, err := SnapshotPOToSnapshotDTO(ctx, po)
+		if err != nil {
+			return nil, apperrors.NewInternalError(err)
+		}
+		ret[index] = snapshot
+	}
+	return ret, nil
+}
+
+func (d *dataModelReadModel) GetDataModelSnapshot(ctx context.Context, id string) (*query.DataModelSnapshot, error) {
+	var po DataModelSnapshot
+	if err := d.db.WithContext(ctx).Where("id = ?", id).First(&po).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NewNotFoundError("data model snapshot", id)
+		}
+		applog.Errorw("failed to get data model snapshot", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	snapshot, err := SnapshotPOToSnapshotDTO(ctx, &po)
+	if err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	return snapshot, nil
+}
+
+func (d *dataModelReadModel) ListDataModelSnapshotRows(ctx context.Context, id string, rowIDs []string) ([][]string, error) {
+	var snapshot DataModelSnapshot
+	if err := d.db.WithContext(ctx).Select("data_model_type").Where("id = ?", id).First(&snapshot).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NewNotFoundError("data model snapshot", id)
+		}
+		applog.Errorw("failed to get data model snapshot", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	db := d.db.WithContext(ctx).Where("snapshot_id = ?", id)
+	if len(rowIDs) != 0 {
+		db = db.Where("row_id IN ?", rowIDs)
+	}
+	var pos []*DataModelSnapshotGrid
+	if err := db.Order("row_id, column_index, id").Find(&pos).Error; err != nil {
+		applog.Errorw("failed to list data model snapshot grids", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	rows, err := SnapshotGridsPOToRowsDTO(ctx, pos, snapshot.DataModelType)
+	if err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	return rows, nil
+}
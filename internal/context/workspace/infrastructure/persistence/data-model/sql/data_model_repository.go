@@ -3,8 +3,10 @@ package sql
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -69,37 +71,41 @@ func (d *dataModelRepository) Delete(ctx context.Context, dm *datamodel.DataMode
 			index = append(index, header.ColumnIndex)
 		}
 		if err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			repo := &dataModelRepository{db: tx}
 			if dm.Headers == nil && dm.RowIDs == nil {
-				if err := d.db.WithContext(ctx).Delete(&dataModel).Error; err != nil {
+				if err := tx.Delete(&dataModel).Error; err != nil {
 					applog.Errorw("failed to delete entity data model", "err", err)
 					return apperrors.NewInternalError(err)
 				}
-				if err := d.db.WithContext(ctx).Where("data_model_id = ?", dm.ID).Delete(&EntityHeader{}).Error; err != nil {
+				if err := tx.Where("data_model_id = ?", dm.ID).Delete(&EntityHeader{}).Error; err != nil {
 					applog.Errorw("failed to delete entity data model headers", "err", err)
 					return apperrors.NewInternalError(err)
 				}
-				if err := d.db.WithContext(ctx).Where("data_model_id = ?", dm.ID).Delete(&EntityGrid{}).Error; err != nil {
+				if err := tx.Where("data_model_id = ?", dm.ID).Delete(&EntityGrid{}).Error; err != nil {
 					applog.Errorw("failed to delete entity data model grids", "err", err)
 					return apperrors.NewInternalError(err)
 				}
-				return d.deleteEntitySetWhenEntityDeleted(ctx, dm)
+				if err := repo.deleteSnapshots(ctx, dm); err != nil {
+					return err
+				}
+				return repo.deleteEntitySetWhenEntityDeleted(ctx, dm)
 			}
 			if len(dm.Headers) != 0 {
-				if err := d.db.WithContext(ctx).Where("data_model_id = ?", dm.ID).Delete(&EntityHeader{}, "name NOT IN ?", dm.Headers).Error; err != nil {
+				if err := tx.Where("data_model_id = ?", dm.ID).Delete(&EntityHeader{}, "name NOT IN ?", dm.Headers).Error; err != nil {
 					applog.Errorw("failed to delete entity data model headers", "err", err)
 					return apperrors.NewInternalError(err)
 				}
-				if err := d.db.WithContext(ctx).Where("data_model_id = ?", dm.ID).Delete(&EntityGrid{}, "column_index NOT IN ?", index).Error; err != nil {
+				if err := tx.Where("data_model_id = ?", dm.ID).Delete(&EntityGrid{}, "column_index NOT IN ?", index).Error; err != nil {
 					applog.Errorw("failed to delete entity data model grids", "err", err)
 					return apperrors.NewInternalError(err)
 				}
 			}
 			if len(dm.RowIDs) != 0 {
-				if err := d.db.WithContext(ctx).Where("data_model_id = ?", dm.ID).Delete(&EntityGrid{}, "row_id NOT IN ?", dm.RowIDs).Error; err != nil {
+				if err := tx.Where("data_model_id = ?", dm.ID).Delete(&EntityGrid{}, "row_id NOT IN ?", dm.RowIDs).Error; err != nil {
 					applog.Errorw("failed to delete entity data model grids", "err", err)
 					return apperrors.NewInternalError(err)
 				}
-				if err := d.updateEntitySetWhenEntityRowDeleted(ctx, dm); err != nil {
+				if err := repo.updateEntitySetWhenEntityRowDeleted(ctx, dm); err != nil {
 					return err
 				}
 			}
@@ -109,22 +115,26 @@ func (d *dataModelRepository) Delete(ctx context.Context, dm *datamodel.DataMode
 		}
 	case consts.DataModelTypeEntitySet:
 		if err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			repo := &dataModelRepository{db: tx}
 			if dm.RowIDs == nil {
-				if err := d.db.WithContext(ctx).Delete(&dataModel).Error; err != nil {
+				if err := tx.Delete(&dataModel).Error; err != nil {
 					applog.Errorw("failed to delete entity_set data model", "err", err)
 					return apperrors.NewInternalError(err)
 				}
-				if err := d.db.WithContext(ctx).Where("data_model_id = ?", dm.ID).Delete(&EntitySetRow{}).Error; err != nil {
+				if err := tx.Where("data_model_id = ?", dm.ID).Delete(&EntitySetRow{}).Error; err != nil {
 					applog.Errorw("failed to delete entity_set data model rows", "err", err)
 					return apperrors.NewInternalError(err)
 				}
-				return d.deleteEntitySetWhenEntityDeleted(ctx, dm)
+				if err := repo.deleteSnapshots(ctx, dm); err != nil {
+					return err
+				}
+				return repo.deleteEntitySetWhenEntityDeleted(ctx, dm)
 			} else if len(dm.RowIDs) != 0 {
-				if err := d.db.WithContext(ctx).Where("data_model_id = ?", dm.ID).Delete(&EntitySetRow{}, "row_id NOT IN ?", dm.RowIDs).Error; err != nil {
+				if err := tx.Where("data_model_id = ?", dm.ID).Delete(&EntitySetRow{}, "row_id NOT IN ?", dm.RowIDs).Error; err != nil {
 					applog.Errorw("failed to delete entity_set data model rows", "err", err)
 					return apperrors.NewInternalError(err)
 				}
-				if err := d.updateEntitySetWhenEntityRowDeleted(ctx, dm); err != nil {
+				if err := repo.updateEntitySetWhenEntityRowDeleted(ctx, dm); err != nil {
 					return err
 				}
 			}
@@ -134,17 +144,21 @@ func (d *dataModelRepository) Delete(ctx context.Context, dm *datamodel.DataMode
 		}
 	case consts.DataModelTypeWorkspace:
 		if err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			repo := &dataModelRepository{db: tx}
 			if dm.RowIDs == nil {
-				if err := d.db.WithContext(ctx).Delete(&dataModel).Error; err != nil {
+				if err := tx.Delete(&dataModel).Error; err != nil {
 					applog.Errorw("failed to delete workspace data model", "err", err)
 					return apperrors.NewInternalError(err)
 				}
-				if err := d.db.WithContext(ctx).Where("data_model_id = ?", dm.ID).Delete(&WorkspaceRow{}).Error; err != nil {
+				if err := tx.Where("data_model_id = ?", dm.ID).Delete(&WorkspaceRow{}).Error; err != nil {
 					applog.Errorw("failed to delete workspace data model rows", "err", err)
 					return apperrors.NewInternalError(err)
 				}
+				if err := repo.deleteSnapshots(ctx, dm); err != nil {
+					return err
+				}
 			} else if len(dm.RowIDs) != 0 {
-				if err := d.db.WithContext(ctx).Where("data_model_id = ?", dm.ID).Delete(&WorkspaceRow{}, "`key` NOT IN ?", dm.RowIDs).Error; err != nil {
+				if err := tx.Where("data_model_id = ?", dm.ID).Delete(&WorkspaceRow{}, "`key` NOT IN ?", dm.RowIDs).Error; err != nil {
 					applog.Errorw("failed to delete workspace data model rows", "err", err)
 					return apperrors.NewInternalError(err)
 				}
@@ -159,6 +173,159 @@ func (d *dataModelRepository) Delete(ctx context.Context, dm *datamodel.DataMode
 	return nil
 }
 
+func (d *dataModelRepository) Replace(ctx context.Context, dm *datamodel.DataModel) error {
+	var content interface{}
+	switch dm.Type {
+	case consts.DataModelTypeEntity:
+		content = &EntityGrid{}
+	case consts.DataModelTypeEntitySet:
+		content = &EntitySetRow{}
+	case consts.DataModelTypeWorkspace:
+		content = &WorkspaceRow{}
+	default:
+		return apperrors.NewInvalidError("unsupported data model type")
+	}
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if dm.Type == consts.DataModelTypeEntity {
+			if err := tx.Where("data_model_id = ?", dm.ID).Delete(&EntityHeader{}).Error; err != nil {
+				applog.Errorw("failed to delete entity data model headers", "err", err)
+				return apperrors.NewInternalError(err)
+			}
+		}
+		if err := tx.Where("data_model_id = ?", dm.ID).Delete(content).Error; err != nil {
+			applog.Errorw("failed to delete data model rows", "err", err)
+			return apperrors.NewInternalError(err)
+		}
+		repo := &dataModelRepository{db: tx}
+		if err := repo.Save(ctx, dm); err != nil {
+			return err
+		}
+		if dm.Type != consts.DataModelTypeEntity {
+			return nil
+		}
+		// the entity set rows refer to the entity rows not restored are removed
+		rowIDs := make([]string, 0, len(dm.Rows))
+		for _, row := range dm.Rows {
+			rowIDs = append(rowIDs, row[0])
+		}
+		return repo.updateEntitySetWhenEntityRowDeleted(ctx, &datamodel.DataModel{
+			WorkspaceID: dm.WorkspaceID,
+			Name:        dm.Name,
+			RowIDs:      rowIDs,
+		})
+	})
+}
+
+func (d *dataModelRepository) SaveSnapshot(ctx context.Context, snapshot *datamodel.Snapshot) error {
+	snapshotPO, err := SnapshotDOToSnapshotPO(ctx, snapshot)
+	if err != nil {
+		return apperrors.NewInvalidError(err.Error())
+	}
+	var rows *gorm.DB
+	switch snapshot.DataModelType {
+	case consts.DataModelTypeEntity:
+		rows = d.db.Model(&EntityGrid{}).Select("?, row_id, column_index, `value`", snapshot.ID)
+	case consts.DataModelTypeEntitySet:
+		rows = d.db.Model(&EntitySetRow{}).Select("?, row_id, 1, ref_row_id", snapshot.ID).Order("id")
+	case consts.DataModelTypeWorkspace:
+		rows = d.db.Model(&WorkspaceRow{}).Select("?, `key`, 1, `value`", snapshot.ID)
+	default:
+		return apperrors.NewInvalidError("unsupported data model type")
+	}
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the rows are copied inside database instead of being read out and written back
+		if err := tx.Exec(fmt.Sprintf("INSERT INTO %s (snapshot_id, row_id, column_index, `value`) ?", (&DataModelSnapshotGrid{}).TableName()),
+			rows.Where("data_model_id = ?", snapshot.DataModelID)).Error; err != nil {
+			applog.Errorw("failed to copy data model snapshot grids", "err", err)
+			return apperrors.NewInternalError(err)
+		}
+		if err := tx.Model(&DataModelSnapshotGrid{}).Where("snapshot_id = ?", snapshot.ID).Distinct("row_id").Count(&snapshotPO.RowCount).Error; err != nil {
+			applog.Errorw("failed to count data model snapshot rows", "err", err)
+			return apperrors.NewInternalError(err)
+		}
+		if err := tx.Create(snapshotPO).Error; err != nil {
+			applog.Errorw("failed to create data model snapshot", "err", err)
+			return apperrors.NewInternalError(err)
+		}
+		return nil
+	})
+}
+
+func (d *dataModelRepository) GetSnapshot(ctx context.Context, id string) (*datamodel.Snapshot, error) {
+	var snapshot DataModelSnapshot
+	if err := d.db.WithContext(ctx).Where("id = ?", id).First(&snapshot).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NewNotFoundError("data model snapshot", id)
+		}
+		applog.Errorw("failed to get data model snapshot", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	var grids []*DataModelSnapshotGrid
+	if err := d.db.WithContext(ctx).Where("snapshot_id = ?", id).Order("row_id, column_index, id").Find(&grids).Error; err != nil {
+		applog.Errorw("failed to list data model snapshot grids", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	res, err := SnapshotPOToSnapshotDO(ctx, &snapshot, grids)
+	if err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	return res, nil
+}
+
+func (d *dataModelRepository) DeleteSnapshot(ctx context.Context, id string) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		repo := &dataModelRepository{db: tx}
+		return repo.deleteSnapshotsWithIDs(ctx, []string{id})
+	})
+}
+
+func (d *dataModelRepository) DeleteAutoSnapshots(ctx context.Context, dataModelID string, keep int, before time.Time) error {
+	var snapshots []*DataModelSnapshot
+	if err := d.db.WithContext(ctx).Select("id", "created_at").Where("data_model_id = ? AND auto = ?", dataModelID, true).
+		Order("created_at DESC").Find(&snapshots).Error; err != nil {
+		applog.Errorw("failed to list data model snapshots", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	snapshotIDs := make([]string, 0)
+	for index, snapshot := range snapshots {
+		if index >= keep && snapshot.CreatedAt.Before(before) {
+			snapshotIDs = append(snapshotIDs, snapshot.ID)
+		}
+	}
+	if len(snapshotIDs) == 0 {
+		return nil
+	}
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		repo := &dataModelRepository{db: tx}
+		return repo.deleteSnapshotsWithIDs(ctx, snapshotIDs)
+	})
+}
+
+func (d *dataModelRepository) deleteSnapshotsWithIDs(ctx context.Context, snapshotIDs []string) error {
+	if err := d.db.WithContext(ctx).Where("snapshot_id IN ?", snapshotIDs).Delete(&DataModelSnapshotGrid{}).Error; err != nil {
+		applog.Errorw("failed to delete data model snapshot grids", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if err := d.db.WithContext(ctx).Where("id IN ?", snapshotIDs).Delete(&DataModelSnapshot{}).Error; err != nil {
+		applog.Errorw("failed to delete data model snapshots", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
+func (d *dataModelRepository) deleteSnapshots(ctx context.Context, dm *datamodel.DataModel) error {
+	snapshotIDs := d.db.WithContext(ctx).Model(&DataModelSnapshot{}).Select("id").Where("data_model_id = ?", dm.ID)
+	if err := d.db.WithContext(ctx).Where("snapshot_id IN (?)", snapshotIDs).Delete(&DataModelSnapshotGrid{}).Error; err != nil {
+		applog.Errorw("failed to delete data model snapshot grids", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	if err := d.db.WithContext(ctx).Where("data_model_id = ?", dm.ID).Delete(&DataModelSnapshot{}).Error; err != nil {
+		applog.Errorw("failed to delete data model snapshots", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
+
 func (d *dataModelRepository) updateEntitySetWhenEntityRowDeleted(ctx context.Context, dm *datamodel.DataModel) error {
 	var entitySet DataModel
 	if err := d.db.WithContext(ctx).Where("workspace_id = ? AND name = ?", dm.WorkspaceID, utils.GenDataModelEntitySetName(dm.Name)).First(&entitySet).Error; err != nil {
@@ -168,7 +335,11 @@ func (d *dataModelRepository) updateEntitySetWhenEntityRowDeleted(ctx context.Co
 		}
 		return apperrors.NewInternalError(err)
 	}
-	if err := d.db.WithContext(ctx).Where("data_model_id = ?", entitySet.ID).Delete(&EntitySetRow{}, "ref_row_id NOT IN ?", dm.RowIDs).Error; err != nil {
+	db := d.db.WithContext(ctx).Where("data_model_id = ?", entitySet.ID)
+	if len(dm.RowIDs) != 0 {
+		db = db.Where("ref_row_id NOT IN ?", dm.RowIDs)
+	}
+	if err := db.Delete(&EntitySetRow{}).Error; err != nil {
 		applog.Errorw("failed to delete entity set data model row", "err", err)
 		return apperrors.NewInternalError(err)
 	}
@@ -196,19 +367,22 @@ func (d *dataModelRepository) saveEntityTypeDataModel(ctx context.Context, dataM
 	grids := DataModelDOtoEntityGridsPO(ctx, dm)
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "name"}, {Name: "workspace_id"}, {Name: "id"}},
+			Columns:   []clause.Column{{Name: "id"}},
 			DoUpdates: clause.AssignmentColumns([]string{"updated_at"}),
 		}).Create(dataModel).Error; err != nil {
 			applog.Errorw("failed to create entity data model", "err", err)
 			return apperrors.NewInternalError(err)
 		}
 		if err := tx.Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "id"}, {Name: "data_model_id"}},
-			DoUpdates: clause.AssignmentColumns([]string{"name", "type"}),
+			Columns:   []clause.Column{{Name: "column_index"}, {Name: "data_model_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "type", "declared"}),
 		}).Create(&entityHeaders).Error; err != nil {
 			applog.Errorw("failed to create entity data model headers", "err", err)
 			return apperrors.NewInternalError(err)
 		}
+		if len(grids) == 0 {
+			return nil
+		}
 		if err := tx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "data_model_id"}, {Name: "row_id"}, {Name: "column_index"}},
 			DoUpdates: clause.AssignmentColumns([]string{"value"}),
@@ -230,14 +404,17 @@ func (d *dataModelRepository) saveEntitySetTypeDataModel(ctx context.Context, da
 	}
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if err = tx.Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "name"}, {Name: "workspace_id"}, {Name: "id"}},
+			Columns:   []clause.Column{{Name: "id"}},
 			DoUpdates: clause.AssignmentColumns([]string{"updated_at"}),
 		}).Create(dataModel).Error; err != nil {
 			applog.Errorw("failed to create entity_set data model", "err", err)
 			return apperrors.NewInternalError(err)
 		}
+		if len(rows) == 0 {
+			return nil
+		}
 		if err = tx.Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "id"}, {Name: "data_model_id"}},
+			Columns:   []clause.Column{{Name: "id"}},
 			DoUpdates: clause.AssignmentColumns([]string{"ref_row_id"}),
 		}).Create(&rows).Error; err != nil {
 			applog.Errorw("failed to create entity_set data model rows", "err", err)
@@ -254,12 +431,15 @@ func (d *dataModelRepository) saveWorkspaceTypeDataModel(ctx context.Context, da
 	}
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "name"}, {Name: "workspace_id"}, {Name: "id"}},
+			Columns:   []clause.Column{{Name: "id"}},
 			DoUpdates: clause.AssignmentColumns([]string{"updated_at"}),
 		}).Create(dataModel).Error; err != nil {
 			applog.Errorw("failed to create workspace data model", "err", err)
 			return apperrors.NewInternalError(err)
 		}
+		if len(rows) == 0 {
+			return nil
+		}
 		if err := tx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "data_model_id"}, {Name: "key"}},
 			DoUpdates: clause.AssignmentColumns([]string{"value"}),
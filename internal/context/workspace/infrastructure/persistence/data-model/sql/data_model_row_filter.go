@@ -0,0 +1,90 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+)
+
+// numberPattern matches the values which can be compared as number. It is embedded into order
+// clauses, so keep it free of quotes and backslashes.
+const numberPattern = "^[-+]?([0-9]+([.][0-9]*)?|[.][0-9]+)([eE][-+]?[0-9]+)?$"
+
+// rowFilterToSQL translates the row filter into sql condition, each condition of it is translated
+// by condToSQL.
+func rowFilterToSQL(filter *query.RowFilter, condToSQL func(cond *query.RowFilterCondition) (string, []interface{})) (string, []interface{}) {
+	if filter.Condition != nil {
+		return condToSQL(filter.Condition)
+	}
+	separator := " AND "
+	if filter.Logic == query.RowFilterLogicOr {
+		separator = " OR "
+	}
+	sqls := make([]string, 0, len(filter.Children))
+	var vars []interface{}
+	for _, child := range filter.Children {
+		sql, childVars := rowFilterToSQL(child, condToSQL)
+		sqls = append(sqls, "("+sql+")")
+		vars = append(vars, childVars...)
+	}
+	return strings.Join(sqls, separator), vars
+}
+
+// compareSQL compares field with the condition value, as number if the condition value is a
+// number. The not equal operator is compared as equal, callers should negate the result.
+func compareSQL(field string, cond *query.RowFilterCondition) (string, []interface{}) {
+	operator := cond.Operator
+	switch operator {
+	case query.RowFilterOperatorNotEqual:
+		operator = query.RowFilterOperatorEqual
+	case query.RowFilterOperatorLike:
+		return field + " LIKE ?", []interface{}{"%" + cond.Value + "%"}
+	}
+	if number, ok := cond.Number(); ok {
+		return fmt.Sprintf("(%s REGEXP ? AND %s + 0 %s ?)", field, field, operator), []interface{}{numberPattern, number}
+	}
+	return fmt.Sprintf("%s %s ?", field, operator), []interface{}{cond.Value}
+}
+
+// rowIDInSQL matches the rows having any record in table, which is in scope and satisfies the
+// condition on field.
+func rowIDInSQL(table, field string, cond *query.RowFilterCondition, scope string, scopeVars ...interface{}) (string, []interface{}) {
+	compare, compareVars := compareSQL(field, cond)
+	in := "IN"
+	if cond.Operator == query.RowFilterOperatorNotEqual {
+		in = "NOT IN"
+	}
+	return fmt.Sprintf("row_id %s (SELECT row_id FROM %s WHERE %s AND %s)", in, table, scope, compare), append(scopeVars, compareVars...)
+}
+
+func entityRowFilterToSQL(id string, filter *query.RowFilter) (string, []interface{}) {
+	return rowFilterToSQL(filter, func(cond *query.RowFilterCondition) (string, []interface{}) {
+		return rowIDInSQL((&EntityGrid{}).TableName(), "`value`", cond, "data_model_id = ? AND column_index = ?", id, cond.ColumnIndex)
+	})
+}
+
+func entitySetRowFilterToSQL(id string, filter *query.RowFilter) (string, []interface{}) {
+	return rowFilterToSQL(filter, func(cond *query.RowFilterCondition) (string, []interface{}) {
+		// the first column is the id of entity set, and the second is the reffed entity row ids
+		field := "row_id"
+		if cond.ColumnIndex == 1 {
+			field = "ref_row_id"
+		}
+		return rowIDInSQL((&EntitySetRow{}).TableName(), field, cond, "data_model_id = ?", id)
+	})
+}
+
+func workspaceRowFilterToSQL(filter *query.RowFilter) (string, []interface{}) {
+	return rowFilterToSQL(filter, func(cond *query.RowFilterCondition) (string, []interface{}) {
+		field := "`key`"
+		if cond.ColumnIndex == 1 {
+			field = "`value`"
+		}
+		sql, vars := compareSQL(field, cond)
+		if cond.Operator == query.RowFilterOperatorNotEqual {
+			sql = "NOT (" + sql + ")"
+		}
+		return sql, vars
+	})
+}
@@ -8,27 +8,78 @@ import (
 
 func eventPOToEventDO(e *Event) *eventbus.Event {
 	return &eventbus.Event{
-		EventID:     e.EventID,
-		Type:        e.Type,
-		Payload:     e.Payload,
-		Status:      e.Status,
-		CreatedAt:   e.CreatedAt.Time(),
-		UpdatedAt:   e.UpdatedAt.Time(),
-		ScheduledAt: e.ScheduledAt.Time(),
-		RetryCount:  e.RetryCount,
+		EventID:       e.EventID,
+		Type:          e.Type,
+		Payload:       e.Payload,
+		Status:        e.Status,
+		CreatedAt:     e.CreatedAt.Time(),
+		UpdatedAt:     e.UpdatedAt.Time(),
+		ScheduledAt:   e.ScheduledAt.Time(),
+		RetryCount:    e.RetryCount,
+		Reason:        e.Reason,
+		LastError:     e.LastError,
+		Attempts:      attemptsPOToAttemptsDO(e.Attempts),
+		Owner:         e.Owner,
+		LeaseExpireAt: e.LeaseExpireAt.Time(),
 	}
 
 }
 
 func eventDOToEventPO(e *eventbus.Event) *Event {
 	return &Event{
-		EventID:     e.EventID,
-		Type:        e.Type,
-		Payload:     e.Payload,
-		Status:      e.Status,
-		CreatedAt:   primitive.NewDateTimeFromTime(e.CreatedAt),
-		UpdatedAt:   primitive.NewDateTimeFromTime(e.UpdatedAt),
-		ScheduledAt: primitive.NewDateTimeFromTime(e.ScheduledAt),
-		RetryCount:  e.RetryCount,
+		EventID:       e.EventID,
+		Type:          e.Type,
+		Payload:       e.Payload,
+		Status:        e.Status,
+		CreatedAt:     primitive.NewDateTimeFromTime(e.CreatedAt),
+		UpdatedAt:     primitive.NewDateTimeFromTime(e.UpdatedAt),
+		ScheduledAt:   primitive.NewDateTimeFromTime(e.ScheduledAt),
+		RetryCount:    e.RetryCount,
+		Reason:        e.Reason,
+		LastError:     e.LastError,
+		Attempts:      attemptsDOToAttemptsPO(e.Attempts),
+		Owner:         e.Owner,
+		LeaseExpireAt: primitive.NewDateTimeFromTime(e.LeaseExpireAt),
 	}
 }
+
+func attemptsPOToAttemptsDO(attempts []Attempt) []eventbus.EventAttempt {
+	result := make([]eventbus.EventAttempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = eventbus.EventAttempt{
+			StartTime: attempt.StartTime.Time(),
+			Duration:  attempt.Duration,
+			Failures:  make([]eventbus.EventHandlerFailure, len(attempt.Failures)),
+		}
+		for j, failure := range attempt.Failures {
+			result[i].Failures[j] = eventbus.EventHandlerFailure{
+				Handler: failure.Handler,
+				Error:   failure.Error,
+			}
+		}
+	}
+	return result
+}
+
+func attemptsDOToAttemptsPO(attempts []eventbus.EventAttempt) []Attempt {
+	result := make([]Attempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = attemptDOToAttemptPO(attempt)
+	}
+	return result
+}
+
+func attemptDOToAttemptPO(attempt eventbus.EventAttempt) Attempt {
+	result := Attempt{
+		StartTime: primitive.NewDateTimeFromTime(attempt.StartTime),
+		Duration:  attempt.Duration,
+		Failures:  make([]FailedHandler, len(attempt.Failures)),
+	}
+	for i, failure := range attempt.Failures {
+		result.Failures[i] = FailedHandler{
+			Handler: failure.Handler,
+			Error:   failure.Error,
+		}
+	}
+	return result
+}
@@ -1,6 +1,8 @@
 package mongo
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -9,12 +11,29 @@ type Event struct {
 	EventID string `json:"id" bson:"id"`
 	Type    string `json:"type" bson:"type"`
 	Status  string `json:"status" bson:"status"`
-	// todo record reason why in current status
-	Reason      string              `bson:"reason" bson:"reason"`
-	Payload     string              `json:"payload" bson:"payload"`
-	RetryCount  int                 `json:"retryCount" bson:"retryCount"`
-	CreatedAt   primitive.DateTime  `json:"createdAt" bson:"createdAt"`
-	UpdatedAt   primitive.DateTime  `json:"updatedAt" bson:"updatedAt"`
-	ScheduledAt primitive.DateTime  `json:"scheduledAt" bson:"scheduledAt"`
-	DeletedAt   *primitive.DateTime `json:"deletedAt" bson:"deletedAt"`
+	// Reason why in current status
+	Reason    string    `json:"reason" bson:"reason"`
+	LastError string    `json:"lastError" bson:"lastError"`
+	Attempts  []Attempt `json:"attempts" bson:"attempts"`
+	// Owner is the event bus handling or last handled the event
+	Owner         string              `json:"owner" bson:"owner"`
+	LeaseExpireAt primitive.DateTime  `json:"leaseExpireAt" bson:"leaseExpireAt"`
+	Payload       string              `json:"payload" bson:"payload"`
+	RetryCount    int                 `json:"retryCount" bson:"retryCount"`
+	CreatedAt     primitive.DateTime  `json:"createdAt" bson:"createdAt"`
+	UpdatedAt     primitive.DateTime  `json:"updatedAt" bson:"updatedAt"`
+	ScheduledAt   primitive.DateTime  `json:"scheduledAt" bson:"scheduledAt"`
+	DeletedAt     *primitive.DateTime `json:"deletedAt" bson:"deletedAt"`
+}
+
+// Attempt to handle the event
+type Attempt struct {
+	StartTime primitive.DateTime `json:"startTime" bson:"startTime"`
+	Duration  time.Duration      `json:"duration" bson:"duration"`
+	Failures  []FailedHandler    `json:"failures" bson:"failures"`
+}
+
+type FailedHandler struct {
+	Handler string `json:"handler" bson:"handler"`
+	Error   string `json:"error" bson:"error"`
 }
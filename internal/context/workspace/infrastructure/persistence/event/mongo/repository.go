@@ -2,7 +2,7 @@ package mongo
 
 import (
 	"context"
-	"fmt"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -16,6 +16,10 @@ import (
 
 const EventCollection = "events"
 
+// deleteBatchSize is the max number of events deleted by one request, so that deleting lots of
+// events doesn't hold the collection for long.
+var deleteBatchSize = 1000
+
 type eventRepository struct {
 	collection     *mongo.Collection
 	dequeueTimeout time.Duration
@@ -28,6 +32,8 @@ func NewEventRepository(ctx context.Context, db *mongo.Database, dequeueTimeout
 	collection := db.Collection(EventCollection)
 	if _, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
 		{Keys: bson.M{"id": 1}, Options: options.Index().SetUnique(true)},
+		// for the compactor
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "updatedAt", Value: 1}}},
 	}); err != nil {
 		return nil, err
 	}
@@ -53,7 +59,7 @@ func (repo *eventRepository) Get(ctx context.Context, id string) (*eventbus.Even
 
 func (repo *eventRepository) UpdateRetryCount(ctx context.Context, event *eventbus.Event, retryCount int) error {
 	filter := bson.M{"id": event.EventID}
-	update := bson.M{"$set": bson.M{"retryCount": retryCount, "deletedAt": time.Now()}}
+	update := bson.M{"$set": bson.M{"retryCount": retryCount, "updatedAt": time.Now()}}
 	_, err := repo.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return err
@@ -91,13 +97,9 @@ func (repo *eventRepository) ListAndLockUnfinishedEvents(ctx context.Context, li
 	if len(eventTypes) != 0 {
 		filter["type"] = bson.M{"$in": eventTypes}
 	}
-	filter["$or"] = bson.A{
-		bson.M{"scheduledAt": bson.M{"$lte": primitive.NewDateTimeFromTime(now)}, "status": eventbus.EventStatusPending},
-		bson.M{"updatedAt": bson.M{"$lte": primitive.NewDateTimeFromTime(now.Add(-repo.dequeueTimeout))}, "status": eventbus.EventStatusDequeue},
-		bson.M{"updatedAt": bson.M{"$lte": primitive.NewDateTimeFromTime(now.Add(-repo.runningTimeout))}, "status": eventbus.EventStatusRunning},
-	}
+	filter["$or"] = repo.unfinishedEvents(now)
 
-	findOptions := options.Find().SetSort(bson.M{"updatedAt": -1}).SetSort(bson.M{"scheduledAt": 1}).SetLimit(int64(limit))
+	findOptions := options.Find().SetSort(bson.D{{Key: "updatedAt", Value: -1}, {Key: "scheduledAt", Value: 1}}).SetLimit(int64(limit))
 	cursor, err := repo.collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		return nil, err
@@ -108,58 +110,95 @@ func (repo *eventRepository) ListAndLockUnfinishedEvents(ctx context.Context, li
 		return nil, err
 	}
 
-	var session mongo.Session
-	session, err = repo.collection.Database().Client().StartSession()
-	if err != nil {
-		return nil, err
+	// the events are locked by dequeuing them, the events dequeued by other replicas are skipped
+	var result []*eventbus.Event
+	for i := range events {
+		updateFilter := bson.M{"id": events[i].EventID, "$or": repo.unfinishedEvents(now)}
+		update := bson.M{"status": eventbus.EventStatusDequeue, "updatedAt": primitive.NewDateTimeFromTime(now)}
+		updateResult, err := repo.collection.UpdateOne(ctx, updateFilter, bson.M{"$set": update})
+		if err != nil {
+			return nil, err
+		}
+		if updateResult.ModifiedCount != 1 {
+			continue
+		}
+		events[i].Status = eventbus.EventStatusDequeue
+		events[i].UpdatedAt = primitive.NewDateTimeFromTime(now)
+		result = append(result, eventPOToEventDO(events[i]))
+	}
+	return result, nil
+}
+
+// unfinishedEvents matches the events to be handled: the pending events scheduled before now, the dequeued
+// events not handled in dequeueTimeout, and the running events whose lease expired or running over runningTimeout.
+func (repo *eventRepository) unfinishedEvents(now time.Time) bson.A {
+	return bson.A{
+		bson.M{"scheduledAt": bson.M{"$lte": primitive.NewDateTimeFromTime(now)}, "status": eventbus.EventStatusPending},
+		bson.M{"updatedAt": bson.M{"$lte": primitive.NewDateTimeFromTime(now.Add(-repo.dequeueTimeout))}, "status": eventbus.EventStatusDequeue},
+		bson.M{"leaseExpireAt": bson.M{"$lte": primitive.NewDateTimeFromTime(now)}, "status": eventbus.EventStatusRunning},
+		bson.M{"updatedAt": bson.M{"$lte": primitive.NewDateTimeFromTime(now.Add(-repo.runningTimeout))}, "status": eventbus.EventStatusRunning},
 	}
-	err = session.StartTransaction()
+}
+
+func (repo *eventRepository) Acquire(ctx context.Context, event *eventbus.Event, owner string, leaseExpireAt time.Time) (bool, error) {
+	now := time.Now()
+	filter := bson.M{"id": event.EventID, "$or": bson.A{
+		bson.M{"status": bson.M{"$in": bson.A{eventbus.EventStatusPending, eventbus.EventStatusDequeue}}},
+		bson.M{"status": eventbus.EventStatusRunning, "owner": owner},
+	}}
+	update := bson.M{"$set": bson.M{
+		"status":        eventbus.EventStatusRunning,
+		"owner":         owner,
+		"leaseExpireAt": primitive.NewDateTimeFromTime(leaseExpireAt),
+		"updatedAt":     primitive.NewDateTimeFromTime(now),
+	}}
+	result, err := repo.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-	err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
-		for i := range events {
-			var result *mongo.UpdateResult
-			var err error
-			switch events[i].Status {
-			case eventbus.EventStatusPending:
-				updateFilter := bson.M{"scheduledAt": bson.M{"$lte": primitive.NewDateTimeFromTime(now)}, "status": eventbus.EventStatusPending}
-				update := bson.M{"status": eventbus.EventStatusDequeue, "updated_at": primitive.NewDateTimeFromTime(now)}
-				result, err = repo.collection.UpdateOne(sc, updateFilter, bson.M{"$set": update})
-			case eventbus.EventStatusDequeue:
-				updateFilter := bson.M{"updatedAt": bson.M{"$lte": primitive.NewDateTimeFromTime(now.Add(-repo.dequeueTimeout))}, "status": eventbus.EventStatusDequeue}
-				update := bson.M{"updatedAt": primitive.NewDateTimeFromTime(now)}
-				result, err = repo.collection.UpdateOne(sc, updateFilter, bson.M{"$set": update})
-			case eventbus.EventStatusRunning:
-				updateFilter := bson.M{"updatedAt": bson.M{"$lte": primitive.NewDateTimeFromTime(now.Add(-repo.runningTimeout))}, "status": eventbus.EventStatusRunning}
-				update := bson.M{"updatedAt": primitive.NewDateTimeFromTime(now)}
-				result, err = repo.collection.UpdateOne(sc, updateFilter, bson.M{"$set": update})
-			default:
-				err = fmt.Errorf("unsupport status %s", events[i].Status)
-			}
-			if err != nil {
-				return err
-			}
-			if result.MatchedCount != 1 || result.ModifiedCount != 1 {
-				return fmt.Errorf("update failed, expected 1 but got %d", result.MatchedCount)
-			}
-		}
-		return session.CommitTransaction(sc)
-	})
-	session.EndSession(ctx)
+	if result.MatchedCount != 1 {
+		return false, nil
+	}
+	event.Status = eventbus.EventStatusRunning
+	event.Owner = owner
+	event.LeaseExpireAt = leaseExpireAt
+	event.UpdatedAt = now
+	return true, nil
+}
+
+func (repo *eventRepository) RenewLease(ctx context.Context, event *eventbus.Event, owner string, leaseExpireAt time.Time) (bool, error) {
+	filter := bson.M{"id": event.EventID, "status": eventbus.EventStatusRunning, "owner": owner}
+	update := bson.M{"$set": bson.M{"leaseExpireAt": primitive.NewDateTimeFromTime(leaseExpireAt)}}
+	result, err := repo.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-	var result []*eventbus.Event
-	for i := range events {
-		result = append(result, eventPOToEventDO(events[i]))
+	if result.MatchedCount != 1 {
+		return false, nil
 	}
-	return result, nil
+	event.LeaseExpireAt = leaseExpireAt
+	return true, nil
 }
 
 func (repo *eventRepository) UpdateStatus(ctx context.Context, event *eventbus.Event, status string) error {
 	filter := bson.M{"id": event.EventID}
-	update := bson.M{"$set": bson.M{"status": status, "updatedAt": time.Now()}}
+	update := bson.M{"$set": bson.M{"status": status, "reason": event.Reason, "updatedAt": time.Now()}}
+	_, err := repo.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *eventRepository) Reschedule(ctx context.Context, event *eventbus.Event) error {
+	filter := bson.M{"id": event.EventID}
+	update := bson.M{"$set": bson.M{
+		"status":      eventbus.EventStatusPending,
+		"retryCount":  event.RetryCount,
+		"reason":      event.Reason,
+		"scheduledAt": primitive.NewDateTimeFromTime(event.ScheduledAt),
+		"updatedAt":   primitive.NewDateTimeFromTime(time.Now()),
+	}}
 	_, err := repo.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return err
@@ -168,10 +207,15 @@ func (repo *eventRepository) UpdateStatus(ctx context.Context, event *eventbus.E
 }
 
 func (repo *eventRepository) Search(ctx context.Context, filter *eventbus.Filter) ([]*eventbus.Event, error) {
-	cursor, err := repo.collection.Find(ctx, getFilter(filter))
+	findOptions := options.Find().SetSort(bson.M{"createdAt": -1})
+	if filter != nil && filter.Limit > 0 {
+		findOptions.SetSkip(int64(filter.Offset)).SetLimit(int64(filter.Limit))
+	}
+	cursor, err := repo.collection.Find(ctx, getFilter(filter), findOptions)
 	if err != nil {
 		return nil, err
 	}
+	defer cursor.Close(ctx)
 
 	var result []*eventbus.Event
 	for cursor.Next(ctx) {
@@ -186,18 +230,93 @@ func (repo *eventRepository) Search(ctx context.Context, filter *eventbus.Filter
 	return result, nil
 }
 
+func (repo *eventRepository) Requeue(ctx context.Context, event *eventbus.Event) error {
+	now := time.Now()
+	filter := bson.M{"id": event.EventID}
+	update := bson.M{"$set": bson.M{
+		"status":      eventbus.EventStatusPending,
+		"retryCount":  0,
+		"reason":      "",
+		"scheduledAt": primitive.NewDateTimeFromTime(now),
+		"updatedAt":   primitive.NewDateTimeFromTime(now),
+	}}
+	_, err := repo.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *eventRepository) Delete(ctx context.Context, filter *eventbus.Filter) (int64, error) {
+	var total int64
+	for {
+		cursor, err := repo.collection.Find(ctx, getFilter(filter), options.Find().
+			SetProjection(bson.M{"id": 1}).SetLimit(int64(deleteBatchSize)))
+		if err != nil {
+			return total, err
+		}
+		var events []*Event
+		if err = cursor.All(ctx, &events); err != nil {
+			return total, err
+		}
+		if len(events) == 0 {
+			return total, nil
+		}
+		ids := make([]string, len(events))
+		for i, event := range events {
+			ids[i] = event.EventID
+		}
+		result, err := repo.collection.DeleteMany(ctx, bson.M{"id": bson.M{"$in": ids}})
+		if err != nil {
+			return total, err
+		}
+		total += result.DeletedCount
+		if len(events) < deleteBatchSize {
+			return total, nil
+		}
+	}
+}
+
+func (repo *eventRepository) AddAttempt(ctx context.Context, event *eventbus.Event, attempt eventbus.EventAttempt) error {
+	event.AddAttempt(attempt)
+	filter := bson.M{"id": event.EventID}
+	update := bson.M{"$push": bson.M{"attempts": bson.M{
+		"$each":  bson.A{attemptDOToAttemptPO(attempt)},
+		"$slice": -eventbus.MaxEventAttempts,
+	}}}
+	if len(attempt.Failures) > 0 {
+		update["$set"] = bson.M{"lastError": event.LastError}
+	}
+	_, err := repo.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func getFilter(filter *eventbus.Filter) bson.M {
 	res := bson.M{}
 	if filter != nil {
 		if len(filter.Payload) > 0 {
-			res["payload"] = bson.M{"$regex": filter.Payload, "$options": ""}
+			// the payload is matched by substring
+			res["payload"] = bson.M{"$regex": regexp.QuoteMeta(filter.Payload), "$options": ""}
 		}
-		if len(filter.Type) > 0 {
-			res["type"] = bson.M{"$in": filter.Type}
+		if len(filter.Type) > 0 || len(filter.ExcludeTypes) > 0 {
+			typeFilter := bson.M{}
+			if len(filter.Type) > 0 {
+				typeFilter["$in"] = filter.Type
+			}
+			if len(filter.ExcludeTypes) > 0 {
+				typeFilter["$nin"] = filter.ExcludeTypes
+			}
+			res["type"] = typeFilter
 		}
 		if len(filter.Status) > 0 {
 			res["status"] = bson.M{"$in": filter.Status}
 		}
+		if !filter.UpdatedBefore.IsZero() {
+			res["updatedAt"] = bson.M{"$lt": primitive.NewDateTimeFromTime(filter.UpdatedBefore)}
+		}
 	}
 	return res
 }
@@ -5,20 +5,48 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/onsi/gomega"
 	"github.com/onsi/gomega/matchers"
 	"github.com/spf13/viper"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
 
 	"github.com/Bio-OS/bioos/internal/apiserver/options"
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/eventbus"
 	"github.com/Bio-OS/bioos/pkg/db"
+	eventbusoptions "github.com/Bio-OS/bioos/pkg/eventbus"
 	"github.com/Bio-OS/bioos/pkg/log"
 )
 
+func TestSQLiteMemory(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+	logOpts := log.NewOptions()
+	// only log to stdout
+	logOpts.OutputPath = ""
+	log.RegisterLogger(logOpts)
+
+	orm, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	// every connection opens a new in-memory database
+	sqlDB, err := orm.DB()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	sqlDB.SetMaxOpenConns(1)
+
+	eventRepo, err := NewEventRepository(ctx, orm, time.Minute*5, time.Minute*60)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	replica, err := NewEventRepository(ctx, orm, time.Minute*5, time.Minute*60)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	testEventRepository(ctx, g, eventRepo, replica)
+}
+
 func TestMysql(t *testing.T) {
 	uri := os.Getenv("MYSQL_URI")
 	if len(uri) == 0 {
@@ -112,6 +140,163 @@ func TestMysql(t *testing.T) {
 			Expected: eventbus.EventStatusFailed,
 		})
 	}
+
+	replica, err := NewEventRepository(ctx, orm, time.Minute*5, time.Minute*60)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	testEventRepository(ctx, g, eventRepo, replica)
+}
+
+// testEventRepository tests the event repository, replica is another repository of the same database.
+func testEventRepository(ctx context.Context, g *gomega.WithT, repo, replica eventbus.EventRepository) {
+	testSearchAndDeleteEvents(ctx, g, repo)
+	testRequeueEvent(ctx, g, repo)
+	testCompactEvents(ctx, g, repo)
+	testAddEventAttempt(ctx, g, repo)
+	testRescheduleEvent(ctx, g, repo)
+	testDeadLetterEvent(ctx, g, repo)
+	testEventLease(ctx, g, repo, replica)
+}
+
+// newTestEvent returns an event of the type unique to the test, so that the events of other tests are not matched.
+func newTestEvent(eventType, payload, status string, createdAt time.Time) *eventbus.Event {
+	return &eventbus.Event{
+		EventID:     uuid.New().String(),
+		Type:        eventType,
+		Payload:     payload,
+		Status:      status,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+		ScheduledAt: createdAt,
+	}
+}
+
+func eventIDs(events []*eventbus.Event) []string {
+	ids := make([]string, len(events))
+	for i, event := range events {
+		ids[i] = event.EventID
+	}
+	return ids
+}
+
+func testSearchAndDeleteEvents(ctx context.Context, g *gomega.WithT, repo eventbus.EventRepository) {
+	typeA, typeB := "A-"+uuid.New().String(), "B-"+uuid.New().String()
+	// time is truncated to second, as it is stored in seconds by some databases
+	now := time.Now().Truncate(time.Second)
+	oldCompleted := newTestEvent(typeA, `{"id":"a.b"}`, eventbus.EventStatusCompleted, now.Add(-time.Hour*2))
+	completed := newTestEvent(typeA, `{"id":"axb"}`, eventbus.EventStatusCompleted, now.Add(-time.Hour))
+	failed := newTestEvent(typeA, `{"id":"c"}`, eventbus.EventStatusFailed, now.Add(-time.Minute))
+	otherType := newTestEvent(typeB, `{"id":"a.b"}`, eventbus.EventStatusCompleted, now.Add(-time.Hour*2))
+	for _, event := range []*eventbus.Event{oldCompleted, completed, failed, otherType} {
+		g.Expect(repo.Save(ctx, event)).To(gomega.Succeed())
+	}
+
+	event, err := repo.Get(ctx, failed.EventID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(event.Type).To(gomega.Equal(typeA))
+	g.Expect(event.Status).To(gomega.Equal(eventbus.EventStatusFailed))
+	_, err = repo.Get(ctx, uuid.New().String())
+	g.Expect(err).To(gomega.Equal(eventbus.ErrEventNotFound))
+
+	// the events are ordered by created time desc
+	events, err := repo.Search(ctx, &eventbus.Filter{Type: []string{typeA}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(eventIDs(events)).To(gomega.Equal([]string{failed.EventID, completed.EventID, oldCompleted.EventID}))
+	events, err = repo.Search(ctx, &eventbus.Filter{Type: []string{typeA}, Offset: 1, Limit: 1})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(eventIDs(events)).To(gomega.Equal([]string{completed.EventID}))
+	events, err = repo.Search(ctx, &eventbus.Filter{Type: []string{typeA}, Status: []string{eventbus.EventStatusCompleted}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(eventIDs(events)).To(gomega.Equal([]string{completed.EventID, oldCompleted.EventID}))
+	// the payload is matched by substring rather than pattern
+	events, err = repo.Search(ctx, &eventbus.Filter{Type: []string{typeA, typeB}, Payload: "a.b"})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(eventIDs(events)).To(gomega.ConsistOf(oldCompleted.EventID, otherType.EventID))
+	events, err = repo.Search(ctx, &eventbus.Filter{Type: []string{typeA, typeB}, ExcludeTypes: []string{typeA}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(eventIDs(events)).To(gomega.Equal([]string{otherType.EventID}))
+	events, err = repo.Search(ctx, &eventbus.Filter{Type: []string{typeA}, UpdatedBefore: now.Add(-time.Minute * 30)})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(eventIDs(events)).To(gomega.Equal([]string{completed.EventID, oldCompleted.EventID}))
+
+	// only the matched events are deleted regardless of Offset and Limit, one per batch
+	defer func(size int) { deleteBatchSize = size }(deleteBatchSize)
+	deleteBatchSize = 1
+	count, err := repo.Delete(ctx, &eventbus.Filter{
+		Type:          []string{typeA, typeB},
+		ExcludeTypes:  []string{typeB},
+		Status:        []string{eventbus.EventStatusCompleted},
+		UpdatedBefore: now.Add(-time.Minute * 30),
+		Limit:         1,
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(count).To(gomega.BeEquivalentTo(2))
+	events, err = repo.Search(ctx, &eventbus.Filter{Type: []string{typeA, typeB}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(eventIDs(events)).To(gomega.Equal([]string{failed.EventID, otherType.EventID}))
+	count, err = repo.Delete(ctx, &eventbus.Filter{Type: []string{typeA, typeB}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(count).To(gomega.BeEquivalentTo(2))
+}
+
+func testRequeueEvent(ctx context.Context, g *gomega.WithT, repo eventbus.EventRepository) {
+	eventType := "requeue-" + uuid.New().String()
+	event := newTestEvent(eventType, "{}", eventbus.EventStatusDeadLetter, time.Now().Add(-time.Hour))
+	event.RetryCount = 3
+	event.Reason = "an error"
+	event.ScheduledAt = time.Now().Add(time.Hour)
+	g.Expect(repo.Save(ctx, event)).To(gomega.Succeed())
+	events, err := repo.ListAndLockUnfinishedEvents(ctx, 10, []string{eventType})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(events).To(gomega.BeEmpty())
+
+	// the requeued event is handled at once
+	g.Expect(repo.Requeue(ctx, event)).To(gomega.Succeed())
+	requeued, err := repo.Get(ctx, event.EventID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(requeued.Status).To(gomega.Equal(eventbus.EventStatusPending))
+	g.Expect(requeued.RetryCount).To(gomega.BeZero())
+	g.Expect(requeued.Reason).To(gomega.BeEmpty())
+	events, err = repo.ListAndLockUnfinishedEvents(ctx, 10, []string{eventType})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(eventIDs(events)).To(gomega.Equal([]string{event.EventID}))
+	g.Expect(events[0].Status).To(gomega.Equal(eventbus.EventStatusDequeue))
+	// the dequeued event is not listed again until dequeue timeout
+	events, err = repo.ListAndLockUnfinishedEvents(ctx, 10, []string{eventType})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(events).To(gomega.BeEmpty())
+
+	_, err = repo.Delete(ctx, &eventbus.Filter{Type: []string{eventType}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+}
+
+func testCompactEvents(ctx context.Context, g *gomega.WithT, repo eventbus.EventRepository) {
+	typeA, typeB := "A-"+uuid.New().String(), "B-"+uuid.New().String()
+	now := time.Now()
+	keptByTypedRule := newTestEvent(typeA, "{}", eventbus.EventStatusCompleted, now.Add(-time.Hour*2))
+	expiredByTypedRule := newTestEvent(typeA, "{}", eventbus.EventStatusCompleted, now.Add(-time.Hour*4))
+	deadLetter := newTestEvent(typeA, "{}", eventbus.EventStatusDeadLetter, now.Add(-time.Hour*2))
+	expired := newTestEvent(typeB, "{}", eventbus.EventStatusCompleted, now.Add(-time.Hour*2))
+	// no rule of failed events
+	failed := newTestEvent(typeB, "{}", eventbus.EventStatusFailed, now.Add(-time.Hour*2))
+	for _, event := range []*eventbus.Event{keptByTypedRule, expiredByTypedRule, deadLetter, expired, failed} {
+		g.Expect(repo.Save(ctx, event)).To(gomega.Succeed())
+	}
+
+	compactor := eventbus.NewCompactor(repo, []eventbusoptions.RetentionRule{
+		{Status: eventbus.EventStatusCompleted, MaxAge: time.Hour},
+		{Types: []string{typeA}, Status: eventbus.EventStatusCompleted, MaxAge: time.Hour * 3},
+		{Types: []string{typeA}, Status: eventbus.EventStatusDeadLetter, MaxAge: time.Hour},
+	})
+	count, err := compactor.Compact(ctx)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	// the expired events of other tests may be deleted by the rule without types as well
+	g.Expect(count).To(gomega.BeNumerically(">=", 3))
+	events, err := repo.Search(ctx, &eventbus.Filter{Type: []string{typeA, typeB}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(eventIDs(events)).To(gomega.ConsistOf(keptByTypedRule.EventID, failed.EventID))
+
+	_, err = repo.Delete(ctx, &eventbus.Filter{Type: []string{typeA, typeB}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
 }
 
 type fakeEvent struct {
@@ -173,3 +358,240 @@ func (receiver fakeEventbusFailedHandler) IsOk() bool {
 		return false
 	}
 }
+
+func testAddEventAttempt(ctx context.Context, g *gomega.WithT, repo eventbus.EventRepository) {
+	eventType := "attempt-" + uuid.New().String()
+	event := newTestEvent(eventType, "{}", eventbus.EventStatusRunning, time.Now().Add(-time.Hour))
+	g.Expect(repo.Save(ctx, event)).To(gomega.Succeed())
+
+	startTime := time.Now().Truncate(time.Second)
+	failed := eventbus.EventAttempt{
+		StartTime: startTime,
+		Duration:  time.Second,
+		Failures:  []eventbus.EventHandlerFailure{{Handler: "*handler.CreateRunsHandler", Error: "an error"}},
+	}
+	g.Expect(repo.AddAttempt(ctx, event, failed)).To(gomega.Succeed())
+	got, err := repo.Get(ctx, event.EventID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(got.LastError).To(gomega.Equal("*handler.CreateRunsHandler: an error"))
+	g.Expect(got.Attempts).To(gomega.HaveLen(1))
+	g.Expect(got.Attempts[0].StartTime.Unix()).To(gomega.Equal(startTime.Unix()))
+	g.Expect(got.Attempts[0].Duration).To(gomega.Equal(time.Second))
+	g.Expect(got.Attempts[0].Failures).To(gomega.Equal(failed.Failures))
+	// the attempt is recorded without changing the status and updated time used by the compactor
+	g.Expect(got.Status).To(gomega.Equal(eventbus.EventStatusRunning))
+	g.Expect(got.UpdatedAt.Unix()).To(gomega.Equal(event.UpdatedAt.Unix()))
+
+	// only the latest attempts are kept, the error of last failed attempt is kept after succeeded
+	for i := 1; i <= eventbus.MaxEventAttempts; i++ {
+		g.Expect(repo.AddAttempt(ctx, event, eventbus.EventAttempt{StartTime: startTime.Add(time.Duration(i) * time.Second)})).To(gomega.Succeed())
+	}
+	got, err = repo.Get(ctx, event.EventID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(got.Attempts).To(gomega.HaveLen(eventbus.MaxEventAttempts))
+	g.Expect(got.Attempts[0].StartTime.Unix()).To(gomega.Equal(startTime.Add(time.Second).Unix()))
+	g.Expect(got.Attempts[0].Failures).To(gomega.BeEmpty())
+	g.Expect(got.LastError).To(gomega.Equal("*handler.CreateRunsHandler: an error"))
+	events, err := repo.Search(ctx, &eventbus.Filter{Type: []string{eventType}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(events).To(gomega.HaveLen(1))
+	g.Expect(events[0].Attempts).To(gomega.Equal(got.Attempts))
+
+	_, err = repo.Delete(ctx, &eventbus.Filter{Type: []string{eventType}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+}
+
+func testRescheduleEvent(ctx context.Context, g *gomega.WithT, repo eventbus.EventRepository) {
+	eventType := "reschedule-" + uuid.New().String()
+	event := newTestEvent(eventType, "{}", eventbus.EventStatusRunning, time.Now().Add(-time.Hour))
+	g.Expect(repo.Save(ctx, event)).To(gomega.Succeed())
+
+	// the rescheduled event is not handled until the backoff passed
+	scheduledAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	event.RetryCount = 1
+	event.Reason = "an error"
+	event.ScheduledAt = scheduledAt
+	g.Expect(repo.Reschedule(ctx, event)).To(gomega.Succeed())
+	got, err := repo.Get(ctx, event.EventID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(got.Status).To(gomega.Equal(eventbus.EventStatusPending))
+	g.Expect(got.RetryCount).To(gomega.Equal(1))
+	g.Expect(got.Reason).To(gomega.Equal("an error"))
+	g.Expect(got.ScheduledAt.Unix()).To(gomega.Equal(scheduledAt.Unix()))
+	events, err := repo.ListAndLockUnfinishedEvents(ctx, 10, []string{eventType})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(events).To(gomega.BeEmpty())
+
+	event.RetryCount = 2
+	event.ScheduledAt = time.Now().Add(-time.Second)
+	g.Expect(repo.Reschedule(ctx, event)).To(gomega.Succeed())
+	events, err = repo.ListAndLockUnfinishedEvents(ctx, 10, []string{eventType})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(eventIDs(events)).To(gomega.Equal([]string{event.EventID}))
+	g.Expect(events[0].RetryCount).To(gomega.Equal(2))
+
+	_, err = repo.Delete(ctx, &eventbus.Filter{Type: []string{eventType}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+}
+
+func testDeadLetterEvent(ctx context.Context, g *gomega.WithT, repo eventbus.EventRepository) {
+	eventType := "deadletter-" + uuid.New().String()
+	initialBackoff := 200 * time.Millisecond
+	eventBus, err := eventbus.NewEventBus(repo,
+		eventbus.WithSyncPeriod(50*time.Millisecond),
+		eventbus.WithBatchSize(10),
+		eventbus.WithRetryPolicies(eventbusoptions.RetryPolicy{MaxRetries: 1}, []eventbusoptions.RetryPolicy{{
+			Types:          []string{eventType},
+			MaxRetries:     3,
+			InitialBackoff: initialBackoff,
+			MaxBackoff:     time.Second,
+			Multiplier:     2,
+		}}),
+	)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	var mutex sync.Mutex
+	var attemptTimes []time.Time
+	eventBus.Subscribe(eventType, eventbus.EventHandlerFunc(func(ctx context.Context, payload string) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		attemptTimes = append(attemptTimes, time.Now())
+		return fmt.Errorf("an error")
+	}))
+	deadLettered := make(chan *eventbus.Event, 1)
+	eventBus.Subscribe(eventbus.EventDeadLettered, eventbus.EventHandlerFunc(func(ctx context.Context, payload string) error {
+		event, err := eventbus.NewDeadLetteredEventFromPayload([]byte(payload))
+		if err != nil {
+			return err
+		}
+		deadLettered <- event.Event
+		return nil
+	}))
+	busCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		_ = eventBus.Start(busCtx, 1)
+	}()
+
+	g.Expect(eventBus.Publish(ctx, fakeEvent{EventTyp: eventType, ID: "id1"})).To(gomega.Succeed())
+	var event *eventbus.Event
+	g.Eventually(deadLettered, 10*time.Second).Should(gomega.Receive(&event))
+	g.Expect(event.Type).To(gomega.Equal(eventType))
+	g.Expect(event.RetryCount).To(gomega.Equal(3))
+
+	// the event is retried with exponential backoff
+	mutex.Lock()
+	g.Expect(attemptTimes).To(gomega.HaveLen(3))
+	g.Expect(attemptTimes[1].Sub(attemptTimes[0])).To(gomega.BeNumerically(">=", initialBackoff))
+	g.Expect(attemptTimes[2].Sub(attemptTimes[1])).To(gomega.BeNumerically(">=", 2*initialBackoff))
+	mutex.Unlock()
+	got, err := repo.Get(ctx, event.EventID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(got.Status).To(gomega.Equal(eventbus.EventStatusDeadLetter))
+	g.Expect(got.RetryCount).To(gomega.Equal(3))
+	g.Expect(got.Attempts).To(gomega.HaveLen(3))
+	g.Expect(got.LastError).To(gomega.HaveSuffix(": an error"))
+	// the dead lettered event is not handled again
+	events, err := repo.ListAndLockUnfinishedEvents(ctx, 10, []string{eventType})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(events).To(gomega.BeEmpty())
+
+	cancel()
+	_, err = repo.Delete(ctx, &eventbus.Filter{Type: []string{eventType}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	_, err = repo.Delete(ctx, &eventbus.Filter{Type: []string{eventbus.EventDeadLettered}, Payload: event.EventID})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+}
+
+func testEventLease(ctx context.Context, g *gomega.WithT, repo, replica eventbus.EventRepository) {
+	eventType := "lease-" + uuid.New().String()
+	saved := newTestEvent(eventType, "{}", eventbus.EventStatusPending, time.Now().Add(-time.Hour))
+	g.Expect(repo.Save(ctx, saved)).To(gomega.Succeed())
+	event, err := repo.Get(ctx, saved.EventID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	replicaEvent, err := replica.Get(ctx, saved.EventID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	// the running event is only acquired by its owner
+	leaseExpireAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	acquired, err := repo.Acquire(ctx, event, "replica-a", leaseExpireAt)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(acquired).To(gomega.BeTrue())
+	g.Expect(event.Status).To(gomega.Equal(eventbus.EventStatusRunning))
+	g.Expect(event.Owner).To(gomega.Equal("replica-a"))
+	acquired, err = replica.Acquire(ctx, replicaEvent, "replica-b", leaseExpireAt)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(acquired).To(gomega.BeFalse())
+	acquired, err = repo.Acquire(ctx, event, "replica-a", leaseExpireAt)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(acquired).To(gomega.BeTrue())
+	got, err := replica.Get(ctx, event.EventID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(got.Status).To(gomega.Equal(eventbus.EventStatusRunning))
+	g.Expect(got.Owner).To(gomega.Equal("replica-a"))
+	g.Expect(got.LeaseExpireAt.Unix()).To(gomega.Equal(leaseExpireAt.Unix()))
+
+	// the lease is only renewed by its owner
+	renewed, err := replica.RenewLease(ctx, replicaEvent, "replica-b", leaseExpireAt.Add(time.Hour))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(renewed).To(gomega.BeFalse())
+	renewed, err = repo.RenewLease(ctx, event, "replica-a", leaseExpireAt.Add(time.Hour))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(renewed).To(gomega.BeTrue())
+	got, err = replica.Get(ctx, event.EventID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(got.LeaseExpireAt.Unix()).To(gomega.Equal(leaseExpireAt.Add(time.Hour).Unix()))
+	events, err := replica.ListAndLockUnfinishedEvents(ctx, 10, []string{eventType})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(events).To(gomega.BeEmpty())
+
+	// the event is taken over by other replica after the lease expired, e.g. the owner is gone
+	renewed, err = repo.RenewLease(ctx, event, "replica-a", time.Now().Add(-time.Second))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(renewed).To(gomega.BeTrue())
+	events, err = replica.ListAndLockUnfinishedEvents(ctx, 10, []string{eventType})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(eventIDs(events)).To(gomega.Equal([]string{event.EventID}))
+	acquired, err = replica.Acquire(ctx, events[0], "replica-b", leaseExpireAt)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(acquired).To(gomega.BeTrue())
+	// the lease of the previous owner is lost
+	renewed, err = repo.RenewLease(ctx, event, "replica-a", leaseExpireAt)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(renewed).To(gomega.BeFalse())
+	acquired, err = repo.Acquire(ctx, event, "replica-a", leaseExpireAt)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(acquired).To(gomega.BeFalse())
+
+	// only one of the replicas acquiring the pending event at the same time gets it,
+	// though the owner may acquire its running event again
+	concurrent := newTestEvent(eventType, "{}", eventbus.EventStatusPending, time.Now())
+	g.Expect(repo.Save(ctx, concurrent)).To(gomega.Succeed())
+	var mutex sync.Mutex
+	owners := map[string]struct{}{}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r, owner := repo, "replica-a"
+			if i%2 == 1 {
+				r, owner = replica, "replica-b"
+			}
+			e := *concurrent
+			if ok, err := r.Acquire(ctx, &e, owner, leaseExpireAt); err == nil && ok {
+				mutex.Lock()
+				owners[owner] = struct{}{}
+				mutex.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	got, err = repo.Get(ctx, concurrent.EventID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(owners).To(gomega.HaveLen(1))
+	g.Expect(owners).To(gomega.HaveKey(got.Owner))
+
+	_, err = repo.Delete(ctx, &eventbus.Filter{Type: []string{eventType}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+}
@@ -5,7 +5,7 @@ import (
 )
 
 func eventPOToEventDO(e *Event) *eventbus.Event {
-	return &eventbus.Event{
+	event := &eventbus.Event{
 		EventID:     e.EventID,
 		Type:        e.Type,
 		Payload:     e.Payload,
@@ -14,12 +14,19 @@ func eventPOToEventDO(e *Event) *eventbus.Event {
 		UpdatedAt:   e.UpdatedAt,
 		ScheduledAt: e.ScheduledAt,
 		RetryCount:  e.RetryCount,
+		Reason:      e.Reason,
+		LastError:   e.LastError,
+		Attempts:    attemptsPOToAttemptsDO(e.Attempts),
+		Owner:       e.Owner,
 	}
-
+	if e.LeaseExpireAt != nil {
+		event.LeaseExpireAt = *e.LeaseExpireAt
+	}
+	return event
 }
 
 func eventDOToEventPO(e *eventbus.Event) *Event {
-	return &Event{
+	event := &Event{
 		EventID:     e.EventID,
 		Type:        e.Type,
 		Payload:     e.Payload,
@@ -28,5 +35,55 @@ func eventDOToEventPO(e *eventbus.Event) *Event {
 		UpdatedAt:   e.UpdatedAt,
 		ScheduledAt: e.ScheduledAt,
 		RetryCount:  e.RetryCount,
+		Reason:      e.Reason,
+		LastError:   e.LastError,
+		Attempts:    attemptsDOToAttemptsPO(e.Attempts),
+		Owner:       e.Owner,
+	}
+	if !e.LeaseExpireAt.IsZero() {
+		leaseExpireAt := e.LeaseExpireAt
+		event.LeaseExpireAt = &leaseExpireAt
+	}
+	return event
+}
+
+func attemptsPOToAttemptsDO(attempts []Attempt) []eventbus.EventAttempt {
+	result := make([]eventbus.EventAttempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = eventbus.EventAttempt{
+			StartTime: attempt.StartTime,
+			Duration:  attempt.Duration,
+			Failures:  make([]eventbus.EventHandlerFailure, len(attempt.Failures)),
+		}
+		for j, failure := range attempt.Failures {
+			result[i].Failures[j] = eventbus.EventHandlerFailure{
+				Handler: failure.Handler,
+				Error:   failure.Error,
+			}
+		}
+	}
+	return result
+}
+
+func attemptsDOToAttemptsPO(attempts []eventbus.EventAttempt) []Attempt {
+	result := make([]Attempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = attemptDOToAttemptPO(attempt)
+	}
+	return result
+}
+
+func attemptDOToAttemptPO(attempt eventbus.EventAttempt) Attempt {
+	result := Attempt{
+		StartTime: attempt.StartTime,
+		Duration:  attempt.Duration,
+		Failures:  make([]FailedHandler, len(attempt.Failures)),
+	}
+	for i, failure := range attempt.Failures {
+		result.Failures[i] = FailedHandler{
+			Handler: failure.Handler,
+			Error:   failure.Error,
+		}
 	}
+	return result
 }
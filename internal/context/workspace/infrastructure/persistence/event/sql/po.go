@@ -10,13 +10,31 @@ import (
 type Event struct {
 	EventID string `gorm:"primaryKey;type:varchar(100)"`
 	Type    string `gorm:"type:varchar(100)"`
-	Status  string `gorm:"type:varchar(100)"`
-	// todo record reason why in current status
-	Reason      string `gorm:"type:varchar(100)"`
-	Payload     string
-	RetryCount  int
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	ScheduledAt time.Time
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	// Status and UpdatedAt are indexed for the compactor
+	Status string `gorm:"type:varchar(100);index:idx_events_status_updated_at,priority:1"`
+	// Reason why in current status
+	Reason    string    `gorm:"type:varchar(1024)"`
+	LastError string    `gorm:"type:varchar(1024)"`
+	Attempts  []Attempt `gorm:"serializer:json"`
+	// Owner is the event bus handling or last handled the event
+	Owner         string `gorm:"type:varchar(128)"`
+	LeaseExpireAt *time.Time
+	Payload       string
+	RetryCount    int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time `gorm:"index:idx_events_status_updated_at,priority:2"`
+	ScheduledAt   time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+}
+
+// Attempt to handle the event
+type Attempt struct {
+	StartTime time.Time       `json:"startTime"`
+	Duration  time.Duration   `json:"duration"`
+	Failures  []FailedHandler `json:"failures"`
+}
+
+type FailedHandler struct {
+	Handler string `json:"handler"`
+	Error   string `json:"error"`
 }
@@ -2,7 +2,7 @@ package sql
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"time"
 
 	"gorm.io/gorm"
@@ -11,6 +11,10 @@ import (
 	applog "github.com/Bio-OS/bioos/pkg/log"
 )
 
+// deleteBatchSize is the max number of events deleted by one statement, so that deleting lots of
+// events doesn't lock the table or blow up the transaction log.
+var deleteBatchSize = 1000
+
 type eventRepository struct {
 	db             *gorm.DB
 	dequeueTimeout time.Duration
@@ -35,6 +39,9 @@ func (repo *eventRepository) Get(ctx context.Context, id string) (*eventbus.Even
 		EventID: id,
 	}
 	if ret := repo.db.WithContext(ctx).First(event); ret.Error != nil {
+		if errors.Is(ret.Error, gorm.ErrRecordNotFound) {
+			return nil, eventbus.ErrEventNotFound
+		}
 		return nil, ret.Error
 	}
 	return eventPOToEventDO(event), nil
@@ -42,7 +49,7 @@ func (repo *eventRepository) Get(ctx context.Context, id string) (*eventbus.Even
 func (repo *eventRepository) Save(ctx context.Context, event *eventbus.Event) error {
 	e := eventDOToEventPO(event)
 	// ref: https://gorm.io/docs/advanced_query.html#FirstOrCreate
-	if ret := repo.db.WithContext(ctx).Where(e).Assign(e).FirstOrCreate(&eventbus.Event{}); ret.Error != nil {
+	if ret := repo.db.WithContext(ctx).Where("event_id = ?", e.EventID).Assign(e).FirstOrCreate(&Event{}); ret.Error != nil {
 		return ret.Error
 	}
 	return nil
@@ -56,9 +63,7 @@ func (repo *eventRepository) ListAndLockUnfinishedEvents(ctx context.Context, li
 		tx = tx.Where("type IN ?", eventTypes)
 	}
 	ret := tx.
-		Where("status = ? AND scheduled_at <= ?", eventbus.EventStatusPending, now).
-		Or("status = ? and updated_at <= ?", eventbus.EventStatusDequeue, now.Add(-repo.dequeueTimeout)).
-		Or("status = ? and updated_at <= ?", eventbus.EventStatusRunning, now.Add(-repo.runningTimeout)).
+		Where(repo.unfinishedEvents(now)).
 		Order("updated_at DESC, scheduled_at ASC").
 		Limit(limit).
 		Find(&events)
@@ -66,58 +71,74 @@ func (repo *eventRepository) ListAndLockUnfinishedEvents(ctx context.Context, li
 		return nil, ret.Error
 	}
 
-	err := repo.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		var err error
-		var rowsAffected int64
-		for i := range events {
-			var updateMap = map[string]interface{}{}
-			switch events[i].Status {
-			case eventbus.EventStatusPending:
-				updateMap["status"] = eventbus.EventStatusDequeue
-				updateMap["updated_at"] = now
-				updates := tx.Model(&Event{}).
-					Where("event_id = ? and status = ?", events[i].EventID, eventbus.EventStatusPending).
-					Updates(updateMap)
-				err = updates.Error
-				rowsAffected = updates.RowsAffected
-
-			case eventbus.EventStatusDequeue:
-				updateMap["updated_at"] = now
-				updates := tx.Model(&Event{}).
-					Where("event_id = ? and status = ? and updated_at <= ?", events[i].EventID, eventbus.EventStatusDequeue, now.Add(-repo.dequeueTimeout)).
-					Updates(updateMap)
-				err = updates.Error
-				rowsAffected = updates.RowsAffected
-			case eventbus.EventStatusRunning:
-				updateMap["updated_at"] = now
-				updates := tx.Model(&Event{}).
-					Where("event_id = ? and status = ? and updated_at <= ?", events[i].EventID, eventbus.EventStatusRunning, now.Add(-repo.runningTimeout)).
-					Updates(updateMap)
-				err = updates.Error
-				rowsAffected = updates.RowsAffected
-			default:
-				err = fmt.Errorf("unsupport status %s", events[i].Status)
-			}
-			if err != nil {
-				return err
-			}
-			if rowsAffected != 1 {
-				return fmt.Errorf("update failed, expected 1 but got %d", rowsAffected)
-			}
-		}
-		return nil
-	})
-	if err != nil {
-		return nil, err
-	}
+	// the events are locked by dequeuing them, the events dequeued by other replicas are skipped
 	result := make([]*eventbus.Event, 0, len(events))
 	for _, e := range events {
-		event := e
-		result = append(result, eventPOToEventDO(event))
+		updates := repo.db.WithContext(ctx).Model(&Event{}).
+			Where("event_id = ?", e.EventID).
+			Where(repo.unfinishedEvents(now)).
+			UpdateColumns(map[string]interface{}{"status": eventbus.EventStatusDequeue, "updated_at": now})
+		if updates.Error != nil {
+			return nil, updates.Error
+		}
+		if updates.RowsAffected != 1 {
+			continue
+		}
+		e.Status = eventbus.EventStatusDequeue
+		e.UpdatedAt = now
+		result = append(result, eventPOToEventDO(e))
 	}
 	return result, nil
 }
 
+// unfinishedEvents matches the events to be handled: the pending events scheduled before now, the dequeued
+// events not handled in dequeueTimeout, and the running events whose lease expired or running over runningTimeout.
+func (repo *eventRepository) unfinishedEvents(now time.Time) *gorm.DB {
+	return repo.db.
+		Where("status = ? AND scheduled_at <= ?", eventbus.EventStatusPending, now).
+		Or("status = ? AND updated_at <= ?", eventbus.EventStatusDequeue, now.Add(-repo.dequeueTimeout)).
+		Or("status = ? AND (lease_expire_at <= ? OR updated_at <= ?)", eventbus.EventStatusRunning, now, now.Add(-repo.runningTimeout))
+}
+
+func (repo *eventRepository) Acquire(ctx context.Context, event *eventbus.Event, owner string, leaseExpireAt time.Time) (bool, error) {
+	now := time.Now()
+	ret := repo.db.WithContext(ctx).Model(&Event{}).
+		Where("event_id = ?", event.EventID).
+		Where(repo.db.Where("status IN ?", []string{eventbus.EventStatusPending, eventbus.EventStatusDequeue}).
+			Or("status = ? AND owner = ?", eventbus.EventStatusRunning, owner)).
+		UpdateColumns(map[string]interface{}{
+			"status":          eventbus.EventStatusRunning,
+			"owner":           owner,
+			"lease_expire_at": leaseExpireAt,
+			"updated_at":      now,
+		})
+	if ret.Error != nil {
+		return false, ret.Error
+	}
+	if ret.RowsAffected != 1 {
+		return false, nil
+	}
+	event.Status = eventbus.EventStatusRunning
+	event.Owner = owner
+	event.LeaseExpireAt = leaseExpireAt
+	event.UpdatedAt = now
+	return true, nil
+}
+
+func (repo *eventRepository) RenewLease(ctx context.Context, event *eventbus.Event, owner string, leaseExpireAt time.Time) (bool, error) {
+	ret := repo.db.WithContext(ctx).Model(&Event{}).
+		Where("event_id = ? AND status = ? AND owner = ?", event.EventID, eventbus.EventStatusRunning, owner).
+		UpdateColumn("lease_expire_at", leaseExpireAt)
+	if ret.Error != nil {
+		return false, ret.Error
+	}
+	if ret.RowsAffected != 1 {
+		return false, nil
+	}
+	event.LeaseExpireAt = leaseExpireAt
+	return true, nil
+}
+
 func (repo *eventRepository) UpdateStatus(ctx context.Context, event *eventbus.Event, status string) error {
 	e := eventDOToEventPO(event)
 	if ret := repo.db.WithContext(ctx).Model(e).First(e); ret.Error != nil {
@@ -126,7 +147,7 @@ func (repo *eventRepository) UpdateStatus(ctx context.Context, event *eventbus.E
 	if event.Status == status {
 		return nil
 	}
-	var updateMap = map[string]interface{}{"status": status, "updated_at": time.Now()}
+	var updateMap = map[string]interface{}{"status": status, "reason": event.Reason, "updated_at": time.Now()}
 	if ret := repo.db.WithContext(ctx).Model(e).UpdateColumns(updateMap); ret.Error != nil {
 		return ret.Error
 	}
@@ -142,17 +163,25 @@ func (repo *eventRepository) UpdateRetryCount(ctx context.Context, event *eventb
 	return nil
 }
 
-func (repo *eventRepository) Search(ctx context.Context, Filter *eventbus.Filter) ([]*eventbus.Event, error) {
-	var events []Event
-	db := repo.db.WithContext(ctx)
-	if len(Filter.Type) > 0 {
-		db = db.Where("type IN ?", Filter.Type)
+func (repo *eventRepository) Reschedule(ctx context.Context, event *eventbus.Event) error {
+	var updateMap = map[string]interface{}{
+		"status":       eventbus.EventStatusPending,
+		"retry_count":  event.RetryCount,
+		"reason":       event.Reason,
+		"scheduled_at": event.ScheduledAt,
+		"updated_at":   time.Now(),
 	}
-	if len(Filter.Status) > 0 {
-		db = db.Where("status IN ?", Filter.Status)
+	if ret := repo.db.WithContext(ctx).Model(&Event{EventID: event.EventID}).UpdateColumns(updateMap); ret.Error != nil {
+		return ret.Error
 	}
-	if Filter.Payload != "" {
-		db = db.Where("payload LIKE ?", "%"+Filter.Payload+"%")
+	return nil
+}
+
+func (repo *eventRepository) Search(ctx context.Context, Filter *eventbus.Filter) ([]*eventbus.Event, error) {
+	var events []Event
+	db := filterEvents(repo.db.WithContext(ctx), Filter).Order("created_at DESC")
+	if Filter != nil && Filter.Limit > 0 {
+		db = db.Offset(Filter.Offset).Limit(Filter.Limit)
 	}
 
 	if err := db.Find(&events).Error; err != nil {
@@ -167,3 +196,79 @@ func (repo *eventRepository) Search(ctx context.Context, Filter *eventbus.Filter
 
 	return eventPOs, nil
 }
+
+func (repo *eventRepository) Requeue(ctx context.Context, event *eventbus.Event) error {
+	now := time.Now()
+	var updateMap = map[string]interface{}{
+		"status":       eventbus.EventStatusPending,
+		"retry_count":  0,
+		"reason":       "",
+		"scheduled_at": now,
+		"updated_at":   now,
+	}
+	if ret := repo.db.WithContext(ctx).Model(&Event{EventID: event.EventID}).UpdateColumns(updateMap); ret.Error != nil {
+		return ret.Error
+	}
+	return nil
+}
+
+func (repo *eventRepository) Delete(ctx context.Context, filter *eventbus.Filter) (int64, error) {
+	var total int64
+	for {
+		var ids []string
+		// the soft deleted events are deleted as well to reclaim the space
+		if ret := filterEvents(repo.db.WithContext(ctx).Unscoped().Model(&Event{}), filter).
+			Limit(deleteBatchSize).Pluck("event_id", &ids); ret.Error != nil {
+			return total, ret.Error
+		}
+		if len(ids) == 0 {
+			return total, nil
+		}
+		ret := repo.db.WithContext(ctx).Unscoped().Where("event_id IN ?", ids).Delete(&Event{})
+		if ret.Error != nil {
+			return total, ret.Error
+		}
+		total += ret.RowsAffected
+		if len(ids) < deleteBatchSize {
+			return total, nil
+		}
+	}
+}
+
+func (repo *eventRepository) AddAttempt(ctx context.Context, event *eventbus.Event, attempt eventbus.EventAttempt) error {
+	event.AddAttempt(attempt)
+	e := &Event{
+		EventID:   event.EventID,
+		LastError: event.LastError,
+		Attempts:  attemptsDOToAttemptsPO(event.Attempts),
+	}
+	// select the columns to update the serialized attempts without touching updated_at
+	if ret := repo.db.WithContext(ctx).Model(e).Select("last_error", "attempts").UpdateColumns(e); ret.Error != nil {
+		return ret.Error
+	}
+	return nil
+}
+
+func filterEvents(db *gorm.DB, filter *eventbus.Filter) *gorm.DB {
+	// gorm refuses to delete without conditions
+	db = db.Where("1 = 1")
+	if filter == nil {
+		return db
+	}
+	if len(filter.Type) > 0 {
+		db = db.Where("type IN ?", filter.Type)
+	}
+	if len(filter.ExcludeTypes) > 0 {
+		db = db.Where("type NOT IN ?", filter.ExcludeTypes)
+	}
+	if len(filter.Status) > 0 {
+		db = db.Where("status IN ?", filter.Status)
+	}
+	if filter.Payload != "" {
+		db = db.Where("payload LIKE ?", "%"+filter.Payload+"%")
+	}
+	if !filter.UpdatedBefore.IsZero() {
+		db = db.Where("updated_at < ?", filter.UpdatedBefore)
+	}
+	return db
+}
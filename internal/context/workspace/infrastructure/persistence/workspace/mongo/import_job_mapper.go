@@ -0,0 +1,75 @@
+package mongo
+
+import (
+	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
+)
+
+func importJobDOToImportJobPO(j *workspace.ImportJob) *importJobPO {
+	res := &importJobPO{
+		ID:                j.ID,
+		Type:              j.Type,
+		WorkspaceID:       j.WorkspaceID,
+		SourceWorkspaceID: j.SourceWorkspaceID,
+		State:             j.State,
+		Components:        make([]importJobComponentPO, len(j.Components)),
+		Error:             j.Error,
+		CreateTime:        j.CreatedAt,
+		UpdateTime:        j.UpdatedAt,
+		FinishTime:        j.FinishedAt,
+	}
+	for i, component := range j.Components {
+		res.Components[i] = importJobComponentPO{
+			Name:    component.Name,
+			State:   component.State,
+			Message: component.Message,
+		}
+	}
+	return res
+}
+
+func importJobPOToImportJobDO(j *importJobPO) *workspace.ImportJob {
+	res := &workspace.ImportJob{
+		ID:                j.ID,
+		Type:              j.Type,
+		WorkspaceID:       j.WorkspaceID,
+		SourceWorkspaceID: j.SourceWorkspaceID,
+		State:             j.State,
+		Components:        make([]*workspace.ImportJobComponent, len(j.Components)),
+		Error:             j.Error,
+		CreatedAt:         j.CreateTime,
+		UpdatedAt:         j.UpdateTime,
+		FinishedAt:        j.FinishTime,
+	}
+	for i, component := range j.Components {
+		res.Components[i] = &workspace.ImportJobComponent{
+			Name:    component.Name,
+			State:   component.State,
+			Message: component.Message,
+		}
+	}
+	return res
+}
+
+func importJobPOToQueryItem(j *importJobPO) *query.ImportJobItem {
+	res := &query.ImportJobItem{
+		ID:                j.ID,
+		Type:              j.Type,
+		WorkspaceID:       j.WorkspaceID,
+		SourceWorkspaceID: j.SourceWorkspaceID,
+		State:             j.State,
+		Components:        make([]query.ImportJobComponent, len(j.Components)),
+		Error:             j.Error,
+		CreatedAt:         j.CreateTime,
+		UpdatedAt:         j.UpdateTime,
+		FinishedAt:        j.FinishTime,
+	}
+	for i, component := range j.Components {
+		res.Components[i] = query.ImportJobComponent{
+			Name:    component.Name,
+			State:   component.State,
+			Message: component.Message,
+		}
+	}
+	return res
+}
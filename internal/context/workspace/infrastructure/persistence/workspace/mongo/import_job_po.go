@@ -0,0 +1,22 @@
+package mongo
+
+import "time"
+
+type importJobPO struct {
+	ID                string                 `json:"id" bson:"id"`
+	Type              string                 `json:"type" bson:"type"`
+	WorkspaceID       string                 `json:"workspaceID" bson:"workspaceID"`
+	SourceWorkspaceID string                 `json:"sourceWorkspaceID" bson:"sourceWorkspaceID,omitempty"`
+	State             string                 `json:"state" bson:"state"`
+	Components        []importJobComponentPO `json:"components" bson:"components"`
+	Error             string                 `json:"error" bson:"error,omitempty"`
+	CreateTime        time.Time              `json:"createTime" bson:"createTime"`
+	UpdateTime        time.Time              `json:"updateTime" bson:"updateTime"`
+	FinishTime        *time.Time             `json:"finishTime" bson:"finishTime,omitempty"`
+}
+
+type importJobComponentPO struct {
+	Name    string `json:"name" bson:"name"`
+	State   string `json:"state" bson:"state"`
+	Message string `json:"message" bson:"message,omitempty"`
+}
@@ -0,0 +1,54 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vinllen/mgo/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+)
+
+const ImportJobCollection = "workspaceImportJob"
+
+type importJobRepository struct {
+	collection *mongo.Collection
+}
+
+var _ workspace.ImportJobRepository = &importJobRepository{}
+
+// NewImportJobRepository ...
+func NewImportJobRepository(ctx context.Context, mongoDB *mongo.Database) (workspace.ImportJobRepository, error) {
+	collection := mongoDB.Collection(ImportJobCollection)
+	if _, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.M{"id": 1}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.M{"workspaceID": 1}},
+	}); err != nil {
+		return nil, err
+	}
+	return &importJobRepository{
+		collection: collection,
+	}, nil
+}
+
+func (r *importJobRepository) Get(ctx context.Context, id string) (*workspace.ImportJob, error) {
+	var result importJobPO
+	if err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&result); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("import job", id)
+		}
+		return nil, apperrors.NewInternalError(err)
+	}
+	return importJobPOToImportJobDO(&result), nil
+}
+
+func (r *importJobRepository) Save(ctx context.Context, j *workspace.ImportJob) error {
+	job := importJobDOToImportJobPO(j)
+	if _, err := r.collection.ReplaceOne(ctx, bson.M{"id": job.ID}, job, options.Replace().SetUpsert(true)); err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
@@ -11,9 +11,21 @@ import (
 func workspacePOToWorkspaceDO(ctx context.Context, w *workspacePO) (*workspace.Workspace, error) {
 	factory := workspace.NewWorkspaceFactory(ctx)
 	param := workspace.CreateWorkspaceParam{
-		Name:        w.Name,
-		Description: w.Description,
-		CreatedAt:   w.CreateTime,
+		ID:            w.ID,
+		Name:          w.Name,
+		Description:   w.Description,
+		CreatedAt:     w.CreateTime,
+		UpdatedAt:     w.UpdateTime,
+		EngineBackend: w.EngineBackend,
+		Owner:         w.Owner,
+		Members:       make([]*workspace.Member, len(w.Members)),
+	}
+	for i, member := range w.Members {
+		param.Members[i] = &workspace.Member{
+			UserName:  member.UserName,
+			Role:      member.Role,
+			CreatedAt: member.CreateTime,
+		}
 	}
 	if w.Storage.NFS != nil {
 		param.Storage.NFS = &workspace.NFSStorage{MountPath: w.Storage.NFS.MountPath}
@@ -23,11 +35,21 @@ func workspacePOToWorkspaceDO(ctx context.Context, w *workspacePO) (*workspace.W
 
 func workspaceDOtoWorkspacePO(ctx context.Context, w *workspace.Workspace) (*workspacePO, error) {
 	res := &workspacePO{
-		ID:          w.GetID(),
-		Name:        w.GetName(),
-		Description: w.GetDescription(),
-		CreateTime:  w.GetCreatedAt(),
-		UpdateTime:  time.Now(),
+		ID:            w.GetID(),
+		Name:          w.GetName(),
+		Description:   w.GetDescription(),
+		CreateTime:    w.GetCreatedAt(),
+		UpdateTime:    time.Now(),
+		EngineBackend: w.GetEngineBackend(),
+		Owner:         w.GetOwner(),
+		Members:       make([]workspaceMember, len(w.GetMembers())),
+	}
+	for i, member := range w.GetMembers() {
+		res.Members[i] = workspaceMember{
+			UserName:   member.UserName,
+			Role:       member.Role,
+			CreateTime: member.CreatedAt,
+		}
 	}
 	storage := w.GetStorage()
 	if storage.NFS != nil {
@@ -40,14 +62,24 @@ func workspaceDOtoWorkspacePO(ctx context.Context, w *workspace.Workspace) (*wor
 
 func workspacePOToQueryItem(ctx context.Context, w *workspacePO) (*query.WorkspaceItem, error) {
 	res := &query.WorkspaceItem{
-		ID:          w.ID,
-		Name:        w.Name,
-		Description: w.Description,
-		CreatedAt:   w.CreateTime,
-		UpdatedAt:   w.UpdateTime,
+		ID:            w.ID,
+		Name:          w.Name,
+		Description:   w.Description,
+		CreatedAt:     w.CreateTime,
+		UpdatedAt:     w.UpdateTime,
+		EngineBackend: w.EngineBackend,
+		Owner:         w.Owner,
 	}
 	if w.Storage.NFS != nil {
 		res.Storage.NFS = &query.NFSWorkspaceStorage{MountPath: w.Storage.NFS.MountPath}
 	}
 	return res, nil
 }
+
+func workspaceMemberPOToQueryItem(m *workspaceMember) *query.WorkspaceMemberItem {
+	return &query.WorkspaceMemberItem{
+		UserName:  m.UserName,
+		Role:      m.Role,
+		CreatedAt: m.CreateTime,
+	}
+}
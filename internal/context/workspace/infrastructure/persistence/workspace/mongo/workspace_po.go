@@ -3,12 +3,21 @@ package mongo
 import "time"
 
 type workspacePO struct {
-	ID          string           `json:"id" bson:"id"`
-	Name        string           `json:"name" bson:"name"`
-	Description string           `json:"description" bson:"description"`
-	Storage     workspaceStorage `json:"storage" bson:"storage"`
-	CreateTime  time.Time        `json:"createTime" bson:"createTime"`
-	UpdateTime  time.Time        `json:"updateTime" bson:"updateTime"`
+	ID            string            `json:"id" bson:"id"`
+	Name          string            `json:"name" bson:"name"`
+	Description   string            `json:"description" bson:"description"`
+	Storage       workspaceStorage  `json:"storage" bson:"storage"`
+	CreateTime    time.Time         `json:"createTime" bson:"createTime"`
+	UpdateTime    time.Time         `json:"updateTime" bson:"updateTime"`
+	EngineBackend string            `json:"engineBackend" bson:"engineBackend,omitempty"`
+	Owner         string            `json:"owner" bson:"owner,omitempty"`
+	Members       []workspaceMember `json:"members" bson:"members,omitempty"`
+}
+
+type workspaceMember struct {
+	UserName   string    `json:"userName" bson:"userName"`
+	Role       string    `json:"role" bson:"role"`
+	CreateTime time.Time `json:"createTime" bson:"createTime"`
 }
 
 // WorkspaceStorage ...
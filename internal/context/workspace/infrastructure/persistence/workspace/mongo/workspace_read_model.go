@@ -2,6 +2,7 @@ package mongo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/vinllen/mgo/bson"
@@ -9,18 +10,21 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
 	"github.com/Bio-OS/bioos/pkg/utils"
 )
 
 type workspaceReadModel struct {
-	collection *mongo.Collection
+	collection          *mongo.Collection
+	importJobCollection *mongo.Collection
 }
 
 func NewWorkspaceReadModel(ctx context.Context, mongoDB *mongo.Database) (query.WorkspaceReadModel, error) {
 	collection := mongoDB.Collection(WorkspaceCollection)
 
 	return &workspaceReadModel{
-		collection: collection,
+		collection:          collection,
+		importJobCollection: mongoDB.Collection(ImportJobCollection),
 	}, nil
 }
 
@@ -137,6 +141,12 @@ func getFilter(filter *query.ListWorkspacesFilter) bson.M {
 		if len(filter.IDs) > 0 {
 			res["id"] = bson.M{"$in": filter.IDs}
 		}
+		if len(filter.UserName) > 0 {
+			res["$or"] = []bson.M{
+				{"owner": bson.M{"$in": []interface{}{"", nil}}},
+				{"members.userName": filter.UserName},
+			}
+		}
 	}
 	return res
 }
@@ -153,6 +163,48 @@ func (w workspaceReadModel) GetWorkspaceById(ctx context.Context, id string) (*q
 	return workspacePOToQueryItem(ctx, &result)
 }
 
+func (w workspaceReadModel) GetImportJob(ctx context.Context, id string) (*query.ImportJobItem, error) {
+	var result importJobPO
+	if err := w.importJobCollection.FindOne(ctx, bson.M{"id": id}).Decode(&result); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("import job", id)
+		}
+		return nil, apperrors.NewInternalError(err)
+	}
+	return importJobPOToQueryItem(&result), nil
+}
+
+func (w workspaceReadModel) ListWorkspaceMembers(ctx context.Context, workspaceID string) ([]*query.WorkspaceMemberItem, error) {
+	var result workspacePO
+	if err := w.collection.FindOne(ctx, bson.M{"id": workspaceID}).Decode(&result); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("workspace", workspaceID)
+		}
+		return nil, apperrors.NewInternalError(err)
+	}
+	res := make([]*query.WorkspaceMemberItem, len(result.Members))
+	for i := range result.Members {
+		res[i] = workspaceMemberPOToQueryItem(&result.Members[i])
+	}
+	return res, nil
+}
+
+func (w workspaceReadModel) GetWorkspaceMember(ctx context.Context, workspaceID, userName string) (*query.WorkspaceMemberItem, error) {
+	var result workspacePO
+	if err := w.collection.FindOne(ctx, bson.M{"id": workspaceID, "members.userName": userName}).Decode(&result); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperrors.NewNotFoundError("workspace member", userName)
+		}
+		return nil, apperrors.NewInternalError(err)
+	}
+	for i := range result.Members {
+		if result.Members[i].UserName == userName {
+			return workspaceMemberPOToQueryItem(&result.Members[i]), nil
+		}
+	}
+	return nil, apperrors.NewNotFoundError("workspace member", userName)
+}
+
 func (w workspaceReadModel) CountWorkspaces(ctx context.Context, filter *query.ListWorkspacesFilter) (int, error) {
 	opts := options.Count().SetHint("_id_")
 	count, err := w.collection.CountDocuments(ctx, getFilter(filter), opts)
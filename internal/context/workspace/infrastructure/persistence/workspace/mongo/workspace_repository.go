@@ -37,6 +37,7 @@ func NewWorkspaceRepository(ctx context.Context, mongoDB *mongo.Database) (works
 	collection := mongoDB.Collection(WorkspaceCollection)
 	if _, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
 		{Keys: bson.M{"id": 1}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.M{"members.userName": 1}},
 	}); err != nil {
 		return nil, err
 	}
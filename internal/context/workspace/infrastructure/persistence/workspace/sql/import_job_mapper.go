@@ -0,0 +1,75 @@
+package mysql
+
+import (
+	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
+)
+
+func ImportJobDOToImportJobPO(j *workspace.ImportJob) *ImportJob {
+	res := &ImportJob{
+		ID:                j.ID,
+		Type:              j.Type,
+		WorkspaceID:       j.WorkspaceID,
+		SourceWorkspaceID: j.SourceWorkspaceID,
+		State:             j.State,
+		Components:        make([]ImportJobComponent, len(j.Components)),
+		Error:             j.Error,
+		CreateTime:        j.CreatedAt,
+		UpdateTime:        j.UpdatedAt,
+		FinishTime:        j.FinishedAt,
+	}
+	for i, component := range j.Components {
+		res.Components[i] = ImportJobComponent{
+			Name:    component.Name,
+			State:   component.State,
+			Message: component.Message,
+		}
+	}
+	return res
+}
+
+func ImportJobPOToImportJobDO(j *ImportJob) *workspace.ImportJob {
+	res := &workspace.ImportJob{
+		ID:                j.ID,
+		Type:              j.Type,
+		WorkspaceID:       j.WorkspaceID,
+		SourceWorkspaceID: j.SourceWorkspaceID,
+		State:             j.State,
+		Components:        make([]*workspace.ImportJobComponent, len(j.Components)),
+		Error:             j.Error,
+		CreatedAt:         j.CreateTime,
+		UpdatedAt:         j.UpdateTime,
+		FinishedAt:        j.FinishTime,
+	}
+	for i, component := range j.Components {
+		res.Components[i] = &workspace.ImportJobComponent{
+			Name:    component.Name,
+			State:   component.State,
+			Message: component.Message,
+		}
+	}
+	return res
+}
+
+func ImportJobPOToImportJobDTO(j *ImportJob) *query.ImportJobItem {
+	res := &query.ImportJobItem{
+		ID:                j.ID,
+		Type:              j.Type,
+		WorkspaceID:       j.WorkspaceID,
+		SourceWorkspaceID: j.SourceWorkspaceID,
+		State:             j.State,
+		Components:        make([]query.ImportJobComponent, len(j.Components)),
+		Error:             j.Error,
+		CreatedAt:         j.CreateTime,
+		UpdatedAt:         j.UpdateTime,
+		FinishedAt:        j.FinishTime,
+	}
+	for i, component := range j.Components {
+		res.Components[i] = query.ImportJobComponent{
+			Name:    component.Name,
+			State:   component.State,
+			Message: component.Message,
+		}
+	}
+	return res
+}
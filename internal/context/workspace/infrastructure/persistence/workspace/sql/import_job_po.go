@@ -0,0 +1,31 @@
+package mysql
+
+import (
+	"time"
+)
+
+// ImportJob model.
+type ImportJob struct {
+	ID string `gorm:"primaryKey"`
+	// Type defaults to Import for the jobs saved before cloning supported
+	Type              string               `gorm:"type:varchar(32);not null;default:Import"`
+	WorkspaceID       string               `gorm:"type:varchar(32);not null;index"`
+	SourceWorkspaceID string               `gorm:"type:varchar(32)"`
+	State             string               `gorm:"type:varchar(32);not null"`
+	Components        []ImportJobComponent `gorm:"serializer:json"`
+	Error             string
+	CreateTime        time.Time
+	UpdateTime        time.Time
+	FinishTime        *time.Time
+}
+
+// ImportJobComponent ...
+type ImportJobComponent struct {
+	Name    string `json:"name"`
+	State   string `json:"state"`
+	Message string `json:"message,omitempty"`
+}
+
+func (j *ImportJob) TableName() string {
+	return "workspace_import_job"
+}
@@ -0,0 +1,47 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+)
+
+type importJobRepository struct {
+	db *gorm.DB
+}
+
+// NewImportJobRepository ...
+func NewImportJobRepository(ctx context.Context, db *gorm.DB) (workspace.ImportJobRepository, error) {
+	if err := db.WithContext(ctx).AutoMigrate(&ImportJob{}); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	return &importJobRepository{db: db}, nil
+}
+
+func (r *importJobRepository) Get(ctx context.Context, id string) (*workspace.ImportJob, error) {
+	var job ImportJob
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&job).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NewNotFoundError("import job", id)
+		}
+		applog.Errorw("failed to get import job", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return ImportJobPOToImportJobDO(&job), nil
+}
+
+func (r *importJobRepository) Save(ctx context.Context, j *workspace.ImportJob) error {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(ImportJobDOToImportJobPO(j)).Error; err != nil {
+		applog.Errorw("failed to save import job", "err", err)
+		return apperrors.NewInternalError(err)
+	}
+	return nil
+}
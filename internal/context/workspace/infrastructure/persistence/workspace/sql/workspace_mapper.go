@@ -9,11 +9,13 @@ import (
 
 func WorkspacePOToWorkspaceDTO(ctx context.Context, w *Workspace) *query.WorkspaceItem {
 	item := &query.WorkspaceItem{
-		ID:          w.ID,
-		Name:        w.Name,
-		Description: w.Description,
-		CreatedAt:   w.CreateTime,
-		UpdatedAt:   w.UpdateTime,
+		ID:            w.ID,
+		Name:          w.Name,
+		Description:   w.Description,
+		CreatedAt:     w.CreateTime,
+		UpdatedAt:     w.UpdateTime,
+		EngineBackend: w.EngineBackend,
+		Owner:         w.Owner,
 	}
 	if w.Storage.NFS != nil {
 		item.Storage = query.WorkspaceStorage{NFS: &query.NFSWorkspaceStorage{MountPath: w.Storage.NFS.MountPath}}
@@ -21,14 +23,24 @@ func WorkspacePOToWorkspaceDTO(ctx context.Context, w *Workspace) *query.Workspa
 	return item
 }
 
-func WorkspacePOToWorkspaceDO(ctx context.Context, w *Workspace) (*workspace.Workspace, error) {
+func WorkspacePOToWorkspaceDO(ctx context.Context, w *Workspace, members []*WorkspaceMember) (*workspace.Workspace, error) {
 	factory := workspace.NewWorkspaceFactory(ctx)
 	param := workspace.CreateWorkspaceParam{
-		ID:          w.ID,
-		Name:        w.Name,
-		Description: w.Description,
-		CreatedAt:   w.CreateTime,
-		UpdatedAt:   w.UpdateTime,
+		ID:            w.ID,
+		Name:          w.Name,
+		Description:   w.Description,
+		CreatedAt:     w.CreateTime,
+		UpdatedAt:     w.UpdateTime,
+		EngineBackend: w.EngineBackend,
+		Owner:         w.Owner,
+		Members:       make([]*workspace.Member, len(members)),
+	}
+	for i, member := range members {
+		param.Members[i] = &workspace.Member{
+			UserName:  member.UserName,
+			Role:      member.Role,
+			CreatedAt: member.CreateTime,
+		}
 	}
 	if w.Storage.NFS != nil {
 		param.Storage.NFS = &workspace.NFSStorage{MountPath: w.Storage.NFS.MountPath}
@@ -38,11 +50,13 @@ func WorkspacePOToWorkspaceDO(ctx context.Context, w *Workspace) (*workspace.Wor
 
 func WorkspaceDOtoWorkspacePO(w *workspace.Workspace) *Workspace {
 	res := &Workspace{
-		ID:          w.GetID(),
-		Name:        w.GetName(),
-		Description: w.GetDescription(),
-		CreateTime:  w.GetCreatedAt(),
-		UpdateTime:  w.GetUpdatedAt(),
+		ID:            w.GetID(),
+		Name:          w.GetName(),
+		Description:   w.GetDescription(),
+		CreateTime:    w.GetCreatedAt(),
+		UpdateTime:    w.GetUpdatedAt(),
+		EngineBackend: w.GetEngineBackend(),
+		Owner:         w.GetOwner(),
 	}
 	storage := w.GetStorage()
 	if storage.NFS != nil {
@@ -52,3 +66,20 @@ func WorkspaceDOtoWorkspacePO(w *workspace.Workspace) *Workspace {
 	}
 	return res
 }
+
+func WorkspaceMemberDOToWorkspaceMemberPO(workspaceID string, m *workspace.Member) *WorkspaceMember {
+	return &WorkspaceMember{
+		WorkspaceID: workspaceID,
+		UserName:    m.UserName,
+		Role:        m.Role,
+		CreateTime:  m.CreatedAt,
+	}
+}
+
+func WorkspaceMemberPOToWorkspaceMemberDTO(m *WorkspaceMember) *query.WorkspaceMemberItem {
+	return &query.WorkspaceMemberItem{
+		UserName:  m.UserName,
+		Role:      m.Role,
+		CreatedAt: m.CreateTime,
+	}
+}
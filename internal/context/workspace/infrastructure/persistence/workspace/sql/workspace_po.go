@@ -6,12 +6,22 @@ import (
 
 // Workspace model.
 type Workspace struct {
-	ID          string `gorm:"primaryKey"`
-	Name        string `gorm:"type:varchar(64) CHARACTER SET gbk COLLATE gbk_bin;not null;unique"`
-	Description string
-	Storage     WorkspaceStorage `gorm:"serializer:json"`
+	ID            string `gorm:"primaryKey"`
+	Name          string `gorm:"type:varchar(64) CHARACTER SET gbk COLLATE gbk_bin;not null;unique"`
+	Description   string
+	Storage       WorkspaceStorage `gorm:"serializer:json"`
+	EngineBackend string           `gorm:"type:varchar(64)"`
+	Owner         string           `gorm:"type:varchar(64);index"`
+	CreateTime    time.Time
+	UpdateTime    time.Time
+}
+
+// WorkspaceMember is the user can access the workspace with role.
+type WorkspaceMember struct {
+	WorkspaceID string `gorm:"type:varchar(32);primaryKey"`
+	UserName    string `gorm:"type:varchar(64);primaryKey;index"`
+	Role        string `gorm:"type:varchar(32);not null"`
 	CreateTime  time.Time
-	UpdateTime  time.Time
 }
 
 // WorkspaceStorage ...
@@ -27,3 +37,7 @@ type NFSWorkspaceStorage struct {
 func (w *Workspace) TableName() string {
 	return "workspace"
 }
+
+func (m *WorkspaceMember) TableName() string {
+	return "workspace_member"
+}
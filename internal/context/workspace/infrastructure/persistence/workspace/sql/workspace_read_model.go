@@ -66,6 +66,43 @@ func (w *workspaceReadModel) GetWorkspaceById(ctx context.Context, id string) (*
 	return WorkspacePOToWorkspaceDTO(ctx, &ws), nil
 }
 
+func (w *workspaceReadModel) GetImportJob(ctx context.Context, id string) (*query.ImportJobItem, error) {
+	var job ImportJob
+	if err := w.db.WithContext(ctx).Where("id = ?", id).First(&job).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NewNotFoundError("import job", id)
+		}
+		applog.Errorw("failed to get import job", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return ImportJobPOToImportJobDTO(&job), nil
+}
+
+func (w *workspaceReadModel) ListWorkspaceMembers(ctx context.Context, workspaceID string) ([]*query.WorkspaceMemberItem, error) {
+	var members []*WorkspaceMember
+	if err := w.db.WithContext(ctx).Where("workspace_id = ?", workspaceID).Order("create_time").Find(&members).Error; err != nil {
+		applog.Errorw("failed to list workspace members", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	ret := make([]*query.WorkspaceMemberItem, len(members))
+	for i, member := range members {
+		ret[i] = WorkspaceMemberPOToWorkspaceMemberDTO(member)
+	}
+	return ret, nil
+}
+
+func (w *workspaceReadModel) GetWorkspaceMember(ctx context.Context, workspaceID, userName string) (*query.WorkspaceMemberItem, error) {
+	var member WorkspaceMember
+	if err := w.db.WithContext(ctx).Where("workspace_id = ? AND user_name = ?", workspaceID, userName).First(&member).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NewNotFoundError("workspace member", userName)
+		}
+		applog.Errorw("failed to get workspace member", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return WorkspaceMemberPOToWorkspaceMemberDTO(&member), nil
+}
+
 func listWorkspacesFilter(db *gorm.DB, filter *query.ListWorkspacesFilter) *gorm.DB {
 	if filter == nil {
 		return db
@@ -76,5 +113,9 @@ func listWorkspacesFilter(db *gorm.DB, filter *query.ListWorkspacesFilter) *gorm
 	if len(filter.IDs) > 0 {
 		db = db.Where("id IN ?", filter.IDs)
 	}
+	if len(filter.UserName) > 0 {
+		db = db.Where("owner = '' OR owner IS NULL OR id IN (?)",
+			db.Session(&gorm.Session{NewDB: true}).Model(&WorkspaceMember{}).Select("workspace_id").Where("user_name = ?", filter.UserName))
+	}
 	return db
 }
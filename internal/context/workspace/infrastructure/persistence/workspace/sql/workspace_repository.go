@@ -18,7 +18,7 @@ type workspaceRepository struct {
 
 // NewWorkspaceRepository ...
 func NewWorkspaceRepository(ctx context.Context, db *gorm.DB) (workspace.Repository, error) {
-	if err := db.WithContext(ctx).AutoMigrate(&Workspace{}); err != nil {
+	if err := db.WithContext(ctx).AutoMigrate(&Workspace{}, &WorkspaceMember{}); err != nil {
 		return nil, apperrors.NewInternalError(err)
 	}
 	return &workspaceRepository{db: db}, nil
@@ -33,42 +33,74 @@ func (r *workspaceRepository) Get(ctx context.Context, id string) (*workspace.Wo
 		applog.Errorw("failed to get workspace", "err", err)
 		return nil, apperrors.NewInternalError(err)
 	}
-	return WorkspacePOToWorkspaceDO(ctx, &ws)
+	var members []*WorkspaceMember
+	if err := r.db.WithContext(ctx).Where("workspace_id = ?", id).Order("create_time").Find(&members).Error; err != nil {
+		applog.Errorw("failed to get workspace members", "err", err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	return WorkspacePOToWorkspaceDO(ctx, &ws, members)
 }
 
 func (r *workspaceRepository) Save(ctx context.Context, w *workspace.Workspace) error {
-	ws := &Workspace{}
-	if err := r.db.WithContext(ctx).Where("id = ?", w.ID).First(&ws).Error; err != nil {
-		// Create if workspace with same id not exist
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			ws = WorkspaceDOtoWorkspacePO(w)
-			if err := r.db.WithContext(ctx).Create(ws).Error; err != nil {
-				applog.Errorw("failed to save workspace", "err", err)
-				return apperrors.NewInternalError(err)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ws := &Workspace{}
+		if err := tx.Where("id = ?", w.ID).First(&ws).Error; err != nil {
+			// Create if workspace with same id not exist
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				ws = WorkspaceDOtoWorkspacePO(w)
+				if err := tx.Create(ws).Error; err != nil {
+					applog.Errorw("failed to save workspace", "err", err)
+					return apperrors.NewInternalError(err)
+				}
+				return saveMembers(tx, w)
 			}
-			return nil
+			applog.Errorw("failed to get workspace", "err", err)
+			return apperrors.NewInternalError(err)
 		}
-		applog.Errorw("failed to get workspace", "err", err)
+		// Update if workspace with same id exist
+		ws = WorkspaceDOtoWorkspacePO(w)
+		if err := tx.Clauses(clause.OnConflict{
+			UpdateAll: true,
+		}).Create(ws).Error; err != nil {
+			applog.Errorw("failed to save workspace", "err", err)
+			return apperrors.NewInternalError(err)
+		}
+		return saveMembers(tx, w)
+	})
+}
+
+// saveMembers replaces the members of workspace.
+func saveMembers(tx *gorm.DB, w *workspace.Workspace) error {
+	if err := tx.Where("workspace_id = ?", w.ID).Delete(&WorkspaceMember{}).Error; err != nil {
+		applog.Errorw("failed to delete workspace members", "err", err)
 		return apperrors.NewInternalError(err)
 	}
-	// Update if workspace with same id exist
-	ws = WorkspaceDOtoWorkspacePO(w)
-	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
-		UpdateAll: true,
-	}).Create(ws).Error; err != nil {
-		applog.Errorw("failed to save workspace", "err", err)
+	if len(w.GetMembers()) == 0 {
+		return nil
+	}
+	members := make([]*WorkspaceMember, len(w.GetMembers()))
+	for i, member := range w.GetMembers() {
+		members[i] = WorkspaceMemberDOToWorkspaceMemberPO(w.ID, member)
+	}
+	if err := tx.Create(members).Error; err != nil {
+		applog.Errorw("failed to save workspace members", "err", err)
 		return apperrors.NewInternalError(err)
 	}
 	return nil
 }
 
 func (r *workspaceRepository) Delete(ctx context.Context, w *workspace.Workspace) error {
-	ws := WorkspaceDOtoWorkspacePO(w)
-	if err := r.db.WithContext(ctx).Delete(ws).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("workspace_id = ?", w.ID).Delete(&WorkspaceMember{}).Error; err != nil {
+			return apperrors.NewInternalError(err)
 		}
-		return apperrors.NewInternalError(err)
-	}
-	return nil
+		ws := WorkspaceDOtoWorkspacePO(w)
+		if err := tx.Delete(ws).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return apperrors.NewInternalError(err)
+		}
+		return nil
+	})
 }
@@ -7,17 +7,16 @@ import (
 	"testing"
 	"time"
 
-	_ "github.com/mattn/go-sqlite3"
 	"github.com/onsi/gomega"
 	mongodriver "go.mongodb.org/mongo-driver/mongo"
 	mongooptions "go.mongodb.org/mongo-driver/mongo/options"
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 
 	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
 	"github.com/Bio-OS/bioos/internal/context/workspace/domain/workspace"
 	"github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/persistence/workspace/mongo"
 	workspacesql "github.com/Bio-OS/bioos/internal/context/workspace/infrastructure/persistence/workspace/sql"
+	bioosdb "github.com/Bio-OS/bioos/pkg/db"
 	applog "github.com/Bio-OS/bioos/pkg/log"
 	"github.com/Bio-OS/bioos/pkg/utils"
 )
@@ -33,7 +32,7 @@ func TestSQLiteMemory(t *testing.T) {
 	g := gomega.NewWithT(t)
 	ctx := context.TODO()
 
-	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	db, err := gorm.Open(bioosdb.SQLite3Dialector(":memory:"), &gorm.Config{})
 	g.Expect(err).ToNot(gomega.HaveOccurred())
 
 	repo, err := workspacesql.NewWorkspaceRepository(ctx, db)
@@ -45,6 +44,20 @@ func TestSQLiteMemory(t *testing.T) {
 	testReadModel(ctx, g, repo, read)
 }
 
+func TestImportJobSQLiteMemory(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.TODO()
+
+	db, err := gorm.Open(bioosdb.SQLite3Dialector(":memory:"), &gorm.Config{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	repo, err := workspacesql.NewImportJobRepository(ctx, db)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	read, err := workspacesql.NewWorkspaceReadModel(ctx, db)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	testImportJob(ctx, g, repo, read)
+}
+
 func TestMongoDB(t *testing.T) {
 	uri := os.Getenv("MONGO_URI")
 	if len(uri) == 0 {
@@ -67,6 +80,10 @@ func TestMongoDB(t *testing.T) {
 	read, err := mongo.NewWorkspaceReadModel(ctx, db)
 	g.Expect(err).ToNot(gomega.HaveOccurred())
 	testReadModel(ctx, g, repo, read)
+
+	importJobRepo, err := mongo.NewImportJobRepository(ctx, db)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	testImportJob(ctx, g, importJobRepo, read)
 }
 
 func testRepository(ctx context.Context, g *gomega.WithT, repo workspace.Repository) {
@@ -98,6 +115,23 @@ func testRepository(ctx context.Context, g *gomega.WithT, repo workspace.Reposit
 	g.Expect(err).ToNot(gomega.HaveOccurred())
 	g.Expect(w.Description).To(gomega.Equal(ws.Description))
 
+	ws.Owner = "alice"
+	g.Expect(ws.AddMember("alice", workspace.RoleOwner)).To(gomega.Succeed())
+	g.Expect(ws.AddMember("bob", workspace.RoleReader)).To(gomega.Succeed())
+	g.Expect(repo.Save(ctx, ws)).ToNot(gomega.HaveOccurred())
+	w, err = repo.Get(ctx, ws.ID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(w.ID).To(gomega.Equal(ws.ID))
+	g.Expect(w.Owner).To(gomega.Equal("alice"))
+	g.Expect(w.Members).To(gomega.HaveLen(2))
+	g.Expect(w.GetMember("bob").Role).To(gomega.Equal(workspace.RoleReader))
+
+	g.Expect(w.RemoveMember("bob")).To(gomega.Succeed())
+	g.Expect(repo.Save(ctx, w)).ToNot(gomega.HaveOccurred())
+	w, err = repo.Get(ctx, ws.ID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(w.Members).To(gomega.HaveLen(1))
+
 	g.Expect(repo.Delete(ctx, ws)).ToNot(gomega.HaveOccurred())
 }
 
@@ -142,4 +176,97 @@ func testReadModel(ctx context.Context, g *gomega.WithT, repo workspace.Reposito
 		g.Expect(list).To(gomega.HaveLen(c.length))
 		g.Expect(count).To(gomega.Equal(c.length))
 	}
+
+	// the workspaces with owner are only visible to members
+	ws.ID, ws.Name, ws.Owner = "owned", "owned", "alice"
+	ws.Members = []*workspace.Member{{UserName: "alice", Role: workspace.RoleOwner, CreatedAt: time.Now()}}
+	g.Expect(repo.Save(ctx, ws)).ToNot(gomega.HaveOccurred())
+	ws.ID, ws.Name = "shared", "shared"
+	ws.Members = []*workspace.Member{
+		{UserName: "alice", Role: workspace.RoleOwner, CreatedAt: time.Now()},
+		{UserName: "bob", Role: workspace.RoleReader, CreatedAt: time.Now()},
+	}
+	g.Expect(repo.Save(ctx, ws)).ToNot(gomega.HaveOccurred())
+	visibleCases := []struct {
+		userName string
+		visible  []string
+		hidden   []string
+	}{
+		{"alice", []string{"owned", "shared", "id-0"}, nil},
+		{"bob", []string{"shared", "id-0"}, []string{"owned"}},
+		{"user1", []string{"id-0"}, []string{"owned", "shared"}},
+	}
+	for _, c := range visibleCases {
+		filter := &query.ListWorkspacesFilter{UserName: c.userName}
+		list, err := read.ListWorkspaces(ctx, *utils.NewPagination(100, 1), filter)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		ids := make([]string, 0, len(list))
+		for _, item := range list {
+			ids = append(ids, item.ID)
+		}
+		g.Expect(ids).To(gomega.HaveLen(20+len(c.visible)-1), c.userName)
+		g.Expect(ids).To(gomega.ContainElements(c.visible), c.userName)
+		for _, id := range c.hidden {
+			g.Expect(ids).ToNot(gomega.ContainElement(id), c.userName)
+		}
+		count, err := read.CountWorkspaces(ctx, filter)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(count).To(gomega.Equal(len(ids)), c.userName)
+	}
+	// the visibility filter works with the other filters
+	list, err := read.ListWorkspaces(ctx, *utils.NewPagination(20, 1), &query.ListWorkspacesFilter{UserName: "bob", SearchWord: "sha"})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(list).To(gomega.HaveLen(1))
+	g.Expect(list[0].ID).To(gomega.Equal("shared"))
+	list, err = read.ListWorkspaces(ctx, *utils.NewPagination(20, 1), &query.ListWorkspacesFilter{UserName: "bob", IDs: []string{"owned", "shared"}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(list).To(gomega.HaveLen(1))
+	g.Expect(list[0].ID).To(gomega.Equal("shared"))
+
+	members, err := read.ListWorkspaceMembers(ctx, "owned")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(members).To(gomega.HaveLen(1))
+	member, err := read.GetWorkspaceMember(ctx, "owned", "alice")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(member.Role).To(gomega.Equal(workspace.RoleOwner))
+	_, err = read.GetWorkspaceMember(ctx, "owned", "bob")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func testImportJob(ctx context.Context, g *gomega.WithT, repo workspace.ImportJobRepository, read query.WorkspaceReadModel) {
+	job := workspace.NewImportJob("workspace-id")
+	g.Expect(repo.Save(ctx, job)).ToNot(gomega.HaveOccurred())
+
+	j, err := repo.Get(ctx, job.ID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(j.Type).To(gomega.Equal(workspace.ImportJobTypeImport))
+	g.Expect(j.State).To(gomega.Equal(workspace.ImportJobPending))
+	g.Expect(j.Components).To(gomega.HaveLen(len(workspace.ImportComponents)))
+
+	job.Start()
+	job.UpdateComponent(workspace.ImportComponentNotebooks, workspace.ImportJobFailed, "bad notebook")
+	job.Fail("notebooks failed")
+	g.Expect(repo.Save(ctx, job)).ToNot(gomega.HaveOccurred())
+
+	item, err := read.GetImportJob(ctx, job.ID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(item.WorkspaceID).To(gomega.Equal("workspace-id"))
+	g.Expect(item.State).To(gomega.Equal(workspace.ImportJobFailed))
+	g.Expect(item.Error).To(gomega.Equal("notebooks failed"))
+	g.Expect(item.FinishedAt).ToNot(gomega.BeNil())
+	g.Expect(item.Components[0].State).To(gomega.Equal(workspace.ImportJobFailed))
+	g.Expect(item.Components[0].Message).To(gomega.Equal("bad notebook"))
+
+	_, err = read.GetImportJob(ctx, "not-exist")
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	cloneJob := workspace.NewCloneJob("clone-id", "workspace-id", true)
+	g.Expect(repo.Save(ctx, cloneJob)).ToNot(gomega.HaveOccurred())
+	item, err = read.GetImportJob(ctx, cloneJob.ID)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(item.Type).To(gomega.Equal(workspace.ImportJobTypeClone))
+	g.Expect(item.WorkspaceID).To(gomega.Equal("clone-id"))
+	g.Expect(item.SourceWorkspaceID).To(gomega.Equal("workspace-id"))
+	g.Expect(item.Components).To(gomega.HaveLen(len(workspace.CloneComponents) + 1))
+	g.Expect(item.Components[len(item.Components)-1].Name).To(gomega.Equal(workspace.CloneComponentStorage))
 }
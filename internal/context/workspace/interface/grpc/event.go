@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Bio-OS/bioos/internal/context/workspace/application"
+	"github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+type eventServer struct {
+	proto.UnimplementedEventServiceServer
+	service *application.WorkspaceService
+}
+
+// NewEventServer new an event bus admin rpc server.
+func NewEventServer(service *application.WorkspaceService) proto.EventServiceServer {
+	return &eventServer{
+		service: service,
+	}
+}
+
+func (s *eventServer) ListEvents(ctx context.Context, req *proto.ListEventsRequest) (*proto.ListEventsResponse, error) {
+	query := newListEventsQuery(req)
+	list, err := s.service.EventQueries.ListEvents.Handle(ctx, query)
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return newListEventsResponse(query, list), nil
+}
+
+func (s *eventServer) GetEvent(ctx context.Context, req *proto.GetEventRequest) (*proto.GetEventResponse, error) {
+	event, err := s.service.EventQueries.GetEvent.Handle(ctx, newGetEventQuery(req))
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return &proto.GetEventResponse{Event: newEventVO(event)}, nil
+}
+
+func (s *eventServer) ReplayEvent(ctx context.Context, req *proto.ReplayEventRequest) (*proto.ReplayEventResponse, error) {
+	if err := s.service.EventCommands.ReplayEvent.Handle(ctx, newReplayEventCommand(req)); err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return &proto.ReplayEventResponse{}, nil
+}
+
+func (s *eventServer) PurgeEvents(ctx context.Context, req *proto.PurgeEventsRequest) (*proto.PurgeEventsResponse, error) {
+	count, err := s.service.EventCommands.PurgeEvents.Handle(ctx, newPurgeEventsCommand(req))
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return &proto.PurgeEventsResponse{Count: count}, nil
+}
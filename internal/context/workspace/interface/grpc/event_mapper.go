@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	command "github.com/Bio-OS/bioos/internal/context/workspace/application/command/event"
+	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/event"
+	"github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+func newListEventsQuery(req *proto.ListEventsRequest) *query.ListEventsQuery {
+	return &query.ListEventsQuery{
+		Pg:      utils.NewPagination(int(req.Size), int(req.Page)),
+		Types:   req.Types,
+		Status:  req.Status,
+		Payload: req.Payload,
+	}
+}
+
+func newGetEventQuery(req *proto.GetEventRequest) *query.GetEventQuery {
+	return &query.GetEventQuery{
+		ID: req.Id,
+	}
+}
+
+func newReplayEventCommand(req *proto.ReplayEventRequest) *command.ReplayEventCommand {
+	return &command.ReplayEventCommand{
+		ID: req.Id,
+	}
+}
+
+func newPurgeEventsCommand(req *proto.PurgeEventsRequest) *command.PurgeEventsCommand {
+	return &command.PurgeEventsCommand{
+		Before: req.Before,
+		Types:  req.Types,
+	}
+}
+
+func newEventVO(dto *query.Event) *proto.Event {
+	event := &proto.Event{
+		Id:          dto.ID,
+		Type:        dto.Type,
+		Payload:     dto.Payload,
+		Status:      dto.Status,
+		Reason:      dto.Reason,
+		RetryCount:  int32(dto.RetryCount),
+		CreatedAt:   dto.CreatedAt.Unix(),
+		UpdatedAt:   dto.UpdatedAt.Unix(),
+		ScheduledAt: dto.ScheduledAt.Unix(),
+		LastError:   dto.LastError,
+		Attempts:    newEventAttemptsVO(dto.Attempts),
+		Owner:       dto.Owner,
+	}
+	if dto.LeaseExpireAt != nil {
+		event.LeaseExpireAt = dto.LeaseExpireAt.Unix()
+	}
+	return event
+}
+
+func newEventAttemptsVO(attempts []query.EventAttempt) []*proto.EventAttempt {
+	result := make([]*proto.EventAttempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = &proto.EventAttempt{
+			StartTime: attempt.StartTime.Unix(),
+			Duration:  attempt.Duration.Milliseconds(),
+			Failures:  make([]*proto.EventHandlerFailure, len(attempt.Failures)),
+		}
+		for j, failure := range attempt.Failures {
+			result[i].Failures[j] = &proto.EventHandlerFailure{
+				Handler: failure.Handler,
+				Error:   failure.Error,
+			}
+		}
+	}
+	return result
+}
+
+func newListEventsResponse(query *query.ListEventsQuery, list []*query.Event) *proto.ListEventsResponse {
+	items := make([]*proto.Event, len(list))
+	for i := range list {
+		items[i] = newEventVO(list[i])
+	}
+	return &proto.ListEventsResponse{
+		Page:  int32(query.Pg.GetPage()),
+		Size:  int32(query.Pg.GetSize()),
+		Items: items,
+	}
+}
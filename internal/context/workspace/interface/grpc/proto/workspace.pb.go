@@ -73,12 +73,15 @@ type Workspace struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Storage     *WorkspaceStorage      `protobuf:"bytes,6,opt,name=storage,proto3" json:"storage,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Storage       *WorkspaceStorage      `protobuf:"bytes,6,opt,name=storage,proto3" json:"storage,omitempty"`
+	EngineBackend string                 `protobuf:"bytes,7,opt,name=engineBackend,proto3" json:"engineBackend,omitempty"`
+	// owner is the user created the workspace, empty means visible to everyone
+	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
 func (x *Workspace) Reset() {
@@ -155,6 +158,20 @@ func (x *Workspace) GetStorage() *WorkspaceStorage {
 	return nil
 }
 
+func (x *Workspace) GetEngineBackend() string {
+	if x != nil {
+		return x.EngineBackend
+	}
+	return ""
+}
+
+func (x *Workspace) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
 type GetWorkspaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -207,9 +224,10 @@ type CreateWorkspaceRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Storage     *WorkspaceStorage `protobuf:"bytes,3,opt,name=storage,proto3" json:"storage,omitempty"`
+	Name          string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Storage       *WorkspaceStorage `protobuf:"bytes,3,opt,name=storage,proto3" json:"storage,omitempty"`
+	EngineBackend string            `protobuf:"bytes,4,opt,name=engineBackend,proto3" json:"engineBackend,omitempty"`
 }
 
 func (x *CreateWorkspaceRequest) Reset() {
@@ -265,6 +283,13 @@ func (x *CreateWorkspaceRequest) GetStorage() *WorkspaceStorage {
 	return nil
 }
 
+func (x *CreateWorkspaceRequest) GetEngineBackend() string {
+	if x != nil {
+		return x.EngineBackend
+	}
+	return ""
+}
+
 type ImportWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -474,7 +499,8 @@ type ImportWorkspaceResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobID string `protobuf:"bytes,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
 }
 
 func (x *ImportWorkspaceResponse) Reset() {
@@ -516,7 +542,14 @@ func (x *ImportWorkspaceResponse) GetId() string {
 	return ""
 }
 
-type DeleteWorkspaceRequest struct {
+func (x *ImportWorkspaceResponse) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+type GetImportJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -524,8 +557,8 @@ type DeleteWorkspaceRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteWorkspaceRequest) Reset() {
-	*x = DeleteWorkspaceRequest{}
+func (x *GetImportJobRequest) Reset() {
+	*x = GetImportJobRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -533,13 +566,13 @@ func (x *DeleteWorkspaceRequest) Reset() {
 	}
 }
 
-func (x *DeleteWorkspaceRequest) String() string {
+func (x *GetImportJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkspaceRequest) ProtoMessage() {}
+func (*GetImportJobRequest) ProtoMessage() {}
 
-func (x *DeleteWorkspaceRequest) ProtoReflect() protoreflect.Message {
+func (x *GetImportJobRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -551,26 +584,39 @@ func (x *DeleteWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWorkspaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetImportJobRequest.ProtoReflect.Descriptor instead.
+func (*GetImportJobRequest) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteWorkspaceRequest) GetId() string {
+func (x *GetImportJobRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteWorkspaceResponse struct {
+type ImportJob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *DeleteWorkspaceResponse) Reset() {
-	*x = DeleteWorkspaceResponse{}
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceID string                 `protobuf:"bytes,2,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	State       string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Components  []*ImportJobComponent  `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
+	Error       string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	FinishedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	// type is Import or Clone
+	Type string `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"`
+	// sourceWorkspaceID is the workspace cloned from
+	SourceWorkspaceID string `protobuf:"bytes,10,opt,name=sourceWorkspaceID,proto3" json:"sourceWorkspaceID,omitempty"`
+}
+
+func (x *ImportJob) Reset() {
+	*x = ImportJob{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -578,13 +624,13 @@ func (x *DeleteWorkspaceResponse) Reset() {
 	}
 }
 
-func (x *DeleteWorkspaceResponse) String() string {
+func (x *ImportJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkspaceResponse) ProtoMessage() {}
+func (*ImportJob) ProtoMessage() {}
 
-func (x *DeleteWorkspaceResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportJob) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,23 +642,93 @@ func (x *DeleteWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkspaceResponse.ProtoReflect.Descriptor instead.
-func (*DeleteWorkspaceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportJob.ProtoReflect.Descriptor instead.
+func (*ImportJob) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{10}
 }
 
-type UpdateWorkspaceRequest struct {
+func (x *ImportJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportJob) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *ImportJob) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ImportJob) GetComponents() []*ImportJobComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *ImportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ImportJob) GetSourceWorkspaceID() string {
+	if x != nil {
+		return x.SourceWorkspaceID
+	}
+	return ""
+}
+
+type ImportJobComponent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State   string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *UpdateWorkspaceRequest) Reset() {
-	*x = UpdateWorkspaceRequest{}
+func (x *ImportJobComponent) Reset() {
+	*x = ImportJobComponent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -620,13 +736,13 @@ func (x *UpdateWorkspaceRequest) Reset() {
 	}
 }
 
-func (x *UpdateWorkspaceRequest) String() string {
+func (x *ImportJobComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkspaceRequest) ProtoMessage() {}
+func (*ImportJobComponent) ProtoMessage() {}
 
-func (x *UpdateWorkspaceRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportJobComponent) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -638,40 +754,42 @@ func (x *UpdateWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWorkspaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportJobComponent.ProtoReflect.Descriptor instead.
+func (*ImportJobComponent) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UpdateWorkspaceRequest) GetId() string {
+func (x *ImportJobComponent) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateWorkspaceRequest) GetName() string {
+func (x *ImportJobComponent) GetState() string {
 	if x != nil {
-		return x.Name
+		return x.State
 	}
 	return ""
 }
 
-func (x *UpdateWorkspaceRequest) GetDescription() string {
+func (x *ImportJobComponent) GetMessage() string {
 	if x != nil {
-		return x.Description
+		return x.Message
 	}
 	return ""
 }
 
-type UpdateWorkspaceResponse struct {
+type GetImportJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Job *ImportJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (x *UpdateWorkspaceResponse) Reset() {
-	*x = UpdateWorkspaceResponse{}
+func (x *GetImportJobResponse) Reset() {
+	*x = GetImportJobResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -679,13 +797,13 @@ func (x *UpdateWorkspaceResponse) Reset() {
 	}
 }
 
-func (x *UpdateWorkspaceResponse) String() string {
+func (x *GetImportJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkspaceResponse) ProtoMessage() {}
+func (*GetImportJobResponse) ProtoMessage() {}
 
-func (x *UpdateWorkspaceResponse) ProtoReflect() protoreflect.Message {
+func (x *GetImportJobResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -697,26 +815,29 @@ func (x *UpdateWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkspaceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateWorkspaceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetImportJobResponse.ProtoReflect.Descriptor instead.
+func (*GetImportJobResponse) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{12}
 }
 
-type ListWorkspaceRequest struct {
+func (x *GetImportJobResponse) GetJob() *ImportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ExportWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page       int32    `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Size       int32    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	OrderBy    string   `protobuf:"bytes,3,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
-	SearchWord string   `protobuf:"bytes,4,opt,name=searchWord,proto3" json:"searchWord,omitempty"`
-	Exact      bool     `protobuf:"varint,5,opt,name=exact,proto3" json:"exact,omitempty"`
-	Ids        []string `protobuf:"bytes,6,rep,name=ids,proto3" json:"ids,omitempty"`
+	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeSubmissions bool   `protobuf:"varint,2,opt,name=includeSubmissions,proto3" json:"includeSubmissions,omitempty"`
 }
 
-func (x *ListWorkspaceRequest) Reset() {
-	*x = ListWorkspaceRequest{}
+func (x *ExportWorkspaceRequest) Reset() {
+	*x = ExportWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -724,13 +845,13 @@ func (x *ListWorkspaceRequest) Reset() {
 	}
 }
 
-func (x *ListWorkspaceRequest) String() string {
+func (x *ExportWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkspaceRequest) ProtoMessage() {}
+func (*ExportWorkspaceRequest) ProtoMessage() {}
 
-func (x *ListWorkspaceRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -742,66 +863,36 @@ func (x *ListWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*ListWorkspaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceRequest) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListWorkspaceRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListWorkspaceRequest) GetSize() int32 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *ListWorkspaceRequest) GetOrderBy() string {
-	if x != nil {
-		return x.OrderBy
-	}
-	return ""
-}
-
-func (x *ListWorkspaceRequest) GetSearchWord() string {
+func (x *ExportWorkspaceRequest) GetId() string {
 	if x != nil {
-		return x.SearchWord
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListWorkspaceRequest) GetExact() bool {
+func (x *ExportWorkspaceRequest) GetIncludeSubmissions() bool {
 	if x != nil {
-		return x.Exact
+		return x.IncludeSubmissions
 	}
 	return false
 }
 
-func (x *ListWorkspaceRequest) GetIds() []string {
-	if x != nil {
-		return x.Ids
-	}
-	return nil
-}
-
-type ListWorkspaceResponse struct {
+// ExportWorkspaceResponse is a chunk of the exported zip
+type ExportWorkspaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page  int32        `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Size  int32        `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Total int32        `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
-	Items []*Workspace `protobuf:"bytes,4,rep,name=Items,proto3" json:"Items,omitempty"`
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *ListWorkspaceResponse) Reset() {
-	*x = ListWorkspaceResponse{}
+func (x *ExportWorkspaceResponse) Reset() {
+	*x = ExportWorkspaceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -809,13 +900,13 @@ func (x *ListWorkspaceResponse) Reset() {
 	}
 }
 
-func (x *ListWorkspaceResponse) String() string {
+func (x *ExportWorkspaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkspaceResponse) ProtoMessage() {}
+func (*ExportWorkspaceResponse) ProtoMessage() {}
 
-func (x *ListWorkspaceResponse) ProtoReflect() protoreflect.Message {
+func (x *ExportWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -827,52 +918,34 @@ func (x *ListWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkspaceResponse.ProtoReflect.Descriptor instead.
-func (*ListWorkspaceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceResponse) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListWorkspaceResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListWorkspaceResponse) GetSize() int32 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *ListWorkspaceResponse) GetTotal() int32 {
+func (x *ExportWorkspaceResponse) GetContent() []byte {
 	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListWorkspaceResponse) GetItems() []*Workspace {
-	if x != nil {
-		return x.Items
+		return x.Content
 	}
 	return nil
 }
 
-type DataModel struct {
+// CloneWorkspaceRequest clones the workspace of id into a new workspace
+type CloneWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	RowCount int64  `protobuf:"varint,3,opt,name=rowCount,proto3" json:"rowCount,omitempty"`
-	Type     string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Storage     *WorkspaceStorage `protobuf:"bytes,4,opt,name=storage,proto3" json:"storage,omitempty"`
+	// includeStorage also copies the files on the storage of source workspace
+	IncludeStorage bool `protobuf:"varint,5,opt,name=includeStorage,proto3" json:"includeStorage,omitempty"`
 }
 
-func (x *DataModel) Reset() {
-	*x = DataModel{}
+func (x *CloneWorkspaceRequest) Reset() {
+	*x = CloneWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -880,13 +953,13 @@ func (x *DataModel) Reset() {
 	}
 }
 
-func (x *DataModel) String() string {
+func (x *CloneWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataModel) ProtoMessage() {}
+func (*CloneWorkspaceRequest) ProtoMessage() {}
 
-func (x *DataModel) ProtoReflect() protoreflect.Message {
+func (x *CloneWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -898,49 +971,57 @@ func (x *DataModel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataModel.ProtoReflect.Descriptor instead.
-func (*DataModel) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloneWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*CloneWorkspaceRequest) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DataModel) GetId() string {
+func (x *CloneWorkspaceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DataModel) GetName() string {
+func (x *CloneWorkspaceRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *DataModel) GetRowCount() int64 {
+func (x *CloneWorkspaceRequest) GetDescription() string {
 	if x != nil {
-		return x.RowCount
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *DataModel) GetType() string {
+func (x *CloneWorkspaceRequest) GetStorage() *WorkspaceStorage {
 	if x != nil {
-		return x.Type
+		return x.Storage
 	}
-	return ""
+	return nil
 }
 
-type Row struct {
+func (x *CloneWorkspaceRequest) GetIncludeStorage() bool {
+	if x != nil {
+		return x.IncludeStorage
+	}
+	return false
+}
+
+type CloneWorkspaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Grids []string `protobuf:"bytes,1,rep,name=grids,proto3" json:"grids,omitempty"`
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobID string `protobuf:"bytes,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
 }
 
-func (x *Row) Reset() {
-	*x = Row{}
+func (x *CloneWorkspaceResponse) Reset() {
+	*x = CloneWorkspaceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -948,13 +1029,13 @@ func (x *Row) Reset() {
 	}
 }
 
-func (x *Row) String() string {
+func (x *CloneWorkspaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Row) ProtoMessage() {}
+func (*CloneWorkspaceResponse) ProtoMessage() {}
 
-func (x *Row) ProtoReflect() protoreflect.Message {
+func (x *CloneWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -966,29 +1047,35 @@ func (x *Row) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Row.ProtoReflect.Descriptor instead.
-func (*Row) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloneWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*CloneWorkspaceResponse) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Row) GetGrids() []string {
+func (x *CloneWorkspaceResponse) GetId() string {
 	if x != nil {
-		return x.Grids
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetDataModelRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CloneWorkspaceResponse) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
 
-	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
-	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+type DeleteWorkspaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetDataModelRequest) Reset() {
-	*x = GetDataModelRequest{}
+func (x *DeleteWorkspaceRequest) Reset() {
+	*x = DeleteWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -996,13 +1083,13 @@ func (x *GetDataModelRequest) Reset() {
 	}
 }
 
-func (x *GetDataModelRequest) String() string {
+func (x *DeleteWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataModelRequest) ProtoMessage() {}
+func (*DeleteWorkspaceRequest) ProtoMessage() {}
 
-func (x *GetDataModelRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1014,36 +1101,26 @@ func (x *GetDataModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataModelRequest.ProtoReflect.Descriptor instead.
-func (*GetDataModelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceRequest) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetDataModelRequest) GetWorkspaceID() string {
-	if x != nil {
-		return x.WorkspaceID
-	}
-	return ""
-}
-
-func (x *GetDataModelRequest) GetId() string {
+func (x *DeleteWorkspaceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetDataModelResponse struct {
+type DeleteWorkspaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	DataModel *DataModel `protobuf:"bytes,1,opt,name=dataModel,proto3" json:"dataModel,omitempty"`
-	Headers   []string   `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
 }
 
-func (x *GetDataModelResponse) Reset() {
-	*x = GetDataModelResponse{}
+func (x *DeleteWorkspaceResponse) Reset() {
+	*x = DeleteWorkspaceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1051,13 +1128,13 @@ func (x *GetDataModelResponse) Reset() {
 	}
 }
 
-func (x *GetDataModelResponse) String() string {
+func (x *DeleteWorkspaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataModelResponse) ProtoMessage() {}
+func (*DeleteWorkspaceResponse) ProtoMessage() {}
 
-func (x *GetDataModelResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1069,39 +1146,24 @@ func (x *GetDataModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataModelResponse.ProtoReflect.Descriptor instead.
-func (*GetDataModelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceResponse) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetDataModelResponse) GetDataModel() *DataModel {
-	if x != nil {
-		return x.DataModel
-	}
-	return nil
-}
-
-func (x *GetDataModelResponse) GetHeaders() []string {
-	if x != nil {
-		return x.Headers
-	}
-	return nil
-}
-
-type ListDataModelsRequest struct {
+type UpdateWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceID string   `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
-	Types       []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
-	SearchWord  string   `protobuf:"bytes,3,opt,name=searchWord,proto3" json:"searchWord,omitempty"`
-	Exact       bool     `protobuf:"varint,4,opt,name=exact,proto3" json:"exact,omitempty"`
-	Ids         []string `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	EngineBackend *string `protobuf:"bytes,4,opt,name=engineBackend,proto3,oneof" json:"engineBackend,omitempty"` // empty string means using the default of server
 }
 
-func (x *ListDataModelsRequest) Reset() {
-	*x = ListDataModelsRequest{}
+func (x *UpdateWorkspaceRequest) Reset() {
+	*x = UpdateWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1109,13 +1171,13 @@ func (x *ListDataModelsRequest) Reset() {
 	}
 }
 
-func (x *ListDataModelsRequest) String() string {
+func (x *UpdateWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDataModelsRequest) ProtoMessage() {}
+func (*UpdateWorkspaceRequest) ProtoMessage() {}
 
-func (x *ListDataModelsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1127,56 +1189,47 @@ func (x *ListDataModelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDataModelsRequest.ProtoReflect.Descriptor instead.
-func (*ListDataModelsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceRequest) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListDataModelsRequest) GetWorkspaceID() string {
+func (x *UpdateWorkspaceRequest) GetId() string {
 	if x != nil {
-		return x.WorkspaceID
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListDataModelsRequest) GetTypes() []string {
-	if x != nil {
-		return x.Types
-	}
-	return nil
-}
-
-func (x *ListDataModelsRequest) GetSearchWord() string {
+func (x *UpdateWorkspaceRequest) GetName() string {
 	if x != nil {
-		return x.SearchWord
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListDataModelsRequest) GetExact() bool {
+func (x *UpdateWorkspaceRequest) GetDescription() string {
 	if x != nil {
-		return x.Exact
+		return x.Description
 	}
-	return false
+	return ""
 }
 
-func (x *ListDataModelsRequest) GetIds() []string {
-	if x != nil {
-		return x.Ids
+func (x *UpdateWorkspaceRequest) GetEngineBackend() string {
+	if x != nil && x.EngineBackend != nil {
+		return *x.EngineBackend
 	}
-	return nil
+	return ""
 }
 
-type ListDataModelsResponse struct {
+type UpdateWorkspaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Items []*DataModel `protobuf:"bytes,4,rep,name=Items,proto3" json:"Items,omitempty"`
 }
 
-func (x *ListDataModelsResponse) Reset() {
-	*x = ListDataModelsResponse{}
+func (x *UpdateWorkspaceResponse) Reset() {
+	*x = UpdateWorkspaceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1184,13 +1237,13 @@ func (x *ListDataModelsResponse) Reset() {
 	}
 }
 
-func (x *ListDataModelsResponse) String() string {
+func (x *UpdateWorkspaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDataModelsResponse) ProtoMessage() {}
+func (*UpdateWorkspaceResponse) ProtoMessage() {}
 
-func (x *ListDataModelsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1202,35 +1255,26 @@ func (x *ListDataModelsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDataModelsResponse.ProtoReflect.Descriptor instead.
-func (*ListDataModelsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceResponse) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListDataModelsResponse) GetItems() []*DataModel {
-	if x != nil {
-		return x.Items
-	}
-	return nil
-}
-
-type ListDataModelRowsRequest struct {
+type ListWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceID string   `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
-	Id          string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Page        int32    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Size        int32    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	OrderBy     string   `protobuf:"bytes,5,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
-	InSetIDs    []string `protobuf:"bytes,6,rep,name=inSetIDs,proto3" json:"inSetIDs,omitempty"`
-	SearchWord  string   `protobuf:"bytes,7,opt,name=searchWord,proto3" json:"searchWord,omitempty"`
-	RowIDs      []string `protobuf:"bytes,8,rep,name=rowIDs,proto3" json:"rowIDs,omitempty"`
+	Page       int32    `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size       int32    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	OrderBy    string   `protobuf:"bytes,3,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	SearchWord string   `protobuf:"bytes,4,opt,name=searchWord,proto3" json:"searchWord,omitempty"`
+	Exact      bool     `protobuf:"varint,5,opt,name=exact,proto3" json:"exact,omitempty"`
+	Ids        []string `protobuf:"bytes,6,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *ListDataModelRowsRequest) Reset() {
-	*x = ListDataModelRowsRequest{}
+func (x *ListWorkspaceRequest) Reset() {
+	*x = ListWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1238,13 +1282,13 @@ func (x *ListDataModelRowsRequest) Reset() {
 	}
 }
 
-func (x *ListDataModelRowsRequest) String() string {
+func (x *ListWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDataModelRowsRequest) ProtoMessage() {}
+func (*ListWorkspaceRequest) ProtoMessage() {}
 
-func (x *ListDataModelRowsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1256,81 +1300,66 @@ func (x *ListDataModelRowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDataModelRowsRequest.ProtoReflect.Descriptor instead.
-func (*ListDataModelRowsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceRequest) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListDataModelRowsRequest) GetWorkspaceID() string {
-	if x != nil {
-		return x.WorkspaceID
-	}
-	return ""
-}
-
-func (x *ListDataModelRowsRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ListDataModelRowsRequest) GetPage() int32 {
+func (x *ListWorkspaceRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ListDataModelRowsRequest) GetSize() int32 {
+func (x *ListWorkspaceRequest) GetSize() int32 {
 	if x != nil {
 		return x.Size
 	}
 	return 0
 }
 
-func (x *ListDataModelRowsRequest) GetOrderBy() string {
+func (x *ListWorkspaceRequest) GetOrderBy() string {
 	if x != nil {
 		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *ListDataModelRowsRequest) GetInSetIDs() []string {
+func (x *ListWorkspaceRequest) GetSearchWord() string {
 	if x != nil {
-		return x.InSetIDs
+		return x.SearchWord
 	}
-	return nil
+	return ""
 }
 
-func (x *ListDataModelRowsRequest) GetSearchWord() string {
+func (x *ListWorkspaceRequest) GetExact() bool {
 	if x != nil {
-		return x.SearchWord
+		return x.Exact
 	}
-	return ""
+	return false
 }
 
-func (x *ListDataModelRowsRequest) GetRowIDs() []string {
+func (x *ListWorkspaceRequest) GetIds() []string {
 	if x != nil {
-		return x.RowIDs
+		return x.Ids
 	}
 	return nil
 }
 
-type ListDataModelRowsResponse struct {
+type ListWorkspaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Headers []string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
-	Rows    []*Row   `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
-	Page    int32    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Size    int32    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	Total   int64    `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Page  int32        `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size  int32        `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Total int32        `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Items []*Workspace `protobuf:"bytes,4,rep,name=Items,proto3" json:"Items,omitempty"`
 }
 
-func (x *ListDataModelRowsResponse) Reset() {
-	*x = ListDataModelRowsResponse{}
+func (x *ListWorkspaceResponse) Reset() {
+	*x = ListWorkspaceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1338,13 +1367,13 @@ func (x *ListDataModelRowsResponse) Reset() {
 	}
 }
 
-func (x *ListDataModelRowsResponse) String() string {
+func (x *ListWorkspaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDataModelRowsResponse) ProtoMessage() {}
+func (*ListWorkspaceResponse) ProtoMessage() {}
 
-func (x *ListDataModelRowsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1356,60 +1385,52 @@ func (x *ListDataModelRowsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDataModelRowsResponse.ProtoReflect.Descriptor instead.
-func (*ListDataModelRowsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceResponse) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListDataModelRowsResponse) GetHeaders() []string {
-	if x != nil {
-		return x.Headers
-	}
-	return nil
-}
-
-func (x *ListDataModelRowsResponse) GetRows() []*Row {
-	if x != nil {
-		return x.Rows
-	}
-	return nil
-}
-
-func (x *ListDataModelRowsResponse) GetPage() int32 {
+func (x *ListWorkspaceResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ListDataModelRowsResponse) GetSize() int32 {
+func (x *ListWorkspaceResponse) GetSize() int32 {
 	if x != nil {
 		return x.Size
 	}
 	return 0
 }
 
-func (x *ListDataModelRowsResponse) GetTotal() int64 {
+func (x *ListWorkspaceResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-type PatchDataModelRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	WorkspaceID string   `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
-	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Async       bool     `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
-	Headers     []string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
-	Rows        []*Row   `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
+func (x *ListWorkspaceResponse) GetItems() []*Workspace {
+	if x != nil {
+		return x.Items
+	}
+	return nil
 }
 
-func (x *PatchDataModelRequest) Reset() {
-	*x = PatchDataModelRequest{}
+// WorkspaceMember is a user can access the workspace with role owner, writer or reader
+type WorkspaceMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName  string                 `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Role      string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *WorkspaceMember) Reset() {
+	*x = WorkspaceMember{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1417,13 +1438,13 @@ func (x *PatchDataModelRequest) Reset() {
 	}
 }
 
-func (x *PatchDataModelRequest) String() string {
+func (x *WorkspaceMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchDataModelRequest) ProtoMessage() {}
+func (*WorkspaceMember) ProtoMessage() {}
 
-func (x *PatchDataModelRequest) ProtoReflect() protoreflect.Message {
+func (x *WorkspaceMember) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1435,56 +1456,42 @@ func (x *PatchDataModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchDataModelRequest.ProtoReflect.Descriptor instead.
-func (*PatchDataModelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkspaceMember.ProtoReflect.Descriptor instead.
+func (*WorkspaceMember) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *PatchDataModelRequest) GetWorkspaceID() string {
+func (x *WorkspaceMember) GetUserName() string {
 	if x != nil {
-		return x.WorkspaceID
+		return x.UserName
 	}
 	return ""
 }
 
-func (x *PatchDataModelRequest) GetName() string {
+func (x *WorkspaceMember) GetRole() string {
 	if x != nil {
-		return x.Name
+		return x.Role
 	}
 	return ""
 }
 
-func (x *PatchDataModelRequest) GetAsync() bool {
-	if x != nil {
-		return x.Async
-	}
-	return false
-}
-
-func (x *PatchDataModelRequest) GetHeaders() []string {
-	if x != nil {
-		return x.Headers
-	}
-	return nil
-}
-
-func (x *PatchDataModelRequest) GetRows() []*Row {
+func (x *WorkspaceMember) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Rows
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type PatchDataModelResponse struct {
+type ListWorkspaceMembersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
 }
 
-func (x *PatchDataModelResponse) Reset() {
-	*x = PatchDataModelResponse{}
+func (x *ListWorkspaceMembersRequest) Reset() {
+	*x = ListWorkspaceMembersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1492,13 +1499,13 @@ func (x *PatchDataModelResponse) Reset() {
 	}
 }
 
-func (x *PatchDataModelResponse) String() string {
+func (x *ListWorkspaceMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchDataModelResponse) ProtoMessage() {}
+func (*ListWorkspaceMembersRequest) ProtoMessage() {}
 
-func (x *PatchDataModelResponse) ProtoReflect() protoreflect.Message {
+func (x *ListWorkspaceMembersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1510,31 +1517,28 @@ func (x *PatchDataModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchDataModelResponse.ProtoReflect.Descriptor instead.
-func (*PatchDataModelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListWorkspaceMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceMembersRequest) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *PatchDataModelResponse) GetId() string {
+func (x *ListWorkspaceMembersRequest) GetWorkspaceID() string {
 	if x != nil {
-		return x.Id
+		return x.WorkspaceID
 	}
 	return ""
 }
 
-type DeleteDataModelRequest struct {
+type ListWorkspaceMembersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceID string   `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
-	Id          string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Headers     []string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
-	RowIDs      []string `protobuf:"bytes,5,rep,name=rowIDs,proto3" json:"rowIDs,omitempty"`
+	Items []*WorkspaceMember `protobuf:"bytes,1,rep,name=Items,proto3" json:"Items,omitempty"`
 }
 
-func (x *DeleteDataModelRequest) Reset() {
-	*x = DeleteDataModelRequest{}
+func (x *ListWorkspaceMembersResponse) Reset() {
+	*x = ListWorkspaceMembersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1542,13 +1546,13 @@ func (x *DeleteDataModelRequest) Reset() {
 	}
 }
 
-func (x *DeleteDataModelRequest) String() string {
+func (x *ListWorkspaceMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDataModelRequest) ProtoMessage() {}
+func (*ListWorkspaceMembersResponse) ProtoMessage() {}
 
-func (x *DeleteDataModelRequest) ProtoReflect() protoreflect.Message {
+func (x *ListWorkspaceMembersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1560,62 +1564,104 @@ func (x *DeleteDataModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDataModelRequest.ProtoReflect.Descriptor instead.
-func (*DeleteDataModelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListWorkspaceMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceMembersResponse) Descriptor() ([]byte, []int) {
 	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DeleteDataModelRequest) GetWorkspaceID() string {
+func (x *ListWorkspaceMembersResponse) GetItems() []*WorkspaceMember {
 	if x != nil {
-		return x.WorkspaceID
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteDataModelRequest) GetId() string {
+type AddWorkspaceMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	UserName    string `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	Role        string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *AddWorkspaceMemberRequest) Reset() {
+	*x = AddWorkspaceMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddWorkspaceMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWorkspaceMemberRequest) ProtoMessage() {}
+
+func (x *AddWorkspaceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWorkspaceMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddWorkspaceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddWorkspaceMemberRequest) GetWorkspaceID() string {
 	if x != nil {
-		return x.Id
+		return x.WorkspaceID
 	}
 	return ""
 }
 
-func (x *DeleteDataModelRequest) GetHeaders() []string {
+func (x *AddWorkspaceMemberRequest) GetUserName() string {
 	if x != nil {
-		return x.Headers
+		return x.UserName
 	}
-	return nil
+	return ""
 }
 
-func (x *DeleteDataModelRequest) GetRowIDs() []string {
+func (x *AddWorkspaceMemberRequest) GetRole() string {
 	if x != nil {
-		return x.RowIDs
+		return x.Role
 	}
-	return nil
+	return ""
 }
 
-type DeleteDataModelResponse struct {
+type AddWorkspaceMemberResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteDataModelResponse) Reset() {
-	*x = DeleteDataModelResponse{}
+func (x *AddWorkspaceMemberResponse) Reset() {
+	*x = AddWorkspaceMemberResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[26]
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteDataModelResponse) String() string {
+func (x *AddWorkspaceMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDataModelResponse) ProtoMessage() {}
+func (*AddWorkspaceMemberResponse) ProtoMessage() {}
 
-func (x *DeleteDataModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[26]
+func (x *AddWorkspaceMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,37 +1672,38 @@ func (x *DeleteDataModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDataModelResponse.ProtoReflect.Descriptor instead.
-func (*DeleteDataModelResponse) Descriptor() ([]byte, []int) {
-	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AddWorkspaceMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddWorkspaceMemberResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{27}
 }
 
-type ListAllDataModelRowIDsRequest struct {
+type UpdateWorkspaceMemberRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
-	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	UserName    string `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	Role        string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *ListAllDataModelRowIDsRequest) Reset() {
-	*x = ListAllDataModelRowIDsRequest{}
+func (x *UpdateWorkspaceMemberRequest) Reset() {
+	*x = UpdateWorkspaceMemberRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[27]
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListAllDataModelRowIDsRequest) String() string {
+func (x *UpdateWorkspaceMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllDataModelRowIDsRequest) ProtoMessage() {}
+func (*UpdateWorkspaceMemberRequest) ProtoMessage() {}
 
-func (x *ListAllDataModelRowIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[27]
+func (x *UpdateWorkspaceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1667,50 +1714,55 @@ func (x *ListAllDataModelRowIDsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllDataModelRowIDsRequest.ProtoReflect.Descriptor instead.
-func (*ListAllDataModelRowIDsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use UpdateWorkspaceMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ListAllDataModelRowIDsRequest) GetWorkspaceID() string {
+func (x *UpdateWorkspaceMemberRequest) GetWorkspaceID() string {
 	if x != nil {
 		return x.WorkspaceID
 	}
 	return ""
 }
 
-func (x *ListAllDataModelRowIDsRequest) GetId() string {
+func (x *UpdateWorkspaceMemberRequest) GetUserName() string {
 	if x != nil {
-		return x.Id
+		return x.UserName
 	}
 	return ""
 }
 
-type ListAllDataModelRowIDsResponse struct {
+func (x *UpdateWorkspaceMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateWorkspaceMemberResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	RowIDs []string `protobuf:"bytes,1,rep,name=rowIDs,proto3" json:"rowIDs,omitempty"`
 }
 
-func (x *ListAllDataModelRowIDsResponse) Reset() {
-	*x = ListAllDataModelRowIDsResponse{}
+func (x *UpdateWorkspaceMemberResponse) Reset() {
+	*x = UpdateWorkspaceMemberResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[28]
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListAllDataModelRowIDsResponse) String() string {
+func (x *UpdateWorkspaceMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllDataModelRowIDsResponse) ProtoMessage() {}
+func (*UpdateWorkspaceMemberResponse) ProtoMessage() {}
 
-func (x *ListAllDataModelRowIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[28]
+func (x *UpdateWorkspaceMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1721,353 +1773,2839 @@ func (x *ListAllDataModelRowIDsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllDataModelRowIDsResponse.ProtoReflect.Descriptor instead.
-func (*ListAllDataModelRowIDsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UpdateWorkspaceMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceMemberResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListAllDataModelRowIDsResponse) GetRowIDs() []string {
-	if x != nil {
-		return x.RowIDs
-	}
-	return nil
+type DeleteWorkspaceMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	UserName    string `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
 }
 
-var File_internal_context_workspace_interface_grpc_proto_workspace_proto protoreflect.FileDescriptor
+func (x *DeleteWorkspaceMemberRequest) Reset() {
+	*x = DeleteWorkspaceMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-var file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDesc = []byte{
-	0x0a, 0x3f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0xf8, 0x01, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38,
-	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x22, 0x40, 0x0a, 0x10, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12,
-	0x2c, 0x0a, 0x03, 0x6e, 0x66, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x46, 0x53, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6e, 0x66, 0x73, 0x22, 0x33, 0x0a,
-	0x13, 0x4e, 0x46, 0x53, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61,
-	0x74, 0x68, 0x22, 0x29, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a,
-	0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a,
-	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a,
-	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57,
-	0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x7d, 0x0a, 0x15, 0x4c,
-	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x26, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x5f, 0x0a, 0x09, 0x44, 0x61,
-	0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
-	0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1b, 0x0a, 0x03, 0x52,
-	0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x05, 0x67, 0x72, 0x69, 0x64, 0x73, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
-	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x60, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x64, 0x61, 0x74,
-	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x09,
-	0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
-	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57,
-	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
-	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x40, 0x0a,
-	0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22,
-	0xe2, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x49, 0x44, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
-	0x77, 0x49, 0x44, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x04,
-	0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x9d, 0x01, 0x0a, 0x15, 0x50,
-	0x61, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73,
-	0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63,
-	0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f,
-	0x77, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x50, 0x61,
-	0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f,
-	0x77, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x77, 0x49,
-	0x44, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a,
-	0x1d, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x52, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x38, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x32, 0xfd, 0x03, 0x0a, 0x10, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x49, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
-	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
-	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x32, 0x96, 0x04, 0x0a, 0x10, 0x44,
-	0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x4f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x49, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x4c,
-	0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77, 0x73,
-	0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x61,
-	0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61,
-	0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x16, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f,
-	0x77, 0x49, 0x44, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77,
-	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *DeleteWorkspaceMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var (
-	file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescOnce sync.Once
-	file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescData = file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDesc
-)
+func (*DeleteWorkspaceMemberRequest) ProtoMessage() {}
 
-func file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP() []byte {
-	file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescOnce.Do(func() {
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescData)
-	})
-	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescData
+func (x *DeleteWorkspaceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
-var file_internal_context_workspace_interface_grpc_proto_workspace_proto_goTypes = []interface{}{
-	(*GetWorkspaceRequest)(nil),            // 0: proto.GetWorkspaceRequest
-	(*Workspace)(nil),                      // 1: proto.Workspace
-	(*GetWorkspaceResponse)(nil),           // 2: proto.GetWorkspaceResponse
-	(*CreateWorkspaceRequest)(nil),         // 3: proto.CreateWorkspaceRequest
-	(*ImportWorkspaceRequest)(nil),         // 4: proto.ImportWorkspaceRequest
-	(*WorkspaceStorage)(nil),               // 5: proto.WorkspaceStorage
-	(*NFSWorkspaceStorage)(nil),            // 6: proto.NFSWorkspaceStorage
-	(*CreateWorkspaceResponse)(nil),        // 7: proto.CreateWorkspaceResponse
-	(*ImportWorkspaceResponse)(nil),        // 8: proto.ImportWorkspaceResponse
-	(*DeleteWorkspaceRequest)(nil),         // 9: proto.DeleteWorkspaceRequest
-	(*DeleteWorkspaceResponse)(nil),        // 10: proto.DeleteWorkspaceResponse
-	(*UpdateWorkspaceRequest)(nil),         // 11: proto.UpdateWorkspaceRequest
-	(*UpdateWorkspaceResponse)(nil),        // 12: proto.UpdateWorkspaceResponse
-	(*ListWorkspaceRequest)(nil),           // 13: proto.ListWorkspaceRequest
-	(*ListWorkspaceResponse)(nil),          // 14: proto.ListWorkspaceResponse
-	(*DataModel)(nil),                      // 15: proto.DataModel
-	(*Row)(nil),                            // 16: proto.Row
-	(*GetDataModelRequest)(nil),            // 17: proto.GetDataModelRequest
-	(*GetDataModelResponse)(nil),           // 18: proto.GetDataModelResponse
-	(*ListDataModelsRequest)(nil),          // 19: proto.ListDataModelsRequest
-	(*ListDataModelsResponse)(nil),         // 20: proto.ListDataModelsResponse
-	(*ListDataModelRowsRequest)(nil),       // 21: proto.ListDataModelRowsRequest
-	(*ListDataModelRowsResponse)(nil),      // 22: proto.ListDataModelRowsResponse
-	(*PatchDataModelRequest)(nil),          // 23: proto.PatchDataModelRequest
-	(*PatchDataModelResponse)(nil),         // 24: proto.PatchDataModelResponse
-	(*DeleteDataModelRequest)(nil),         // 25: proto.DeleteDataModelRequest
-	(*DeleteDataModelResponse)(nil),        // 26: proto.DeleteDataModelResponse
-	(*ListAllDataModelRowIDsRequest)(nil),  // 27: proto.ListAllDataModelRowIDsRequest
-	(*ListAllDataModelRowIDsResponse)(nil), // 28: proto.ListAllDataModelRowIDsResponse
-	(*timestamppb.Timestamp)(nil),          // 29: google.protobuf.Timestamp
-}
-var file_internal_context_workspace_interface_grpc_proto_workspace_proto_depIdxs = []int32{
-	29, // 0: proto.Workspace.createdAt:type_name -> google.protobuf.Timestamp
-	29, // 1: proto.Workspace.updatedAt:type_name -> google.protobuf.Timestamp
-	5,  // 2: proto.Workspace.storage:type_name -> proto.WorkspaceStorage
-	1,  // 3: proto.GetWorkspaceResponse.workspace:type_name -> proto.Workspace
-	5,  // 4: proto.CreateWorkspaceRequest.storage:type_name -> proto.WorkspaceStorage
-	5,  // 5: proto.ImportWorkspaceRequest.storage:type_name -> proto.WorkspaceStorage
-	6,  // 6: proto.WorkspaceStorage.nfs:type_name -> proto.NFSWorkspaceStorage
-	1,  // 7: proto.ListWorkspaceResponse.Items:type_name -> proto.Workspace
-	15, // 8: proto.GetDataModelResponse.dataModel:type_name -> proto.DataModel
-	15, // 9: proto.ListDataModelsResponse.Items:type_name -> proto.DataModel
-	16, // 10: proto.ListDataModelRowsResponse.rows:type_name -> proto.Row
-	16, // 11: proto.PatchDataModelRequest.rows:type_name -> proto.Row
-	0,  // 12: proto.WorkspaceService.GetWorkspace:input_type -> proto.GetWorkspaceRequest
-	3,  // 13: proto.WorkspaceService.CreateWorkspace:input_type -> proto.CreateWorkspaceRequest
-	9,  // 14: proto.WorkspaceService.DeleteWorkspace:input_type -> proto.DeleteWorkspaceRequest
-	11, // 15: proto.WorkspaceService.UpdateWorkspace:input_type -> proto.UpdateWorkspaceRequest
-	13, // 16: proto.WorkspaceService.ListWorkspace:input_type -> proto.ListWorkspaceRequest
-	4,  // 17: proto.WorkspaceService.ImportWorkspace:input_type -> proto.ImportWorkspaceRequest
-	19, // 18: proto.DataModelService.ListDataModels:input_type -> proto.ListDataModelsRequest
-	17, // 19: proto.DataModelService.GetDataModel:input_type -> proto.GetDataModelRequest
-	21, // 20: proto.DataModelService.ListDataModelRows:input_type -> proto.ListDataModelRowsRequest
-	23, // 21: proto.DataModelService.PatchDataModel:input_type -> proto.PatchDataModelRequest
-	25, // 22: proto.DataModelService.DeleteDataModel:input_type -> proto.DeleteDataModelRequest
-	27, // 23: proto.DataModelService.ListAllDataModelRowIDs:input_type -> proto.ListAllDataModelRowIDsRequest
-	2,  // 24: proto.WorkspaceService.GetWorkspace:output_type -> proto.GetWorkspaceResponse
-	7,  // 25: proto.WorkspaceService.CreateWorkspace:output_type -> proto.CreateWorkspaceResponse
-	10, // 26: proto.WorkspaceService.DeleteWorkspace:output_type -> proto.DeleteWorkspaceResponse
-	12, // 27: proto.WorkspaceService.UpdateWorkspace:output_type -> proto.UpdateWorkspaceResponse
-	14, // 28: proto.WorkspaceService.ListWorkspace:output_type -> proto.ListWorkspaceResponse
-	8,  // 29: proto.WorkspaceService.ImportWorkspace:output_type -> proto.ImportWorkspaceResponse
-	20, // 30: proto.DataModelService.ListDataModels:output_type -> proto.ListDataModelsResponse
-	18, // 31: proto.DataModelService.GetDataModel:output_type -> proto.GetDataModelResponse
-	22, // 32: proto.DataModelService.ListDataModelRows:output_type -> proto.ListDataModelRowsResponse
-	24, // 33: proto.DataModelService.PatchDataModel:output_type -> proto.PatchDataModelResponse
-	26, // 34: proto.DataModelService.DeleteDataModel:output_type -> proto.DeleteDataModelResponse
-	28, // 35: proto.DataModelService.ListAllDataModelRowIDs:output_type -> proto.ListAllDataModelRowIDsResponse
-	24, // [24:36] is the sub-list for method output_type
-	12, // [12:24] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+// Deprecated: Use DeleteWorkspaceMemberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteWorkspaceMemberRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *DeleteWorkspaceMemberRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type DeleteWorkspaceMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteWorkspaceMemberResponse) Reset() {
+	*x = DeleteWorkspaceMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkspaceMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkspaceMemberResponse) ProtoMessage() {}
+
+func (x *DeleteWorkspaceMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkspaceMemberResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceMemberResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{31}
+}
+
+type DataModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RowCount int64  `protobuf:"varint,3,opt,name=rowCount,proto3" json:"rowCount,omitempty"`
+	Type     string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *DataModel) Reset() {
+	*x = DataModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataModel) ProtoMessage() {}
+
+func (x *DataModel) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataModel.ProtoReflect.Descriptor instead.
+func (*DataModel) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DataModel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataModel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DataModel) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *DataModel) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type Row struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grids []string `protobuf:"bytes,1,rep,name=grids,proto3" json:"grids,omitempty"`
+}
+
+func (x *Row) Reset() {
+	*x = Row{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Row) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Row) ProtoMessage() {}
+
+func (x *Row) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Row.ProtoReflect.Descriptor instead.
+func (*Row) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Row) GetGrids() []string {
+	if x != nil {
+		return x.Grids
+	}
+	return nil
+}
+
+type GetDataModelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetDataModelRequest) Reset() {
+	*x = GetDataModelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataModelRequest) ProtoMessage() {}
+
+func (x *GetDataModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataModelRequest.ProtoReflect.Descriptor instead.
+func (*GetDataModelRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetDataModelRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *GetDataModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDataModelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataModel *DataModel `protobuf:"bytes,1,opt,name=dataModel,proto3" json:"dataModel,omitempty"`
+	Headers   []string   `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (x *GetDataModelResponse) Reset() {
+	*x = GetDataModelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataModelResponse) ProtoMessage() {}
+
+func (x *GetDataModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataModelResponse.ProtoReflect.Descriptor instead.
+func (*GetDataModelResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetDataModelResponse) GetDataModel() *DataModel {
+	if x != nil {
+		return x.DataModel
+	}
+	return nil
+}
+
+func (x *GetDataModelResponse) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type ListDataModelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string   `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	Types       []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	SearchWord  string   `protobuf:"bytes,3,opt,name=searchWord,proto3" json:"searchWord,omitempty"`
+	Exact       bool     `protobuf:"varint,4,opt,name=exact,proto3" json:"exact,omitempty"`
+	Ids         []string `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *ListDataModelsRequest) Reset() {
+	*x = ListDataModelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDataModelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDataModelsRequest) ProtoMessage() {}
+
+func (x *ListDataModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDataModelsRequest.ProtoReflect.Descriptor instead.
+func (*ListDataModelsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListDataModelsRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *ListDataModelsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListDataModelsRequest) GetSearchWord() string {
+	if x != nil {
+		return x.SearchWord
+	}
+	return ""
+}
+
+func (x *ListDataModelsRequest) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *ListDataModelsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ListDataModelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*DataModel `protobuf:"bytes,4,rep,name=Items,proto3" json:"Items,omitempty"`
+}
+
+func (x *ListDataModelsResponse) Reset() {
+	*x = ListDataModelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDataModelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDataModelsResponse) ProtoMessage() {}
+
+func (x *ListDataModelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDataModelsResponse.ProtoReflect.Descriptor instead.
+func (*ListDataModelsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListDataModelsResponse) GetItems() []*DataModel {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ListDataModelRowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string   `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	Id          string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Page        int32    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size        int32    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	OrderBy     string   `protobuf:"bytes,5,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	InSetIDs    []string `protobuf:"bytes,6,rep,name=inSetIDs,proto3" json:"inSetIDs,omitempty"`
+	SearchWord  string   `protobuf:"bytes,7,opt,name=searchWord,proto3" json:"searchWord,omitempty"`
+	RowIDs      []string `protobuf:"bytes,8,rep,name=rowIDs,proto3" json:"rowIDs,omitempty"`
+	// where filters rows by conditions on columns, e.g. tissue = liver AND read_count > 1e6
+	Where string `protobuf:"bytes,9,opt,name=where,proto3" json:"where,omitempty"`
+}
+
+func (x *ListDataModelRowsRequest) Reset() {
+	*x = ListDataModelRowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDataModelRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDataModelRowsRequest) ProtoMessage() {}
+
+func (x *ListDataModelRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDataModelRowsRequest.ProtoReflect.Descriptor instead.
+func (*ListDataModelRowsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListDataModelRowsRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *ListDataModelRowsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListDataModelRowsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDataModelRowsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListDataModelRowsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListDataModelRowsRequest) GetInSetIDs() []string {
+	if x != nil {
+		return x.InSetIDs
+	}
+	return nil
+}
+
+func (x *ListDataModelRowsRequest) GetSearchWord() string {
+	if x != nil {
+		return x.SearchWord
+	}
+	return ""
+}
+
+func (x *ListDataModelRowsRequest) GetRowIDs() []string {
+	if x != nil {
+		return x.RowIDs
+	}
+	return nil
+}
+
+func (x *ListDataModelRowsRequest) GetWhere() string {
+	if x != nil {
+		return x.Where
+	}
+	return ""
+}
+
+type ListDataModelRowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Headers []string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	Rows    []*Row   `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	Page    int32    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size    int32    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Total   int64    `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	// headerTypes are the column types aligned with headers
+	HeaderTypes []string `protobuf:"bytes,6,rep,name=headerTypes,proto3" json:"headerTypes,omitempty"`
+}
+
+func (x *ListDataModelRowsResponse) Reset() {
+	*x = ListDataModelRowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDataModelRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDataModelRowsResponse) ProtoMessage() {}
+
+func (x *ListDataModelRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDataModelRowsResponse.ProtoReflect.Descriptor instead.
+func (*ListDataModelRowsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListDataModelRowsResponse) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *ListDataModelRowsResponse) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ListDataModelRowsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDataModelRowsResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListDataModelRowsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDataModelRowsResponse) GetHeaderTypes() []string {
+	if x != nil {
+		return x.HeaderTypes
+	}
+	return nil
+}
+
+type PatchDataModelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string   `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Async       bool     `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
+	Headers     []string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	Rows        []*Row   `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
+	// columnTypes declares the types of entity columns keyed by header, types of the others are inferred
+	ColumnTypes map[string]string `protobuf:"bytes,6,rep,name=columnTypes,proto3" json:"columnTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PatchDataModelRequest) Reset() {
+	*x = PatchDataModelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchDataModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchDataModelRequest) ProtoMessage() {}
+
+func (x *PatchDataModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchDataModelRequest.ProtoReflect.Descriptor instead.
+func (*PatchDataModelRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PatchDataModelRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *PatchDataModelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PatchDataModelRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *PatchDataModelRequest) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *PatchDataModelRequest) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *PatchDataModelRequest) GetColumnTypes() map[string]string {
+	if x != nil {
+		return x.ColumnTypes
+	}
+	return nil
+}
+
+type PatchDataModelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PatchDataModelResponse) Reset() {
+	*x = PatchDataModelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchDataModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchDataModelResponse) ProtoMessage() {}
+
+func (x *PatchDataModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchDataModelResponse.ProtoReflect.Descriptor instead.
+func (*PatchDataModelResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PatchDataModelResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteDataModelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string   `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	Id          string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Headers     []string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	RowIDs      []string `protobuf:"bytes,5,rep,name=rowIDs,proto3" json:"rowIDs,omitempty"`
+}
+
+func (x *DeleteDataModelRequest) Reset() {
+	*x = DeleteDataModelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDataModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDataModelRequest) ProtoMessage() {}
+
+func (x *DeleteDataModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDataModelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDataModelRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteDataModelRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *DeleteDataModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteDataModelRequest) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *DeleteDataModelRequest) GetRowIDs() []string {
+	if x != nil {
+		return x.RowIDs
+	}
+	return nil
+}
+
+type DeleteDataModelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteDataModelResponse) Reset() {
+	*x = DeleteDataModelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDataModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDataModelResponse) ProtoMessage() {}
+
+func (x *DeleteDataModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDataModelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDataModelResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{43}
+}
+
+type ListAllDataModelRowIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListAllDataModelRowIDsRequest) Reset() {
+	*x = ListAllDataModelRowIDsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllDataModelRowIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllDataModelRowIDsRequest) ProtoMessage() {}
+
+func (x *ListAllDataModelRowIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllDataModelRowIDsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllDataModelRowIDsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListAllDataModelRowIDsRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *ListAllDataModelRowIDsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAllDataModelRowIDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RowIDs []string `protobuf:"bytes,1,rep,name=rowIDs,proto3" json:"rowIDs,omitempty"`
+}
+
+func (x *ListAllDataModelRowIDsResponse) Reset() {
+	*x = ListAllDataModelRowIDsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllDataModelRowIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllDataModelRowIDsResponse) ProtoMessage() {}
+
+func (x *ListAllDataModelRowIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllDataModelRowIDsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllDataModelRowIDsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListAllDataModelRowIDsResponse) GetRowIDs() []string {
+	if x != nil {
+		return x.RowIDs
+	}
+	return nil
+}
+
+type ExportDataModelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// format is csv or tsv, csv by default
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// columns are the headers exported in order, all headers are exported if empty
+	Columns []string `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	// where filters rows by conditions on columns, e.g. tissue = liver AND read_count > 1e6
+	Where string `protobuf:"bytes,5,opt,name=where,proto3" json:"where,omitempty"`
+}
+
+func (x *ExportDataModelRequest) Reset() {
+	*x = ExportDataModelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportDataModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDataModelRequest) ProtoMessage() {}
+
+func (x *ExportDataModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDataModelRequest.ProtoReflect.Descriptor instead.
+func (*ExportDataModelRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportDataModelRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *ExportDataModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportDataModelRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportDataModelRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportDataModelRequest) GetWhere() string {
+	if x != nil {
+		return x.Where
+	}
+	return ""
+}
+
+// ExportDataModelResponse is a chunk of the exported file
+type ExportDataModelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *ExportDataModelResponse) Reset() {
+	*x = ExportDataModelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportDataModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDataModelResponse) ProtoMessage() {}
+
+func (x *ExportDataModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDataModelResponse.ProtoReflect.Descriptor instead.
+func (*ExportDataModelResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportDataModelResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type DataModelSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DataModelID string `protobuf:"bytes,2,opt,name=dataModelID,proto3" json:"dataModelID,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// auto marks the snapshot taken automatically before the data model is overwritten
+	Auto    bool     `protobuf:"varint,5,opt,name=auto,proto3" json:"auto,omitempty"`
+	Headers []string `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty"`
+	// headerTypes are the column types aligned with headers
+	HeaderTypes []string               `protobuf:"bytes,7,rep,name=headerTypes,proto3" json:"headerTypes,omitempty"`
+	RowCount    int64                  `protobuf:"varint,8,opt,name=rowCount,proto3" json:"rowCount,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *DataModelSnapshot) Reset() {
+	*x = DataModelSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataModelSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataModelSnapshot) ProtoMessage() {}
+
+func (x *DataModelSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataModelSnapshot.ProtoReflect.Descriptor instead.
+func (*DataModelSnapshot) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DataModelSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataModelSnapshot) GetDataModelID() string {
+	if x != nil {
+		return x.DataModelID
+	}
+	return ""
+}
+
+func (x *DataModelSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DataModelSnapshot) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DataModelSnapshot) GetAuto() bool {
+	if x != nil {
+		return x.Auto
+	}
+	return false
+}
+
+func (x *DataModelSnapshot) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *DataModelSnapshot) GetHeaderTypes() []string {
+	if x != nil {
+		return x.HeaderTypes
+	}
+	return nil
+}
+
+func (x *DataModelSnapshot) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *DataModelSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateDataModelSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	DataModelID string `protobuf:"bytes,2,opt,name=dataModelID,proto3" json:"dataModelID,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Auto        bool   `protobuf:"varint,5,opt,name=auto,proto3" json:"auto,omitempty"`
+}
+
+func (x *CreateDataModelSnapshotRequest) Reset() {
+	*x = CreateDataModelSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDataModelSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDataModelSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateDataModelSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDataModelSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateDataModelSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateDataModelSnapshotRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *CreateDataModelSnapshotRequest) GetDataModelID() string {
+	if x != nil {
+		return x.DataModelID
+	}
+	return ""
+}
+
+func (x *CreateDataModelSnapshotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDataModelSnapshotRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateDataModelSnapshotRequest) GetAuto() bool {
+	if x != nil {
+		return x.Auto
+	}
+	return false
+}
+
+type CreateDataModelSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateDataModelSnapshotResponse) Reset() {
+	*x = CreateDataModelSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDataModelSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDataModelSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateDataModelSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDataModelSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateDataModelSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateDataModelSnapshotResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDataModelSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	DataModelID string `protobuf:"bytes,2,opt,name=dataModelID,proto3" json:"dataModelID,omitempty"`
+}
+
+func (x *ListDataModelSnapshotsRequest) Reset() {
+	*x = ListDataModelSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDataModelSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDataModelSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListDataModelSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDataModelSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListDataModelSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListDataModelSnapshotsRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *ListDataModelSnapshotsRequest) GetDataModelID() string {
+	if x != nil {
+		return x.DataModelID
+	}
+	return ""
+}
+
+type ListDataModelSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*DataModelSnapshot `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ListDataModelSnapshotsResponse) Reset() {
+	*x = ListDataModelSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDataModelSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDataModelSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListDataModelSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDataModelSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListDataModelSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListDataModelSnapshotsResponse) GetItems() []*DataModelSnapshot {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetDataModelSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	DataModelID string `protobuf:"bytes,2,opt,name=dataModelID,proto3" json:"dataModelID,omitempty"`
+	Id          string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// rowIDs are the rows returned, all rows are returned if empty
+	RowIDs []string `protobuf:"bytes,4,rep,name=rowIDs,proto3" json:"rowIDs,omitempty"`
+}
+
+func (x *GetDataModelSnapshotRequest) Reset() {
+	*x = GetDataModelSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataModelSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataModelSnapshotRequest) ProtoMessage() {}
+
+func (x *GetDataModelSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataModelSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetDataModelSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetDataModelSnapshotRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *GetDataModelSnapshotRequest) GetDataModelID() string {
+	if x != nil {
+		return x.DataModelID
+	}
+	return ""
+}
+
+func (x *GetDataModelSnapshotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDataModelSnapshotRequest) GetRowIDs() []string {
+	if x != nil {
+		return x.RowIDs
+	}
+	return nil
+}
+
+type GetDataModelSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot *DataModelSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Rows     []*Row             `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *GetDataModelSnapshotResponse) Reset() {
+	*x = GetDataModelSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataModelSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataModelSnapshotResponse) ProtoMessage() {}
+
+func (x *GetDataModelSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataModelSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*GetDataModelSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetDataModelSnapshotResponse) GetSnapshot() *DataModelSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *GetDataModelSnapshotResponse) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type DiffDataModelSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	DataModelID string `protobuf:"bytes,2,opt,name=dataModelID,proto3" json:"dataModelID,omitempty"`
+	From        string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the snapshot compared with from, the current content of data model is compared if empty
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DiffDataModelSnapshotsRequest) Reset() {
+	*x = DiffDataModelSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffDataModelSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffDataModelSnapshotsRequest) ProtoMessage() {}
+
+func (x *DiffDataModelSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffDataModelSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*DiffDataModelSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DiffDataModelSnapshotsRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *DiffDataModelSnapshotsRequest) GetDataModelID() string {
+	if x != nil {
+		return x.DataModelID
+	}
+	return ""
+}
+
+func (x *DiffDataModelSnapshotsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DiffDataModelSnapshotsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type DataModelGridDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Column string `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	From   string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DataModelGridDiff) Reset() {
+	*x = DataModelGridDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataModelGridDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataModelGridDiff) ProtoMessage() {}
+
+func (x *DataModelGridDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataModelGridDiff.ProtoReflect.Descriptor instead.
+func (*DataModelGridDiff) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DataModelGridDiff) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *DataModelGridDiff) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DataModelGridDiff) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type DataModelRowDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RowID string               `protobuf:"bytes,1,opt,name=rowID,proto3" json:"rowID,omitempty"`
+	Grids []*DataModelGridDiff `protobuf:"bytes,2,rep,name=grids,proto3" json:"grids,omitempty"`
+}
+
+func (x *DataModelRowDiff) Reset() {
+	*x = DataModelRowDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataModelRowDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataModelRowDiff) ProtoMessage() {}
+
+func (x *DataModelRowDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataModelRowDiff.ProtoReflect.Descriptor instead.
+func (*DataModelRowDiff) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DataModelRowDiff) GetRowID() string {
+	if x != nil {
+		return x.RowID
+	}
+	return ""
+}
+
+func (x *DataModelRowDiff) GetGrids() []*DataModelGridDiff {
+	if x != nil {
+		return x.Grids
+	}
+	return nil
+}
+
+type DiffDataModelSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddedColumns   []string            `protobuf:"bytes,1,rep,name=addedColumns,proto3" json:"addedColumns,omitempty"`
+	RemovedColumns []string            `protobuf:"bytes,2,rep,name=removedColumns,proto3" json:"removedColumns,omitempty"`
+	AddedRowIDs    []string            `protobuf:"bytes,3,rep,name=addedRowIDs,proto3" json:"addedRowIDs,omitempty"`
+	RemovedRowIDs  []string            `protobuf:"bytes,4,rep,name=removedRowIDs,proto3" json:"removedRowIDs,omitempty"`
+	ChangedRows    []*DataModelRowDiff `protobuf:"bytes,5,rep,name=changedRows,proto3" json:"changedRows,omitempty"`
+}
+
+func (x *DiffDataModelSnapshotsResponse) Reset() {
+	*x = DiffDataModelSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffDataModelSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffDataModelSnapshotsResponse) ProtoMessage() {}
+
+func (x *DiffDataModelSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffDataModelSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*DiffDataModelSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DiffDataModelSnapshotsResponse) GetAddedColumns() []string {
+	if x != nil {
+		return x.AddedColumns
+	}
+	return nil
+}
+
+func (x *DiffDataModelSnapshotsResponse) GetRemovedColumns() []string {
+	if x != nil {
+		return x.RemovedColumns
+	}
+	return nil
+}
+
+func (x *DiffDataModelSnapshotsResponse) GetAddedRowIDs() []string {
+	if x != nil {
+		return x.AddedRowIDs
+	}
+	return nil
+}
+
+func (x *DiffDataModelSnapshotsResponse) GetRemovedRowIDs() []string {
+	if x != nil {
+		return x.RemovedRowIDs
+	}
+	return nil
+}
+
+func (x *DiffDataModelSnapshotsResponse) GetChangedRows() []*DataModelRowDiff {
+	if x != nil {
+		return x.ChangedRows
+	}
+	return nil
+}
+
+type RestoreDataModelSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	DataModelID string `protobuf:"bytes,2,opt,name=dataModelID,proto3" json:"dataModelID,omitempty"`
+	Id          string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreDataModelSnapshotRequest) Reset() {
+	*x = RestoreDataModelSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreDataModelSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDataModelSnapshotRequest) ProtoMessage() {}
+
+func (x *RestoreDataModelSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDataModelSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreDataModelSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RestoreDataModelSnapshotRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *RestoreDataModelSnapshotRequest) GetDataModelID() string {
+	if x != nil {
+		return x.DataModelID
+	}
+	return ""
+}
+
+func (x *RestoreDataModelSnapshotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreDataModelSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// backupSnapshotID is the snapshot of data model taken before restoring
+	BackupSnapshotID string `protobuf:"bytes,1,opt,name=backupSnapshotID,proto3" json:"backupSnapshotID,omitempty"`
+}
+
+func (x *RestoreDataModelSnapshotResponse) Reset() {
+	*x = RestoreDataModelSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreDataModelSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDataModelSnapshotResponse) ProtoMessage() {}
+
+func (x *RestoreDataModelSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDataModelSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestoreDataModelSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RestoreDataModelSnapshotResponse) GetBackupSnapshotID() string {
+	if x != nil {
+		return x.BackupSnapshotID
+	}
+	return ""
+}
+
+type DeleteDataModelSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceID string `protobuf:"bytes,1,opt,name=workspaceID,proto3" json:"workspaceID,omitempty"`
+	DataModelID string `protobuf:"bytes,2,opt,name=dataModelID,proto3" json:"dataModelID,omitempty"`
+	Id          string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteDataModelSnapshotRequest) Reset() {
+	*x = DeleteDataModelSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDataModelSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDataModelSnapshotRequest) ProtoMessage() {}
+
+func (x *DeleteDataModelSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDataModelSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDataModelSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteDataModelSnapshotRequest) GetWorkspaceID() string {
+	if x != nil {
+		return x.WorkspaceID
+	}
+	return ""
+}
+
+func (x *DeleteDataModelSnapshotRequest) GetDataModelID() string {
+	if x != nil {
+		return x.DataModelID
+	}
+	return ""
+}
+
+func (x *DeleteDataModelSnapshotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteDataModelSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteDataModelSnapshotResponse) Reset() {
+	*x = DeleteDataModelSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDataModelSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDataModelSnapshotResponse) ProtoMessage() {}
+
+func (x *DeleteDataModelSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDataModelSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDataModelSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP(), []int{62}
+}
+
+var File_internal_context_workspace_interface_grpc_proto_workspace_proto protoreflect.FileDescriptor
+
+var file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDesc = []byte{
+	0x0a, 0x3f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0xb4, 0x02, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x46, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22,
+	0xa7, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x31, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x16, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x22, 0x40, 0x0a,
+	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x2c, 0x0a, 0x03, 0x6e, 0x66, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x46, 0x53, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6e, 0x66, 0x73, 0x22,
+	0x33, 0x0a, 0x13, 0x4e, 0x46, 0x53, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x22, 0x29, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x3f, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44,
+	0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x96, 0x03, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44,
+	0x22, 0x58, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
+	0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x58, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x33, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x22, 0x3e, 0x0a, 0x16, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44,
+	0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x88, 0x01,
+	0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0,
+	0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x61,
+	0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x22, 0x7d, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x7b, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3f, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x22, 0x4c,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x6d, 0x0a, 0x19,
+	0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x41,
+	0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x70, 0x0a, 0x1c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x1c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x09, 0x44,
+	0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1b, 0x0a, 0x03,
+	0x52, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x69, 0x64, 0x73, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x60, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x64, 0x61,
+	0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x09, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x57, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x40,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0xf8, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x49, 0x44, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x6f, 0x77, 0x49, 0x44, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x15, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x12, 0x4f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x28, 0x0a, 0x16, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7c,
+	0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x22, 0x19, 0x0a, 0x17,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77, 0x49, 0x44,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x1e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f,
+	0x77, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x77, 0x49, 0x44, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x22, 0x33, 0x0a, 0x17, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa1,
+	0x02, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x75, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x61, 0x75, 0x74, 0x6f,
+	0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61,
+	0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x61,
+	0x75, 0x74, 0x6f, 0x22, 0x31, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x44, 0x22, 0x50, 0x0a, 0x1e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x89, 0x01,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x22, 0x74, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22,
+	0x87, 0x01, 0x0a, 0x1d, 0x44, 0x69, 0x66, 0x66, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x4f, 0x0a, 0x11, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x72, 0x69, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x10, 0x44, 0x61,
+	0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77, 0x44, 0x69, 0x66, 0x66, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x77, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x05, 0x67, 0x72, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x72, 0x69, 0x64, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x67,
+	0x72, 0x69, 0x64, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x1e, 0x44, 0x69, 0x66, 0x66, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x49,
+	0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x52,
+	0x6f, 0x77, 0x49, 0x44, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x52, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x52, 0x6f, 0x77, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x75, 0x0a, 0x1f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a,
+	0x20, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x44, 0x22, 0x74, 0x0a,
+	0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xfb, 0x08, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
+	0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x54, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x49, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x54, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0e, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x41,
+	0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64,
+	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x32, 0xe8, 0x09, 0x0a, 0x10, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x0e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44,
+	0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x52, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x12, 0x24,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x77,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a,
+	0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x25,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x67, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x16, 0x44,
+	0x69, 0x66, 0x66, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x25,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x09, 0x5a, 0x07, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescOnce sync.Once
+	file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescData = file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDesc
+)
+
+func file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescGZIP() []byte {
+	file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescOnce.Do(func() {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescData)
+	})
+	return file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDescData
+}
+
+var file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
+var file_internal_context_workspace_interface_grpc_proto_workspace_proto_goTypes = []interface{}{
+	(*GetWorkspaceRequest)(nil),              // 0: proto.GetWorkspaceRequest
+	(*Workspace)(nil),                        // 1: proto.Workspace
+	(*GetWorkspaceResponse)(nil),             // 2: proto.GetWorkspaceResponse
+	(*CreateWorkspaceRequest)(nil),           // 3: proto.CreateWorkspaceRequest
+	(*ImportWorkspaceRequest)(nil),           // 4: proto.ImportWorkspaceRequest
+	(*WorkspaceStorage)(nil),                 // 5: proto.WorkspaceStorage
+	(*NFSWorkspaceStorage)(nil),              // 6: proto.NFSWorkspaceStorage
+	(*CreateWorkspaceResponse)(nil),          // 7: proto.CreateWorkspaceResponse
+	(*ImportWorkspaceResponse)(nil),          // 8: proto.ImportWorkspaceResponse
+	(*GetImportJobRequest)(nil),              // 9: proto.GetImportJobRequest
+	(*ImportJob)(nil),                        // 10: proto.ImportJob
+	(*ImportJobComponent)(nil),               // 11: proto.ImportJobComponent
+	(*GetImportJobResponse)(nil),             // 12: proto.GetImportJobResponse
+	(*ExportWorkspaceRequest)(nil),           // 13: proto.ExportWorkspaceRequest
+	(*ExportWorkspaceResponse)(nil),          // 14: proto.ExportWorkspaceResponse
+	(*CloneWorkspaceRequest)(nil),            // 15: proto.CloneWorkspaceRequest
+	(*CloneWorkspaceResponse)(nil),           // 16: proto.CloneWorkspaceResponse
+	(*DeleteWorkspaceRequest)(nil),           // 17: proto.DeleteWorkspaceRequest
+	(*DeleteWorkspaceResponse)(nil),          // 18: proto.DeleteWorkspaceResponse
+	(*UpdateWorkspaceRequest)(nil),           // 19: proto.UpdateWorkspaceRequest
+	(*UpdateWorkspaceResponse)(nil),          // 20: proto.UpdateWorkspaceResponse
+	(*ListWorkspaceRequest)(nil),             // 21: proto.ListWorkspaceRequest
+	(*ListWorkspaceResponse)(nil),            // 22: proto.ListWorkspaceResponse
+	(*WorkspaceMember)(nil),                  // 23: proto.WorkspaceMember
+	(*ListWorkspaceMembersRequest)(nil),      // 24: proto.ListWorkspaceMembersRequest
+	(*ListWorkspaceMembersResponse)(nil),     // 25: proto.ListWorkspaceMembersResponse
+	(*AddWorkspaceMemberRequest)(nil),        // 26: proto.AddWorkspaceMemberRequest
+	(*AddWorkspaceMemberResponse)(nil),       // 27: proto.AddWorkspaceMemberResponse
+	(*UpdateWorkspaceMemberRequest)(nil),     // 28: proto.UpdateWorkspaceMemberRequest
+	(*UpdateWorkspaceMemberResponse)(nil),    // 29: proto.UpdateWorkspaceMemberResponse
+	(*DeleteWorkspaceMemberRequest)(nil),     // 30: proto.DeleteWorkspaceMemberRequest
+	(*DeleteWorkspaceMemberResponse)(nil),    // 31: proto.DeleteWorkspaceMemberResponse
+	(*DataModel)(nil),                        // 32: proto.DataModel
+	(*Row)(nil),                              // 33: proto.Row
+	(*GetDataModelRequest)(nil),              // 34: proto.GetDataModelRequest
+	(*GetDataModelResponse)(nil),             // 35: proto.GetDataModelResponse
+	(*ListDataModelsRequest)(nil),            // 36: proto.ListDataModelsRequest
+	(*ListDataModelsResponse)(nil),           // 37: proto.ListDataModelsResponse
+	(*ListDataModelRowsRequest)(nil),         // 38: proto.ListDataModelRowsRequest
+	(*ListDataModelRowsResponse)(nil),        // 39: proto.ListDataModelRowsResponse
+	(*PatchDataModelRequest)(nil),            // 40: proto.PatchDataModelRequest
+	(*PatchDataModelResponse)(nil),           // 41: proto.PatchDataModelResponse
+	(*DeleteDataModelRequest)(nil),           // 42: proto.DeleteDataModelRequest
+	(*DeleteDataModelResponse)(nil),          // 43: proto.DeleteDataModelResponse
+	(*ListAllDataModelRowIDsRequest)(nil),    // 44: proto.ListAllDataModelRowIDsRequest
+	(*ListAllDataModelRowIDsResponse)(nil),   // 45: proto.ListAllDataModelRowIDsResponse
+	(*ExportDataModelRequest)(nil),           // 46: proto.ExportDataModelRequest
+	(*ExportDataModelResponse)(nil),          // 47: proto.ExportDataModelResponse
+	(*DataModelSnapshot)(nil),                // 48: proto.DataModelSnapshot
+	(*CreateDataModelSnapshotRequest)(nil),   // 49: proto.CreateDataModelSnapshotRequest
+	(*CreateDataModelSnapshotResponse)(nil),  // 50: proto.CreateDataModelSnapshotResponse
+	(*ListDataModelSnapshotsRequest)(nil),    // 51: proto.ListDataModelSnapshotsRequest
+	(*ListDataModelSnapshotsResponse)(nil),   // 52: proto.ListDataModelSnapshotsResponse
+	(*GetDataModelSnapshotRequest)(nil),      // 53: proto.GetDataModelSnapshotRequest
+	(*GetDataModelSnapshotResponse)(nil),     // 54: proto.GetDataModelSnapshotResponse
+	(*DiffDataModelSnapshotsRequest)(nil),    // 55: proto.DiffDataModelSnapshotsRequest
+	(*DataModelGridDiff)(nil),                // 56: proto.DataModelGridDiff
+	(*DataModelRowDiff)(nil),                 // 57: proto.DataModelRowDiff
+	(*DiffDataModelSnapshotsResponse)(nil),   // 58: proto.DiffDataModelSnapshotsResponse
+	(*RestoreDataModelSnapshotRequest)(nil),  // 59: proto.RestoreDataModelSnapshotRequest
+	(*RestoreDataModelSnapshotResponse)(nil), // 60: proto.RestoreDataModelSnapshotResponse
+	(*DeleteDataModelSnapshotRequest)(nil),   // 61: proto.DeleteDataModelSnapshotRequest
+	(*DeleteDataModelSnapshotResponse)(nil),  // 62: proto.DeleteDataModelSnapshotResponse
+	nil,                                      // 63: proto.PatchDataModelRequest.ColumnTypesEntry
+	(*timestamppb.Timestamp)(nil),            // 64: google.protobuf.Timestamp
+}
+var file_internal_context_workspace_interface_grpc_proto_workspace_proto_depIdxs = []int32{
+	64, // 0: proto.Workspace.createdAt:type_name -> google.protobuf.Timestamp
+	64, // 1: proto.Workspace.updatedAt:type_name -> google.protobuf.Timestamp
+	5,  // 2: proto.Workspace.storage:type_name -> proto.WorkspaceStorage
+	1,  // 3: proto.GetWorkspaceResponse.workspace:type_name -> proto.Workspace
+	5,  // 4: proto.CreateWorkspaceRequest.storage:type_name -> proto.WorkspaceStorage
+	5,  // 5: proto.ImportWorkspaceRequest.storage:type_name -> proto.WorkspaceStorage
+	6,  // 6: proto.WorkspaceStorage.nfs:type_name -> proto.NFSWorkspaceStorage
+	11, // 7: proto.ImportJob.components:type_name -> proto.ImportJobComponent
+	64, // 8: proto.ImportJob.createdAt:type_name -> google.protobuf.Timestamp
+	64, // 9: proto.ImportJob.updatedAt:type_name -> google.protobuf.Timestamp
+	64, // 10: proto.ImportJob.finishedAt:type_name -> google.protobuf.Timestamp
+	10, // 11: proto.GetImportJobResponse.job:type_name -> proto.ImportJob
+	5,  // 12: proto.CloneWorkspaceRequest.storage:type_name -> proto.WorkspaceStorage
+	1,  // 13: proto.ListWorkspaceResponse.Items:type_name -> proto.Workspace
+	64, // 14: proto.WorkspaceMember.createdAt:type_name -> google.protobuf.Timestamp
+	23, // 15: proto.ListWorkspaceMembersResponse.Items:type_name -> proto.WorkspaceMember
+	32, // 16: proto.GetDataModelResponse.dataModel:type_name -> proto.DataModel
+	32, // 17: proto.ListDataModelsResponse.Items:type_name -> proto.DataModel
+	33, // 18: proto.ListDataModelRowsResponse.rows:type_name -> proto.Row
+	33, // 19: proto.PatchDataModelRequest.rows:type_name -> proto.Row
+	63, // 20: proto.PatchDataModelRequest.columnTypes:type_name -> proto.PatchDataModelRequest.ColumnTypesEntry
+	64, // 21: proto.DataModelSnapshot.createdAt:type_name -> google.protobuf.Timestamp
+	48, // 22: proto.ListDataModelSnapshotsResponse.items:type_name -> proto.DataModelSnapshot
+	48, // 23: proto.GetDataModelSnapshotResponse.snapshot:type_name -> proto.DataModelSnapshot
+	33, // 24: proto.GetDataModelSnapshotResponse.rows:type_name -> proto.Row
+	56, // 25: proto.DataModelRowDiff.grids:type_name -> proto.DataModelGridDiff
+	57, // 26: proto.DiffDataModelSnapshotsResponse.changedRows:type_name -> proto.DataModelRowDiff
+	0,  // 27: proto.WorkspaceService.GetWorkspace:input_type -> proto.GetWorkspaceRequest
+	3,  // 28: proto.WorkspaceService.CreateWorkspace:input_type -> proto.CreateWorkspaceRequest
+	17, // 29: proto.WorkspaceService.DeleteWorkspace:input_type -> proto.DeleteWorkspaceRequest
+	19, // 30: proto.WorkspaceService.UpdateWorkspace:input_type -> proto.UpdateWorkspaceRequest
+	21, // 31: proto.WorkspaceService.ListWorkspace:input_type -> proto.ListWorkspaceRequest
+	4,  // 32: proto.WorkspaceService.ImportWorkspace:input_type -> proto.ImportWorkspaceRequest
+	9,  // 33: proto.WorkspaceService.GetImportJob:input_type -> proto.GetImportJobRequest
+	13, // 34: proto.WorkspaceService.ExportWorkspace:input_type -> proto.ExportWorkspaceRequest
+	15, // 35: proto.WorkspaceService.CloneWorkspace:input_type -> proto.CloneWorkspaceRequest
+	24, // 36: proto.WorkspaceService.ListWorkspaceMembers:input_type -> proto.ListWorkspaceMembersRequest
+	26, // 37: proto.WorkspaceService.AddWorkspaceMember:input_type -> proto.AddWorkspaceMemberRequest
+	28, // 38: proto.WorkspaceService.UpdateWorkspaceMember:input_type -> proto.UpdateWorkspaceMemberRequest
+	30, // 39: proto.WorkspaceService.DeleteWorkspaceMember:input_type -> proto.DeleteWorkspaceMemberRequest
+	36, // 40: proto.DataModelService.ListDataModels:input_type -> proto.ListDataModelsRequest
+	34, // 41: proto.DataModelService.GetDataModel:input_type -> proto.GetDataModelRequest
+	38, // 42: proto.DataModelService.ListDataModelRows:input_type -> proto.ListDataModelRowsRequest
+	40, // 43: proto.DataModelService.PatchDataModel:input_type -> proto.PatchDataModelRequest
+	42, // 44: proto.DataModelService.DeleteDataModel:input_type -> proto.DeleteDataModelRequest
+	44, // 45: proto.DataModelService.ListAllDataModelRowIDs:input_type -> proto.ListAllDataModelRowIDsRequest
+	46, // 46: proto.DataModelService.ExportDataModel:input_type -> proto.ExportDataModelRequest
+	49, // 47: proto.DataModelService.CreateDataModelSnapshot:input_type -> proto.CreateDataModelSnapshotRequest
+	51, // 48: proto.DataModelService.ListDataModelSnapshots:input_type -> proto.ListDataModelSnapshotsRequest
+	53, // 49: proto.DataModelService.GetDataModelSnapshot:input_type -> proto.GetDataModelSnapshotRequest
+	55, // 50: proto.DataModelService.DiffDataModelSnapshots:input_type -> proto.DiffDataModelSnapshotsRequest
+	59, // 51: proto.DataModelService.RestoreDataModelSnapshot:input_type -> proto.RestoreDataModelSnapshotRequest
+	61, // 52: proto.DataModelService.DeleteDataModelSnapshot:input_type -> proto.DeleteDataModelSnapshotRequest
+	2,  // 53: proto.WorkspaceService.GetWorkspace:output_type -> proto.GetWorkspaceResponse
+	7,  // 54: proto.WorkspaceService.CreateWorkspace:output_type -> proto.CreateWorkspaceResponse
+	18, // 55: proto.WorkspaceService.DeleteWorkspace:output_type -> proto.DeleteWorkspaceResponse
+	20, // 56: proto.WorkspaceService.UpdateWorkspace:output_type -> proto.UpdateWorkspaceResponse
+	22, // 57: proto.WorkspaceService.ListWorkspace:output_type -> proto.ListWorkspaceResponse
+	8,  // 58: proto.WorkspaceService.ImportWorkspace:output_type -> proto.ImportWorkspaceResponse
+	12, // 59: proto.WorkspaceService.GetImportJob:output_type -> proto.GetImportJobResponse
+	14, // 60: proto.WorkspaceService.ExportWorkspace:output_type -> proto.ExportWorkspaceResponse
+	16, // 61: proto.WorkspaceService.CloneWorkspace:output_type -> proto.CloneWorkspaceResponse
+	25, // 62: proto.WorkspaceService.ListWorkspaceMembers:output_type -> proto.ListWorkspaceMembersResponse
+	27, // 63: proto.WorkspaceService.AddWorkspaceMember:output_type -> proto.AddWorkspaceMemberResponse
+	29, // 64: proto.WorkspaceService.UpdateWorkspaceMember:output_type -> proto.UpdateWorkspaceMemberResponse
+	31, // 65: proto.WorkspaceService.DeleteWorkspaceMember:output_type -> proto.DeleteWorkspaceMemberResponse
+	37, // 66: proto.DataModelService.ListDataModels:output_type -> proto.ListDataModelsResponse
+	35, // 67: proto.DataModelService.GetDataModel:output_type -> proto.GetDataModelResponse
+	39, // 68: proto.DataModelService.ListDataModelRows:output_type -> proto.ListDataModelRowsResponse
+	41, // 69: proto.DataModelService.PatchDataModel:output_type -> proto.PatchDataModelResponse
+	43, // 70: proto.DataModelService.DeleteDataModel:output_type -> proto.DeleteDataModelResponse
+	45, // 71: proto.DataModelService.ListAllDataModelRowIDs:output_type -> proto.ListAllDataModelRowIDsResponse
+	47, // 72: proto.DataModelService.ExportDataModel:output_type -> proto.ExportDataModelResponse
+	50, // 73: proto.DataModelService.CreateDataModelSnapshot:output_type -> proto.CreateDataModelSnapshotResponse
+	52, // 74: proto.DataModelService.ListDataModelSnapshots:output_type -> proto.ListDataModelSnapshotsResponse
+	54, // 75: proto.DataModelService.GetDataModelSnapshot:output_type -> proto.GetDataModelSnapshotResponse
+	58, // 76: proto.DataModelService.DiffDataModelSnapshots:output_type -> proto.DiffDataModelSnapshotsResponse
+	60, // 77: proto.DataModelService.RestoreDataModelSnapshot:output_type -> proto.RestoreDataModelSnapshotResponse
+	62, // 78: proto.DataModelService.DeleteDataModelSnapshot:output_type -> proto.DeleteDataModelSnapshotResponse
+	53, // [53:79] is the sub-list for method output_type
+	27, // [27:53] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_internal_context_workspace_interface_grpc_proto_workspace_proto_init() }
@@ -2088,8 +4626,188 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Workspace); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Workspace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceStorage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NFSWorkspaceStorage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkspaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportWorkspaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImportJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportJobComponent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImportJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportWorkspaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneWorkspaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2100,8 +4818,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkspaceResponse); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkspaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2112,8 +4830,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWorkspaceRequest); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkspaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2124,8 +4842,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportWorkspaceRequest); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkspaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2136,8 +4854,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceStorage); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkspaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2148,8 +4866,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NFSWorkspaceStorage); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2160,8 +4878,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWorkspaceResponse); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2172,8 +4890,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportWorkspaceResponse); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2184,8 +4902,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkspaceRequest); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2196,8 +4914,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkspaceResponse); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2208,8 +4926,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkspaceRequest); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWorkspaceMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2220,8 +4938,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkspaceResponse); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWorkspaceMemberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2232,8 +4950,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkspaceRequest); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkspaceMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2244,8 +4962,8 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkspaceResponse); i {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkspaceMemberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2256,7 +4974,31 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkspaceMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkspaceMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataModel); i {
 			case 0:
 				return &v.state
@@ -2268,7 +5010,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Row); i {
 			case 0:
 				return &v.state
@@ -2280,7 +5022,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDataModelRequest); i {
 			case 0:
 				return &v.state
@@ -2292,7 +5034,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDataModelResponse); i {
 			case 0:
 				return &v.state
@@ -2304,7 +5046,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDataModelsRequest); i {
 			case 0:
 				return &v.state
@@ -2316,7 +5058,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDataModelsResponse); i {
 			case 0:
 				return &v.state
@@ -2328,7 +5070,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDataModelRowsRequest); i {
 			case 0:
 				return &v.state
@@ -2340,7 +5082,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDataModelRowsResponse); i {
 			case 0:
 				return &v.state
@@ -2352,7 +5094,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PatchDataModelRequest); i {
 			case 0:
 				return &v.state
@@ -2364,7 +5106,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PatchDataModelResponse); i {
 			case 0:
 				return &v.state
@@ -2376,7 +5118,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteDataModelRequest); i {
 			case 0:
 				return &v.state
@@ -2388,7 +5130,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteDataModelResponse); i {
 			case 0:
 				return &v.state
@@ -2400,7 +5142,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAllDataModelRowIDsRequest); i {
 			case 0:
 				return &v.state
@@ -2412,7 +5154,7 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
-		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAllDataModelRowIDsResponse); i {
 			case 0:
 				return &v.state
@@ -2424,14 +5166,219 @@ func file_internal_context_workspace_interface_grpc_proto_workspace_proto_init()
 				return nil
 			}
 		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportDataModelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportDataModelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataModelSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDataModelSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDataModelSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDataModelSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDataModelSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataModelSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataModelSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffDataModelSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataModelGridDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataModelRowDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffDataModelSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreDataModelSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreDataModelSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDataModelSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDataModelSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_internal_context_workspace_interface_grpc_proto_workspace_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_context_workspace_interface_grpc_proto_workspace_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   29,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -19,12 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	WorkspaceService_GetWorkspace_FullMethodName    = "/proto.WorkspaceService/GetWorkspace"
-	WorkspaceService_CreateWorkspace_FullMethodName = "/proto.WorkspaceService/CreateWorkspace"
-	WorkspaceService_DeleteWorkspace_FullMethodName = "/proto.WorkspaceService/DeleteWorkspace"
-	WorkspaceService_UpdateWorkspace_FullMethodName = "/proto.WorkspaceService/UpdateWorkspace"
-	WorkspaceService_ListWorkspace_FullMethodName   = "/proto.WorkspaceService/ListWorkspace"
-	WorkspaceService_ImportWorkspace_FullMethodName = "/proto.WorkspaceService/ImportWorkspace"
+	WorkspaceService_GetWorkspace_FullMethodName          = "/proto.WorkspaceService/GetWorkspace"
+	WorkspaceService_CreateWorkspace_FullMethodName       = "/proto.WorkspaceService/CreateWorkspace"
+	WorkspaceService_DeleteWorkspace_FullMethodName       = "/proto.WorkspaceService/DeleteWorkspace"
+	WorkspaceService_UpdateWorkspace_FullMethodName       = "/proto.WorkspaceService/UpdateWorkspace"
+	WorkspaceService_ListWorkspace_FullMethodName         = "/proto.WorkspaceService/ListWorkspace"
+	WorkspaceService_ImportWorkspace_FullMethodName       = "/proto.WorkspaceService/ImportWorkspace"
+	WorkspaceService_GetImportJob_FullMethodName          = "/proto.WorkspaceService/GetImportJob"
+	WorkspaceService_ExportWorkspace_FullMethodName       = "/proto.WorkspaceService/ExportWorkspace"
+	WorkspaceService_CloneWorkspace_FullMethodName        = "/proto.WorkspaceService/CloneWorkspace"
+	WorkspaceService_ListWorkspaceMembers_FullMethodName  = "/proto.WorkspaceService/ListWorkspaceMembers"
+	WorkspaceService_AddWorkspaceMember_FullMethodName    = "/proto.WorkspaceService/AddWorkspaceMember"
+	WorkspaceService_UpdateWorkspaceMember_FullMethodName = "/proto.WorkspaceService/UpdateWorkspaceMember"
+	WorkspaceService_DeleteWorkspaceMember_FullMethodName = "/proto.WorkspaceService/DeleteWorkspaceMember"
 )
 
 // WorkspaceServiceClient is the client API for WorkspaceService service.
@@ -37,6 +44,13 @@ type WorkspaceServiceClient interface {
 	UpdateWorkspace(ctx context.Context, in *UpdateWorkspaceRequest, opts ...grpc.CallOption) (*UpdateWorkspaceResponse, error)
 	ListWorkspace(ctx context.Context, in *ListWorkspaceRequest, opts ...grpc.CallOption) (*ListWorkspaceResponse, error)
 	ImportWorkspace(ctx context.Context, opts ...grpc.CallOption) (WorkspaceService_ImportWorkspaceClient, error)
+	GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*GetImportJobResponse, error)
+	ExportWorkspace(ctx context.Context, in *ExportWorkspaceRequest, opts ...grpc.CallOption) (WorkspaceService_ExportWorkspaceClient, error)
+	CloneWorkspace(ctx context.Context, in *CloneWorkspaceRequest, opts ...grpc.CallOption) (*CloneWorkspaceResponse, error)
+	ListWorkspaceMembers(ctx context.Context, in *ListWorkspaceMembersRequest, opts ...grpc.CallOption) (*ListWorkspaceMembersResponse, error)
+	AddWorkspaceMember(ctx context.Context, in *AddWorkspaceMemberRequest, opts ...grpc.CallOption) (*AddWorkspaceMemberResponse, error)
+	UpdateWorkspaceMember(ctx context.Context, in *UpdateWorkspaceMemberRequest, opts ...grpc.CallOption) (*UpdateWorkspaceMemberResponse, error)
+	DeleteWorkspaceMember(ctx context.Context, in *DeleteWorkspaceMemberRequest, opts ...grpc.CallOption) (*DeleteWorkspaceMemberResponse, error)
 }
 
 type workspaceServiceClient struct {
@@ -126,6 +140,92 @@ func (x *workspaceServiceImportWorkspaceClient) CloseAndRecv() (*ImportWorkspace
 	return m, nil
 }
 
+func (c *workspaceServiceClient) GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*GetImportJobResponse, error) {
+	out := new(GetImportJobResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetImportJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ExportWorkspace(ctx context.Context, in *ExportWorkspaceRequest, opts ...grpc.CallOption) (WorkspaceService_ExportWorkspaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WorkspaceService_ServiceDesc.Streams[1], WorkspaceService_ExportWorkspace_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workspaceServiceExportWorkspaceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkspaceService_ExportWorkspaceClient interface {
+	Recv() (*ExportWorkspaceResponse, error)
+	grpc.ClientStream
+}
+
+type workspaceServiceExportWorkspaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *workspaceServiceExportWorkspaceClient) Recv() (*ExportWorkspaceResponse, error) {
+	m := new(ExportWorkspaceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workspaceServiceClient) CloneWorkspace(ctx context.Context, in *CloneWorkspaceRequest, opts ...grpc.CallOption) (*CloneWorkspaceResponse, error) {
+	out := new(CloneWorkspaceResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_CloneWorkspace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListWorkspaceMembers(ctx context.Context, in *ListWorkspaceMembersRequest, opts ...grpc.CallOption) (*ListWorkspaceMembersResponse, error) {
+	out := new(ListWorkspaceMembersResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListWorkspaceMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) AddWorkspaceMember(ctx context.Context, in *AddWorkspaceMemberRequest, opts ...grpc.CallOption) (*AddWorkspaceMemberResponse, error) {
+	out := new(AddWorkspaceMemberResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_AddWorkspaceMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) UpdateWorkspaceMember(ctx context.Context, in *UpdateWorkspaceMemberRequest, opts ...grpc.CallOption) (*UpdateWorkspaceMemberResponse, error) {
+	out := new(UpdateWorkspaceMemberResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_UpdateWorkspaceMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DeleteWorkspaceMember(ctx context.Context, in *DeleteWorkspaceMemberRequest, opts ...grpc.CallOption) (*DeleteWorkspaceMemberResponse, error) {
+	out := new(DeleteWorkspaceMemberResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_DeleteWorkspaceMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceServiceServer is the server API for WorkspaceService service.
 // All implementations must embed UnimplementedWorkspaceServiceServer
 // for forward compatibility
@@ -136,6 +236,13 @@ type WorkspaceServiceServer interface {
 	UpdateWorkspace(context.Context, *UpdateWorkspaceRequest) (*UpdateWorkspaceResponse, error)
 	ListWorkspace(context.Context, *ListWorkspaceRequest) (*ListWorkspaceResponse, error)
 	ImportWorkspace(WorkspaceService_ImportWorkspaceServer) error
+	GetImportJob(context.Context, *GetImportJobRequest) (*GetImportJobResponse, error)
+	ExportWorkspace(*ExportWorkspaceRequest, WorkspaceService_ExportWorkspaceServer) error
+	CloneWorkspace(context.Context, *CloneWorkspaceRequest) (*CloneWorkspaceResponse, error)
+	ListWorkspaceMembers(context.Context, *ListWorkspaceMembersRequest) (*ListWorkspaceMembersResponse, error)
+	AddWorkspaceMember(context.Context, *AddWorkspaceMemberRequest) (*AddWorkspaceMemberResponse, error)
+	UpdateWorkspaceMember(context.Context, *UpdateWorkspaceMemberRequest) (*UpdateWorkspaceMemberResponse, error)
+	DeleteWorkspaceMember(context.Context, *DeleteWorkspaceMemberRequest) (*DeleteWorkspaceMemberResponse, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
 
@@ -161,6 +268,27 @@ func (UnimplementedWorkspaceServiceServer) ListWorkspace(context.Context, *ListW
 func (UnimplementedWorkspaceServiceServer) ImportWorkspace(WorkspaceService_ImportWorkspaceServer) error {
 	return status.Errorf(codes.Unimplemented, "method ImportWorkspace not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) GetImportJob(context.Context, *GetImportJobRequest) (*GetImportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportJob not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ExportWorkspace(*ExportWorkspaceRequest, WorkspaceService_ExportWorkspaceServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportWorkspace not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) CloneWorkspace(context.Context, *CloneWorkspaceRequest) (*CloneWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneWorkspace not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListWorkspaceMembers(context.Context, *ListWorkspaceMembersRequest) (*ListWorkspaceMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceMembers not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) AddWorkspaceMember(context.Context, *AddWorkspaceMemberRequest) (*AddWorkspaceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorkspaceMember not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) UpdateWorkspaceMember(context.Context, *UpdateWorkspaceMemberRequest) (*UpdateWorkspaceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceMember not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DeleteWorkspaceMember(context.Context, *DeleteWorkspaceMemberRequest) (*DeleteWorkspaceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkspaceMember not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) mustEmbedUnimplementedWorkspaceServiceServer() {}
 
 // UnsafeWorkspaceServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -290,6 +418,135 @@ func (x *workspaceServiceImportWorkspaceServer) Recv() (*ImportWorkspaceRequest,
 	return m, nil
 }
 
+func _WorkspaceService_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetImportJob(ctx, req.(*GetImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ExportWorkspace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportWorkspaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkspaceServiceServer).ExportWorkspace(m, &workspaceServiceExportWorkspaceServer{stream})
+}
+
+type WorkspaceService_ExportWorkspaceServer interface {
+	Send(*ExportWorkspaceResponse) error
+	grpc.ServerStream
+}
+
+type workspaceServiceExportWorkspaceServer struct {
+	grpc.ServerStream
+}
+
+func (x *workspaceServiceExportWorkspaceServer) Send(m *ExportWorkspaceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkspaceService_CloneWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).CloneWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_CloneWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).CloneWorkspace(ctx, req.(*CloneWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListWorkspaceMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListWorkspaceMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListWorkspaceMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListWorkspaceMembers(ctx, req.(*ListWorkspaceMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_AddWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWorkspaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).AddWorkspaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_AddWorkspaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).AddWorkspaceMember(ctx, req.(*AddWorkspaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_UpdateWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).UpdateWorkspaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_UpdateWorkspaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).UpdateWorkspaceMember(ctx, req.(*UpdateWorkspaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DeleteWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkspaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DeleteWorkspaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_DeleteWorkspaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DeleteWorkspaceMember(ctx, req.(*DeleteWorkspaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,6 +574,30 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkspace",
 			Handler:    _WorkspaceService_ListWorkspace_Handler,
 		},
+		{
+			MethodName: "GetImportJob",
+			Handler:    _WorkspaceService_GetImportJob_Handler,
+		},
+		{
+			MethodName: "CloneWorkspace",
+			Handler:    _WorkspaceService_CloneWorkspace_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceMembers",
+			Handler:    _WorkspaceService_ListWorkspaceMembers_Handler,
+		},
+		{
+			MethodName: "AddWorkspaceMember",
+			Handler:    _WorkspaceService_AddWorkspaceMember_Handler,
+		},
+		{
+			MethodName: "UpdateWorkspaceMember",
+			Handler:    _WorkspaceService_UpdateWorkspaceMember_Handler,
+		},
+		{
+			MethodName: "DeleteWorkspaceMember",
+			Handler:    _WorkspaceService_DeleteWorkspaceMember_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -324,17 +605,29 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _WorkspaceService_ImportWorkspace_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ExportWorkspace",
+			Handler:       _WorkspaceService_ExportWorkspace_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "internal/context/workspace/interface/grpc/proto/workspace.proto",
 }
 
 const (
-	DataModelService_ListDataModels_FullMethodName         = "/proto.DataModelService/ListDataModels"
-	DataModelService_GetDataModel_FullMethodName           = "/proto.DataModelService/GetDataModel"
-	DataModelService_ListDataModelRows_FullMethodName      = "/proto.DataModelService/ListDataModelRows"
-	DataModelService_PatchDataModel_FullMethodName         = "/proto.DataModelService/PatchDataModel"
-	DataModelService_DeleteDataModel_FullMethodName        = "/proto.DataModelService/DeleteDataModel"
-	DataModelService_ListAllDataModelRowIDs_FullMethodName = "/proto.DataModelService/ListAllDataModelRowIDs"
+	DataModelService_ListDataModels_FullMethodName           = "/proto.DataModelService/ListDataModels"
+	DataModelService_GetDataModel_FullMethodName             = "/proto.DataModelService/GetDataModel"
+	DataModelService_ListDataModelRows_FullMethodName        = "/proto.DataModelService/ListDataModelRows"
+	DataModelService_PatchDataModel_FullMethodName           = "/proto.DataModelService/PatchDataModel"
+	DataModelService_DeleteDataModel_FullMethodName          = "/proto.DataModelService/DeleteDataModel"
+	DataModelService_ListAllDataModelRowIDs_FullMethodName   = "/proto.DataModelService/ListAllDataModelRowIDs"
+	DataModelService_ExportDataModel_FullMethodName          = "/proto.DataModelService/ExportDataModel"
+	DataModelService_CreateDataModelSnapshot_FullMethodName  = "/proto.DataModelService/CreateDataModelSnapshot"
+	DataModelService_ListDataModelSnapshots_FullMethodName   = "/proto.DataModelService/ListDataModelSnapshots"
+	DataModelService_GetDataModelSnapshot_FullMethodName     = "/proto.DataModelService/GetDataModelSnapshot"
+	DataModelService_DiffDataModelSnapshots_FullMethodName   = "/proto.DataModelService/DiffDataModelSnapshots"
+	DataModelService_RestoreDataModelSnapshot_FullMethodName = "/proto.DataModelService/RestoreDataModelSnapshot"
+	DataModelService_DeleteDataModelSnapshot_FullMethodName  = "/proto.DataModelService/DeleteDataModelSnapshot"
 )
 
 // DataModelServiceClient is the client API for DataModelService service.
@@ -347,6 +640,13 @@ type DataModelServiceClient interface {
 	PatchDataModel(ctx context.Context, in *PatchDataModelRequest, opts ...grpc.CallOption) (*PatchDataModelResponse, error)
 	DeleteDataModel(ctx context.Context, in *DeleteDataModelRequest, opts ...grpc.CallOption) (*DeleteDataModelResponse, error)
 	ListAllDataModelRowIDs(ctx context.Context, in *ListAllDataModelRowIDsRequest, opts ...grpc.CallOption) (*ListAllDataModelRowIDsResponse, error)
+	ExportDataModel(ctx context.Context, in *ExportDataModelRequest, opts ...grpc.CallOption) (DataModelService_ExportDataModelClient, error)
+	CreateDataModelSnapshot(ctx context.Context, in *CreateDataModelSnapshotRequest, opts ...grpc.CallOption) (*CreateDataModelSnapshotResponse, error)
+	ListDataModelSnapshots(ctx context.Context, in *ListDataModelSnapshotsRequest, opts ...grpc.CallOption) (*ListDataModelSnapshotsResponse, error)
+	GetDataModelSnapshot(ctx context.Context, in *GetDataModelSnapshotRequest, opts ...grpc.CallOption) (*GetDataModelSnapshotResponse, error)
+	DiffDataModelSnapshots(ctx context.Context, in *DiffDataModelSnapshotsRequest, opts ...grpc.CallOption) (*DiffDataModelSnapshotsResponse, error)
+	RestoreDataModelSnapshot(ctx context.Context, in *RestoreDataModelSnapshotRequest, opts ...grpc.CallOption) (*RestoreDataModelSnapshotResponse, error)
+	DeleteDataModelSnapshot(ctx context.Context, in *DeleteDataModelSnapshotRequest, opts ...grpc.CallOption) (*DeleteDataModelSnapshotResponse, error)
 }
 
 type dataModelServiceClient struct {
@@ -411,6 +711,92 @@ func (c *dataModelServiceClient) ListAllDataModelRowIDs(ctx context.Context, in
 	return out, nil
 }
 
+func (c *dataModelServiceClient) ExportDataModel(ctx context.Context, in *ExportDataModelRequest, opts ...grpc.CallOption) (DataModelService_ExportDataModelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DataModelService_ServiceDesc.Streams[0], DataModelService_ExportDataModel_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dataModelServiceExportDataModelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DataModelService_ExportDataModelClient interface {
+	Recv() (*ExportDataModelResponse, error)
+	grpc.ClientStream
+}
+
+type dataModelServiceExportDataModelClient struct {
+	grpc.ClientStream
+}
+
+func (x *dataModelServiceExportDataModelClient) Recv() (*ExportDataModelResponse, error) {
+	m := new(ExportDataModelResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dataModelServiceClient) CreateDataModelSnapshot(ctx context.Context, in *CreateDataModelSnapshotRequest, opts ...grpc.CallOption) (*CreateDataModelSnapshotResponse, error) {
+	out := new(CreateDataModelSnapshotResponse)
+	err := c.cc.Invoke(ctx, DataModelService_CreateDataModelSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataModelServiceClient) ListDataModelSnapshots(ctx context.Context, in *ListDataModelSnapshotsRequest, opts ...grpc.CallOption) (*ListDataModelSnapshotsResponse, error) {
+	out := new(ListDataModelSnapshotsResponse)
+	err := c.cc.Invoke(ctx, DataModelService_ListDataModelSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataModelServiceClient) GetDataModelSnapshot(ctx context.Context, in *GetDataModelSnapshotRequest, opts ...grpc.CallOption) (*GetDataModelSnapshotResponse, error) {
+	out := new(GetDataModelSnapshotResponse)
+	err := c.cc.Invoke(ctx, DataModelService_GetDataModelSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataModelServiceClient) DiffDataModelSnapshots(ctx context.Context, in *DiffDataModelSnapshotsRequest, opts ...grpc.CallOption) (*DiffDataModelSnapshotsResponse, error) {
+	out := new(DiffDataModelSnapshotsResponse)
+	err := c.cc.Invoke(ctx, DataModelService_DiffDataModelSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataModelServiceClient) RestoreDataModelSnapshot(ctx context.Context, in *RestoreDataModelSnapshotRequest, opts ...grpc.CallOption) (*RestoreDataModelSnapshotResponse, error) {
+	out := new(RestoreDataModelSnapshotResponse)
+	err := c.cc.Invoke(ctx, DataModelService_RestoreDataModelSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataModelServiceClient) DeleteDataModelSnapshot(ctx context.Context, in *DeleteDataModelSnapshotRequest, opts ...grpc.CallOption) (*DeleteDataModelSnapshotResponse, error) {
+	out := new(DeleteDataModelSnapshotResponse)
+	err := c.cc.Invoke(ctx, DataModelService_DeleteDataModelSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataModelServiceServer is the server API for DataModelService service.
 // All implementations must embed UnimplementedDataModelServiceServer
 // for forward compatibility
@@ -421,6 +807,13 @@ type DataModelServiceServer interface {
 	PatchDataModel(context.Context, *PatchDataModelRequest) (*PatchDataModelResponse, error)
 	DeleteDataModel(context.Context, *DeleteDataModelRequest) (*DeleteDataModelResponse, error)
 	ListAllDataModelRowIDs(context.Context, *ListAllDataModelRowIDsRequest) (*ListAllDataModelRowIDsResponse, error)
+	ExportDataModel(*ExportDataModelRequest, DataModelService_ExportDataModelServer) error
+	CreateDataModelSnapshot(context.Context, *CreateDataModelSnapshotRequest) (*CreateDataModelSnapshotResponse, error)
+	ListDataModelSnapshots(context.Context, *ListDataModelSnapshotsRequest) (*ListDataModelSnapshotsResponse, error)
+	GetDataModelSnapshot(context.Context, *GetDataModelSnapshotRequest) (*GetDataModelSnapshotResponse, error)
+	DiffDataModelSnapshots(context.Context, *DiffDataModelSnapshotsRequest) (*DiffDataModelSnapshotsResponse, error)
+	RestoreDataModelSnapshot(context.Context, *RestoreDataModelSnapshotRequest) (*RestoreDataModelSnapshotResponse, error)
+	DeleteDataModelSnapshot(context.Context, *DeleteDataModelSnapshotRequest) (*DeleteDataModelSnapshotResponse, error)
 	mustEmbedUnimplementedDataModelServiceServer()
 }
 
@@ -446,6 +839,27 @@ func (UnimplementedDataModelServiceServer) DeleteDataModel(context.Context, *Del
 func (UnimplementedDataModelServiceServer) ListAllDataModelRowIDs(context.Context, *ListAllDataModelRowIDsRequest) (*ListAllDataModelRowIDsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllDataModelRowIDs not implemented")
 }
+func (UnimplementedDataModelServiceServer) ExportDataModel(*ExportDataModelRequest, DataModelService_ExportDataModelServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDataModel not implemented")
+}
+func (UnimplementedDataModelServiceServer) CreateDataModelSnapshot(context.Context, *CreateDataModelSnapshotRequest) (*CreateDataModelSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDataModelSnapshot not implemented")
+}
+func (UnimplementedDataModelServiceServer) ListDataModelSnapshots(context.Context, *ListDataModelSnapshotsRequest) (*ListDataModelSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDataModelSnapshots not implemented")
+}
+func (UnimplementedDataModelServiceServer) GetDataModelSnapshot(context.Context, *GetDataModelSnapshotRequest) (*GetDataModelSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataModelSnapshot not implemented")
+}
+func (UnimplementedDataModelServiceServer) DiffDataModelSnapshots(context.Context, *DiffDataModelSnapshotsRequest) (*DiffDataModelSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffDataModelSnapshots not implemented")
+}
+func (UnimplementedDataModelServiceServer) RestoreDataModelSnapshot(context.Context, *RestoreDataModelSnapshotRequest) (*RestoreDataModelSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDataModelSnapshot not implemented")
+}
+func (UnimplementedDataModelServiceServer) DeleteDataModelSnapshot(context.Context, *DeleteDataModelSnapshotRequest) (*DeleteDataModelSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDataModelSnapshot not implemented")
+}
 func (UnimplementedDataModelServiceServer) mustEmbedUnimplementedDataModelServiceServer() {}
 
 // UnsafeDataModelServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -567,6 +981,135 @@ func _DataModelService_ListAllDataModelRowIDs_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataModelService_ExportDataModel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDataModelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataModelServiceServer).ExportDataModel(m, &dataModelServiceExportDataModelServer{stream})
+}
+
+type DataModelService_ExportDataModelServer interface {
+	Send(*ExportDataModelResponse) error
+	grpc.ServerStream
+}
+
+type dataModelServiceExportDataModelServer struct {
+	grpc.ServerStream
+}
+
+func (x *dataModelServiceExportDataModelServer) Send(m *ExportDataModelResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DataModelService_CreateDataModelSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDataModelSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataModelServiceServer).CreateDataModelSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataModelService_CreateDataModelSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataModelServiceServer).CreateDataModelSnapshot(ctx, req.(*CreateDataModelSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataModelService_ListDataModelSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDataModelSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataModelServiceServer).ListDataModelSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataModelService_ListDataModelSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataModelServiceServer).ListDataModelSnapshots(ctx, req.(*ListDataModelSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataModelService_GetDataModelSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataModelSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataModelServiceServer).GetDataModelSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataModelService_GetDataModelSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataModelServiceServer).GetDataModelSnapshot(ctx, req.(*GetDataModelSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataModelService_DiffDataModelSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffDataModelSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataModelServiceServer).DiffDataModelSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataModelService_DiffDataModelSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataModelServiceServer).DiffDataModelSnapshots(ctx, req.(*DiffDataModelSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataModelService_RestoreDataModelSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDataModelSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataModelServiceServer).RestoreDataModelSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataModelService_RestoreDataModelSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataModelServiceServer).RestoreDataModelSnapshot(ctx, req.(*RestoreDataModelSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataModelService_DeleteDataModelSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDataModelSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataModelServiceServer).DeleteDataModelSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataModelService_DeleteDataModelSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataModelServiceServer).DeleteDataModelSnapshot(ctx, req.(*DeleteDataModelSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataModelService_ServiceDesc is the grpc.ServiceDesc for DataModelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -598,7 +1141,37 @@ var DataModelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAllDataModelRowIDs",
 			Handler:    _DataModelService_ListAllDataModelRowIDs_Handler,
 		},
+		{
+			MethodName: "CreateDataModelSnapshot",
+			Handler:    _DataModelService_CreateDataModelSnapshot_Handler,
+		},
+		{
+			MethodName: "ListDataModelSnapshots",
+			Handler:    _DataModelService_ListDataModelSnapshots_Handler,
+		},
+		{
+			MethodName: "GetDataModelSnapshot",
+			Handler:    _DataModelService_GetDataModelSnapshot_Handler,
+		},
+		{
+			MethodName: "DiffDataModelSnapshots",
+			Handler:    _DataModelService_DiffDataModelSnapshots_Handler,
+		},
+		{
+			MethodName: "RestoreDataModelSnapshot",
+			Handler:    _DataModelService_RestoreDataModelSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteDataModelSnapshot",
+			Handler:    _DataModelService_DeleteDataModelSnapshot_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportDataModel",
+			Handler:       _DataModelService_ExportDataModel_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/context/workspace/interface/grpc/proto/workspace.proto",
 }
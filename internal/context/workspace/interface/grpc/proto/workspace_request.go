@@ -0,0 +1,27 @@
+package proto
+
+// GetWorkspaceID returns the id of workspace requested, the requests on a workspace are authorized
+// by the membership of user.
+func (x *GetWorkspaceRequest) GetWorkspaceID() string {
+	return x.GetId()
+}
+
+// GetWorkspaceID returns the id of workspace requested.
+func (x *DeleteWorkspaceRequest) GetWorkspaceID() string {
+	return x.GetId()
+}
+
+// GetWorkspaceID returns the id of workspace requested.
+func (x *UpdateWorkspaceRequest) GetWorkspaceID() string {
+	return x.GetId()
+}
+
+// GetWorkspaceID returns the id of workspace requested.
+func (x *ExportWorkspaceRequest) GetWorkspaceID() string {
+	return x.GetId()
+}
+
+// GetWorkspaceID returns the id of workspace cloned from.
+func (x *CloneWorkspaceRequest) GetWorkspaceID() string {
+	return x.GetId()
+}
@@ -18,9 +18,13 @@ import (
 	pb "github.com/Bio-OS/bioos/internal/context/workspace/interface/grpc/proto"
 	"github.com/Bio-OS/bioos/pkg/consts"
 	"github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/middlewares"
 	"github.com/Bio-OS/bioos/pkg/utils"
 )
 
+// exportChunkSize is the max size of content in each response of export
+const exportChunkSize = 64 * 1024
+
 // todo move datamodel seperately
 type workspaceServer struct {
 	pb.UnimplementedWorkspaceServiceServer
@@ -60,18 +64,21 @@ func (s *workspaceServer) GetWorkspace(ctx context.Context, r *pb.GetWorkspaceRe
 	}
 	return &pb.GetWorkspaceResponse{
 		Workspace: &pb.Workspace{
-			Id:          workspace.ID,
-			Name:        workspace.Name,
-			Description: workspace.Description,
-			CreatedAt:   timestamppb.New(workspace.CreatedAt),
-			UpdatedAt:   timestamppb.New(workspace.UpdatedAt),
-			Storage:     storage,
+			Id:            workspace.ID,
+			Name:          workspace.Name,
+			Description:   workspace.Description,
+			CreatedAt:     timestamppb.New(workspace.CreatedAt),
+			UpdatedAt:     timestamppb.New(workspace.UpdatedAt),
+			Storage:       storage,
+			EngineBackend: workspace.EngineBackend,
+			Owner:         workspace.Owner,
 		},
 	}, nil
 }
 
 func (s *workspaceServer) CreateWorkspace(ctx context.Context, r *pb.CreateWorkspaceRequest) (*pb.CreateWorkspaceResponse, error) {
 	cmd := createWorkspaceVoToDto(r)
+	cmd.Owner = middlewares.UserNameFromCtx(ctx)
 	id, err := s.workspaceService.WorkspaceCommands.CreateWorkspace.Handle(ctx, cmd)
 	if err != nil {
 		return nil, utils.ToGRPCError(err)
@@ -103,9 +110,10 @@ func (s *workspaceServer) UpdateWorkspace(ctx context.Context, r *pb.UpdateWorks
 		description = utils.PointString(r.GetDescription())
 	}
 	cmd := &command.UpdateWorkspaceCommand{
-		ID:          r.GetId(),
-		Name:        name,
-		Description: description,
+		ID:            r.GetId(),
+		Name:          name,
+		Description:   description,
+		EngineBackend: r.EngineBackend,
 	}
 	err := s.workspaceService.WorkspaceCommands.UpdateWorkspace.Handle(ctx, cmd)
 	if err != nil {
@@ -119,6 +127,7 @@ func (s *workspaceServer) ListWorkspace(ctx context.Context, r *pb.ListWorkspace
 	if err != nil {
 		return nil, utils.ToGRPCError(err)
 	}
+	listWorkspaceDto.Filter.UserName = middlewares.UserNameFromCtx(ctx)
 	workspaceDtos, total, err := s.workspaceService.WorkspaceQueries.ListWorkspaces.Handle(ctx, listWorkspaceDto)
 	if err != nil {
 		return nil, utils.ToGRPCError(err)
@@ -154,13 +163,14 @@ func (s *workspaceServer) ImportWorkspace(stream pb.WorkspaceService_ImportWorks
 		r, err = stream.Recv()
 		if cmd == nil {
 			cmd = importWorkspaceVoToDto(r)
+			cmd.Owner = middlewares.UserNameFromCtx(stream.Context())
 		}
 		if err == io.EOF {
-			err = s.workspaceService.WorkspaceCommands.ImportWorkspace.Handle(stream.Context(), cmd)
+			jobID, err := s.workspaceService.WorkspaceCommands.ImportWorkspace.Handle(stream.Context(), cmd)
 			if err != nil {
 				return utils.ToGRPCError(err)
 			}
-			return stream.SendAndClose(&pb.ImportWorkspaceResponse{Id: cmd.ID})
+			return stream.SendAndClose(&pb.ImportWorkspaceResponse{Id: cmd.ID, JobID: jobID})
 		}
 		if err != nil {
 			return utils.ToGRPCError(err)
@@ -201,6 +211,100 @@ func (s *workspaceServer) ImportWorkspace(stream pb.WorkspaceService_ImportWorks
 	}
 }
 
+func (s *workspaceServer) GetImportJob(ctx context.Context, r *pb.GetImportJobRequest) (*pb.GetImportJobResponse, error) {
+	job, err := s.workspaceService.WorkspaceQueries.GetImportJob.Handle(ctx, &query.GetImportJobQuery{ID: r.GetId()})
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return &pb.GetImportJobResponse{Job: importJobDtoToVo(job)}, nil
+}
+
+func (s *workspaceServer) CloneWorkspace(ctx context.Context, r *pb.CloneWorkspaceRequest) (*pb.CloneWorkspaceResponse, error) {
+	cmd := cloneWorkspaceVoToDto(r)
+	cmd.Owner = middlewares.UserNameFromCtx(ctx)
+	id, jobID, err := s.workspaceService.WorkspaceCommands.CloneWorkspace.Handle(ctx, cmd)
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return &pb.CloneWorkspaceResponse{Id: id, JobID: jobID}, nil
+}
+
+func (s *workspaceServer) ExportWorkspace(r *pb.ExportWorkspaceRequest, stream pb.WorkspaceService_ExportWorkspaceServer) error {
+	// send the zip in chunks instead of each small write of zip writer
+	writer := bufio.NewWriterSize(&exportStreamWriter{send: func(content []byte) error {
+		return stream.Send(&pb.ExportWorkspaceResponse{Content: content})
+	}}, exportChunkSize)
+	err := s.workspaceService.WorkspaceCommands.ExportWorkspace.Handle(stream.Context(), &command.ExportWorkspaceCommand{
+		ID:                 r.GetId(),
+		IncludeSubmissions: r.GetIncludeSubmissions(),
+	}, writer)
+	if err != nil {
+		return utils.ToGRPCError(err)
+	}
+	return writer.Flush()
+}
+
+// exportStreamWriter sends each write as a response of stream.
+type exportStreamWriter struct {
+	send func(content []byte) error
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	if err := w.send(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *workspaceServer) ListWorkspaceMembers(ctx context.Context, r *pb.ListWorkspaceMembersRequest) (*pb.ListWorkspaceMembersResponse, error) {
+	members, err := s.workspaceService.WorkspaceQueries.ListMembers.Handle(ctx, &query.ListWorkspaceMembersQuery{
+		WorkspaceID: r.GetWorkspaceID(),
+	})
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	items := make([]*pb.WorkspaceMember, len(members))
+	for i, member := range members {
+		items[i] = workspaceMemberDtoToVo(member)
+	}
+	return &pb.ListWorkspaceMembersResponse{Items: items}, nil
+}
+
+func (s *workspaceServer) AddWorkspaceMember(ctx context.Context, r *pb.AddWorkspaceMemberRequest) (*pb.AddWorkspaceMemberResponse, error) {
+	err := s.workspaceService.WorkspaceCommands.AddMember.Handle(ctx, &command.AddWorkspaceMemberCommand{
+		WorkspaceID: r.GetWorkspaceID(),
+		UserName:    r.GetUserName(),
+		Role:        r.GetRole(),
+	})
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return &pb.AddWorkspaceMemberResponse{}, nil
+}
+
+func (s *workspaceServer) UpdateWorkspaceMember(ctx context.Context, r *pb.UpdateWorkspaceMemberRequest) (*pb.UpdateWorkspaceMemberResponse, error) {
+	err := s.workspaceService.WorkspaceCommands.UpdateMember.Handle(ctx, &command.UpdateWorkspaceMemberCommand{
+		WorkspaceID: r.GetWorkspaceID(),
+		UserName:    r.GetUserName(),
+		Role:        r.GetRole(),
+	})
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return &pb.UpdateWorkspaceMemberResponse{}, nil
+}
+
+func (s *workspaceServer) DeleteWorkspaceMember(ctx context.Context, r *pb.DeleteWorkspaceMemberRequest) (*pb.DeleteWorkspaceMemberResponse, error) {
+	err := s.workspaceService.WorkspaceCommands.DeleteMember.Handle(ctx, &command.DeleteWorkspaceMemberCommand{
+		WorkspaceID: r.GetWorkspaceID(),
+		UserName:    r.GetUserName(),
+	})
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return &pb.DeleteWorkspaceMemberResponse{}, nil
+}
+
 func (s *workspaceServer) PatchDataModel(ctx context.Context, r *pb.PatchDataModelRequest) (*pb.PatchDataModelResponse, error) {
 	patchDataModelDto := patchDataModelVoToDto(r)
 	id, err := s.workspaceService.DataModelCommands.PatchDataModel.Handle(ctx, patchDataModelDto)
@@ -257,7 +361,7 @@ func (s *workspaceServer) ListDataModelRows(ctx context.Context, r *pb.ListDataM
 	if err != nil {
 		return nil, utils.ToGRPCError(err)
 	}
-	headers, rows, total, err := s.workspaceService.DataModelQueries.ListDataModelRows.Handle(ctx, listDataModelRowsDto)
+	headers, headerTypes, rows, total, err := s.workspaceService.DataModelQueries.ListDataModelRows.Handle(ctx, listDataModelRowsDto)
 	if err != nil {
 		return nil, utils.ToGRPCError(err)
 	}
@@ -268,11 +372,12 @@ func (s *workspaceServer) ListDataModelRows(ctx context.Context, r *pb.ListDataM
 	}
 
 	return &pb.ListDataModelRowsResponse{
-		Headers: headers,
-		Rows:    rowVO,
-		Page:    r.Page,
-		Size:    r.Size,
-		Total:   total,
+		Headers:     headers,
+		Rows:        rowVO,
+		Page:        r.Page,
+		Size:        r.Size,
+		Total:       total,
+		HeaderTypes: headerTypes,
 	}, nil
 }
 
@@ -286,3 +391,82 @@ func (s *workspaceServer) ListAllDataModelRowIDs(ctx context.Context, r *pb.List
 		RowIDs: ids,
 	}, nil
 }
+
+func (s *workspaceServer) ExportDataModel(r *pb.ExportDataModelRequest, stream pb.DataModelService_ExportDataModelServer) error {
+	exportDataModelDto, err := exportDataModelVoToDto(r)
+	if err != nil {
+		return utils.ToGRPCError(err)
+	}
+	// send the file in chunks instead of each row
+	writer := bufio.NewWriterSize(&exportStreamWriter{send: func(content []byte) error {
+		return stream.Send(&pb.ExportDataModelResponse{Content: content})
+	}}, exportChunkSize)
+	if err := s.workspaceService.DataModelQueries.ExportDataModel.Handle(stream.Context(), exportDataModelDto, writer); err != nil {
+		return utils.ToGRPCError(err)
+	}
+	return writer.Flush()
+}
+
+func (s *workspaceServer) CreateDataModelSnapshot(ctx context.Context, r *pb.CreateDataModelSnapshotRequest) (*pb.CreateDataModelSnapshotResponse, error) {
+	id, err := s.workspaceService.DataModelCommands.CreateDataModelSnapshot.Handle(ctx, createDataModelSnapshotVoToDto(r))
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return &pb.CreateDataModelSnapshotResponse{
+		Id: id,
+	}, nil
+}
+
+func (s *workspaceServer) ListDataModelSnapshots(ctx context.Context, r *pb.ListDataModelSnapshotsRequest) (*pb.ListDataModelSnapshotsResponse, error) {
+	snapshots, err := s.workspaceService.DataModelQueries.ListDataModelSnapshots.Handle(ctx, listDataModelSnapshotsVoToDto(r))
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	items := make([]*pb.DataModelSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		items = append(items, dataModelSnapshotDtoToVo(snapshot))
+	}
+	return &pb.ListDataModelSnapshotsResponse{
+		Items: items,
+	}, nil
+}
+
+func (s *workspaceServer) GetDataModelSnapshot(ctx context.Context, r *pb.GetDataModelSnapshotRequest) (*pb.GetDataModelSnapshotResponse, error) {
+	snapshot, rows, err := s.workspaceService.DataModelQueries.GetDataModelSnapshot.Handle(ctx, getDataModelSnapshotVoToDto(r))
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	rowVO := make([]*pb.Row, 0, len(rows))
+	for _, row := range rows {
+		rowVO = append(rowVO, rowDtoToVo(row))
+	}
+	return &pb.GetDataModelSnapshotResponse{
+		Snapshot: dataModelSnapshotDtoToVo(snapshot),
+		Rows:     rowVO,
+	}, nil
+}
+
+func (s *workspaceServer) DiffDataModelSnapshots(ctx context.Context, r *pb.DiffDataModelSnapshotsRequest) (*pb.DiffDataModelSnapshotsResponse, error) {
+	diff, err := s.workspaceService.DataModelQueries.DiffDataModelSnapshots.Handle(ctx, diffDataModelSnapshotsVoToDto(r))
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return dataModelSnapshotDiffDtoToVo(diff), nil
+}
+
+func (s *workspaceServer) RestoreDataModelSnapshot(ctx context.Context, r *pb.RestoreDataModelSnapshotRequest) (*pb.RestoreDataModelSnapshotResponse, error) {
+	backupSnapshotID, err := s.workspaceService.DataModelCommands.RestoreDataModelSnapshot.Handle(ctx, restoreDataModelSnapshotVoToDto(r))
+	if err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return &pb.RestoreDataModelSnapshotResponse{
+		BackupSnapshotID: backupSnapshotID,
+	}, nil
+}
+
+func (s *workspaceServer) DeleteDataModelSnapshot(ctx context.Context, r *pb.DeleteDataModelSnapshotRequest) (*pb.DeleteDataModelSnapshotResponse, error) {
+	if err := s.workspaceService.DataModelCommands.DeleteDataModelSnapshot.Handle(ctx, deleteDataModelSnapshotVoToDto(r)); err != nil {
+		return nil, utils.ToGRPCError(err)
+	}
+	return &pb.DeleteDataModelSnapshotResponse{}, nil
+}
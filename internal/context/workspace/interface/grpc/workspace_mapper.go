@@ -16,9 +16,10 @@ import (
 
 func createWorkspaceVoToDto(req *pb.CreateWorkspaceRequest) *command.CreateWorkspaceCommand {
 	return &command.CreateWorkspaceCommand{
-		Name:        req.GetName(),
-		Description: req.GetDescription(),
-		Storage:     workspaceStorageVoToDto(req.Storage),
+		Name:          req.GetName(),
+		Description:   req.GetDescription(),
+		Storage:       workspaceStorageVoToDto(req.Storage),
+		EngineBackend: req.GetEngineBackend(),
 	}
 }
 
@@ -30,6 +31,16 @@ func importWorkspaceVoToDto(req *pb.ImportWorkspaceRequest) *command.ImportWorks
 	}
 }
 
+func cloneWorkspaceVoToDto(req *pb.CloneWorkspaceRequest) *command.CloneWorkspaceCommand {
+	return &command.CloneWorkspaceCommand{
+		SourceID:       req.GetId(),
+		Name:           req.GetName(),
+		Description:    req.GetDescription(),
+		Storage:        workspaceStorageVoToDto(req.Storage),
+		IncludeStorage: req.GetIncludeStorage(),
+	}
+}
+
 func listWorkspacesVoToDto(req *pb.ListWorkspaceRequest) (*query.ListWorkspacesQuery, error) {
 	pg := utils.NewPagination(int(req.GetSize()), int(req.GetPage()))
 	if err := pg.SetOrderBy(req.GetOrderBy()); err != nil {
@@ -46,23 +57,58 @@ func listWorkspacesVoToDto(req *pb.ListWorkspaceRequest) (*query.ListWorkspacesQ
 }
 
 func workspaceStorageVoToDto(s *pb.WorkspaceStorage) (res command.WorkspaceStorage) {
-	if s.Nfs != nil {
-		res.NFS = &command.NFSWorkspaceStorage{MountPath: s.Nfs.GetMountPath()}
+	if s.GetNfs() != nil {
+		res.NFS = &command.NFSWorkspaceStorage{MountPath: s.GetNfs().GetMountPath()}
 	}
 	return res
 }
 
 func workspaceItemDtoToVo(ws *query.WorkspaceItem) *pb.Workspace {
 	return &pb.Workspace{
-		Id:          ws.ID,
-		Name:        ws.Name,
-		Description: ws.Description,
-		Storage:     workspaceStorageDtoToVo(ws.Storage),
-		CreatedAt:   timestamppb.New(ws.CreatedAt),
-		UpdatedAt:   timestamppb.New(ws.UpdatedAt),
+		Id:            ws.ID,
+		Name:          ws.Name,
+		Description:   ws.Description,
+		Storage:       workspaceStorageDtoToVo(ws.Storage),
+		CreatedAt:     timestamppb.New(ws.CreatedAt),
+		UpdatedAt:     timestamppb.New(ws.UpdatedAt),
+		EngineBackend: ws.EngineBackend,
+		Owner:         ws.Owner,
+	}
+}
+
+func workspaceMemberDtoToVo(member *query.WorkspaceMemberItem) *pb.WorkspaceMember {
+	return &pb.WorkspaceMember{
+		UserName:  member.UserName,
+		Role:      member.Role,
+		CreatedAt: timestamppb.New(member.CreatedAt),
 	}
 }
 
+func importJobDtoToVo(job *query.ImportJobItem) *pb.ImportJob {
+	res := &pb.ImportJob{
+		Id:                job.ID,
+		Type:              job.Type,
+		WorkspaceID:       job.WorkspaceID,
+		SourceWorkspaceID: job.SourceWorkspaceID,
+		State:             job.State,
+		Components:        make([]*pb.ImportJobComponent, len(job.Components)),
+		Error:             job.Error,
+		CreatedAt:         timestamppb.New(job.CreatedAt),
+		UpdatedAt:         timestamppb.New(job.UpdatedAt),
+	}
+	if job.FinishedAt != nil {
+		res.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+	for i, component := range job.Components {
+		res.Components[i] = &pb.ImportJobComponent{
+			Name:    component.Name,
+			State:   component.State,
+			Message: component.Message,
+		}
+	}
+	return res
+}
+
 func workspaceStorageDtoToVo(ws query.WorkspaceStorage) *pb.WorkspaceStorage {
 	res := &pb.WorkspaceStorage{}
 	if ws.NFS != nil {
@@ -83,6 +129,7 @@ func patchDataModelVoToDto(req *pb.PatchDataModelRequest) *datamodelcommand.Patc
 		Name:        req.Name,
 		Async:       req.Async,
 		Headers:     req.Headers,
+		ColumnTypes: req.ColumnTypes,
 		Rows:        rows,
 	}
 }
@@ -123,6 +170,10 @@ func listDataModelRowsVoToDto(req *pb.ListDataModelRowsRequest) (*datamodelquery
 	if len(pg.Orders) > 1 {
 		return nil, fmt.Errorf("only support one orderby")
 	}
+	where, err := datamodelquery.ParseRowFilter(req.GetWhere())
+	if err != nil {
+		return nil, err
+	}
 	return &datamodelquery.ListDataModelRowsQuery{
 		WorkspaceID: req.WorkspaceID,
 		ID:          req.Id,
@@ -131,6 +182,7 @@ func listDataModelRowsVoToDto(req *pb.ListDataModelRowsRequest) (*datamodelquery
 			SearchWord: req.SearchWord,
 			InSetIDs:   req.InSetIDs,
 			RowIDs:     req.RowIDs,
+			Where:      where,
 		},
 	}, nil
 }
@@ -142,6 +194,110 @@ func listAllDataModelRowIDsVoToDto(req *pb.ListAllDataModelRowIDsRequest) *datam
 	}
 }
 
+func exportDataModelVoToDto(req *pb.ExportDataModelRequest) (*datamodelquery.ExportDataModelQuery, error) {
+	where, err := datamodelquery.ParseRowFilter(req.Where)
+	if err != nil {
+		return nil, err
+	}
+	return &datamodelquery.ExportDataModelQuery{
+		WorkspaceID: req.WorkspaceID,
+		ID:          req.Id,
+		Format:      req.Format,
+		Columns:     req.Columns,
+		Filter:      &datamodelquery.ListDataModelRowsFilter{Where: where},
+	}, nil
+}
+
+func createDataModelSnapshotVoToDto(req *pb.CreateDataModelSnapshotRequest) *datamodelcommand.CreateDataModelSnapshotCommand {
+	return &datamodelcommand.CreateDataModelSnapshotCommand{
+		WorkspaceID: req.WorkspaceID,
+		DataModelID: req.DataModelID,
+		Name:        req.Name,
+		Description: req.Description,
+		Auto:        req.Auto,
+	}
+}
+
+func restoreDataModelSnapshotVoToDto(req *pb.RestoreDataModelSnapshotRequest) *datamodelcommand.RestoreDataModelSnapshotCommand {
+	return &datamodelcommand.RestoreDataModelSnapshotCommand{
+		WorkspaceID: req.WorkspaceID,
+		DataModelID: req.DataModelID,
+		ID:          req.Id,
+	}
+}
+
+func deleteDataModelSnapshotVoToDto(req *pb.DeleteDataModelSnapshotRequest) *datamodelcommand.DeleteDataModelSnapshotCommand {
+	return &datamodelcommand.DeleteDataModelSnapshotCommand{
+		WorkspaceID: req.WorkspaceID,
+		DataModelID: req.DataModelID,
+		ID:          req.Id,
+	}
+}
+
+func listDataModelSnapshotsVoToDto(req *pb.ListDataModelSnapshotsRequest) *datamodelquery.ListDataModelSnapshotsQuery {
+	return &datamodelquery.ListDataModelSnapshotsQuery{
+		WorkspaceID: req.WorkspaceID,
+		DataModelID: req.DataModelID,
+	}
+}
+
+func getDataModelSnapshotVoToDto(req *pb.GetDataModelSnapshotRequest) *datamodelquery.GetDataModelSnapshotQuery {
+	return &datamodelquery.GetDataModelSnapshotQuery{
+		WorkspaceID: req.WorkspaceID,
+		DataModelID: req.DataModelID,
+		ID:          req.Id,
+		RowIDs:      req.RowIDs,
+	}
+}
+
+func diffDataModelSnapshotsVoToDto(req *pb.DiffDataModelSnapshotsRequest) *datamodelquery.DiffDataModelSnapshotsQuery {
+	return &datamodelquery.DiffDataModelSnapshotsQuery{
+		WorkspaceID: req.WorkspaceID,
+		DataModelID: req.DataModelID,
+		From:        req.From,
+		To:          req.To,
+	}
+}
+
+func dataModelSnapshotDtoToVo(snapshot *datamodelquery.DataModelSnapshot) *pb.DataModelSnapshot {
+	return &pb.DataModelSnapshot{
+		Id:          snapshot.ID,
+		DataModelID: snapshot.DataModelID,
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+		Auto:        snapshot.Auto,
+		Headers:     snapshot.Headers,
+		HeaderTypes: snapshot.HeaderTypes,
+		RowCount:    snapshot.RowCount,
+		CreatedAt:   timestamppb.New(snapshot.CreatedAt),
+	}
+}
+
+func dataModelSnapshotDiffDtoToVo(diff *datamodelquery.DataModelSnapshotDiff) *pb.DiffDataModelSnapshotsResponse {
+	changedRows := make([]*pb.DataModelRowDiff, 0, len(diff.ChangedRows))
+	for _, row := range diff.ChangedRows {
+		grids := make([]*pb.DataModelGridDiff, 0, len(row.Grids))
+		for _, grid := range row.Grids {
+			grids = append(grids, &pb.DataModelGridDiff{
+				Column: grid.Column,
+				From:   grid.From,
+				To:     grid.To,
+			})
+		}
+		changedRows = append(changedRows, &pb.DataModelRowDiff{
+			RowID: row.RowID,
+			Grids: grids,
+		})
+	}
+	return &pb.DiffDataModelSnapshotsResponse{
+		AddedColumns:   diff.AddedColumns,
+		RemovedColumns: diff.RemovedColumns,
+		AddedRowIDs:    diff.AddedRowIDs,
+		RemovedRowIDs:  diff.RemovedRowIDs,
+		ChangedRows:    changedRows,
+	}
+}
+
 func dataModelsDtoToVo(dataModel *datamodelquery.DataModel) *pb.DataModel {
 	return &pb.DataModel{
 		Id:       dataModel.ID,
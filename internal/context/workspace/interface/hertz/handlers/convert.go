@@ -11,9 +11,10 @@ import (
 
 func createWorkspaceVoToDto(req CreateWorkspaceRequest) *command.CreateWorkspaceCommand {
 	return &command.CreateWorkspaceCommand{
-		Name:        req.Name,
-		Description: req.Description,
-		Storage:     workspaceStorageVoToDto(req.Storage),
+		Name:          req.Name,
+		Description:   req.Description,
+		Storage:       workspaceStorageVoToDto(req.Storage),
+		EngineBackend: req.EngineBackend,
 	}
 }
 
@@ -29,6 +30,16 @@ func importWorkspaceVoToDto(req ImportWorkspaceRequest) *command.ImportWorkspace
 	}
 }
 
+func cloneWorkspaceVoToDto(req CloneWorkspaceRequest) *command.CloneWorkspaceCommand {
+	return &command.CloneWorkspaceCommand{
+		SourceID:       req.ID,
+		Name:           req.Name,
+		Description:    req.Description,
+		Storage:        workspaceStorageVoToDto(req.Storage),
+		IncludeStorage: req.IncludeStorage,
+	}
+}
+
 func workspaceStorageVoToDto(s WorkspaceStorage) (res command.WorkspaceStorage) {
 	if s.NFS != nil {
 		res.NFS = &command.NFSWorkspaceStorage{MountPath: s.NFS.MountPath}
@@ -38,9 +49,10 @@ func workspaceStorageVoToDto(s WorkspaceStorage) (res command.WorkspaceStorage)
 
 func updateWorkspaceVoToDto(req UpdateWorkspaceRequest) *command.UpdateWorkspaceCommand {
 	return &command.UpdateWorkspaceCommand{
-		ID:          req.ID,
-		Name:        req.Name,
-		Description: req.Description,
+		ID:            req.ID,
+		Name:          req.Name,
+		Description:   req.Description,
+		EngineBackend: req.EngineBackend,
 	}
 }
 
@@ -65,13 +77,40 @@ func listWorkspacesVoToDto(req ListWorkspacesRequest) (*query.ListWorkspacesQuer
 
 func workspaceItemDtoToVo(ws *query.WorkspaceItem) WorkspaceItem {
 	return WorkspaceItem{
-		Id:          ws.ID,
-		Name:        ws.Name,
-		Description: ws.Description,
-		Storage:     workspaceStorageDtoToVo(ws.Storage),
-		CreateTime:  ws.CreatedAt.Unix(),
-		UpdateTime:  ws.UpdatedAt.Unix(),
+		Id:            ws.ID,
+		Name:          ws.Name,
+		Description:   ws.Description,
+		Storage:       workspaceStorageDtoToVo(ws.Storage),
+		CreateTime:    ws.CreatedAt.Unix(),
+		UpdateTime:    ws.UpdatedAt.Unix(),
+		EngineBackend: ws.EngineBackend,
+		Owner:         ws.Owner,
+	}
+}
+
+func importJobDtoToVo(job *query.ImportJobItem) ImportJob {
+	res := ImportJob{
+		ID:                job.ID,
+		Type:              job.Type,
+		WorkspaceID:       job.WorkspaceID,
+		SourceWorkspaceID: job.SourceWorkspaceID,
+		State:             job.State,
+		Components:        make([]ImportJobComponent, len(job.Components)),
+		Error:             job.Error,
+		CreateTime:        job.CreatedAt.Unix(),
+		UpdateTime:        job.UpdatedAt.Unix(),
 	}
+	if job.FinishedAt != nil {
+		res.FinishTime = utils.PointInt64(job.FinishedAt.Unix())
+	}
+	for i, component := range job.Components {
+		res.Components[i] = ImportJobComponent{
+			Name:    component.Name,
+			State:   component.State,
+			Message: component.Message,
+		}
+	}
+	return res
 }
 
 func workspaceStorageDtoToVo(s query.WorkspaceStorage) (res WorkspaceStorage) {
@@ -80,3 +119,11 @@ func workspaceStorageDtoToVo(s query.WorkspaceStorage) (res WorkspaceStorage) {
 	}
 	return res
 }
+
+func workspaceMemberDtoToVo(member *query.WorkspaceMemberItem) WorkspaceMember {
+	return WorkspaceMember{
+		UserName:   member.UserName,
+		Role:       member.Role,
+		CreateTime: member.CreatedAt.Unix(),
+	}
+}
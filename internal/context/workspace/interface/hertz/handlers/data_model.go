@@ -1,12 +1,14 @@
 package handlers
 
 import (
+	"bufio"
 	"context"
 
 	"github.com/cloudwego/hertz/pkg/app"
 
 	datamodelcommand "github.com/Bio-OS/bioos/internal/context/workspace/application/command/data-model"
 	datamodelquery "github.com/Bio-OS/bioos/internal/context/workspace/application/query/data-model"
+	"github.com/Bio-OS/bioos/pkg/consts"
 	apperrors "github.com/Bio-OS/bioos/pkg/errors"
 	applog "github.com/Bio-OS/bioos/pkg/log"
 	"github.com/Bio-OS/bioos/pkg/utils"
@@ -184,6 +186,7 @@ func GetDataModel(ctx context.Context, c *app.RequestContext, handler datamodelq
 //	@Param			inSetIDs		query		[]string	false	"data model entity set reffed entity row ids"
 //	@Param			searchWord		query		string		false	"query searchWord"
 //	@Param			rowIDs			query		[]string	false	"data model row ids"
+//	@Param			where			query		string		false	"filter rows by conditions on columns, e.g. tissue = liver AND read_count > 1e6"
 //	@Success		200				{object}	ListDataModelRowsResponse
 //	@Failure		400				{object}	apperrors.AppError	"invalid param"
 //	@Failure		401				{object}	apperrors.AppError	"unauthorized"
@@ -203,18 +206,19 @@ func ListDataModelRows(ctx context.Context, c *app.RequestContext, handler datam
 		utils.WriteHertzErrorResponse(c, err)
 		return
 	}
-	headers, rows, total, err := handler.Handle(ctx, query)
+	headers, headerTypes, rows, total, err := handler.Handle(ctx, query)
 	if err != nil {
 		utils.WriteHertzErrorResponse(c, err)
 		return
 	}
 
 	resp := &ListDataModelRowsResponse{
-		Headers: headers,
-		Rows:    rows,
-		Size:    int32(query.Pagination.Size),
-		Page:    int32(query.Pagination.Page),
-		Total:   total,
+		Headers:     headers,
+		HeaderTypes: headerTypes,
+		Rows:        rows,
+		Size:        int32(query.Pagination.Size),
+		Page:        int32(query.Pagination.Page),
+		Total:       total,
 	}
 	utils.WriteHertzOKResponse(c, resp)
 }
@@ -256,3 +260,246 @@ func ListAllDataModelRowIDs(ctx context.Context, c *app.RequestContext, handler
 	}
 	utils.WriteHertzOKResponse(c, resp)
 }
+
+// ExportDataModel export data model
+//
+//	@Summary		use to export data model
+//	@Description	export the rows of data model as csv or tsv file, which is streamed page by page
+//	@Tags			datamodel
+//	@Accept			application/json
+//	@Produce		text/csv
+//	@Produce		text/tab-separated-values
+//	@Router			/workspace/{workspace_id}/data_model/{id}/export [get]
+//	@Security		basicAuth
+//	@Param			workspace_id	path		string		true	"workspace id"
+//	@Param			id				path		string		true	"data model id"
+//	@Param			format			query		string		false	"csv or tsv, csv by default"
+//	@Param			columns			query		[]string	false	"columns exported in order, all columns by default"
+//	@Param			where			query		string		false	"filter rows by conditions on columns, e.g. tissue = liver AND read_count > 1e6"
+//	@Success		200				{file}		binary
+//	@Failure		400				{object}	apperrors.AppError	"invalid param"
+//	@Failure		401				{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403				{object}	apperrors.AppError	"forbidden"
+//	@Failure		404				{object}	apperrors.AppError	"not found"
+//	@Failure		500				{object}	apperrors.AppError	"internal system error"
+func ExportDataModel(ctx context.Context, c *app.RequestContext, handler datamodelquery.ExportDataModelHandler) {
+	var req ExportDataModelRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	query, err := exportDataModelVoToDto(req)
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+	w := &chunkedWriter{c: c, contentType: "text/csv", fileName: req.ID + "." + consts.DataModelExportFormatCSV}
+	if req.Format == consts.DataModelExportFormatTSV {
+		w.contentType, w.fileName = "text/tab-separated-values", req.ID+"."+consts.DataModelExportFormatTSV
+	}
+	// send the file in chunks instead of each row
+	buf := bufio.NewWriterSize(w, exportChunkSize)
+	err = handler.Handle(ctx, query, buf)
+	if err == nil {
+		err = buf.Flush()
+	}
+	if err != nil {
+		if w.started {
+			// the status is already sent
+			applog.Errorw("failed to export data model", "err", err)
+			return
+		}
+		utils.WriteHertzErrorResponse(c, err)
+	}
+}
+
+// CreateDataModelSnapshot create data model snapshot
+//
+//	@Summary		use to create data model snapshot
+//	@Description	save the current headers and rows of data model to an immutable snapshot
+//	@Tags			datamodel
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{workspace_id}/data_model/{id}/snapshot [post]
+//	@Security		basicAuth
+//	@Param			workspace_id	path		string							true	"workspace id"
+//	@Param			id				path		string							true	"data model id"
+//	@Param			request			body		CreateDataModelSnapshotRequest	true	"create data model snapshot request"
+//	@Success		201				{object}	CreateDataModelSnapshotResponse
+//	@Failure		400				{object}	apperrors.AppError	"invalid param"
+//	@Failure		401				{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403				{object}	apperrors.AppError	"forbidden"
+//	@Failure		404				{object}	apperrors.AppError	"not found"
+//	@Failure		500				{object}	apperrors.AppError	"internal system error"
+func CreateDataModelSnapshot(ctx context.Context, c *app.RequestContext, handler datamodelcommand.CreateDataModelSnapshotHandler) {
+	var req CreateDataModelSnapshotRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	id, err := handler.Handle(ctx, createDataModelSnapshotVoToDto(req))
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	utils.WriteHertzCreatedResponse(c, &CreateDataModelSnapshotResponse{ID: id})
+}
+
+// ListDataModelSnapshots list data model snapshots
+//
+//	@Summary		use to list data model snapshots
+//	@Description	list the snapshots of data model, the latest first
+//	@Tags			datamodel
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{workspace_id}/data_model/{id}/snapshot [get]
+//	@Security		basicAuth
+//	@Param			workspace_id	path		string	true	"workspace id"
+//	@Param			id				path		string	true	"data model id"
+//	@Success		200				{object}	ListDataModelSnapshotsResponse
+//	@Failure		400				{object}	apperrors.AppError	"invalid param"
+//	@Failure		401				{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403				{object}	apperrors.AppError	"forbidden"
+//	@Failure		404				{object}	apperrors.AppError	"not found"
+//	@Failure		500				{object}	apperrors.AppError	"internal system error"
+func ListDataModelSnapshots(ctx context.Context, c *app.RequestContext, handler datamodelquery.ListDataModelSnapshotsHandler) {
+	var req ListDataModelSnapshotsRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	snapshots, err := handler.Handle(ctx, listDataModelSnapshotsVoToDto(req))
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	items := make([]*DataModelSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		items = append(items, dataModelSnapshotDtoToVo(snapshot))
+	}
+	utils.WriteHertzOKResponse(c, &ListDataModelSnapshotsResponse{Items: items})
+}
+
+// GetDataModelSnapshot get data model snapshot
+//
+//	@Summary		use to get data model snapshot
+//	@Description	get the data model snapshot with its rows
+//	@Tags			datamodel
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{workspace_id}/data_model/{id}/snapshot/{snapshot_id} [get]
+//	@Security		basicAuth
+//	@Param			workspace_id	path		string		true	"workspace id"
+//	@Param			id				path		string		true	"data model id"
+//	@Param			snapshot_id		path		string		true	"snapshot id"
+//	@Param			rowIDs			query		[]string	false	"the rows returned, all rows by default"
+//	@Success		200				{object}	GetDataModelSnapshotResponse
+//	@Failure		400				{object}	apperrors.AppError	"invalid param"
+//	@Failure		401				{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403				{object}	apperrors.AppError	"forbidden"
+//	@Failure		404				{object}	apperrors.AppError	"not found"
+//	@Failure		500				{object}	apperrors.AppError	"internal system error"
+func GetDataModelSnapshot(ctx context.Context, c *app.RequestContext, handler datamodelquery.GetDataModelSnapshotHandler) {
+	var req GetDataModelSnapshotRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	snapshot, rows, err := handler.Handle(ctx, getDataModelSnapshotVoToDto(req))
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	utils.WriteHertzOKResponse(c, &GetDataModelSnapshotResponse{
+		Snapshot: dataModelSnapshotDtoToVo(snapshot),
+		Rows:     rows,
+	})
+}
+
+// DiffDataModelSnapshots diff data model snapshots
+//
+//	@Summary		use to diff data model snapshots
+//	@Description	compare the columns and rows of a snapshot with another snapshot or the current data model
+//	@Tags			datamodel
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{workspace_id}/data_model/{id}/snapshot/diff [get]
+//	@Security		basicAuth
+//	@Param			workspace_id	path		string	true	"workspace id"
+//	@Param			id				path		string	true	"data model id"
+//	@Param			from			query		string	true	"snapshot id compared from"
+//	@Param			to				query		string	false	"snapshot id compared to, the current data model by default"
+//	@Success		200				{object}	DiffDataModelSnapshotsResponse
+//	@Failure		400				{object}	apperrors.AppError	"invalid param"
+//	@Failure		401				{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403				{object}	apperrors.AppError	"forbidden"
+//	@Failure		404				{object}	apperrors.AppError	"not found"
+//	@Failure		500				{object}	apperrors.AppError	"internal system error"
+func DiffDataModelSnapshots(ctx context.Context, c *app.RequestContext, handler datamodelquery.DiffDataModelSnapshotsHandler) {
+	var req DiffDataModelSnapshotsRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	diff, err := handler.Handle(ctx, diffDataModelSnapshotsVoToDto(req))
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	utils.WriteHertzOKResponse(c, dataModelSnapshotDiffDtoToVo(diff))
+}
+
+// RestoreDataModelSnapshot restore data model snapshot
+//
+//	@Summary		use to restore data model snapshot
+//	@Description	overwrite the data model with the snapshot, the data model is saved to a new snapshot before restoring
+//	@Tags			datamodel
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{workspace_id}/data_model/{id}/snapshot/{snapshot_id}/restore [post]
+//	@Security		basicAuth
+//	@Param			workspace_id	path		string	true	"workspace id"
+//	@Param			id				path		string	true	"data model id"
+//	@Param			snapshot_id		path		string	true	"snapshot id"
+//	@Success		200				{object}	RestoreDataModelSnapshotResponse
+//	@Failure		400				{object}	apperrors.AppError	"invalid param"
+//	@Failure		401				{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403				{object}	apperrors.AppError	"forbidden"
+//	@Failure		404				{object}	apperrors.AppError	"not found"
+//	@Failure		500				{object}	apperrors.AppError	"internal system error"
+func RestoreDataModelSnapshot(ctx context.Context, c *app.RequestContext, handler datamodelcommand.RestoreDataModelSnapshotHandler) {
+	var req RestoreDataModelSnapshotRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	backupSnapshotID, err := handler.Handle(ctx, restoreDataModelSnapshotVoToDto(req))
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	utils.WriteHertzOKResponse(c, &RestoreDataModelSnapshotResponse{BackupSnapshotID: backupSnapshotID})
+}
@@ -12,6 +12,7 @@ func patchDataModelVoToDto(req PatchDataModelRequest) *datamodelcommand.PatchDat
 		Name:        req.Name,
 		Async:       req.Async,
 		Headers:     req.Headers,
+		ColumnTypes: req.ColumnTypes,
 		Rows:        req.Rows,
 	}
 }
@@ -48,6 +49,10 @@ func listDataModelRowsVoToDto(req ListDataModelRowsRequest) (*datamodelquery.Lis
 	if err := pg.SetOrderBy(req.OrderBy); err != nil {
 		return nil, err
 	}
+	where, err := datamodelquery.ParseRowFilter(req.Where)
+	if err != nil {
+		return nil, err
+	}
 	return &datamodelquery.ListDataModelRowsQuery{
 		WorkspaceID: req.WorkspaceID,
 		ID:          req.ID,
@@ -56,6 +61,7 @@ func listDataModelRowsVoToDto(req ListDataModelRowsRequest) (*datamodelquery.Lis
 			SearchWord: req.SearchWord,
 			InSetIDs:   req.InSetIDs,
 			RowIDs:     req.RowIDs,
+			Where:      where,
 		},
 	}, nil
 }
@@ -67,6 +73,20 @@ func listAllDataModelRowIDsVoToDto(req ListAllDataModelRowIDsRequest) *datamodel
 	}
 }
 
+func exportDataModelVoToDto(req ExportDataModelRequest) (*datamodelquery.ExportDataModelQuery, error) {
+	where, err := datamodelquery.ParseRowFilter(req.Where)
+	if err != nil {
+		return nil, err
+	}
+	return &datamodelquery.ExportDataModelQuery{
+		WorkspaceID: req.WorkspaceID,
+		ID:          req.ID,
+		Format:      req.Format,
+		Columns:     req.Columns,
+		Filter:      &datamodelquery.ListDataModelRowsFilter{Where: where},
+	}, nil
+}
+
 func dataModelDtoToVo(dataModel *datamodelquery.DataModel) *DataModel {
 	return &DataModel{
 		dataModel.ID,
@@ -76,6 +96,87 @@ func dataModelDtoToVo(dataModel *datamodelquery.DataModel) *DataModel {
 	}
 }
 
+func createDataModelSnapshotVoToDto(req CreateDataModelSnapshotRequest) *datamodelcommand.CreateDataModelSnapshotCommand {
+	return &datamodelcommand.CreateDataModelSnapshotCommand{
+		WorkspaceID: req.WorkspaceID,
+		DataModelID: req.DataModelID,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+func restoreDataModelSnapshotVoToDto(req RestoreDataModelSnapshotRequest) *datamodelcommand.RestoreDataModelSnapshotCommand {
+	return &datamodelcommand.RestoreDataModelSnapshotCommand{
+		WorkspaceID: req.WorkspaceID,
+		DataModelID: req.DataModelID,
+		ID:          req.ID,
+	}
+}
+
+func listDataModelSnapshotsVoToDto(req ListDataModelSnapshotsRequest) *datamodelquery.ListDataModelSnapshotsQuery {
+	return &datamodelquery.ListDataModelSnapshotsQuery{
+		WorkspaceID: req.WorkspaceID,
+		DataModelID: req.DataModelID,
+	}
+}
+
+func getDataModelSnapshotVoToDto(req GetDataModelSnapshotRequest) *datamodelquery.GetDataModelSnapshotQuery {
+	return &datamodelquery.GetDataModelSnapshotQuery{
+		WorkspaceID: req.WorkspaceID,
+		DataModelID: req.DataModelID,
+		ID:          req.ID,
+		RowIDs:      req.RowIDs,
+	}
+}
+
+func diffDataModelSnapshotsVoToDto(req DiffDataModelSnapshotsRequest) *datamodelquery.DiffDataModelSnapshotsQuery {
+	return &datamodelquery.DiffDataModelSnapshotsQuery{
+		WorkspaceID: req.WorkspaceID,
+		DataModelID: req.DataModelID,
+		From:        req.From,
+		To:          req.To,
+	}
+}
+
+func dataModelSnapshotDtoToVo(snapshot *datamodelquery.DataModelSnapshot) *DataModelSnapshot {
+	return &DataModelSnapshot{
+		ID:          snapshot.ID,
+		DataModelID: snapshot.DataModelID,
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+		Auto:        snapshot.Auto,
+		Headers:     snapshot.Headers,
+		HeaderTypes: snapshot.HeaderTypes,
+		RowCount:    snapshot.RowCount,
+		CreatedAt:   snapshot.CreatedAt.Unix(),
+	}
+}
+
+func dataModelSnapshotDiffDtoToVo(diff *datamodelquery.DataModelSnapshotDiff) *DiffDataModelSnapshotsResponse {
+	changedRows := make([]*DataModelRowDiff, 0, len(diff.ChangedRows))
+	for _, row := range diff.ChangedRows {
+		grids := make([]*DataModelGridDiff, 0, len(row.Grids))
+		for _, grid := range row.Grids {
+			grids = append(grids, &DataModelGridDiff{
+				Column: grid.Column,
+				From:   grid.From,
+				To:     grid.To,
+			})
+		}
+		changedRows = append(changedRows, &DataModelRowDiff{
+			RowID: row.RowID,
+			Grids: grids,
+		})
+	}
+	return &DiffDataModelSnapshotsResponse{
+		AddedColumns:   diff.AddedColumns,
+		RemovedColumns: diff.RemovedColumns,
+		AddedRowIDs:    diff.AddedRowIDs,
+		RemovedRowIDs:  diff.RemovedRowIDs,
+		ChangedRows:    changedRows,
+	}
+}
+
 /*
 func rowDtoToVo(row *datamodelquery.Row) *Row {
 	grids := make([]*Grid, 0, len(row.Grids))
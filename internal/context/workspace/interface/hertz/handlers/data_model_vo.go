@@ -37,14 +37,16 @@ type ListDataModelRowsRequest struct {
 	SearchWord  string   `query:"searchWord"`
 	InSetIDs    []string `query:"inSetIDs"`
 	RowIDs      []string `query:"rowIDs"`
+	Where       string   `query:"where"`
 }
 
 type ListDataModelRowsResponse struct {
-	Headers []string   `json:"headers"`
-	Rows    [][]string `json:"rows"`
-	Page    int32      `json:"page"`
-	Size    int32      `json:"size"`
-	Total   int64      `json:"total"`
+	Headers     []string   `json:"headers"`
+	HeaderTypes []string   `json:"headerTypes"`
+	Rows        [][]string `json:"rows"`
+	Page        int32      `json:"page"`
+	Size        int32      `json:"size"`
+	Total       int64      `json:"total"`
 }
 
 type Row struct {
@@ -62,6 +64,8 @@ type PatchDataModelRequest struct {
 	Async       bool       `json:"async"`
 	Headers     []string   `json:"headers"`
 	Rows        [][]string `json:"rows"`
+	// ColumnTypes declares the types of entity columns keyed by header, types of the others are inferred
+	ColumnTypes map[string]string `json:"columnTypes,omitempty"`
 }
 
 type PatchDataModelResponse struct {
@@ -80,6 +84,97 @@ type ListAllDataModelRowIDsRequest struct {
 	ID          string `path:"id"`
 }
 
+type ExportDataModelRequest struct {
+	WorkspaceID string   `path:"workspace_id"`
+	ID          string   `path:"id"`
+	Format      string   `query:"format"`
+	Columns     []string `query:"columns"`
+	Where       string   `query:"where"`
+}
+
 type ListAllDataModelRowIDsResponse struct {
 	RowIDs []string `json:"rowIDs"`
 }
+
+type CreateDataModelSnapshotRequest struct {
+	WorkspaceID string `path:"workspace_id"`
+	DataModelID string `path:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type CreateDataModelSnapshotResponse struct {
+	ID string `json:"id"`
+}
+
+type ListDataModelSnapshotsRequest struct {
+	WorkspaceID string `path:"workspace_id"`
+	DataModelID string `path:"id"`
+}
+
+type ListDataModelSnapshotsResponse struct {
+	Items []*DataModelSnapshot `json:"items"`
+}
+
+type DataModelSnapshot struct {
+	ID          string `json:"id"`
+	DataModelID string `json:"dataModelID"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Auto marks the snapshot taken automatically before the data model is overwritten
+	Auto        bool     `json:"auto"`
+	Headers     []string `json:"headers"`
+	HeaderTypes []string `json:"headerTypes"`
+	RowCount    int64    `json:"rowCount"`
+	CreatedAt   int64    `json:"createdAt"`
+}
+
+type GetDataModelSnapshotRequest struct {
+	WorkspaceID string   `path:"workspace_id"`
+	DataModelID string   `path:"id"`
+	ID          string   `path:"snapshot_id"`
+	RowIDs      []string `query:"rowIDs"`
+}
+
+type GetDataModelSnapshotResponse struct {
+	Snapshot *DataModelSnapshot `json:"snapshot"`
+	Rows     [][]string         `json:"rows"`
+}
+
+type DiffDataModelSnapshotsRequest struct {
+	WorkspaceID string `path:"workspace_id"`
+	DataModelID string `path:"id"`
+	From        string `query:"from"`
+	// To is the snapshot compared with From, the current content of data model is compared if empty
+	To string `query:"to"`
+}
+
+type DiffDataModelSnapshotsResponse struct {
+	AddedColumns   []string            `json:"addedColumns"`
+	RemovedColumns []string            `json:"removedColumns"`
+	AddedRowIDs    []string            `json:"addedRowIDs"`
+	RemovedRowIDs  []string            `json:"removedRowIDs"`
+	ChangedRows    []*DataModelRowDiff `json:"changedRows"`
+}
+
+type DataModelRowDiff struct {
+	RowID string               `json:"rowID"`
+	Grids []*DataModelGridDiff `json:"grids"`
+}
+
+type DataModelGridDiff struct {
+	Column string `json:"column"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+type RestoreDataModelSnapshotRequest struct {
+	WorkspaceID string `path:"workspace_id"`
+	DataModelID string `path:"id"`
+	ID          string `path:"snapshot_id"`
+}
+
+type RestoreDataModelSnapshotResponse struct {
+	// BackupSnapshotID is the snapshot of data model taken before restoring
+	BackupSnapshotID string `json:"backupSnapshotID"`
+}
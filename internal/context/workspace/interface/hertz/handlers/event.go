@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	command "github.com/Bio-OS/bioos/internal/context/workspace/application/command/event"
+	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/event"
+	apperrors "github.com/Bio-OS/bioos/pkg/errors"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+// ListEvents list events
+//
+//	@Summary		use to list events of event bus
+//	@Description	list events of event bus by type, status and payload, the latest created first
+//	@Tags			admin
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/admin/event [get]
+//	@Security		basicAuth
+//	@Param			page	query		int			false	"page number"
+//	@Param			size	query		int			false	"page size"
+//	@Param			types	query		[]string	false	"event types"
+//	@Param			status	query		[]string	false	"event status"	Enums(pending, dequeue, running, completed, failed, deadletter)
+//	@Param			payload	query		string		false	"substring of payload"
+//	@Success		200		{object}	listEventsResponse
+//	@Failure		400		{object}	apperrors.AppError	"invalid param"
+//	@Failure		401		{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403		{object}	apperrors.AppError	"forbidden"
+//	@Failure		500		{object}	apperrors.AppError	"internal system error"
+func ListEvents(ctx context.Context, c *app.RequestContext, handler query.ListEventsHandler) {
+	var req listEventsRequest
+	if err := c.Bind(&req); err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	dto := req.toDTO()
+	list, err := handler.Handle(ctx, dto)
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+	utils.WriteHertzOKResponse(c, newListEventsResponse(dto, list))
+}
+
+// GetEvent get event
+//
+//	@Summary		use to get event of event bus
+//	@Description	get event of event bus with retry count and the reason of current status
+//	@Tags			admin
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/admin/event/{id} [get]
+//	@Security		basicAuth
+//	@Param			id	path		string	true	"event id"
+//	@Success		200	{object}	eventItem
+//	@Failure		400	{object}	apperrors.AppError	"invalid param"
+//	@Failure		401	{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403	{object}	apperrors.AppError	"forbidden"
+//	@Failure		404	{object}	apperrors.AppError	"not found"
+//	@Failure		500	{object}	apperrors.AppError	"internal system error"
+func GetEvent(ctx context.Context, c *app.RequestContext, handler query.GetEventHandler) {
+	var req getEventRequest
+	if err := c.Bind(&req); err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	dto, err := handler.Handle(ctx, req.toDTO())
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+	utils.WriteHertzOKResponse(c, newEventItem(dto))
+}
+
+// ReplayEvent replay event
+//
+//	@Summary		use to replay failed or dead lettered event
+//	@Description	requeue failed or dead lettered event with retry count reset, so that it will be handled again
+//	@Tags			admin
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/admin/event/{id}/replay [post]
+//	@Security		basicAuth
+//	@Param			id	path	string	true	"event id"
+//	@Success		202
+//	@Failure		400	{object}	apperrors.AppError	"invalid param"
+//	@Failure		401	{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403	{object}	apperrors.AppError	"forbidden"
+//	@Failure		404	{object}	apperrors.AppError	"not found"
+//	@Failure		500	{object}	apperrors.AppError	"internal system error"
+func ReplayEvent(ctx context.Context, c *app.RequestContext, handler command.ReplayEventHandler) {
+	var req replayEventRequest
+	if err := c.Bind(&req); err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	if err := handler.Handle(ctx, req.toDTO()); err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+	utils.WriteHertzAcceptedResponse(c)
+}
+
+// PurgeEvents purge events
+//
+//	@Summary		use to purge completed events
+//	@Description	delete completed events updated before the time permanently
+//	@Tags			admin
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/admin/event [delete]
+//	@Security		basicAuth
+//	@Param			before	query		int			true	"unix time, events completed before it are deleted"
+//	@Param			types	query		[]string	false	"event types"
+//	@Success		200		{object}	purgeEventsResponse
+//	@Failure		400		{object}	apperrors.AppError	"invalid param"
+//	@Failure		401		{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403		{object}	apperrors.AppError	"forbidden"
+//	@Failure		500		{object}	apperrors.AppError	"internal system error"
+func PurgeEvents(ctx context.Context, c *app.RequestContext, handler command.PurgeEventsHandler) {
+	var req purgeEventsRequest
+	if err := c.Bind(&req); err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	count, err := handler.Handle(ctx, req.toDTO())
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+	utils.WriteHertzOKResponse(c, &purgeEventsResponse{Count: count})
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	command "github.com/Bio-OS/bioos/internal/context/workspace/application/command/event"
+	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/event"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+type listEventsRequest struct {
+	Page    int      `query:"page"`
+	Size    int      `query:"size"`
+	Types   []string `query:"types"`
+	Status  []string `query:"status"`
+	Payload string   `query:"payload"`
+}
+
+func (req *listEventsRequest) toDTO() *query.ListEventsQuery {
+	return &query.ListEventsQuery{
+		Pg:      utils.NewPagination(req.Size, req.Page),
+		Types:   req.Types,
+		Status:  req.Status,
+		Payload: req.Payload,
+	}
+}
+
+type listEventsResponse struct {
+	Page  int          `json:"page"`
+	Size  int          `json:"size"`
+	Items []*eventItem `json:"items"`
+}
+
+func newListEventsResponse(query *query.ListEventsQuery, list []*query.Event) *listEventsResponse {
+	res := &listEventsResponse{
+		Page:  query.Pg.GetPage(),
+		Size:  query.Pg.GetSize(),
+		Items: make([]*eventItem, len(list)),
+	}
+	for i := range list {
+		res.Items[i] = newEventItem(list[i])
+	}
+	return res
+}
+
+type eventItem struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Payload string `json:"payload"`
+	Status  string `json:"status"`
+	// Reason is why the event is in current status, e.g. the error of the last handling
+	Reason      string `json:"reason"`
+	RetryCount  int    `json:"retryCount"`
+	CreatedAt   int64  `json:"createdAt"`
+	UpdatedAt   int64  `json:"updatedAt"`
+	ScheduledAt int64  `json:"scheduledAt"`
+	// LastError is the error of the last failed attempt
+	LastError string `json:"lastError"`
+	// Attempts are the latest attempts to handle the event, from oldest
+	Attempts []eventAttempt `json:"attempts"`
+	// Owner is the event bus handling or last handled the event
+	Owner string `json:"owner"`
+	// LeaseExpireAt is when the running event can be taken over by other event bus, 0 if never running
+	LeaseExpireAt int64 `json:"leaseExpireAt"`
+}
+
+type eventAttempt struct {
+	StartTime int64 `json:"startTime"`
+	// Duration in milliseconds
+	Duration int64                 `json:"duration"`
+	Failures []eventHandlerFailure `json:"failures"`
+}
+
+type eventHandlerFailure struct {
+	Handler string `json:"handler"`
+	Error   string `json:"error"`
+}
+
+func newEventItem(dto *query.Event) *eventItem {
+	item := &eventItem{
+		ID:          dto.ID,
+		Type:        dto.Type,
+		Payload:     dto.Payload,
+		Status:      dto.Status,
+		Reason:      dto.Reason,
+		RetryCount:  dto.RetryCount,
+		CreatedAt:   dto.CreatedAt.Unix(),
+		UpdatedAt:   dto.UpdatedAt.Unix(),
+		ScheduledAt: dto.ScheduledAt.Unix(),
+		LastError:   dto.LastError,
+		Attempts:    newEventAttempts(dto.Attempts),
+		Owner:       dto.Owner,
+	}
+	if dto.LeaseExpireAt != nil {
+		item.LeaseExpireAt = dto.LeaseExpireAt.Unix()
+	}
+	return item
+}
+
+func newEventAttempts(attempts []query.EventAttempt) []eventAttempt {
+	result := make([]eventAttempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = eventAttempt{
+			StartTime: attempt.StartTime.Unix(),
+			Duration:  attempt.Duration.Milliseconds(),
+			Failures:  make([]eventHandlerFailure, len(attempt.Failures)),
+		}
+		for j, failure := range attempt.Failures {
+			result[i].Failures[j] = eventHandlerFailure{
+				Handler: failure.Handler,
+				Error:   failure.Error,
+			}
+		}
+	}
+	return result
+}
+
+type getEventRequest struct {
+	ID string `path:"id"`
+}
+
+func (req *getEventRequest) toDTO() *query.GetEventQuery {
+	return &query.GetEventQuery{
+		ID: req.ID,
+	}
+}
+
+type replayEventRequest struct {
+	ID string `path:"id"`
+}
+
+func (req *replayEventRequest) toDTO() *command.ReplayEventCommand {
+	return &command.ReplayEventCommand{
+		ID: req.ID,
+	}
+}
+
+type purgeEventsRequest struct {
+	Before int64    `query:"before"`
+	Types  []string `query:"types"`
+}
+
+func (req *purgeEventsRequest) toDTO() *command.PurgeEventsCommand {
+	return &command.PurgeEventsCommand{
+		Before: req.Before,
+		Types:  req.Types,
+	}
+}
+
+type purgeEventsResponse struct {
+	Count int64 `json:"count"`
+}
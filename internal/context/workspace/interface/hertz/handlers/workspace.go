@@ -1,19 +1,25 @@
 package handlers
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"path"
 	"path/filepath"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/network"
+	"github.com/cloudwego/hertz/pkg/protocol/http1/resp"
 
 	command "github.com/Bio-OS/bioos/internal/context/workspace/application/command/workspace"
 	query "github.com/Bio-OS/bioos/internal/context/workspace/application/query/workspace"
 	"github.com/Bio-OS/bioos/pkg/consts"
 	apperrors "github.com/Bio-OS/bioos/pkg/errors"
 	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/middlewares"
 	"github.com/Bio-OS/bioos/pkg/utils"
 )
 
@@ -42,6 +48,7 @@ func CreateWorkspace(ctx context.Context, c *app.RequestContext, handler command
 	}
 
 	cmd := createWorkspaceVoToDto(req)
+	cmd.Owner = middlewares.UserNameFromCtx(ctx)
 	id, err := handler.Handle(ctx, cmd)
 	if err != nil {
 		utils.WriteHertzErrorResponse(c, err)
@@ -159,6 +166,136 @@ func GetWorkspaceById(ctx context.Context, c *app.RequestContext, handler query.
 	utils.WriteHertzOKResponse(c, resp)
 }
 
+// GetImportJob get import job
+//
+//	@Summary		use to get the progress of importing workspace
+//	@Description	get import job
+//	@Tags			workspace
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/import/{id} [get]
+//	@Security		basicAuth
+//	@Param			id	path		string	true	"import job id"
+//	@Success		200	{object}	GetImportJobResponse
+//	@Failure		400	{object}	apperrors.AppError	"invalid param"
+//	@Failure		401	{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403	{object}	apperrors.AppError	"forbidden"
+//	@Failure		404	{object}	apperrors.AppError	"not found"
+//	@Failure		500	{object}	apperrors.AppError	"internal system error"
+func GetImportJob(ctx context.Context, c *app.RequestContext, handler query.GetImportJobHandler) {
+	var req GetImportJobRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	job, err := handler.Handle(ctx, &query.GetImportJobQuery{ID: req.ID})
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	utils.WriteHertzOKResponse(c, &GetImportJobResponse{ImportJob: importJobDtoToVo(job)})
+}
+
+// ExportWorkspace export workspace
+//
+//	@Summary		use to export workspace
+//	@Description	export workspace as a zip which can be imported, the submissions with the inputs and outputs of their runs are included on request
+//	@Tags			workspace
+//	@Accept			application/json
+//	@Produce		application/zip
+//	@Router			/workspace/{id}/export [get]
+//	@Security		basicAuth
+//	@Param			id					path		string	true	"workspace id"
+//	@Param			includeSubmissions	query		bool	false	"export submissions too"
+//	@Success		200					{file}		binary
+//	@Failure		400					{object}	apperrors.AppError	"invalid param"
+//	@Failure		401					{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403					{object}	apperrors.AppError	"forbidden"
+//	@Failure		404					{object}	apperrors.AppError	"not found"
+//	@Failure		500					{object}	apperrors.AppError	"internal system error"
+func ExportWorkspace(ctx context.Context, c *app.RequestContext, handler command.ExportWorkspaceHandler) {
+	var req ExportWorkspaceRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	w := &chunkedWriter{c: c, contentType: "application/zip", fileName: req.ID + consts.ImportWorkspaceFileTypeExt}
+	// send the zip in chunks instead of each small write of zip writer
+	buf := bufio.NewWriterSize(w, exportChunkSize)
+	err = handler.Handle(ctx, &command.ExportWorkspaceCommand{
+		ID:                 req.ID,
+		IncludeSubmissions: req.IncludeSubmissions,
+	}, buf)
+	if err == nil {
+		err = buf.Flush()
+	}
+	if err != nil {
+		if w.started {
+			// the status is already sent, abort the response so that the client doesn't take the
+			// truncated zip as a complete one
+			applog.Errorw("failed to export workspace", "err", err)
+			w.Abort()
+			return
+		}
+		utils.WriteHertzErrorResponse(c, err)
+	}
+}
+
+// exportChunkSize is the max size of each chunk of exported file
+const exportChunkSize = 64 * 1024
+
+// chunkedWriter writes the response as an attachment in chunked transfer encoding from the first
+// write, so that errors before it can still be responded normally.
+type chunkedWriter struct {
+	c           *app.RequestContext
+	contentType string
+	fileName    string
+	started     bool
+}
+
+func (w *chunkedWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.c.SetStatusCode(http.StatusOK)
+		w.c.SetContentType(w.contentType)
+		w.c.Response.Header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", w.fileName))
+		w.c.Response.HijackWriter(resp.NewChunkedBodyWriter(&w.c.Response, w.c.GetWriter()))
+		w.started = true
+	}
+	// flush each chunk, as p is reused by the caller after return
+	writer := w.c.Response.GetHijackWriter()
+	if _, err := writer.Write(p); err != nil {
+		return 0, err
+	}
+	if err := writer.Flush(); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// errResponseAborted is returned by finalizing the aborted response.
+var errResponseAborted = errors.New("response aborted")
+
+// Abort closes the connection without the last chunk, the client fails to read the response body.
+func (w *chunkedWriter) Abort() {
+	w.c.Response.HijackWriter(abortedWriter{ExtWriter: w.c.Response.GetHijackWriter()})
+}
+
+// abortedWriter fails to finalize the response, so that the server closes the connection at once.
+type abortedWriter struct {
+	network.ExtWriter
+}
+
+func (abortedWriter) Finalize() error {
+	return errResponseAborted
+}
+
 // ListWorkspaces list workspaces
 //
 //	@Summary		use to list workspaces
@@ -193,6 +330,8 @@ func ListWorkspaces(ctx context.Context, c *app.RequestContext, handler query.Li
 		utils.WriteHertzErrorResponse(c, err)
 		return
 	}
+	// only list the workspaces visible to the user
+	qry.Filter.UserName = middlewares.UserNameFromCtx(ctx)
 	workspaces, total, err := handler.Handle(ctx, qry)
 	if err != nil {
 		utils.WriteHertzErrorResponse(c, err)
@@ -255,6 +394,7 @@ func ImportWorkspace(ctx context.Context, c *app.RequestContext, handler command
 	req.FileName = file.Filename
 
 	cmd := importWorkspaceVoToDto(req)
+	cmd.Owner = middlewares.UserNameFromCtx(ctx)
 
 	//In order to avoid the cost of wrong file writing, we validate file type here
 	if path.Ext(cmd.FileName) != consts.ImportWorkspaceFileTypeExt {
@@ -278,14 +418,208 @@ func ImportWorkspace(ctx context.Context, c *app.RequestContext, handler command
 		return
 	}
 
-	err = handler.Handle(ctx, cmd)
+	jobID, err := handler.Handle(ctx, cmd)
 	if err != nil {
 		utils.WriteHertzErrorResponse(c, err)
 		return
 	}
 	resp := &ImportWorkspaceResponse{
-		Id: cmd.ID,
+		Id:    cmd.ID,
+		JobID: jobID,
 	}
 
 	utils.WriteHertzOKResponse(c, resp)
 }
+
+// CloneWorkspace clone workspace
+//
+//	@Summary		use to clone workspace
+//	@Description	clone the workflows, data models, notebooks and optionally the files on storage of workspace into a new workspace asynchronously, the progress is tracked by the returned job
+//	@Tags			workspace
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{id}/clone [post]
+//	@Security		basicAuth
+//	@Param			id		path		string					true	"source workspace id"
+//	@Param			request	body		CloneWorkspaceRequest	true	"clone workspace request"
+//	@Success		201		{object}	CloneWorkspaceResponse
+//	@Failure		400		{object}	apperrors.AppError	"invalid param"
+//	@Failure		401		{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403		{object}	apperrors.AppError	"forbidden"
+//	@Failure		404		{object}	apperrors.AppError	"not found"
+//	@Failure		500		{object}	apperrors.AppError	"internal system error"
+func CloneWorkspace(ctx context.Context, c *app.RequestContext, handler command.CloneWorkspaceHandler) {
+	var req CloneWorkspaceRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	cmd := cloneWorkspaceVoToDto(req)
+	cmd.Owner = middlewares.UserNameFromCtx(ctx)
+	id, jobID, err := handler.Handle(ctx, cmd)
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	resp := &CloneWorkspaceResponse{Id: id, JobID: jobID}
+	utils.WriteHertzCreatedResponse(c, resp)
+}
+
+// ListWorkspaceMembers list workspace members
+//
+//	@Summary		use to list the members of workspace
+//	@Description	list workspace members
+//	@Tags			workspace
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{id}/member [get]
+//	@Security		basicAuth
+//	@Param			id	path		string	true	"workspace id"
+//	@Success		200	{object}	ListWorkspaceMembersResponse
+//	@Failure		400	{object}	apperrors.AppError	"invalid param"
+//	@Failure		401	{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403	{object}	apperrors.AppError	"forbidden"
+//	@Failure		404	{object}	apperrors.AppError	"not found"
+//	@Failure		500	{object}	apperrors.AppError	"internal system error"
+func ListWorkspaceMembers(ctx context.Context, c *app.RequestContext, handler query.ListWorkspaceMembersHandler) {
+	var req ListWorkspaceMembersRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	members, err := handler.Handle(ctx, &query.ListWorkspaceMembersQuery{WorkspaceID: req.WorkspaceID})
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	items := make([]WorkspaceMember, 0, len(members))
+	for _, member := range members {
+		items = append(items, workspaceMemberDtoToVo(member))
+	}
+	utils.WriteHertzOKResponse(c, &ListWorkspaceMembersResponse{Items: items})
+}
+
+// AddWorkspaceMember add workspace member
+//
+//	@Summary		use to add a member to workspace
+//	@Description	add workspace member with role owner, writer or reader
+//	@Tags			workspace
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{id}/member [post]
+//	@Security		basicAuth
+//	@Param			id		path	string						true	"workspace id"
+//	@Param			request	body	AddWorkspaceMemberRequest	true	"add workspace member request"
+//	@Success		201
+//	@Failure		400	{object}	apperrors.AppError	"invalid param"
+//	@Failure		401	{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403	{object}	apperrors.AppError	"forbidden"
+//	@Failure		404	{object}	apperrors.AppError	"not found"
+//	@Failure		500	{object}	apperrors.AppError	"internal system error"
+func AddWorkspaceMember(ctx context.Context, c *app.RequestContext, handler command.AddWorkspaceMemberHandler) {
+	var req AddWorkspaceMemberRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	err = handler.Handle(ctx, &command.AddWorkspaceMemberCommand{
+		WorkspaceID: req.WorkspaceID,
+		UserName:    req.UserName,
+		Role:        req.Role,
+	})
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	utils.WriteHertzCreatedResponse(c, nil)
+}
+
+// UpdateWorkspaceMember update workspace member
+//
+//	@Summary		use to change the role of workspace member
+//	@Description	update workspace member, the last owner can not be changed
+//	@Tags			workspace
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{id}/member/{name} [patch]
+//	@Security		basicAuth
+//	@Param			id		path	string							true	"workspace id"
+//	@Param			name	path	string							true	"user name of member"
+//	@Param			request	body	UpdateWorkspaceMemberRequest	true	"update workspace member request"
+//	@Success		200
+//	@Failure		400	{object}	apperrors.AppError	"invalid param"
+//	@Failure		401	{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403	{object}	apperrors.AppError	"forbidden"
+//	@Failure		404	{object}	apperrors.AppError	"not found"
+//	@Failure		500	{object}	apperrors.AppError	"internal system error"
+func UpdateWorkspaceMember(ctx context.Context, c *app.RequestContext, handler command.UpdateWorkspaceMemberHandler) {
+	var req UpdateWorkspaceMemberRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	err = handler.Handle(ctx, &command.UpdateWorkspaceMemberCommand{
+		WorkspaceID: req.WorkspaceID,
+		UserName:    req.UserName,
+		Role:        req.Role,
+	})
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	utils.WriteHertzOKResponse(c, nil)
+}
+
+// DeleteWorkspaceMember delete workspace member
+//
+//	@Summary		use to remove a member from workspace
+//	@Description	delete workspace member, the last owner can not be removed
+//	@Tags			workspace
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Router			/workspace/{id}/member/{name} [delete]
+//	@Security		basicAuth
+//	@Param			id		path	string	true	"workspace id"
+//	@Param			name	path	string	true	"user name of member"
+//	@Success		200
+//	@Failure		400	{object}	apperrors.AppError	"invalid param"
+//	@Failure		401	{object}	apperrors.AppError	"unauthorized"
+//	@Failure		403	{object}	apperrors.AppError	"forbidden"
+//	@Failure		404	{object}	apperrors.AppError	"not found"
+//	@Failure		500	{object}	apperrors.AppError	"internal system error"
+func DeleteWorkspaceMember(ctx context.Context, c *app.RequestContext, handler command.DeleteWorkspaceMemberHandler) {
+	var req DeleteWorkspaceMemberRequest
+	err := c.Bind(&req)
+	if err != nil {
+		applog.Errorw("hertz bind error", "err", err)
+		utils.WriteHertzErrorResponse(c, apperrors.NewHertzBindError(err))
+		return
+	}
+
+	err = handler.Handle(ctx, &command.DeleteWorkspaceMemberCommand{
+		WorkspaceID: req.WorkspaceID,
+		UserName:    req.UserName,
+	})
+	if err != nil {
+		utils.WriteHertzErrorResponse(c, err)
+		return
+	}
+
+	utils.WriteHertzOKResponse(c, nil)
+}
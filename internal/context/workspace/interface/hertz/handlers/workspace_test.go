@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/onsi/gomega"
+
+	command "github.com/Bio-OS/bioos/internal/context/workspace/application/command/workspace"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+)
+
+func TestMain(m *testing.M) {
+	applog.RegisterLogger(&applog.Options{
+		Level: "fatal",
+	})
+	os.Exit(m.Run())
+}
+
+// fakeExportWorkspaceHandler writes size bytes of the zip, and fails after that if err is set.
+type fakeExportWorkspaceHandler struct {
+	size int
+	err  error
+}
+
+func (f *fakeExportWorkspaceHandler) Handle(_ context.Context, _ *command.ExportWorkspaceCommand, w io.Writer) error {
+	if _, err := w.Write(bytes.Repeat([]byte("z"), f.size)); err != nil {
+		return err
+	}
+	return f.err
+}
+
+// startTestServer serves handler on a free local port, returns the url of the export api.
+func startTestServer(t *testing.T, handler command.ExportWorkspaceHandler) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	h := server.New(server.WithHostPorts(addr), server.WithDisablePrintRoute(true))
+	h.GET("/workspace/:id/export", func(ctx context.Context, c *app.RequestContext) {
+		ExportWorkspace(ctx, c, handler)
+	})
+	go h.Run()
+	t.Cleanup(func() {
+		_ = h.Close()
+	})
+	for i := 0; i < 100; i++ {
+		if conn, err := net.Dial("tcp", addr); err == nil {
+			conn.Close()
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return fmt.Sprintf("http://%s/workspace/ws-1/export", addr)
+}
+
+func TestExportWorkspace(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	url := startTestServer(t, &fakeExportWorkspaceHandler{size: 3 * exportChunkSize})
+	resp, err := http.Get(url)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	defer resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	body, err := io.ReadAll(resp.Body)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(body).To(gomega.HaveLen(3 * exportChunkSize))
+}
+
+func TestExportWorkspaceFailed(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// the error before the first chunk is responded as usual
+	url := startTestServer(t, &fakeExportWorkspaceHandler{size: 10, err: fmt.Errorf("an error")})
+	resp, err := http.Get(url)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusInternalServerError))
+
+	// the response is aborted if it fails after the first chunk
+	url = startTestServer(t, &fakeExportWorkspaceHandler{size: 3 * exportChunkSize, err: fmt.Errorf("an error")})
+	resp, err = http.Get(url)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	defer resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	_, err = io.ReadAll(resp.Body)
+	g.Expect(err).To(gomega.MatchError(io.ErrUnexpectedEOF))
+}
@@ -4,6 +4,8 @@ type CreateWorkspaceRequest struct {
 	Name        string           `json:"name"`
 	Description string           `json:"description"`
 	Storage     WorkspaceStorage `json:"storage"`
+	// EngineBackend is the default WES backend of workspace, empty means the default of server
+	EngineBackend string `json:"engineBackend,omitempty"`
 }
 
 type CreateWorkspaceResponse struct {
@@ -18,6 +20,8 @@ type UpdateWorkspaceRequest struct {
 	ID          string  `path:"id"`
 	Name        *string `json:"name,omitempty"`
 	Description *string `json:"description,omitempty"`
+	// EngineBackend set to empty string means using the default of server
+	EngineBackend *string `json:"engineBackend,omitempty"`
 }
 
 type GetWorkspaceByIdRequest struct {
@@ -45,12 +49,15 @@ type ListWorkspacesResponse struct {
 }
 
 type WorkspaceItem struct {
-	Id          string           `json:"id"`
-	Name        string           `json:"name"`
-	Description string           `json:"description"`
-	Storage     WorkspaceStorage `json:"storage"`
-	CreateTime  int64            `json:"createTime"`
-	UpdateTime  int64            `json:"updateTime"`
+	Id            string           `json:"id"`
+	Name          string           `json:"name"`
+	Description   string           `json:"description"`
+	Storage       WorkspaceStorage `json:"storage"`
+	CreateTime    int64            `json:"createTime"`
+	UpdateTime    int64            `json:"updateTime"`
+	EngineBackend string           `json:"engineBackend"`
+	// Owner is the user created the workspace, empty means visible to everyone
+	Owner string `json:"owner,omitempty"`
 }
 
 type WorkspaceStorage struct {
@@ -69,4 +76,85 @@ type ImportWorkspaceRequest struct {
 
 type ImportWorkspaceResponse struct {
 	Id string `json:"id"`
+	// JobID is the import job tracking the progress
+	JobID string `json:"jobID"`
+}
+
+type ExportWorkspaceRequest struct {
+	ID                 string `path:"id"`
+	IncludeSubmissions bool   `query:"includeSubmissions"`
+}
+
+type CloneWorkspaceRequest struct {
+	ID          string           `path:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Storage     WorkspaceStorage `json:"storage"`
+	// IncludeStorage also copies the files on the storage of source workspace
+	IncludeStorage bool `json:"includeStorage,omitempty"`
+}
+
+type CloneWorkspaceResponse struct {
+	Id string `json:"id"`
+	// JobID is the clone job tracking the progress
+	JobID string `json:"jobID"`
+}
+
+type GetImportJobRequest struct {
+	ID string `path:"id"`
+}
+
+type GetImportJobResponse struct {
+	ImportJob `json:",inline"`
+}
+
+type ImportJob struct {
+	ID          string `json:"id"`
+	Type        string `json:"type" enums:"Import,Clone"`
+	WorkspaceID string `json:"workspaceID"`
+	// SourceWorkspaceID is the workspace cloned from
+	SourceWorkspaceID string               `json:"sourceWorkspaceID,omitempty"`
+	State             string               `json:"state" enums:"Pending,Running,Succeeded,Failed"`
+	Components        []ImportJobComponent `json:"components"`
+	Error             string               `json:"error,omitempty"`
+	CreateTime        int64                `json:"createTime"`
+	UpdateTime        int64                `json:"updateTime"`
+	FinishTime        *int64               `json:"finishTime,omitempty"`
+}
+
+type ImportJobComponent struct {
+	Name    string `json:"name" enums:"notebooks,workflows,dataModels,notebookServers,storage"`
+	State   string `json:"state" enums:"Pending,Running,Succeeded,Failed"`
+	Message string `json:"message,omitempty"`
+}
+
+type ListWorkspaceMembersRequest struct {
+	WorkspaceID string `path:"id"`
+}
+
+type ListWorkspaceMembersResponse struct {
+	Items []WorkspaceMember `json:"items"`
+}
+
+type WorkspaceMember struct {
+	UserName   string `json:"userName"`
+	Role       string `json:"role" enums:"owner,writer,reader"`
+	CreateTime int64  `json:"createTime"`
+}
+
+type AddWorkspaceMemberRequest struct {
+	WorkspaceID string `path:"id"`
+	UserName    string `json:"userName"`
+	Role        string `json:"role" enums:"owner,writer,reader"`
+}
+
+type UpdateWorkspaceMemberRequest struct {
+	WorkspaceID string `path:"id"`
+	UserName    string `path:"name"`
+	Role        string `json:"role" enums:"owner,writer,reader"`
+}
+
+type DeleteWorkspaceMemberRequest struct {
+	WorkspaceID string `path:"id"`
+	UserName    string `path:"name"`
 }
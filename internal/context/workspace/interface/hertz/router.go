@@ -31,6 +31,10 @@ func (r *register) AddRoute(h route.IRouter) {
 	addWorkflowRoute(workspace, r.svc)
 	addNotebookRoute(workspace, r.svc)
 	addDataModelRouter(workspace, r.svc)
+
+	admin := h.Group("/admin")
+	admin.Use(apphertz.Authn())
+	addEventRoute(admin, r.svc)
 	return
 }
 
@@ -74,6 +78,50 @@ func addWorkspaceRoute(group *route.RouterGroup, workspaceService *application.W
 		handlers.ImportWorkspace(c, ctx, workspaceService.WorkspaceCommands.ImportWorkspace)
 	})
 
+	group.GET("/import/:id", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return "Workspace:GetImportJob"
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.GetImportJob(c, ctx, workspaceService.WorkspaceQueries.GetImportJob)
+	})
+
+	group.GET("/:id/export", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		workspaceId := c.Param("id")
+		return fmt.Sprintf("Workspace-%s:Export", workspaceId)
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.ExportWorkspace(c, ctx, workspaceService.WorkspaceCommands.ExportWorkspace)
+	})
+
+	group.POST("/:id/clone", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		workspaceId := c.Param("id")
+		return fmt.Sprintf("Workspace-%s:Clone", workspaceId)
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.CloneWorkspace(c, ctx, workspaceService.WorkspaceCommands.CloneWorkspace)
+	})
+
+	group.GET("/:id/member", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:ListMembers", c.Param("id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.ListWorkspaceMembers(c, ctx, workspaceService.WorkspaceQueries.ListMembers)
+	})
+
+	group.POST("/:id/member", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:AddMember", c.Param("id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.AddWorkspaceMember(c, ctx, workspaceService.WorkspaceCommands.AddMember)
+	})
+
+	group.PATCH("/:id/member/:name", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:UpdateMember", c.Param("id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.UpdateWorkspaceMember(c, ctx, workspaceService.WorkspaceCommands.UpdateMember)
+	})
+
+	group.DELETE("/:id/member/:name", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:DeleteMember", c.Param("id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.DeleteWorkspaceMember(c, ctx, workspaceService.WorkspaceCommands.DeleteMember)
+	})
+
 	return
 }
 
@@ -180,8 +228,70 @@ func addDataModelRouter(group *route.RouterGroup, service *application.Workspace
 	})
 
 	group.GET("/:workspace_id/data_model/:id/rows/ids", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
-		return fmt.Sprintf("Workspace-%s:ListAllDataModelRowIDs", c.Param("workspace-id"))
+		return fmt.Sprintf("Workspace-%s:ListAllDataModelRowIDs", c.Param("workspace_id"))
 	}), func(c context.Context, ctx *app.RequestContext) {
 		handlers.ListAllDataModelRowIDs(c, ctx, service.DataModelQueries.ListAllDataModelRowIDs)
 	})
+
+	group.GET("/:workspace_id/data_model/:id/export", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:ExportDataModel", c.Param("workspace_id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.ExportDataModel(c, ctx, service.DataModelQueries.ExportDataModel)
+	})
+
+	group.POST("/:workspace_id/data_model/:id/snapshot", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:CreateDataModelSnapshot", c.Param("workspace_id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.CreateDataModelSnapshot(c, ctx, service.DataModelCommands.CreateDataModelSnapshot)
+	})
+
+	group.GET("/:workspace_id/data_model/:id/snapshot", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:ListDataModelSnapshots", c.Param("workspace_id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.ListDataModelSnapshots(c, ctx, service.DataModelQueries.ListDataModelSnapshots)
+	})
+
+	group.GET("/:workspace_id/data_model/:id/snapshot/diff", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:DiffDataModelSnapshots", c.Param("workspace_id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.DiffDataModelSnapshots(c, ctx, service.DataModelQueries.DiffDataModelSnapshots)
+	})
+
+	group.GET("/:workspace_id/data_model/:id/snapshot/:snapshot_id", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:GetDataModelSnapshot", c.Param("workspace_id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.GetDataModelSnapshot(c, ctx, service.DataModelQueries.GetDataModelSnapshot)
+	})
+
+	group.POST("/:workspace_id/data_model/:id/snapshot/:snapshot_id/restore", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return fmt.Sprintf("Workspace-%s:RestoreDataModelSnapshot", c.Param("workspace_id"))
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.RestoreDataModelSnapshot(c, ctx, service.DataModelCommands.RestoreDataModelSnapshot)
+	})
+}
+
+func addEventRoute(group *route.RouterGroup, service *application.WorkspaceService) {
+	group.GET("/event", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return "Admin:ListEvents"
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.ListEvents(c, ctx, service.EventQueries.ListEvents)
+	})
+
+	group.GET("/event/:id", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return "Admin:GetEvent"
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.GetEvent(c, ctx, service.EventQueries.GetEvent)
+	})
+
+	group.POST("/event/:id/replay", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return "Admin:ReplayEvent"
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.ReplayEvent(c, ctx, service.EventCommands.ReplayEvent)
+	})
+
+	group.DELETE("/event", apphertz.Authz(func(ctx context.Context, c *app.RequestContext) string {
+		return "Admin:PurgeEvents"
+	}), func(c context.Context, ctx *app.RequestContext) {
+		handlers.PurgeEvents(c, ctx, service.EventCommands.PurgeEvents)
+	})
 }
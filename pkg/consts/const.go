@@ -16,7 +16,10 @@
 
 package consts
 
-import "os"
+import (
+	"os"
+	"time"
+)
 
 const (
 	XRequestIDKey = "X-Request-ID"
@@ -47,6 +50,38 @@ const (
 	WorkspaceTypeDataModelRefPrefix = "workspace."
 )
 
+// DataModel's column type
+const (
+	DataModelColumnTypeString  = "string"
+	DataModelColumnTypeInt     = "int"
+	DataModelColumnTypeFloat   = "float"
+	DataModelColumnTypeBoolean = "boolean"
+	DataModelColumnTypeFile    = "file"
+	DataModelColumnTypeArray   = "array"
+	DataModelColumnTypeJSON    = "json"
+)
+
+// DataModel's export format
+const (
+	DataModelExportFormatCSV = "csv"
+	DataModelExportFormatTSV = "tsv"
+)
+
+// Names of the DataModel's snapshots taken automatically
+const (
+	DataModelSnapshotNameBeforeRestore   = "before-restore"
+	DataModelSnapshotNameBeforeWriteBack = "before-write-back"
+	DataModelSnapshotNameSubmission      = "submission-inputs"
+)
+
+// Retention of the DataModel's snapshots taken automatically, the latest ones are always kept
+// and the others are deleted once they are older than the max age. The snapshots of submission
+// inputs are not marked as auto, so they are kept as long as the submissions.
+const (
+	DataModelAutoSnapshotsKept  = 10
+	DataModelAutoSnapshotMaxAge = 30 * 24 * time.Hour
+)
+
 // Submission's type
 const (
 	DataModelTypeSubmission = "dataModel"
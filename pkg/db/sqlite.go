@@ -18,21 +18,80 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/mattn/go-sqlite3"
 	"github.com/spf13/pflag"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
 )
 
 const inmemory = ":memory:"
 
+// SQLite3DriverName is the name of sqlite3 driver with the REGEXP operator, which is used by
+// the data model queries but not implemented by sqlite itself.
+const SQLite3DriverName = "sqlite3_regexp"
+
+func init() {
+	sql.Register(SQLite3DriverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			// X REGEXP Y calls regexp(Y, X)
+			return conn.RegisterFunc("regexp", regexpMatch, true)
+		},
+	})
+}
+
+// SQLite3Dialector returns the gorm dialector of sqlite3 driver with the REGEXP operator, which
+// also migrates the tables whose column types are tagged with mysql charset.
+func SQLite3Dialector(dsn string) gorm.Dialector {
+	return &sqlite3Dialector{Dialector: &sqlite.Dialector{DriverName: SQLite3DriverName, DSN: dsn}}
+}
+
+// mysqlCharsetPattern matches the charset and collation of column types tagged for mysql.
+var mysqlCharsetPattern = regexp.MustCompile(`(?i)\s+(CHARACTER SET|CHARSET|COLLATE)\s+\w+`)
+
+// sqlite3Dialector drops the mysql charset and collation of column types, which sqlite can not parse.
+type sqlite3Dialector struct {
+	*sqlite.Dialector
+}
+
+func (d *sqlite3Dialector) DataTypeOf(field *schema.Field) string {
+	return mysqlCharsetPattern.ReplaceAllString(d.Dialector.DataTypeOf(field), "")
+}
+
+func (d *sqlite3Dialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return sqlite.Migrator{Migrator: migrator.Migrator{Config: migrator.Config{
+		DB:                          db,
+		Dialector:                   d,
+		CreateIndexAfterCreateTable: true,
+	}}}
+}
+
+// regexps caches the compiled patterns, the patterns of queries are few but matched against every row.
+var regexps sync.Map
+
+func regexpMatch(pattern, s string) (bool, error) {
+	re, ok := regexps.Load(pattern)
+	if !ok {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, err
+		}
+		re, _ = regexps.LoadOrStore(pattern, compiled)
+	}
+	return re.(*regexp.Regexp).MatchString(s), nil
+}
+
 // SQLite3Options stands for sqlite options.
 type SQLite3Options struct {
 	File string `json:"file" mapstructure:"file"`
@@ -78,7 +137,7 @@ func (o *SQLite3Options) GetGORMInstance(ctx context.Context) (*gorm.DB, error)
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	orm, err := gorm.Open(sqlite.Open(o.File), &gorm.Config{
+	orm, err := gorm.Open(SQLite3Dialector(o.File), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
 	})
 	if err != nil {
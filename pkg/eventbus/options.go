@@ -17,6 +17,7 @@
 package eventbus
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/spf13/pflag"
@@ -29,27 +30,198 @@ const (
 	DefaultMaxRetries     = 10
 	DefaultDequeueTimeout = time.Minute * 5
 	DefaultRunningTimeout = time.Hour * 24 * 365 // 1year
+	// DefaultCompactPeriod 0 means the compactor is disabled
+	DefaultCompactPeriod      = time.Hour
+	DefaultCompletedRetention = time.Hour * 24 * 7
+	DefaultInitialBackoff     = time.Second * 10
+	DefaultMaxBackoff         = time.Minute * 10
+	DefaultBackoffMultiplier  = 2.0
+	DefaultBackoffJitter      = 0.2
+	DefaultLeaseDuration      = time.Minute
+	DefaultRedisKeyPrefix     = "bioos:eventbus"
+)
+
+// backends of event bus
+const (
+	// BackendDatabase polls the events saved in db
+	BackendDatabase = "database"
+	// BackendRedis delivers the events by redis streams shared by the replicas, the events are not in
+	// the event repository to be inspected or compacted
+	BackendRedis = "redis"
+)
+
+// status of the finished events, which can be reclaimed
+const (
+	retentionStatusCompleted  = "completed"
+	retentionStatusFailed     = "failed"
+	retentionStatusDeadLetter = "deadletter"
 )
 
 type Options struct {
+	// Backend delivers the events, BackendDatabase if empty
+	Backend        string        `json:"backend" mapstructure:"backend"`
 	MaxRetries     int           `json:"maxRetries" mapstructure:"maxRetries"`
 	SyncPeriod     time.Duration `json:"syncPeriod" mapstructure:"syncPeriod"`
 	BatchSize      int           `json:"batchSize" mapstructure:"batchSize"`
 	Workers        int           `json:"workers" mapstructure:"workers"`
 	DequeueTimeout time.Duration `json:"dequeueTimeout" mapstructure:"dequeueTimeout"`
 	RunningTimeout time.Duration `json:"runningTimeout" mapstructure:"runningTimeout"`
+	// LeaseDuration is the time a running event is owned by the event bus without heartbeat,
+	// the event can be taken over by other replicas after the lease expired, 0 means DefaultLeaseDuration
+	LeaseDuration time.Duration `json:"leaseDuration" mapstructure:"leaseDuration"`
+	Retention     *Retention    `json:"retention" mapstructure:"retention"`
+	// RetryPolicies of failed events, the events not matched by any policy are retried
+	// MaxRetries times with the default backoff
+	RetryPolicies []RetryPolicy `json:"retryPolicies" mapstructure:"retryPolicies"`
+	// Redis delivers the events if Backend is BackendRedis
+	Redis *RedisOptions `json:"redis" mapstructure:"redis"`
+}
+
+// RedisOptions is the redis delivering events by streams.
+type RedisOptions struct {
+	Addr     string `json:"addr" mapstructure:"addr"`
+	Username string `json:"username" mapstructure:"username"`
+	Password string `json:"-" mapstructure:"password"`
+	DB       int    `json:"db" mapstructure:"db"`
+	// KeyPrefix of the keys of event bus, DefaultRedisKeyPrefix if empty
+	KeyPrefix string `json:"keyPrefix" mapstructure:"keyPrefix"`
+}
+
+// RetryPolicy retries the failed events with exponential backoff, the backoff of nth retry is
+// InitialBackoff * Multiplier^(n-1) capped by MaxBackoff, and randomly changed by at most Jitter of it.
+// The policy without Types is the default policy of all the other events.
+type RetryPolicy struct {
+	Types          []string      `json:"types" mapstructure:"types"`
+	MaxRetries     int           `json:"maxRetries" mapstructure:"maxRetries"`
+	InitialBackoff time.Duration `json:"initialBackoff" mapstructure:"initialBackoff"`
+	MaxBackoff     time.Duration `json:"maxBackoff" mapstructure:"maxBackoff"`
+	Multiplier     float64       `json:"multiplier" mapstructure:"multiplier"`
+	Jitter         float64       `json:"jitter" mapstructure:"jitter"`
+}
+
+// Retention is the retention policy of finished events.
+type Retention struct {
+	// CompactPeriod is the period to delete the expired events, 0 means disabled
+	CompactPeriod time.Duration `json:"compactPeriod" mapstructure:"compactPeriod"`
+	// Rules of retention, the events not matched by any rule are kept forever
+	Rules []RetentionRule `json:"rules" mapstructure:"rules"`
+}
+
+// RetentionRule keeps the events in status for MaxAge since they are last updated.
+// The rule with Types overrides the rule without Types for the events of these types.
+type RetentionRule struct {
+	Types  []string      `json:"types" mapstructure:"types"`
+	Status string        `json:"status" mapstructure:"status"`
+	MaxAge time.Duration `json:"maxAge" mapstructure:"maxAge"`
 }
 
 // NewOptions new an event bus option.
 func NewOptions() *Options {
-	return &Options{}
+	return &Options{
+		Retention: &Retention{
+			Rules: []RetentionRule{
+				{Status: retentionStatusCompleted, MaxAge: DefaultCompletedRetention},
+			},
+		},
+	}
 }
 
 // Validate validate log options is valid.
 func (o *Options) Validate() error {
+	if o.Backend != "" && o.Backend != BackendDatabase && o.Backend != BackendRedis {
+		return fmt.Errorf("event bus backend must be one of %s and %s", BackendDatabase, BackendRedis)
+	}
+	if o.Backend == BackendRedis && (o.Redis == nil || o.Redis.Addr == "") {
+		return fmt.Errorf("event bus redis address is required by backend %s", BackendRedis)
+	}
+	if o.LeaseDuration < 0 {
+		return fmt.Errorf("event bus lease duration must not be negative")
+	}
+	if err := o.validateRetryPolicies(); err != nil {
+		return err
+	}
+	if o.Retention == nil {
+		return nil
+	}
+	if o.Retention.CompactPeriod < 0 {
+		return fmt.Errorf("event bus compact period must not be negative")
+	}
+	rules := make(map[string]struct{}, len(o.Retention.Rules))
+	for _, rule := range o.Retention.Rules {
+		// only the finished events can be reclaimed
+		if rule.Status != retentionStatusCompleted && rule.Status != retentionStatusFailed && rule.Status != retentionStatusDeadLetter {
+			return fmt.Errorf("status of event retention rule must be one of %s, %s and %s", retentionStatusCompleted, retentionStatusFailed, retentionStatusDeadLetter)
+		}
+		if rule.MaxAge <= 0 {
+			return fmt.Errorf("max age of event retention rule must be positive")
+		}
+		types := rule.Types
+		if len(types) == 0 {
+			types = []string{""}
+		}
+		for _, eventType := range types {
+			key := eventType + "/" + rule.Status
+			if _, ok := rules[key]; ok {
+				return fmt.Errorf("duplicated event retention rule of type %q and status %s", eventType, rule.Status)
+			}
+			rules[key] = struct{}{}
+		}
+	}
 	return nil
 }
 
+func (o *Options) validateRetryPolicies() error {
+	policies := make(map[string]struct{}, len(o.RetryPolicies))
+	for _, policy := range o.RetryPolicies {
+		if policy.MaxRetries <= 0 {
+			return fmt.Errorf("max retries of event retry policy must be positive")
+		}
+		if policy.InitialBackoff <= 0 || policy.MaxBackoff < policy.InitialBackoff {
+			return fmt.Errorf("backoff of event retry policy must be positive and max backoff must not be less than initial backoff")
+		}
+		if policy.Multiplier < 1 {
+			return fmt.Errorf("multiplier of event retry policy must not be less than 1")
+		}
+		if policy.Jitter < 0 || policy.Jitter >= 1 {
+			return fmt.Errorf("jitter of event retry policy must be in [0, 1)")
+		}
+		types := policy.Types
+		if len(types) == 0 {
+			types = []string{""}
+		}
+		for _, eventType := range types {
+			if _, ok := policies[eventType]; ok {
+				return fmt.Errorf("duplicated event retry policy of type %q", eventType)
+			}
+			policies[eventType] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// DefaultRetryPolicy returns the policy without types, or the one retries MaxRetries times
+// with the default backoff if there is no such policy.
+func (o *Options) DefaultRetryPolicy() RetryPolicy {
+	for _, policy := range o.RetryPolicies {
+		if len(policy.Types) == 0 {
+			return policy
+		}
+	}
+	return RetryPolicy{
+		MaxRetries:     o.MaxRetries,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+		Multiplier:     DefaultBackoffMultiplier,
+		Jitter:         DefaultBackoffJitter,
+	}
+}
+
+// Persistent returns true if the events are persisted in the event repository by the backend,
+// the events delivered by broker are only kept in the broker.
+func (o *Options) Persistent() bool {
+	return o.Backend == "" || o.Backend == BackendDatabase
+}
+
 // Enabled check if event bus is enabled
 func (o *Options) Enabled() bool {
 	return true
@@ -57,10 +229,20 @@ func (o *Options) Enabled() bool {
 
 // AddFlags add event bus flags
 func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Backend, "event-bus-backend", BackendDatabase, "backend to deliver events, one of database and redis")
 	fs.IntVar(&o.MaxRetries, "event-bus-max-retries", DefaultMaxRetries, "event max try times")
 	fs.DurationVar(&o.SyncPeriod, "event-bus-sync-period", DefaultSyncPeriod, "sync period in seconds")
 	fs.IntVar(&o.BatchSize, "event-bus-batch-size", DefaultBatchSize, "batch size to get events")
 	fs.IntVar(&o.Workers, "event-bus-workers", DefaultWorkers, "concurrent workers")
 	fs.DurationVar(&o.DequeueTimeout, "event-bus-dequeue-timeout", DefaultDequeueTimeout, "dequeue timeout")
 	fs.DurationVar(&o.RunningTimeout, "event-bus-running-timeout", DefaultRunningTimeout, "running timeout")
+	fs.DurationVar(&o.LeaseDuration, "event-bus-lease-duration", DefaultLeaseDuration, "lease duration of running events, which are renewed by heartbeat")
+	if o.Retention == nil {
+		o.Retention = &Retention{}
+	}
+	if o.Redis == nil {
+		o.Redis = &RedisOptions{}
+	}
+	fs.StringVar(&o.Redis.Addr, "event-bus-redis-addr", "", "address of redis delivering events if the backend is redis")
+	fs.DurationVar(&o.Retention.CompactPeriod, "event-bus-compact-period", DefaultCompactPeriod, "period to delete the expired events, 0 means disabled")
 }
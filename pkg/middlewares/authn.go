@@ -97,6 +97,15 @@ func (a *guardianAuthN) Authenticate(ctx context.Context, r *http.Request) (guar
 	return info, err
 }
 
+// UserNameFromCtx returns the name of authenticated user in context, empty if not authenticated.
+func UserNameFromCtx(ctx context.Context) string {
+	user := guardauth.UserFromCtx(ctx)
+	if user == nil {
+		return ""
+	}
+	return user.GetUserName()
+}
+
 //___________________
 
 type nobodyAuthN struct {
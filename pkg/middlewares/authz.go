@@ -17,7 +17,9 @@
 package middlewares
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,9 +36,33 @@ type Authorizer interface {
 	Authorize(sub, obj, act string) (bool, error)
 }
 
+// WorkspaceAuthorizer authorizes the actions on a workspace by the membership of user. managed is false
+// if the workspace is not managed by membership, e.g. it has no owner, then allowed is meaningless.
+type WorkspaceAuthorizer interface {
+	AuthorizeWorkspace(ctx context.Context, user, workspaceID, act string) (allowed, managed bool, err error)
+}
+
+// WorkspaceAuthorizerFunc is an adapter to use the function as WorkspaceAuthorizer.
+type WorkspaceAuthorizerFunc func(ctx context.Context, user, workspaceID, act string) (allowed, managed bool, err error)
+
+// AuthorizeWorkspace calls f(ctx, user, workspaceID, act).
+func (f WorkspaceAuthorizerFunc) AuthorizeWorkspace(ctx context.Context, user, workspaceID, act string) (bool, bool, error) {
+	return f(ctx, user, workspaceID, act)
+}
+
+// WorkspaceObjectPrefix is the prefix of authorized object of a workspace, e.g. Workspace-<id>.
+const WorkspaceObjectPrefix = "Workspace-"
+
 var (
 	DefaultAuthorizer  Authorizer
 	registerAuthorizer sync.Once
+	// authorizerEnabled is whether the global authorizer is a real one instead of allowing all
+	authorizerEnabled bool
+
+	DefaultWorkspaceAuthorizer WorkspaceAuthorizer
+	// serviceUserName is the user the services of apiserver call each other as, which acts on behalf
+	// of the members and is not a member of workspaces itself
+	serviceUserName string
 )
 
 // RegisterAuthorizer register global authorizer.
@@ -48,6 +74,7 @@ func RegisterAuthorizer(opts *authz.Options) {
 			if err != nil {
 				applog.Fatalf("failed to register global authorizer: %v", err)
 			}
+			authorizerEnabled = true
 			applog.Infof("casbin authz enabled")
 		} else {
 			applog.Warnf("authorization disabled")
@@ -56,6 +83,44 @@ func RegisterAuthorizer(opts *authz.Options) {
 	})
 }
 
+// RegisterWorkspaceAuthorizer register global workspace authorizer which evaluates the membership.
+func RegisterWorkspaceAuthorizer(authorizer WorkspaceAuthorizer) {
+	DefaultWorkspaceAuthorizer = authorizer
+}
+
+// RegisterServiceUser registers the user the services of apiserver call each other as, which is
+// authorized by the global authorizer only instead of the membership of workspaces.
+func RegisterServiceUser(userName string) {
+	serviceUserName = userName
+}
+
+// WorkspaceIDOfObject returns the workspace id if the authorized object is a workspace.
+func WorkspaceIDOfObject(obj string) (string, bool) {
+	if !strings.HasPrefix(obj, WorkspaceObjectPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(obj, WorkspaceObjectPrefix), true
+}
+
+// AuthorizeWorkspace authorizes the action on workspace by the membership of user. The workspaces not
+// managed by membership are authorized by the global authorizer only, and the users permitted by the
+// global authorizer explicitly, e.g. admin of casbin, are always permitted too. So is the service user
+// unless the global authorizer denies it.
+func AuthorizeWorkspace(ctx context.Context, user, workspaceID, act string) (bool, error) {
+	obj := WorkspaceObjectPrefix + workspaceID
+	if DefaultWorkspaceAuthorizer == nil || workspaceID == "" || (serviceUserName != "" && user == serviceUserName) {
+		return DefaultAuthorizer.Authorize(user, obj, act)
+	}
+	allowed, managed, err := DefaultWorkspaceAuthorizer.AuthorizeWorkspace(ctx, user, workspaceID, act)
+	if err != nil {
+		return false, err
+	}
+	if managed && (allowed || !authorizerEnabled) {
+		return allowed, nil
+	}
+	return DefaultAuthorizer.Authorize(user, obj, act)
+}
+
 type casbinAuthZ struct {
 	*casbin.SyncedEnforcer
 }
@@ -0,0 +1,143 @@
+//
+// Copyright 2023 Beijing Volcano Engine Technology Ltd.
+// Copyright 2023 Guangzhou Laboratory
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/pkg/auth/authz"
+)
+
+// fakeWorkspaceAuthorizer has the roles of users by workspace, the workspaces absent are not managed.
+// Owners are permitted to do everything and readers are permitted to Get only.
+type fakeWorkspaceAuthorizer map[string]map[string]string
+
+func (f fakeWorkspaceAuthorizer) AuthorizeWorkspace(_ context.Context, user, workspaceID, act string) (bool, bool, error) {
+	roles, ok := f[workspaceID]
+	if !ok {
+		return false, false, nil
+	}
+	switch roles[user] {
+	case "owner":
+		return true, true, nil
+	case "reader":
+		return act == "Get", true, nil
+	default:
+		return false, true, nil
+	}
+}
+
+// useAuthorizers replaces the global authorizers during the test, the casbin authorizer uses the
+// shipped model and policy if casbinEnabled.
+func useAuthorizers(t *testing.T, casbinEnabled bool, workspaceAuthorizer WorkspaceAuthorizer) {
+	defaultAuthorizer, enabled, defaultWorkspaceAuthorizer, serviceUser := DefaultAuthorizer, authorizerEnabled, DefaultWorkspaceAuthorizer, serviceUserName
+	t.Cleanup(func() {
+		DefaultAuthorizer, authorizerEnabled, DefaultWorkspaceAuthorizer, serviceUserName = defaultAuthorizer, enabled, defaultWorkspaceAuthorizer, serviceUser
+	})
+	if casbinEnabled {
+		authorizer, err := newCasbinAuthorizer(&authz.CasbinOption{
+			ModelFile:  "../../conf/model.conf",
+			PolicyFile: "../../conf/policy.csv",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		DefaultAuthorizer = authorizer
+	} else {
+		DefaultAuthorizer = newNoneAuthorizer()
+	}
+	authorizerEnabled = casbinEnabled
+	DefaultWorkspaceAuthorizer = workspaceAuthorizer
+}
+
+func TestAuthorizeWorkspace(t *testing.T) {
+	workspaces := fakeWorkspaceAuthorizer{
+		"owned": {"alice": "owner", "bob": "reader"},
+	}
+	cases := []struct {
+		name          string
+		casbinEnabled bool
+		user          string
+		workspaceID   string
+		act           string
+		allowed       bool
+	}{
+		// workspaces without owner are left to casbin, which denies user1 except the policies
+		{"casbin denies user of ownerless workspace", true, "user1", "legacy", "Delete", false},
+		{"casbin permits admin of ownerless workspace", true, "admin", "legacy", "Delete", true},
+		{"casbin permits owner", true, "alice", "owned", "Delete", true},
+		{"casbin denies reader beyond role", true, "bob", "owned", "Delete", false},
+		{"casbin permits reader within role", true, "bob", "owned", "Get", true},
+		{"casbin denies non member", true, "user1", "owned", "Get", false},
+		{"casbin permits admin of owned workspace", true, "admin", "owned", "Delete", true},
+		// everything is permitted without casbin except the workspaces managed by membership
+		{"ownerless workspace without casbin", false, "user1", "legacy", "Delete", true},
+		{"owner without casbin", false, "alice", "owned", "Delete", true},
+		{"reader beyond role without casbin", false, "bob", "owned", "Delete", false},
+		{"non member without casbin", false, "user1", "owned", "Get", false},
+		{"admin not member without casbin", false, "admin", "owned", "Get", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			useAuthorizers(t, c.casbinEnabled, workspaces)
+			allowed, err := AuthorizeWorkspace(context.TODO(), c.user, c.workspaceID, c.act)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(allowed).To(gomega.Equal(c.allowed))
+		})
+	}
+}
+
+func TestAuthorizeWorkspaceOfServiceUser(t *testing.T) {
+	g := gomega.NewWithT(t)
+	workspaces := fakeWorkspaceAuthorizer{
+		"owned": {"alice": "owner"},
+	}
+
+	// the service user acts on behalf of members without being a member
+	for _, casbinEnabled := range []bool{true, false} {
+		useAuthorizers(t, casbinEnabled, workspaces)
+		RegisterServiceUser("admin")
+		allowed, err := AuthorizeWorkspace(context.TODO(), "admin", "owned", "PatchDataModel")
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(allowed).To(gomega.BeTrue(), "casbin enabled: %v", casbinEnabled)
+	}
+
+	// the service user is still denied by casbin
+	useAuthorizers(t, true, workspaces)
+	RegisterServiceUser("user1")
+	allowed, err := AuthorizeWorkspace(context.TODO(), "user1", "owned", "PatchDataModel")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(allowed).To(gomega.BeFalse())
+}
+
+func TestAuthorizeWorkspaceWithoutMembership(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	useAuthorizers(t, true, nil)
+	allowed, err := AuthorizeWorkspace(context.TODO(), "user1", "legacy", "Delete")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(allowed).To(gomega.BeFalse())
+
+	useAuthorizers(t, false, nil)
+	allowed, err = AuthorizeWorkspace(context.TODO(), "user1", "legacy", "Delete")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(allowed).To(gomega.BeTrue())
+}
@@ -28,12 +28,20 @@ import (
 	"github.com/Bio-OS/bioos/pkg/middlewares"
 )
 
+// workspaceRequest is the request on a workspace, which is authorized by the membership of user.
+type workspaceRequest interface {
+	GetWorkspaceID() string
+}
+
 // RBACUnaryServerChain check rbac permission in unary.
 func RBACUnaryServerChain() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		if err := checkPermission(ctx, info.FullMethod); err != nil {
 			return nil, err
 		}
+		if err := checkWorkspacePermission(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
 		return handler(ctx, req)
 	}
 }
@@ -44,8 +52,26 @@ func RBACStreamServerChain() grpc.StreamServerInterceptor {
 		if err := checkPermission(ss.Context(), info.FullMethod); err != nil {
 			return err
 		}
-		return handler(srv, ss)
+		return handler(srv, &authorizedServerStream{ServerStream: ss, fullMethod: info.FullMethod})
+	}
+}
+
+// authorizedServerStream checks the workspace permission with the first received request.
+type authorizedServerStream struct {
+	grpc.ServerStream
+	fullMethod string
+	checked    bool
+}
+
+func (s *authorizedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.checked {
+		return nil
 	}
+	s.checked = true
+	return checkWorkspacePermission(s.Context(), s.fullMethod, m)
 }
 
 func checkPermission(ctx context.Context, fullMethod string) error {
@@ -68,3 +94,22 @@ func checkPermission(ctx context.Context, fullMethod string) error {
 	applog.Debugw("user permitted to enter", "obj", obj, "action", action, "user", user)
 	return nil
 }
+
+func checkWorkspacePermission(ctx context.Context, fullMethod string, req interface{}) error {
+	r, ok := req.(workspaceRequest)
+	if !ok || r.GetWorkspaceID() == "" || middlewares.DefaultWorkspaceAuthorizer == nil {
+		return nil
+	}
+	action := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	user := middlewares.UserNameFromCtx(ctx)
+	allowed, err := middlewares.AuthorizeWorkspace(ctx, user, r.GetWorkspaceID(), action)
+	if err != nil {
+		applog.Errorw("authorize workspace fail", "err", err)
+		return grpc.Errorf(codes.Internal, "Authorizer internal error")
+	}
+	if !allowed {
+		applog.Debugw("user not allowed", "workspace", r.GetWorkspaceID(), "action", action, "user", user)
+		return grpc.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return nil
+}
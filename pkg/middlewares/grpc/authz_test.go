@@ -0,0 +1,87 @@
+//
+// Copyright 2023 Beijing Volcano Engine Technology Ltd.
+// Copyright 2023 Guangzhou Laboratory
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/shaj13/go-guardian/v2/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Bio-OS/bioos/pkg/auth/authz"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/middlewares"
+)
+
+func TestMain(m *testing.M) {
+	applog.RegisterLogger(&applog.Options{
+		Level: "fatal",
+	})
+	// casbin is disabled, so the workspaces are authorized by the membership only
+	middlewares.RegisterAuthorizer(&authz.Options{})
+	os.Exit(m.Run())
+}
+
+type fakeWorkspaceRequest struct {
+	workspaceID string
+}
+
+func (r *fakeWorkspaceRequest) GetWorkspaceID() string {
+	return r.workspaceID
+}
+
+func TestRBACUnaryServerChain(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// the workspace is owned by a user other than the service user
+	middlewares.RegisterWorkspaceAuthorizer(middlewares.WorkspaceAuthorizerFunc(
+		func(_ context.Context, user, workspaceID, _ string) (bool, bool, error) {
+			return user == "alice", workspaceID == "owned", nil
+		}))
+	middlewares.RegisterServiceUser("admin")
+	t.Cleanup(func() {
+		middlewares.RegisterWorkspaceAuthorizer(nil)
+		middlewares.RegisterServiceUser("")
+	})
+	interceptor := RBACUnaryServerChain()
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(user, method, workspaceID string) error {
+		ctx := auth.CtxWithUser(context.TODO(), auth.NewUserInfo(user, user, nil, nil))
+		_, err := interceptor(ctx, &fakeWorkspaceRequest{workspaceID: workspaceID}, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	// the submission service reads and writes back the data model as the service user
+	for _, method := range []string{
+		"/proto.WorkspaceService/GetWorkspace",
+		"/proto.DataModelService/CreateDataModelSnapshot",
+		"/proto.DataModelService/PatchDataModel",
+	} {
+		g.Expect(call("admin", method, "owned")).To(gomega.Succeed(), method)
+		g.Expect(call("alice", method, "owned")).To(gomega.Succeed(), method)
+		g.Expect(status.Code(call("user1", method, "owned"))).To(gomega.Equal(codes.PermissionDenied), method)
+	}
+	// the workspace without owner is permitted to everyone when casbin is disabled
+	g.Expect(call("user1", "/proto.DataModelService/PatchDataModel", "legacy")).To(gomega.Succeed())
+}
@@ -53,7 +53,13 @@ func Authz(permissionFunc PermissionFunc) app.HandlerFunc {
 			return
 		}
 
-		allowed, err := middlewares.DefaultAuthorizer.Authorize(user.GetUserName(), permissions[0], permissions[1])
+		var allowed bool
+		var err error
+		if workspaceID, ok := middlewares.WorkspaceIDOfObject(permissions[0]); ok {
+			allowed, err = middlewares.AuthorizeWorkspace(ctx, user.GetUserName(), workspaceID, permissions[1])
+		} else {
+			allowed, err = middlewares.DefaultAuthorizer.Authorize(user.GetUserName(), permissions[0], permissions[1])
+		}
 		if err != nil {
 			applog.Errorf("authorize fail %s", err)
 			c.AbortWithStatus(consts.StatusInternalServerError)
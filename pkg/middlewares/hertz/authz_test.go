@@ -0,0 +1,105 @@
+//
+// Copyright 2023 Beijing Volcano Engine Technology Ltd.
+// Copyright 2023 Guangzhou Laboratory
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hertz
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/onsi/gomega"
+	"github.com/shaj13/go-guardian/v2/auth"
+
+	"github.com/Bio-OS/bioos/pkg/auth/authz"
+	applog "github.com/Bio-OS/bioos/pkg/log"
+	"github.com/Bio-OS/bioos/pkg/middlewares"
+)
+
+func TestMain(m *testing.M) {
+	applog.RegisterLogger(&applog.Options{
+		Level: "fatal",
+	})
+	// casbin is disabled, so the workspaces are authorized by the membership only
+	middlewares.RegisterAuthorizer(&authz.Options{})
+	os.Exit(m.Run())
+}
+
+func TestAuthz(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// the workspace is owned by alice and bob is a reader of it
+	middlewares.RegisterWorkspaceAuthorizer(middlewares.WorkspaceAuthorizerFunc(
+		func(_ context.Context, user, workspaceID, act string) (bool, bool, error) {
+			switch workspaceID {
+			case "owned":
+				return user == "alice" || (user == "bob" && act == "read"), true, nil
+			case "broken":
+				return false, false, fmt.Errorf("an error")
+			}
+			return false, false, nil
+		}))
+	middlewares.RegisterServiceUser("admin")
+	t.Cleanup(func() {
+		middlewares.RegisterWorkspaceAuthorizer(nil)
+		middlewares.RegisterServiceUser("")
+	})
+	// serve calls the handler behind Authz, and returns the status of response
+	serve := func(user, permission string) int {
+		ctx := context.TODO()
+		if user != "" {
+			ctx = auth.CtxWithUser(ctx, auth.NewUserInfo(user, user, nil, nil))
+		}
+		c := app.NewContext(0)
+		c.SetHandlers(app.HandlersChain{
+			Authz(func(context.Context, *app.RequestContext) string {
+				return permission
+			}),
+			func(_ context.Context, c *app.RequestContext) {
+				c.Status(consts.StatusOK)
+			},
+		})
+		c.Next(ctx)
+		return c.Response.StatusCode()
+	}
+
+	cases := []struct {
+		user       string
+		permission string
+		status     int
+	}{
+		{"", "", consts.StatusOK},
+		{"", "Workspace-owned:read", consts.StatusUnauthorized},
+		{"alice", "Workspace-owned", consts.StatusInternalServerError},
+		{"alice", "Workspace-owned:write", consts.StatusOK},
+		{"bob", "Workspace-owned:read", consts.StatusOK},
+		{"bob", "Workspace-owned:write", consts.StatusForbidden},
+		{"user1", "Workspace-owned:read", consts.StatusForbidden},
+		// the service user acts on behalf of the members
+		{"admin", "Workspace-owned:write", consts.StatusOK},
+		// the workspace without owner is permitted to everyone when casbin is disabled
+		{"user1", "Workspace-legacy:write", consts.StatusOK},
+		{"user1", "Workspace-broken:read", consts.StatusInternalServerError},
+		// the objects other than workspace are authorized by the global authorizer
+		{"user1", "Workflow:read", consts.StatusOK},
+	}
+	for _, c := range cases {
+		g.Expect(serve(c.user, c.permission)).To(gomega.Equal(c.status), "%s %s", c.user, c.permission)
+	}
+}
@@ -24,6 +24,8 @@ type WorkspaceTypedSchema struct {
 	DataModels  []DataModelTypedSchema `yaml:"dataModels,omitempty"`
 	Workflows   []WorkflowTypedSchema  `yaml:"workflows,omitempty"`
 	Notebooks   NotebookTypedSchema    `yaml:"notebooks,omitempty"`
+	// Submissions are only exported on request and not imported
+	Submissions []SubmissionTypedSchema `yaml:"submissions,omitempty"`
 }
 
 // DataModelTypedSchema ...
@@ -31,6 +33,8 @@ type DataModelTypedSchema struct {
 	Name string `yaml:"name"`
 	Type string `yaml:"type"`
 	Path string `yaml:"path"`
+	// ColumnTypes are the types of entity columns by header, columns not in it are inferred from values
+	ColumnTypes map[string]string `yaml:"columnTypes,omitempty"`
 }
 
 // WorkflowTypedSchema ...
@@ -71,3 +75,24 @@ type Artifact struct {
 	Name string `yaml:"name"`
 	Path string `yaml:"path"`
 }
+
+// SubmissionTypedSchema ...
+type SubmissionTypedSchema struct {
+	Name        string  `yaml:"name"`
+	Description *string `yaml:"description,omitempty"`
+	Type        string  `yaml:"type"`
+	Status      string  `yaml:"status"`
+	// Workflow is the name of workflow submitted
+	Workflow string `yaml:"workflow"`
+	// Path is the yaml file of runs
+	Path string `yaml:"path"`
+}
+
+// RunTypedSchema ...
+type RunTypedSchema struct {
+	Name    string  `yaml:"name"`
+	Status  string  `yaml:"status"`
+	Inputs  string  `yaml:"inputs,omitempty"`
+	Outputs string  `yaml:"outputs,omitempty"`
+	Message *string `yaml:"message,omitempty"`
+}
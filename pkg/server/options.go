@@ -17,8 +17,6 @@
 package server
 
 import (
-	"fmt"
-
 	"github.com/spf13/pflag"
 
 	"github.com/Bio-OS/bioos/pkg/utils"
@@ -41,12 +39,11 @@ func (o Options) Validate() error {
 	if err := o.Http.Validate(); err != nil {
 		return err
 	}
-	// validate womtool file
-	if o.WomtoolFile == "" {
-		return fmt.Errorf("womtool file can not be empty")
-	}
-	if err := utils.ValidateFileExist(o.WomtoolFile); err != nil {
-		return err
+	// womtool is optional, wdl is parsed in process and falls back to womtool if configured
+	if o.WomtoolFile != "" {
+		if err := utils.ValidateFileExist(o.WomtoolFile); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -57,7 +54,7 @@ func (o Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.CertFile, "cert-file", "", "server cert file")
 	fs.StringVar(&o.KeyFile, "key-file", "", "server key file")
 	fs.StringVar(&o.CaFile, "ca-file", "", "ca file")
-	fs.StringVar(&o.WomtoolFile, "womtool-file", "womtool.jar", "womtool file")
+	fs.StringVar(&o.WomtoolFile, "womtool-file", "", "womtool file, used as the fallback of the builtin wdl parser, required by the wdl workflows with http(s) imports")
 }
 
 func NewOptions() *Options {
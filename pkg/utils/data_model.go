@@ -18,11 +18,13 @@ package utils
 
 import (
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/Bio-OS/bioos/pkg/consts"
@@ -50,6 +52,159 @@ func GenDataModelEntitySetName(name string) string {
 	return name + consts.DataModelEntitySetNameSuffix
 }
 
+// dataModelFilePrefixes are the prefixes of values of file type column.
+var dataModelFilePrefixes = []string{"s3://", "tos://", "oss://", "gs://", "http://", "https://", "/"}
+
+// ValidDataModelColumnType returns whether typ is a supported data model column type.
+func ValidDataModelColumnType(typ string) bool {
+	switch typ {
+	case consts.DataModelColumnTypeString, consts.DataModelColumnTypeInt, consts.DataModelColumnTypeFloat,
+		consts.DataModelColumnTypeBoolean, consts.DataModelColumnTypeFile, consts.DataModelColumnTypeArray,
+		consts.DataModelColumnTypeJSON:
+		return true
+	}
+	return false
+}
+
+// ValidateDataModelValue returns whether value matches the column type, empty value matches all types.
+func ValidateDataModelValue(typ, value string) bool {
+	if value == "" {
+		return true
+	}
+	switch typ {
+	case consts.DataModelColumnTypeInt:
+		_, err := strconv.ParseInt(value, 10, 64)
+		return err == nil
+	case consts.DataModelColumnTypeFloat:
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case consts.DataModelColumnTypeBoolean:
+		return value == "true" || value == "false"
+	case consts.DataModelColumnTypeFile:
+		for _, prefix := range dataModelFilePrefixes {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+		}
+		return false
+	case consts.DataModelColumnTypeArray:
+		var array []interface{}
+		return json.Unmarshal([]byte(value), &array) == nil
+	case consts.DataModelColumnTypeJSON:
+		return json.Valid([]byte(value))
+	default:
+		return true
+	}
+}
+
+// InferDataModelColumnType returns the most specific column type matching all the values, it is
+// string if there is no value.
+func InferDataModelColumnType(values []string) string {
+	for _, typ := range []string{
+		consts.DataModelColumnTypeBoolean,
+		consts.DataModelColumnTypeInt,
+		consts.DataModelColumnTypeFloat,
+		consts.DataModelColumnTypeFile,
+		consts.DataModelColumnTypeArray,
+	} {
+		matched := false
+		for _, value := range values {
+			if !ValidateDataModelValue(typ, value) {
+				matched = false
+				break
+			}
+			matched = matched || value != ""
+		}
+		if matched {
+			return typ
+		}
+	}
+	// only infer json for objects, as json matches numbers and strings too
+	matched := false
+	for _, value := range values {
+		var object map[string]interface{}
+		if value != "" && json.Unmarshal([]byte(value), &object) != nil {
+			matched = false
+			break
+		}
+		matched = matched || value != ""
+	}
+	if matched {
+		return consts.DataModelColumnTypeJSON
+	}
+	return consts.DataModelColumnTypeString
+}
+
+// dataModelWiderColumnTypes are the wider types of each column type from the narrowest, the values
+// matching a type always match its wider types.
+var dataModelWiderColumnTypes = map[string][]string{
+	consts.DataModelColumnTypeInt:     {consts.DataModelColumnTypeFloat, consts.DataModelColumnTypeString},
+	consts.DataModelColumnTypeFloat:   {consts.DataModelColumnTypeString},
+	consts.DataModelColumnTypeBoolean: {consts.DataModelColumnTypeString},
+	consts.DataModelColumnTypeFile:    {consts.DataModelColumnTypeString},
+	consts.DataModelColumnTypeArray:   {consts.DataModelColumnTypeJSON, consts.DataModelColumnTypeString},
+	consts.DataModelColumnTypeJSON:    {consts.DataModelColumnTypeString},
+}
+
+// CanWidenDataModelColumnType returns whether the column type from can be changed to the column
+// type to without invalidating the values, i.e. to is from or a wider type of it.
+func CanWidenDataModelColumnType(from, to string) bool {
+	if from == to {
+		return true
+	}
+	for _, typ := range dataModelWiderColumnTypes[from] {
+		if typ == to {
+			return true
+		}
+	}
+	return false
+}
+
+// WidenDataModelColumnType returns the narrowest type among typ and its wider types matching all
+// the values, e.g. int is widened to float by 2.5 and to string by liver.
+func WidenDataModelColumnType(typ string, values []string) string {
+	for _, candidate := range append([]string{typ}, dataModelWiderColumnTypes[typ]...) {
+		matched := true
+		for _, value := range values {
+			if !ValidateDataModelValue(candidate, value) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return candidate
+		}
+	}
+	return consts.DataModelColumnTypeString
+}
+
+// ParseDataModelValue parses value of the column type. Values of string column and the ones not
+// matching the column type are parsed by UnmarshalParamValue as before column types are introduced.
+func ParseDataModelValue(typ, value string) interface{} {
+	switch typ {
+	case consts.DataModelColumnTypeInt:
+		if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return number
+		}
+	case consts.DataModelColumnTypeFloat:
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return number
+		}
+	case consts.DataModelColumnTypeBoolean:
+		if boolean, err := strconv.ParseBool(value); err == nil {
+			return boolean
+		}
+	case consts.DataModelColumnTypeFile:
+		return value
+	case consts.DataModelColumnTypeArray, consts.DataModelColumnTypeJSON:
+		var res interface{}
+		if err := json.Unmarshal([]byte(value), &res); err == nil {
+			return res
+		}
+	}
+	return UnmarshalParamValue(value)
+}
+
 func ReadDataModelFromCSV(filePath string) ([]string, [][]string, error) {
 	if path.Ext(filePath) != ".csv" {
 		return nil, nil, fmt.Errorf("%s not support, please use a csv file", path.Ext(filePath))
@@ -98,12 +253,26 @@ func WriteDataModelToCSVFile(filePath string, headers []string, rows [][]string)
 	}
 	defer file.Close()
 
-	fileCSV := bom.WriteWithBOM(file, bom.UTF8)
+	return WriteDataModelToCSV(file, headers, rows)
+}
+
+// NewDataModelWriter returns a writer of data model rows in format, csv is written with utf-8 BOM
+// and tsv is separated by tab.
+func NewDataModelWriter(writer io.Writer, format string) *csv.Writer {
+	if format == consts.DataModelExportFormatTSV {
+		w := csv.NewWriter(writer)
+		w.Comma = '\t'
+		return w
+	}
+	return csv.NewWriter(bom.WriteWithBOM(writer, bom.UTF8))
+}
 
-	w := csv.NewWriter(fileCSV)
+// WriteDataModelToCSV writes headers and rows to w as csv with utf-8 BOM.
+func WriteDataModelToCSV(writer io.Writer, headers []string, rows [][]string) error {
+	w := NewDataModelWriter(writer, consts.DataModelExportFormatCSV)
 
 	// write headers
-	err = w.Write(headers)
+	err := w.Write(headers)
 	if err != nil {
 		return err
 	}
@@ -118,5 +287,5 @@ func WriteDataModelToCSVFile(filePath string, headers []string, rows [][]string)
 		// flush buffer
 		w.Flush()
 	}
-	return nil
+	return w.Error()
 }
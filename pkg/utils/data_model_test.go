@@ -0,0 +1,95 @@
+//
+// Copyright 2023 Beijing Volcano Engine Technology Ltd.
+// Copyright 2023 Guangzhou Laboratory
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/Bio-OS/bioos/pkg/consts"
+)
+
+func TestInferDataModelColumnType(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	cases := []struct {
+		values []string
+		typ    string
+	}{
+		{nil, consts.DataModelColumnTypeString},
+		{[]string{"", ""}, consts.DataModelColumnTypeString},
+		{[]string{"true", "", "false"}, consts.DataModelColumnTypeBoolean},
+		{[]string{"1", "-20"}, consts.DataModelColumnTypeInt},
+		{[]string{"1", "2.5", "1e6"}, consts.DataModelColumnTypeFloat},
+		{[]string{"s3://bucket/a.bam", "/data/b.bam"}, consts.DataModelColumnTypeFile},
+		{[]string{`["a"]`, "[]"}, consts.DataModelColumnTypeArray},
+		{[]string{`{"a":1}`, ""}, consts.DataModelColumnTypeJSON},
+		{[]string{"1", "liver"}, consts.DataModelColumnTypeString},
+		{[]string{`{"a":1}`, "1"}, consts.DataModelColumnTypeString},
+	}
+	for _, c := range cases {
+		g.Expect(InferDataModelColumnType(c.values)).To(gomega.Equal(c.typ), "%v", c.values)
+	}
+}
+
+func TestWidenDataModelColumnType(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	cases := []struct {
+		typ    string
+		values []string
+		widen  string
+	}{
+		{consts.DataModelColumnTypeInt, []string{"1", ""}, consts.DataModelColumnTypeInt},
+		{consts.DataModelColumnTypeInt, []string{"1", "2.5"}, consts.DataModelColumnTypeFloat},
+		{consts.DataModelColumnTypeInt, []string{"1", "liver"}, consts.DataModelColumnTypeString},
+		{consts.DataModelColumnTypeFloat, []string{"true"}, consts.DataModelColumnTypeString},
+		{consts.DataModelColumnTypeArray, []string{`{"a":1}`}, consts.DataModelColumnTypeJSON},
+		{consts.DataModelColumnTypeFile, []string{"a.bam"}, consts.DataModelColumnTypeString},
+		{consts.DataModelColumnTypeString, []string{"1"}, consts.DataModelColumnTypeString},
+	}
+	for _, c := range cases {
+		g.Expect(WidenDataModelColumnType(c.typ, c.values)).To(gomega.Equal(c.widen), "%s %v", c.typ, c.values)
+	}
+
+	g.Expect(CanWidenDataModelColumnType(consts.DataModelColumnTypeInt, consts.DataModelColumnTypeInt)).To(gomega.BeTrue())
+	g.Expect(CanWidenDataModelColumnType(consts.DataModelColumnTypeInt, consts.DataModelColumnTypeFloat)).To(gomega.BeTrue())
+	g.Expect(CanWidenDataModelColumnType(consts.DataModelColumnTypeArray, consts.DataModelColumnTypeString)).To(gomega.BeTrue())
+	g.Expect(CanWidenDataModelColumnType(consts.DataModelColumnTypeFloat, consts.DataModelColumnTypeInt)).To(gomega.BeFalse())
+	g.Expect(CanWidenDataModelColumnType(consts.DataModelColumnTypeString, consts.DataModelColumnTypeJSON)).To(gomega.BeFalse())
+}
+
+func TestParseDataModelValue(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	g.Expect(ParseDataModelValue(consts.DataModelColumnTypeInt, "3")).To(gomega.Equal(int64(3)))
+	g.Expect(ParseDataModelValue(consts.DataModelColumnTypeFloat, "1e6")).To(gomega.Equal(1e6))
+	g.Expect(ParseDataModelValue(consts.DataModelColumnTypeBoolean, "true")).To(gomega.Equal(true))
+	g.Expect(ParseDataModelValue(consts.DataModelColumnTypeFile, "/data/1")).To(gomega.Equal("/data/1"))
+	g.Expect(ParseDataModelValue(consts.DataModelColumnTypeArray, `["a",1]`)).To(gomega.Equal([]interface{}{"a", float64(1)}))
+	g.Expect(ParseDataModelValue(consts.DataModelColumnTypeJSON, `{"a":"b"}`)).To(gomega.Equal(map[string]interface{}{"a": "b"}))
+	// values of string column are parsed as before
+	g.Expect(ParseDataModelValue(consts.DataModelColumnTypeString, "liver")).To(gomega.Equal("liver"))
+	g.Expect(ParseDataModelValue(consts.DataModelColumnTypeString, `["a"]`)).To(gomega.Equal([]interface{}{"a"}))
+	g.Expect(ParseDataModelValue(consts.DataModelColumnTypeInt, "")).To(gomega.Equal(""))
+
+	g.Expect(ValidateDataModelValue(consts.DataModelColumnTypeInt, "1.5")).To(gomega.BeFalse())
+	g.Expect(ValidateDataModelValue(consts.DataModelColumnTypeFile, "a.bam")).To(gomega.BeFalse())
+	g.Expect(ValidateDataModelValue(consts.DataModelColumnTypeJSON, "{")).To(gomega.BeFalse())
+	g.Expect(ValidDataModelColumnType("date")).To(gomega.BeFalse())
+}
@@ -18,6 +18,7 @@ package utils
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/onsi/gomega"
@@ -75,3 +76,31 @@ func TestIsSubPath(t *testing.T) {
 		}
 	}
 }
+
+func TestCopyDir(t *testing.T) {
+	g := gomega.NewWithT(t)
+	src, dst := t.TempDir(), t.TempDir()
+	g.Expect(os.MkdirAll(filepath.Join(src, "a", "b"), 0o755)).To(gomega.Succeed())
+	g.Expect(os.WriteFile(filepath.Join(src, "a", "b", "c.txt"), []byte("c"), 0o600)).To(gomega.Succeed())
+	g.Expect(os.WriteFile(filepath.Join(src, "d.txt"), []byte("d"), 0o644)).To(gomega.Succeed())
+	g.Expect(os.Symlink("d.txt", filepath.Join(src, "e.txt"))).To(gomega.Succeed())
+	// existing files are overwritten
+	g.Expect(os.WriteFile(filepath.Join(dst, "d.txt"), []byte("old"), 0o644)).To(gomega.Succeed())
+
+	g.Expect(CopyDir(src, dst)).To(gomega.Succeed())
+	content, err := os.ReadFile(filepath.Join(dst, "a", "b", "c.txt"))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(string(content)).To(gomega.Equal("c"))
+	info, err := os.Stat(filepath.Join(dst, "a", "b", "c.txt"))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(info.Mode().Perm()).To(gomega.Equal(os.FileMode(0o600)))
+	content, err = os.ReadFile(filepath.Join(dst, "d.txt"))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(string(content)).To(gomega.Equal("d"))
+	link, err := os.Readlink(filepath.Join(dst, "e.txt"))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(link).To(gomega.Equal("d.txt"))
+
+	// copy again
+	g.Expect(CopyDir(src, dst)).To(gomega.Succeed())
+}
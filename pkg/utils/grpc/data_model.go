@@ -31,6 +31,9 @@ type DataModelClient interface {
 	ListDataModelRows(context.Context, *workspaceproto.ListDataModelRowsRequest) (*workspaceproto.ListDataModelRowsResponse, error)
 	ListAllDataModelRowIDs(context.Context, *workspaceproto.ListAllDataModelRowIDsRequest) (*workspaceproto.ListAllDataModelRowIDsResponse, error)
 	PatchDataModel(context.Context, *workspaceproto.PatchDataModelRequest) (*workspaceproto.PatchDataModelResponse, error)
+	CreateDataModelSnapshot(context.Context, *workspaceproto.CreateDataModelSnapshotRequest) (*workspaceproto.CreateDataModelSnapshotResponse, error)
+	GetDataModelSnapshot(context.Context, *workspaceproto.GetDataModelSnapshotRequest) (*workspaceproto.GetDataModelSnapshotResponse, error)
+	DeleteDataModelSnapshot(context.Context, *workspaceproto.DeleteDataModelSnapshotRequest) (*workspaceproto.DeleteDataModelSnapshotResponse, error)
 }
 
 func NewDataModelClient(opts *client.Options) (DataModelClient, error) {
@@ -112,3 +115,42 @@ func (d dataModelClientImpl) PatchDataModel(ctx context.Context, req *workspacep
 	}
 	return nil, fmt.Errorf("not support method")
 }
+
+func (d dataModelClientImpl) CreateDataModelSnapshot(ctx context.Context, req *workspaceproto.CreateDataModelSnapshotRequest) (*workspaceproto.CreateDataModelSnapshotResponse, error) {
+	if d.opts.Method == client.GRPCMethod {
+		conn, err := utils.GrpcDial(d.opts.ConnectInfo, d.opts.AuthInfo)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		client := workspaceproto.NewDataModelServiceClient(conn)
+		return client.CreateDataModelSnapshot(ctx, req)
+	}
+	return nil, fmt.Errorf("not support method")
+}
+
+func (d dataModelClientImpl) GetDataModelSnapshot(ctx context.Context, req *workspaceproto.GetDataModelSnapshotRequest) (*workspaceproto.GetDataModelSnapshotResponse, error) {
+	if d.opts.Method == client.GRPCMethod {
+		conn, err := utils.GrpcDial(d.opts.ConnectInfo, d.opts.AuthInfo)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		client := workspaceproto.NewDataModelServiceClient(conn)
+		return client.GetDataModelSnapshot(ctx, req)
+	}
+	return nil, fmt.Errorf("not support method")
+}
+
+func (d dataModelClientImpl) DeleteDataModelSnapshot(ctx context.Context, req *workspaceproto.DeleteDataModelSnapshotRequest) (*workspaceproto.DeleteDataModelSnapshotResponse, error) {
+	if d.opts.Method == client.GRPCMethod {
+		conn, err := utils.GrpcDial(d.opts.ConnectInfo, d.opts.AuthInfo)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		client := workspaceproto.NewDataModelServiceClient(conn)
+		return client.DeleteDataModelSnapshot(ctx, req)
+	}
+	return nil, fmt.Errorf("not support method")
+}
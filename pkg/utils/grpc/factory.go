@@ -25,6 +25,7 @@ type Factory interface {
 	WorkflowClient() (WorkflowClient, error)
 	DataModelClient() (DataModelClient, error)
 	VersionClient() (VersionClient, error)
+	SubmissionClient() (SubmissionClient, error)
 }
 
 func NewFactory(opts *client.Options) Factory {
@@ -54,3 +55,7 @@ func (f factoryImpl) DataModelClient() (DataModelClient, error) {
 func (f factoryImpl) VersionClient() (VersionClient, error) {
 	return NewVersionClient(f.opts)
 }
+
+func (f factoryImpl) SubmissionClient() (SubmissionClient, error) {
+	return NewSubmissionClient(f.opts)
+}
@@ -0,0 +1,73 @@
+//
+// Copyright 2023 Beijing Volcano Engine Technology Ltd.
+// Copyright 2023 Guangzhou Laboratory
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	submissionproto "github.com/Bio-OS/bioos/internal/context/submission/interface/grpc/proto"
+	"github.com/Bio-OS/bioos/pkg/client"
+	"github.com/Bio-OS/bioos/pkg/utils"
+)
+
+type SubmissionClient interface {
+	ListSubmissions(context.Context, *submissionproto.ListSubmissionsRequest) (*submissionproto.ListSubmissionsResponse, error)
+	ListRuns(context.Context, *submissionproto.ListRunsRequest) (*submissionproto.ListRunsResponse, error)
+}
+
+func NewSubmissionClient(opts *client.Options) (SubmissionClient, error) {
+	if err := opts.Method.Validate(); err != nil {
+		return nil, err
+	}
+
+	return submissionClientImpl{
+		opts: opts,
+	}, nil
+}
+
+var _ SubmissionClient = submissionClientImpl{}
+
+type submissionClientImpl struct {
+	opts *client.Options
+}
+
+func (s submissionClientImpl) ListSubmissions(ctx context.Context, req *submissionproto.ListSubmissionsRequest) (*submissionproto.ListSubmissionsResponse, error) {
+	if s.opts.Method == client.GRPCMethod {
+		conn, err := utils.GrpcDial(s.opts.ConnectInfo, s.opts.AuthInfo)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		client := submissionproto.NewSubmissionServiceClient(conn)
+		return client.ListSubmissions(ctx, req)
+	}
+	return nil, fmt.Errorf("not support method")
+}
+
+func (s submissionClientImpl) ListRuns(ctx context.Context, req *submissionproto.ListRunsRequest) (*submissionproto.ListRunsResponse, error) {
+	if s.opts.Method == client.GRPCMethod {
+		conn, err := utils.GrpcDial(s.opts.ConnectInfo, s.opts.AuthInfo)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		client := submissionproto.NewSubmissionServiceClient(conn)
+		return client.ListRuns(ctx, req)
+	}
+	return nil, fmt.Errorf("not support method")
+}
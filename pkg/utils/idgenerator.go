@@ -96,3 +96,13 @@ func GenClusterID() string {
 func GenDataModelID() string {
 	return genResourceID("d")
 }
+
+// GenDataModelSnapshotID ...
+func GenDataModelSnapshotID() string {
+	return genResourceID("ds")
+}
+
+// GenImportJobID ...
+func GenImportJobID() string {
+	return genResourceID("ij")
+}
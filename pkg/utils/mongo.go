@@ -18,6 +18,7 @@ package utils
 
 import (
 	"context"
+	"regexp"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -74,3 +75,24 @@ func EnsureIndex(ctx context.Context, collection *mongo.Collection, indexName st
 	}
 	return
 }
+
+// MongoSearchWordFilter is the mongo version of SearchWordFilter, it returns
+// nil when there is no keyword in word.
+func MongoSearchWordFilter(word string, fields []string, exact bool) bson.M {
+	keywords := splitSearchWord(word)
+	if len(fields) == 0 || len(keywords) == 0 {
+		return nil
+	}
+
+	conditions := make(bson.A, 0, len(fields)*len(keywords))
+	for _, field := range fields {
+		for _, kw := range keywords {
+			if exact {
+				conditions = append(conditions, bson.M{field: kw})
+			} else {
+				conditions = append(conditions, bson.M{field: bson.M{"$regex": regexp.QuoteMeta(kw), "$options": "i"}})
+			}
+		}
+	}
+	return bson.M{"$or": conditions}
+}
@@ -31,10 +31,7 @@ func SearchWordFilter(db *gorm.DB, word string, fields []string, exact bool) *go
 	if len(fields) == 0 {
 		return db
 	}
-	word = strings.TrimSpace(word)
-	keywords := strings.FieldsFunc(word, func(r rune) bool {
-		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '-' && r != '_'
-	})
+	keywords := splitSearchWord(word)
 	if len(keywords) == 0 {
 		return db
 	}
@@ -74,3 +71,10 @@ func DBOrder(orders []Order, validOrderMap map[string]string) string {
 	}
 	return strings.Join(orderStrs, ", ")
 }
+
+// splitSearchWord splits the search word into keywords by special characters.
+func splitSearchWord(word string) []string {
+	return strings.FieldsFunc(strings.TrimSpace(word), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '-' && r != '_'
+	})
+}
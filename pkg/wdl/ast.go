@@ -0,0 +1,164 @@
+package wdl
+
+import (
+	"strings"
+)
+
+// WDL versions
+const (
+	VersionDraft2      = "draft-2"
+	Version1_0         = "1.0"
+	Version1_1         = "1.1"
+	VersionDevelopment = "development"
+)
+
+// Document is a parsed WDL document with its imports resolved.
+type Document struct {
+	// Path is the absolute path of the document
+	Path     string
+	Version  string
+	Imports  []*Import
+	Structs  []*Struct
+	Tasks    []*Task
+	Workflow *Workflow
+}
+
+// Task returns the task named name defined in the document.
+func (d *Document) Task(name string) *Task {
+	for _, task := range d.Tasks {
+		if task.Name == name {
+			return task
+		}
+	}
+	return nil
+}
+
+// Import is an import statement.
+type Import struct {
+	URI       string
+	Namespace string
+	// Aliases maps struct names of the imported document to local names
+	Aliases  map[string]string
+	Document *Document
+	Pos      Position
+}
+
+// Struct is a struct definition.
+type Struct struct {
+	Name    string
+	Members []*Declaration
+	Pos     Position
+}
+
+// Type is a WDL type.
+type Type struct {
+	// Name is a primitive type, Array, Map, Pair, Object or a struct name
+	Name       string
+	Parameters []*Type
+	Optional   bool
+	NonEmpty   bool
+}
+
+// String renders the type as it is written in WDL.
+func (t *Type) String() string {
+	if t == nil {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(t.Name)
+	if len(t.Parameters) > 0 {
+		b.WriteString("[")
+		for i, param := range t.Parameters {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(param.String())
+		}
+		b.WriteString("]")
+	}
+	if t.NonEmpty {
+		b.WriteString("+")
+	}
+	if t.Optional {
+		b.WriteString("?")
+	}
+	return b.String()
+}
+
+// Declaration is a typed declaration, Expression is nil when unbound.
+type Declaration struct {
+	Type       *Type
+	Name       string
+	Expression Expression
+	Pos        Position
+}
+
+// Task is a task definition.
+type Task struct {
+	Name string
+	// Inputs are declarations of the input section, or the top level declarations before command in draft-2
+	Inputs []*Declaration
+	// Declarations are private declarations of the task body
+	Declarations []*Declaration
+	Command      string
+	Outputs      []*Declaration
+	Runtime      map[string]Expression
+	Pos          Position
+}
+
+// Workflow is a workflow definition.
+type Workflow struct {
+	Name string
+	// Inputs are declarations of the input section, or the top level declarations in draft-2
+	Inputs []*Declaration
+	Body   []WorkflowElement
+	// Outputs are declarations of the output section. Type is nil for draft-2 output references
+	// such as call.out or call.*, whose Name is the reference as written.
+	Outputs []*Declaration
+	Pos     Position
+}
+
+// WorkflowElement is an element of workflow body: *Declaration, *Call, *Scatter or *Conditional.
+type WorkflowElement interface {
+	workflowElement()
+}
+
+func (*Declaration) workflowElement() {}
+func (*Call) workflowElement()        {}
+func (*Scatter) workflowElement()     {}
+func (*Conditional) workflowElement() {}
+
+// Call is a call statement.
+type Call struct {
+	// Target is the called task or workflow, may be qualified with an import namespace
+	Target string
+	Alias  string
+	After  []string
+	// InputNames keeps the order of Inputs
+	InputNames []string
+	Inputs     map[string]Expression
+	Pos        Position
+}
+
+// Name returns the name used to reference the call.
+func (c *Call) Name() string {
+	if c.Alias != "" {
+		return c.Alias
+	}
+	return c.Target[strings.LastIndex(c.Target, ".")+1:]
+}
+
+// Scatter is a scatter block.
+type Scatter struct {
+	Variable   string
+	Expression Expression
+	Body       []WorkflowElement
+	Pos        Position
+}
+
+// Conditional is an if block.
+type Conditional struct {
+	Expression Expression
+	Body       []WorkflowElement
+	Pos        Position
+}
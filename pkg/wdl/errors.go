@@ -0,0 +1,41 @@
+package wdl
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrRemoteImport is returned when a document imports a http(s) url, which is not fetched by the parser.
+var ErrRemoteImport = errors.New("remote import is not supported")
+
+// SyntaxError is an error in the syntax of a WDL document.
+type SyntaxError struct {
+	Path    string
+	Pos     Position
+	Message string
+}
+
+func newSyntaxError(pos Position, format string, args ...interface{}) *SyntaxError {
+	return &SyntaxError{Pos: pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("%s: %s", e.Pos, e.Message)
+	}
+	return fmt.Sprintf("%s:%s: %s", e.Path, e.Pos, e.Message)
+}
+
+// ValidationError is a semantic error of a syntactically valid WDL document.
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func newValidationError(path string, format string, args ...interface{}) *ValidationError {
+	return &ValidationError{Path: path, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
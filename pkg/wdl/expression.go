@@ -0,0 +1,240 @@
+package wdl
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Expression is a WDL expression.
+type Expression interface {
+	// String renders the expression in a normalized form.
+	String() string
+	// walk calls fn with the expression and all its sub expressions.
+	walk(fn func(Expression))
+}
+
+// Literal is a boolean, number or None literal.
+type Literal struct {
+	Value string
+}
+
+// StringLiteral is a quoted string, Parts are the expressions of its placeholders.
+type StringLiteral struct {
+	Raw   string
+	Parts []Expression
+}
+
+// Identifier references a declaration, call or scatter variable.
+type Identifier struct {
+	Name string
+}
+
+// MemberAccess is expr.name.
+type MemberAccess struct {
+	Expression Expression
+	Member     string
+}
+
+// IndexAccess is expr[index].
+type IndexAccess struct {
+	Expression Expression
+	Index      Expression
+}
+
+// Apply is a function call.
+type Apply struct {
+	Function  string
+	Arguments []Expression
+}
+
+// UnaryOperation is !x or -x.
+type UnaryOperation struct {
+	Operator   string
+	Expression Expression
+}
+
+// BinaryOperation is x op y.
+type BinaryOperation struct {
+	Operator string
+	Left     Expression
+	Right    Expression
+}
+
+// ArrayLiteral is [a, b].
+type ArrayLiteral struct {
+	Items []Expression
+}
+
+// PairLiteral is (left, right).
+type PairLiteral struct {
+	Left  Expression
+	Right Expression
+}
+
+// MapLiteral is {k: v}, also used by object and struct literals whose Name is "object" or the struct name.
+type MapLiteral struct {
+	Name   string
+	Keys   []Expression
+	Values []Expression
+}
+
+// IfThenElse is if c then a else b.
+type IfThenElse struct {
+	Condition Expression
+	Then      Expression
+	Else      Expression
+}
+
+func (e *Literal) String() string { return e.Value }
+
+func (e *StringLiteral) String() string { return e.Raw }
+
+// Value returns the unquoted content of the string.
+func (e *StringLiteral) Value() string {
+	if len(e.Raw) < 2 {
+		return e.Raw
+	}
+	raw := e.Raw
+	if raw[0] == '\'' {
+		raw = `"` + strings.ReplaceAll(strings.ReplaceAll(raw[1:len(raw)-1], `\'`, `'`), `"`, `\"`) + `"`
+	}
+	var value string
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return e.Raw[1 : len(e.Raw)-1]
+	}
+	return value
+}
+
+func (e *Identifier) String() string { return e.Name }
+
+func (e *MemberAccess) String() string { return e.Expression.String() + "." + e.Member }
+
+func (e *IndexAccess) String() string {
+	return e.Expression.String() + "[" + e.Index.String() + "]"
+}
+
+func (e *Apply) String() string {
+	return e.Function + "(" + joinExpressions(e.Arguments) + ")"
+}
+
+func (e *UnaryOperation) String() string { return e.Operator + e.Expression.String() }
+
+func (e *BinaryOperation) String() string {
+	return e.Left.String() + " " + e.Operator + " " + e.Right.String()
+}
+
+func (e *ArrayLiteral) String() string { return "[" + joinExpressions(e.Items) + "]" }
+
+func (e *PairLiteral) String() string {
+	return "(" + e.Left.String() + ", " + e.Right.String() + ")"
+}
+
+func (e *MapLiteral) String() string {
+	var b strings.Builder
+	if e.Name != "" {
+		b.WriteString(e.Name)
+		b.WriteString(" ")
+	}
+	b.WriteString("{")
+	for i := range e.Keys {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(e.Keys[i].String())
+		b.WriteString(": ")
+		b.WriteString(e.Values[i].String())
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+func (e *IfThenElse) String() string {
+	return "if " + e.Condition.String() + " then " + e.Then.String() + " else " + e.Else.String()
+}
+
+func (e *Literal) walk(fn func(Expression)) { fn(e) }
+
+func (e *StringLiteral) walk(fn func(Expression)) {
+	fn(e)
+	walkExpressions(fn, e.Parts...)
+}
+
+func (e *Identifier) walk(fn func(Expression)) { fn(e) }
+
+func (e *MemberAccess) walk(fn func(Expression)) {
+	fn(e)
+	e.Expression.walk(fn)
+}
+
+func (e *IndexAccess) walk(fn func(Expression)) {
+	fn(e)
+	walkExpressions(fn, e.Expression, e.Index)
+}
+
+func (e *Apply) walk(fn func(Expression)) {
+	fn(e)
+	walkExpressions(fn, e.Arguments...)
+}
+
+func (e *UnaryOperation) walk(fn func(Expression)) {
+	fn(e)
+	e.Expression.walk(fn)
+}
+
+func (e *BinaryOperation) walk(fn func(Expression)) {
+	fn(e)
+	walkExpressions(fn, e.Left, e.Right)
+}
+
+func (e *ArrayLiteral) walk(fn func(Expression)) {
+	fn(e)
+	walkExpressions(fn, e.Items...)
+}
+
+func (e *PairLiteral) walk(fn func(Expression)) {
+	fn(e)
+	walkExpressions(fn, e.Left, e.Right)
+}
+
+func (e *MapLiteral) walk(fn func(Expression)) {
+	fn(e)
+	if e.Name == "" {
+		// keys of object and struct literals are member names
+		walkExpressions(fn, e.Keys...)
+	}
+	walkExpressions(fn, e.Values...)
+}
+
+func (e *IfThenElse) walk(fn func(Expression)) {
+	fn(e)
+	walkExpressions(fn, e.Condition, e.Then, e.Else)
+}
+
+func walkExpressions(fn func(Expression), expressions ...Expression) {
+	for _, expression := range expressions {
+		if expression != nil {
+			expression.walk(fn)
+		}
+	}
+}
+
+func joinExpressions(expressions []Expression) string {
+	items := make([]string, 0, len(expressions))
+	for _, expression := range expressions {
+		items = append(items, expression.String())
+	}
+	return strings.Join(items, ", ")
+}
+
+// References returns the names of identifiers referenced by the expression, in order of first appearance.
+func References(expression Expression) []string {
+	references := make([]string, 0)
+	seen := make(map[string]bool)
+	walkExpressions(func(e Expression) {
+		if identifier, ok := e.(*Identifier); ok && !seen[identifier.Name] {
+			seen[identifier.Name] = true
+			references = append(references, identifier.Name)
+		}
+	}, expression)
+	return references
+}
@@ -0,0 +1,144 @@
+package wdl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Graph renders the call graph of the workflow of the document in dot format. Calls in scatter and
+// conditional blocks are grouped in clusters, an edge means a call depends on outputs of another call.
+func (d *Document) Graph() (string, error) {
+	if d.Workflow == nil {
+		return "", fmt.Errorf("%s: no workflow found", d.Path)
+	}
+	g := &graphBuilder{
+		definitions: map[string]Expression{},
+		blocks:      map[*Call][]WorkflowElement{},
+		calls:       map[string]bool{},
+		resolved:    map[string][]string{},
+	}
+	for _, input := range d.Workflow.Inputs {
+		g.definitions[input.Name] = input.Expression
+	}
+	g.collect(d.Workflow.Body, nil)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", d.Workflow.Name)
+	b.WriteString("  compound=true;\n")
+	for _, call := range g.orderedCalls {
+		for _, upstream := range g.dependencies(call) {
+			fmt.Fprintf(&b, "  %q -> %q;\n", "CALL_"+upstream, "CALL_"+call.Name())
+		}
+	}
+	cluster := 0
+	g.writeNodes(&b, d.Workflow.Body, "  ", &cluster)
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+type graphBuilder struct {
+	// definitions maps declarations and scatter variables to their expressions
+	definitions map[string]Expression
+	// blocks of calls, from outermost
+	blocks       map[*Call][]WorkflowElement
+	orderedCalls []*Call
+	calls        map[string]bool
+	resolved     map[string][]string
+}
+
+func (g *graphBuilder) collect(elements []WorkflowElement, blocks []WorkflowElement) {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *Declaration:
+			g.definitions[e.Name] = e.Expression
+		case *Call:
+			g.blocks[e] = blocks
+			g.orderedCalls = append(g.orderedCalls, e)
+			g.calls[e.Name()] = true
+		case *Scatter:
+			g.definitions[e.Variable] = e.Expression
+			g.collect(e.Body, append(blocks[:len(blocks):len(blocks)], e))
+		case *Conditional:
+			g.collect(e.Body, append(blocks[:len(blocks):len(blocks)], e))
+		}
+	}
+}
+
+// dependencies returns names of calls which the call depends on, in order of appearance.
+func (g *graphBuilder) dependencies(call *Call) []string {
+	upstreams := make([]string, 0)
+	seen := map[string]bool{call.Name(): true}
+	add := func(names []string) {
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				upstreams = append(upstreams, name)
+			}
+		}
+	}
+	add(call.After)
+	for _, name := range call.InputNames {
+		add(g.resolveExpression(call.Inputs[name]))
+	}
+	for _, block := range g.blocks[call] {
+		switch e := block.(type) {
+		case *Scatter:
+			add(g.resolveExpression(e.Expression))
+		case *Conditional:
+			add(g.resolveExpression(e.Expression))
+		}
+	}
+	ordered := make([]string, 0, len(upstreams))
+	for _, c := range g.orderedCalls {
+		for _, upstream := range upstreams {
+			if c.Name() == upstream {
+				ordered = append(ordered, upstream)
+			}
+		}
+	}
+	return ordered
+}
+
+// resolveExpression returns the calls referenced by an expression directly or through declarations.
+func (g *graphBuilder) resolveExpression(expression Expression) []string {
+	calls := make([]string, 0)
+	for _, name := range References(expression) {
+		calls = append(calls, g.resolveName(name)...)
+	}
+	return calls
+}
+
+func (g *graphBuilder) resolveName(name string) []string {
+	if g.calls[name] {
+		return []string{name}
+	}
+	if calls, ok := g.resolved[name]; ok {
+		return calls
+	}
+	// mark as resolving to break cycles
+	g.resolved[name] = nil
+	calls := g.resolveExpression(g.definitions[name])
+	g.resolved[name] = calls
+	return calls
+}
+
+func (g *graphBuilder) writeNodes(b *strings.Builder, elements []WorkflowElement, indent string, cluster *int) {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *Call:
+			fmt.Fprintf(b, "%s%q [label=%q];\n", indent, "CALL_"+e.Name(), "call "+e.Name())
+		case *Scatter:
+			fmt.Fprintf(b, "%ssubgraph cluster_%d {\n", indent, *cluster)
+			*cluster++
+			fmt.Fprintf(b, "%s  label=%q;\n", indent, fmt.Sprintf("scatter (%s in %s)", e.Variable, e.Expression))
+			g.writeNodes(b, e.Body, indent+"  ", cluster)
+			fmt.Fprintf(b, "%s}\n", indent)
+		case *Conditional:
+			fmt.Fprintf(b, "%ssubgraph cluster_%d {\n", indent, *cluster)
+			*cluster++
+			fmt.Fprintf(b, "%s  label=%q;\n", indent, fmt.Sprintf("if (%s)", e.Expression))
+			g.writeNodes(b, e.Body, indent+"  ", cluster)
+			fmt.Fprintf(b, "%s}\n", indent)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package wdl
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenInt
+	tokenFloat
+	tokenString
+	tokenPunct
+)
+
+// Position is a position in a WDL document, line and column start with 1.
+type Position struct {
+	Line   int
+	Column int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  Position
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of file"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// multi-character punctuations, longest first
+var punctuations = []string{"==", "!=", "<=", ">=", "&&", "||"}
+
+type lexer struct {
+	src    string
+	offset int
+	line   int
+	column int
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: src, line: 1, column: 1}
+}
+
+func (l *lexer) pos() Position {
+	return Position{Line: l.line, Column: l.column}
+}
+
+func (l *lexer) eof() bool {
+	return l.offset >= len(l.src)
+}
+
+func (l *lexer) peekByte(n int) byte {
+	if l.offset+n >= len(l.src) {
+		return 0
+	}
+	return l.src[l.offset+n]
+}
+
+func (l *lexer) hasPrefix(prefix string) bool {
+	return strings.HasPrefix(l.src[l.offset:], prefix)
+}
+
+func (l *lexer) advance(n int) {
+	for i := 0; i < n && !l.eof(); i++ {
+		if l.src[l.offset] == '\n' {
+			l.line++
+			l.column = 1
+		} else {
+			l.column++
+		}
+		l.offset++
+	}
+}
+
+func (l *lexer) skipSpaceAndComment() {
+	for !l.eof() {
+		switch c := l.src[l.offset]; {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			l.advance(1)
+		case c == '#':
+			for !l.eof() && l.src[l.offset] != '\n' {
+				l.advance(1)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// readLine returns the rest of current line without comment, used by the version statement.
+func (l *lexer) readLine() string {
+	start := l.offset
+	for !l.eof() && l.src[l.offset] != '\n' && l.src[l.offset] != '#' {
+		l.advance(1)
+	}
+	return strings.TrimSpace(l.src[start:l.offset])
+}
+
+func (l *lexer) next() (token, error) {
+	l.skipSpaceAndComment()
+	pos := l.pos()
+	if l.eof() {
+		return token{kind: tokenEOF, pos: pos}, nil
+	}
+	c := l.src[l.offset]
+	switch {
+	case isIdentStart(c):
+		start := l.offset
+		for !l.eof() && isIdentPart(l.src[l.offset]) {
+			l.advance(1)
+		}
+		return token{kind: tokenIdent, text: l.src[start:l.offset], pos: pos}, nil
+	case isDigit(c):
+		return l.scanNumber(pos), nil
+	case c == '"' || c == '\'':
+		start := l.offset
+		if err := l.scanString(c); err != nil {
+			return token{}, err
+		}
+		return token{kind: tokenString, text: l.src[start:l.offset], pos: pos}, nil
+	}
+	for _, punct := range punctuations {
+		if l.hasPrefix(punct) {
+			l.advance(len(punct))
+			return token{kind: tokenPunct, text: punct, pos: pos}, nil
+		}
+	}
+	if strings.IndexByte("{}[](),:.=+-*/%!?<>", c) >= 0 {
+		l.advance(1)
+		return token{kind: tokenPunct, text: string(c), pos: pos}, nil
+	}
+	return token{}, newSyntaxError(pos, "unexpected character %q", c)
+}
+
+func (l *lexer) scanNumber(pos Position) token {
+	start := l.offset
+	kind := tokenInt
+	for !l.eof() && isDigit(l.src[l.offset]) {
+		l.advance(1)
+	}
+	if l.peekByte(0) == '.' && isDigit(l.peekByte(1)) {
+		kind = tokenFloat
+		l.advance(1)
+		for !l.eof() && isDigit(l.src[l.offset]) {
+			l.advance(1)
+		}
+	}
+	if c := l.peekByte(0); c == 'e' || c == 'E' {
+		n := 1
+		if s := l.peekByte(1); s == '+' || s == '-' {
+			n++
+		}
+		if isDigit(l.peekByte(n)) {
+			kind = tokenFloat
+			l.advance(n)
+			for !l.eof() && isDigit(l.src[l.offset]) {
+				l.advance(1)
+			}
+		}
+	}
+	return token{kind: kind, text: l.src[start:l.offset], pos: pos}
+}
+
+// scanString scans a quoted string including placeholders, which may contain nested strings.
+func (l *lexer) scanString(quote byte) error {
+	pos := l.pos()
+	l.advance(1)
+	for !l.eof() {
+		switch c := l.src[l.offset]; {
+		case c == '\\':
+			l.advance(2)
+		case c == quote:
+			l.advance(1)
+			return nil
+		case c == '\n':
+			return newSyntaxError(pos, "unterminated string")
+		case (c == '$' || c == '~') && l.peekByte(1) == '{':
+			if err := l.scanPlaceholder(); err != nil {
+				return err
+			}
+		default:
+			l.advance(1)
+		}
+	}
+	return newSyntaxError(pos, "unterminated string")
+}
+
+// scanPlaceholder scans ${...} or ~{...} until the matching brace.
+func (l *lexer) scanPlaceholder() error {
+	pos := l.pos()
+	l.advance(2)
+	depth := 1
+	for !l.eof() {
+		switch c := l.src[l.offset]; c {
+		case '"', '\'':
+			if err := l.scanString(c); err != nil {
+				return err
+			}
+			continue
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				l.advance(1)
+				return nil
+			}
+		}
+		l.advance(1)
+	}
+	return newSyntaxError(pos, "unterminated placeholder")
+}
+
+// readCommand reads the raw command section after the command keyword.
+func (l *lexer) readCommand() (string, error) {
+	l.skipSpaceAndComment()
+	pos := l.pos()
+	if l.hasPrefix("<<<") {
+		l.advance(3)
+		end := strings.Index(l.src[l.offset:], ">>>")
+		if end < 0 {
+			return "", newSyntaxError(pos, "unterminated command")
+		}
+		command := l.src[l.offset : l.offset+end]
+		l.advance(end + 3)
+		return command, nil
+	}
+	if l.peekByte(0) != '{' {
+		return "", newSyntaxError(pos, "expect '{' or '<<<' after command")
+	}
+	l.advance(1)
+	start := l.offset
+	depth := 0
+	for !l.eof() {
+		switch l.src[l.offset] {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				command := l.src[start:l.offset]
+				l.advance(1)
+				return command, nil
+			}
+			depth--
+		}
+		l.advance(1)
+	}
+	return "", newSyntaxError(pos, "unterminated command")
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
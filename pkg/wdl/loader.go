@@ -0,0 +1,170 @@
+package wdl
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Load parses the document at path and all documents it imports from local files.
+func Load(path string) (*Document, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	l := &loader{documents: map[string]*Document{}, loading: map[string]bool{}}
+	doc, err := l.load(absPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDocument(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+type loader struct {
+	documents map[string]*Document
+	loading   map[string]bool
+}
+
+func (l *loader) load(path string) (*Document, error) {
+	if doc, ok := l.documents[path]; ok {
+		return doc, nil
+	}
+	if l.loading[path] {
+		return nil, newValidationError(path, "cyclic import")
+	}
+	l.loading[path] = true
+	defer delete(l.loading, path)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := Parse(string(content))
+	if err != nil {
+		var syntaxErr *SyntaxError
+		if errors.As(err, &syntaxErr) {
+			syntaxErr.Path = path
+		}
+		return nil, err
+	}
+	doc.Path = path
+
+	for _, imp := range doc.Imports {
+		importPath, err := resolveImport(filepath.Dir(path), imp.URI)
+		if err != nil {
+			return nil, fmt.Errorf("%s: import %q: %w", path, imp.URI, err)
+		}
+		if imp.Document, err = l.load(importPath); err != nil {
+			return nil, err
+		}
+		if imp.Document.Version != doc.Version {
+			return nil, newValidationError(path, "imported document %s is version %s, expect %s", imp.URI, imp.Document.Version, doc.Version)
+		}
+	}
+	l.documents[path] = doc
+	return doc, nil
+}
+
+func resolveImport(dir, uri string) (string, error) {
+	switch {
+	case strings.HasPrefix(uri, "http://"), strings.HasPrefix(uri, "https://"):
+		return "", ErrRemoteImport
+	case strings.HasPrefix(uri, "file://"):
+		uri = strings.TrimPrefix(uri, "file://")
+	}
+	if !filepath.IsAbs(uri) {
+		uri = filepath.Join(dir, uri)
+	}
+	info, err := os.Stat(uri)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("%s is a directory", uri)
+	}
+	return filepath.Clean(uri), nil
+}
+
+// Files returns absolute paths of the document and all documents imported directly or indirectly,
+// the document comes first.
+func (d *Document) Files() []string {
+	files := make([]string, 0)
+	seen := make(map[string]bool)
+	var walk func(doc *Document)
+	walk = func(doc *Document) {
+		if seen[doc.Path] {
+			return
+		}
+		seen[doc.Path] = true
+		files = append(files, doc.Path)
+		for _, imp := range doc.Imports {
+			walk(imp.Document)
+		}
+	}
+	walk(d)
+	return files
+}
+
+// Callable is a task or workflow which can be called.
+type Callable struct {
+	Task     *Task
+	Workflow *Workflow
+	Document *Document
+}
+
+// Name returns the name of the task or workflow.
+func (c *Callable) Name() string {
+	if c.Task != nil {
+		return c.Task.Name
+	}
+	return c.Workflow.Name
+}
+
+// ResolveCall returns the task or workflow called by target, which is a local name or namespace.name.
+func (d *Document) ResolveCall(target string) (*Callable, error) {
+	parts := strings.Split(target, ".")
+	doc := d
+	for _, namespace := range parts[:len(parts)-1] {
+		var found *Document
+		for _, imp := range doc.Imports {
+			if imp.Namespace == namespace {
+				found = imp.Document
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("namespace %s not found", namespace)
+		}
+		doc = found
+	}
+	name := parts[len(parts)-1]
+	if task := doc.Task(name); task != nil {
+		return &Callable{Task: task, Document: doc}, nil
+	}
+	if doc != d && doc.Workflow != nil && doc.Workflow.Name == name {
+		return &Callable{Workflow: doc.Workflow, Document: doc}, nil
+	}
+	return nil, fmt.Errorf("task or workflow %s not found", target)
+}
+
+// StructNames returns the names of structs usable in the document, including imported ones.
+func (d *Document) StructNames() map[string]bool {
+	names := make(map[string]bool)
+	for _, st := range d.Structs {
+		names[st.Name] = true
+	}
+	for _, imp := range d.Imports {
+		for name := range imp.Document.StructNames() {
+			if alias, ok := imp.Aliases[name]; ok {
+				name = alias
+			}
+			names[name] = true
+		}
+	}
+	return names
+}
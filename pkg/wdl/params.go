@@ -0,0 +1,215 @@
+package wdl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Parameter is an input or output of a workflow, named by its fully qualified name as womtool does,
+// e.g. wf.input_name, wf.call_name.task_input_name or wf.output_name.
+type Parameter struct {
+	Name     string
+	Type     *Type
+	Optional bool
+	// Default is the default expression of an input, nil when absent
+	Default Expression
+}
+
+// DefaultValue returns the default of the parameter as a string, string literals are unquoted.
+func (p *Parameter) DefaultValue() (string, bool) {
+	if p.Default == nil {
+		return "", false
+	}
+	if literal, ok := p.Default.(*StringLiteral); ok {
+		return literal.Value(), true
+	}
+	return p.Default.String(), true
+}
+
+// Inputs returns the inputs of the workflow of the document sorted by name, including inputs of calls
+// which are not supplied by the call.
+func (d *Document) Inputs() ([]*Parameter, error) {
+	if d.Workflow == nil {
+		return nil, fmt.Errorf("%s: no workflow found", d.Path)
+	}
+	params, err := workflowInputs(d, d.Workflow, d.Workflow.Name)
+	if err != nil {
+		return nil, err
+	}
+	sortParameters(params)
+	return params, nil
+}
+
+func workflowInputs(doc *Document, workflow *Workflow, prefix string) ([]*Parameter, error) {
+	params := declarationInputs(workflow.Inputs, prefix)
+	err := walkCalls(workflow.Body, func(call *Call) error {
+		callable, err := doc.ResolveCall(call.Target)
+		if err != nil {
+			return err
+		}
+		callPrefix := prefix + "." + call.Name()
+		if callable.Task != nil {
+			inputs := make([]*Declaration, 0, len(callable.Task.Inputs))
+			for _, input := range callable.Task.Inputs {
+				if _, supplied := call.Inputs[input.Name]; !supplied {
+					inputs = append(inputs, input)
+				}
+			}
+			params = append(params, declarationInputs(inputs, callPrefix)...)
+			return nil
+		}
+		subParams, err := workflowInputs(callable.Document, callable.Workflow, callPrefix)
+		if err != nil {
+			return err
+		}
+		for _, param := range subParams {
+			// top level inputs of the sub workflow may be supplied by the call
+			name := strings.TrimPrefix(param.Name, callPrefix+".")
+			if _, supplied := call.Inputs[name]; !supplied {
+				params = append(params, param)
+			}
+		}
+		return nil
+	})
+	return params, err
+}
+
+func declarationInputs(decls []*Declaration, prefix string) []*Parameter {
+	params := make([]*Parameter, 0, len(decls))
+	for _, decl := range decls {
+		params = append(params, &Parameter{
+			Name:     prefix + "." + decl.Name,
+			Type:     decl.Type,
+			Optional: decl.Type.Optional || decl.Expression != nil,
+			Default:  decl.Expression,
+		})
+	}
+	return params
+}
+
+// Outputs returns the outputs of the workflow of the document sorted by name.
+func (d *Document) Outputs() ([]*Parameter, error) {
+	if d.Workflow == nil {
+		return nil, fmt.Errorf("%s: no workflow found", d.Path)
+	}
+	workflow := d.Workflow
+	params := make([]*Parameter, 0, len(workflow.Outputs))
+	for _, output := range workflow.Outputs {
+		if output.Type != nil {
+			params = append(params, &Parameter{Name: workflow.Name + "." + output.Name, Type: output.Type})
+			continue
+		}
+		// draft-2 output reference: call.output or call.*
+		parts := strings.SplitN(output.Name, ".", 2)
+		call, blocks := findCall(workflow.Body, parts[0], nil)
+		if call == nil || len(parts) != 2 {
+			return nil, fmt.Errorf("%s: invalid output %s", d.Path, output.Name)
+		}
+		callable, err := d.ResolveCall(call.Target)
+		if err != nil {
+			return nil, err
+		}
+		callOutputs, err := callableOutputs(callable)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, callOutput := range callOutputs {
+			if parts[1] == "*" || parts[1] == callOutput.Name {
+				found = true
+				params = append(params, &Parameter{
+					Name: workflow.Name + "." + call.Name() + "." + callOutput.Name,
+					Type: wrapType(callOutput.Type, blocks),
+				})
+			}
+		}
+		if !found && parts[1] != "*" {
+			return nil, fmt.Errorf("%s: call %s has no output %s", d.Path, call.Name(), parts[1])
+		}
+	}
+	sortParameters(params)
+	return params, nil
+}
+
+// callableOutputs returns outputs of a task or sub workflow with names relative to it.
+func callableOutputs(callable *Callable) ([]*Declaration, error) {
+	if callable.Task != nil {
+		return callable.Task.Outputs, nil
+	}
+	params, err := callable.Document.Outputs()
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]*Declaration, 0, len(params))
+	for _, param := range params {
+		outputs = append(outputs, &Declaration{Name: strings.TrimPrefix(param.Name, callable.Workflow.Name+"."), Type: param.Type})
+	}
+	return outputs, nil
+}
+
+// walkCalls calls fn with all calls in elements, including those in scatter and conditional blocks.
+func walkCalls(elements []WorkflowElement, fn func(call *Call) error) error {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *Call:
+			if err := fn(e); err != nil {
+				return err
+			}
+		case *Scatter:
+			if err := walkCalls(e.Body, fn); err != nil {
+				return err
+			}
+		case *Conditional:
+			if err := walkCalls(e.Body, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// findCall returns the call named name and its enclosing scatter and conditional blocks from outermost.
+func findCall(elements []WorkflowElement, name string, blocks []WorkflowElement) (*Call, []WorkflowElement) {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *Call:
+			if e.Name() == name {
+				return e, blocks
+			}
+		case *Scatter:
+			if call, found := findCall(e.Body, name, append(blocks[:len(blocks):len(blocks)], e)); call != nil {
+				return call, found
+			}
+		case *Conditional:
+			if call, found := findCall(e.Body, name, append(blocks[:len(blocks):len(blocks)], e)); call != nil {
+				return call, found
+			}
+		}
+	}
+	return nil, nil
+}
+
+// wrapType returns the type of a value declared in blocks when referenced outside of them:
+// an array for each scatter and optional for each conditional.
+func wrapType(typ *Type, blocks []WorkflowElement) *Type {
+	for i := len(blocks) - 1; i >= 0; i-- {
+		switch blocks[i].(type) {
+		case *Scatter:
+			typ = &Type{Name: "Array", Parameters: []*Type{typ}}
+		case *Conditional:
+			if !typ.Optional {
+				optional := *typ
+				optional.Optional = true
+				typ = &optional
+			}
+		}
+	}
+	return typ
+}
+
+func sortParameters(params []*Parameter) {
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].Name < params[j].Name
+	})
+}
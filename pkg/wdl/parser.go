@@ -0,0 +1,703 @@
+package wdl
+
+import (
+	"strings"
+)
+
+// section keywords which can not start a declaration
+var (
+	taskSectionKeywords     = []string{"input", "output", "command", "runtime", "meta", "parameter_meta", "requirements", "hints"}
+	workflowSectionKeywords = []string{"input", "output", "meta", "parameter_meta", "hints", "call", "scatter", "if"}
+)
+
+type parser struct {
+	lexer   *lexer
+	tokens  []token
+	version string
+}
+
+// Parse parses the source of a WDL document, imports are not resolved.
+func Parse(src string) (*Document, error) {
+	p := &parser{lexer: newLexer(src)}
+	return p.parseDocument()
+}
+
+func (p *parser) fill(n int) error {
+	for len(p.tokens) <= n {
+		tok, err := p.lexer.next()
+		if err != nil {
+			return err
+		}
+		p.tokens = append(p.tokens, tok)
+	}
+	return nil
+}
+
+func (p *parser) peekN(n int) (token, error) {
+	if err := p.fill(n); err != nil {
+		return token{}, err
+	}
+	return p.tokens[n], nil
+}
+
+func (p *parser) peek() (token, error) {
+	return p.peekN(0)
+}
+
+func (p *parser) next() (token, error) {
+	tok, err := p.peek()
+	if err != nil {
+		return token{}, err
+	}
+	p.tokens = p.tokens[1:]
+	return tok, nil
+}
+
+// accept consumes the next token if its text is text.
+func (p *parser) accept(text string) (bool, error) {
+	tok, err := p.peek()
+	if err != nil {
+		return false, err
+	}
+	if tok.kind == tokenEOF || tok.kind == tokenString || tok.text != text {
+		return false, nil
+	}
+	p.tokens = p.tokens[1:]
+	return true, nil
+}
+
+func (p *parser) expect(text string) (token, error) {
+	tok, err := p.next()
+	if err != nil {
+		return token{}, err
+	}
+	if tok.kind == tokenEOF || tok.kind == tokenString || tok.text != text {
+		return token{}, newSyntaxError(tok.pos, "expect %q but got %s", text, tok)
+	}
+	return tok, nil
+}
+
+func (p *parser) expectIdent() (token, error) {
+	tok, err := p.next()
+	if err != nil {
+		return token{}, err
+	}
+	if tok.kind != tokenIdent {
+		return token{}, newSyntaxError(tok.pos, "expect identifier but got %s", tok)
+	}
+	return tok, nil
+}
+
+func (p *parser) isNext(text string) bool {
+	tok, err := p.peek()
+	return err == nil && tok.kind != tokenString && tok.kind != tokenEOF && tok.text == text
+}
+
+func (p *parser) parseDocument() (*Document, error) {
+	doc := &Document{Version: VersionDraft2}
+	p.lexer.skipSpaceAndComment()
+	if p.lexer.hasPrefix("version") && !isIdentPart(p.lexer.peekByte(len("version"))) {
+		pos := p.lexer.pos()
+		p.lexer.advance(len("version"))
+		doc.Version = p.lexer.readLine()
+		switch doc.Version {
+		case Version1_0, Version1_1, VersionDevelopment:
+		default:
+			return nil, newSyntaxError(pos, "unsupported version %q", doc.Version)
+		}
+	}
+	p.version = doc.Version
+
+	for {
+		tok, err := p.peek()
+		if err != nil {
+			return nil, err
+		}
+		if tok.kind == tokenEOF {
+			return doc, nil
+		}
+		if tok.kind != tokenIdent {
+			return nil, newSyntaxError(tok.pos, "unexpected %s", tok)
+		}
+		switch tok.text {
+		case "import":
+			imp, err := p.parseImport()
+			if err != nil {
+				return nil, err
+			}
+			doc.Imports = append(doc.Imports, imp)
+		case "struct":
+			if doc.Version == VersionDraft2 {
+				return nil, newSyntaxError(tok.pos, "struct is not supported in draft-2")
+			}
+			st, err := p.parseStruct()
+			if err != nil {
+				return nil, err
+			}
+			doc.Structs = append(doc.Structs, st)
+		case "task":
+			task, err := p.parseTask()
+			if err != nil {
+				return nil, err
+			}
+			doc.Tasks = append(doc.Tasks, task)
+		case "workflow":
+			if doc.Workflow != nil {
+				return nil, newSyntaxError(tok.pos, "only one workflow is allowed in a document")
+			}
+			workflow, err := p.parseWorkflow()
+			if err != nil {
+				return nil, err
+			}
+			doc.Workflow = workflow
+		default:
+			return nil, newSyntaxError(tok.pos, "unexpected %s", tok)
+		}
+	}
+}
+
+func (p *parser) parseImport() (*Import, error) {
+	tok, _ := p.next()
+	uri, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if uri.kind != tokenString {
+		return nil, newSyntaxError(uri.pos, "expect import uri but got %s", uri)
+	}
+	imp := &Import{
+		URI:     (&StringLiteral{Raw: uri.text}).Value(),
+		Aliases: map[string]string{},
+		Pos:     tok.pos,
+	}
+	if ok, err := p.accept("as"); err != nil {
+		return nil, err
+	} else if ok {
+		namespace, err := p.expectIdent()
+		if err != nil {
+			return nil, err
+		}
+		imp.Namespace = namespace.text
+	} else {
+		name := imp.URI[strings.LastIndex(imp.URI, "/")+1:]
+		imp.Namespace = strings.TrimSuffix(name, ".wdl")
+	}
+	for p.isNext("alias") {
+		_, _ = p.next()
+		from, err := p.expectIdent()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect("as"); err != nil {
+			return nil, err
+		}
+		to, err := p.expectIdent()
+		if err != nil {
+			return nil, err
+		}
+		imp.Aliases[from.text] = to.text
+	}
+	return imp, nil
+}
+
+func (p *parser) parseStruct() (*Struct, error) {
+	tok, _ := p.next()
+	name, err := p.expectIdent()
+	if err != nil {
+		return nil, err
+	}
+	st := &Struct{Name: name.text, Pos: tok.pos}
+	if _, err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for !p.isNext("}") {
+		decl, err := p.parseDeclaration(false)
+		if err != nil {
+			return nil, err
+		}
+		st.Members = append(st.Members, decl)
+	}
+	_, err = p.expect("}")
+	return st, err
+}
+
+func (p *parser) parseTask() (*Task, error) {
+	tok, _ := p.next()
+	name, err := p.expectIdent()
+	if err != nil {
+		return nil, err
+	}
+	task := &Task{Name: name.text, Runtime: map[string]Expression{}, Pos: tok.pos}
+	if _, err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	commandParsed := false
+	for {
+		tok, err := p.peek()
+		if err != nil {
+			return nil, err
+		}
+		if tok.kind == tokenPunct && tok.text == "}" {
+			_, _ = p.next()
+			return task, nil
+		}
+		if tok.kind != tokenIdent {
+			return nil, newSyntaxError(tok.pos, "unexpected %s in task %s", tok, task.Name)
+		}
+		if !p.isSection(tok.text, taskSectionKeywords) {
+			decl, err := p.parseDeclaration(true)
+			if err != nil {
+				return nil, err
+			}
+			if p.version == VersionDraft2 && !commandParsed && isDraft2Input(decl) {
+				task.Inputs = append(task.Inputs, decl)
+			} else {
+				task.Declarations = append(task.Declarations, decl)
+			}
+			continue
+		}
+		_, _ = p.next()
+		switch tok.text {
+		case "input":
+			if task.Inputs, err = p.parseDeclarationBlock(); err != nil {
+				return nil, err
+			}
+		case "output":
+			if task.Outputs, err = p.parseDeclarationBlock(); err != nil {
+				return nil, err
+			}
+		case "command":
+			if len(p.tokens) > 0 {
+				return nil, newSyntaxError(tok.pos, "unexpected command")
+			}
+			if task.Command, err = p.lexer.readCommand(); err != nil {
+				return nil, err
+			}
+			commandParsed = true
+		case "runtime":
+			keys, values, err := p.parseKeyValueBlock()
+			if err != nil {
+				return nil, err
+			}
+			for i, key := range keys {
+				task.Runtime[key] = values[i]
+			}
+		default:
+			// meta, parameter_meta, requirements and hints are not used
+			if _, _, err := p.parseKeyValueBlock(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (p *parser) parseWorkflow() (*Workflow, error) {
+	tok, _ := p.next()
+	name, err := p.expectIdent()
+	if err != nil {
+		return nil, err
+	}
+	workflow := &Workflow{Name: name.text, Pos: tok.pos}
+	if _, err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for {
+		tok, err := p.peek()
+		if err != nil {
+			return nil, err
+		}
+		if tok.kind == tokenPunct && tok.text == "}" {
+			_, _ = p.next()
+			return workflow, nil
+		}
+		if tok.kind != tokenIdent {
+			return nil, newSyntaxError(tok.pos, "unexpected %s in workflow %s", tok, workflow.Name)
+		}
+		switch {
+		case tok.text == "input" && p.isSection(tok.text, workflowSectionKeywords):
+			_, _ = p.next()
+			if workflow.Inputs, err = p.parseDeclarationBlock(); err != nil {
+				return nil, err
+			}
+		case tok.text == "output" && p.isSection(tok.text, workflowSectionKeywords):
+			_, _ = p.next()
+			if workflow.Outputs, err = p.parseWorkflowOutputs(); err != nil {
+				return nil, err
+			}
+		case (tok.text == "meta" || tok.text == "parameter_meta" || tok.text == "hints") && p.isSection(tok.text, workflowSectionKeywords):
+			_, _ = p.next()
+			if _, _, err := p.parseKeyValueBlock(); err != nil {
+				return nil, err
+			}
+		default:
+			element, err := p.parseWorkflowElement()
+			if err != nil {
+				return nil, err
+			}
+			if decl, ok := element.(*Declaration); ok && p.version == VersionDraft2 && isDraft2Input(decl) {
+				workflow.Inputs = append(workflow.Inputs, decl)
+				continue
+			}
+			workflow.Body = append(workflow.Body, element)
+		}
+	}
+}
+
+// isDraft2Input returns whether a top level declaration of a draft-2 task or workflow is an input:
+// unbound declarations, or those whose default does not reference other values.
+func isDraft2Input(decl *Declaration) bool {
+	return decl.Expression == nil || len(References(decl.Expression)) == 0
+}
+
+// isSection returns whether the keyword starts a section instead of a declaration of a struct type with the same name.
+func (p *parser) isSection(keyword string, keywords []string) bool {
+	found := false
+	for _, k := range keywords {
+		if k == keyword {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return false
+	}
+	// the command body must be read raw, so look at the source instead of the next token
+	if keyword == "command" && len(p.tokens) == 1 {
+		p.lexer.skipSpaceAndComment()
+		return p.lexer.hasPrefix("{") || p.lexer.hasPrefix("<<<")
+	}
+	next, err := p.peekN(1)
+	if err != nil {
+		return true
+	}
+	// a declaration is followed by its name, or [ ? + of its type
+	return !(next.kind == tokenIdent && keyword != "call") && !(next.kind == tokenPunct && (next.text == "?" || next.text == "+" || next.text == "["))
+}
+
+func (p *parser) parseWorkflowElement() (WorkflowElement, error) {
+	tok, err := p.peek()
+	if err != nil {
+		return nil, err
+	}
+	switch tok.text {
+	case "call":
+		return p.parseCall()
+	case "scatter":
+		if p.isSection(tok.text, workflowSectionKeywords) {
+			return p.parseScatter()
+		}
+	case "if":
+		if p.isSection(tok.text, workflowSectionKeywords) {
+			return p.parseConditional()
+		}
+	}
+	return p.parseDeclaration(true)
+}
+
+func (p *parser) parseWorkflowBody() ([]WorkflowElement, error) {
+	if _, err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	elements := make([]WorkflowElement, 0)
+	for !p.isNext("}") {
+		tok, err := p.peek()
+		if err != nil {
+			return nil, err
+		}
+		if tok.kind != tokenIdent {
+			return nil, newSyntaxError(tok.pos, "unexpected %s", tok)
+		}
+		element, err := p.parseWorkflowElement()
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+	_, err := p.expect("}")
+	return elements, err
+}
+
+func (p *parser) parseCall() (*Call, error) {
+	tok, _ := p.next()
+	call := &Call{Inputs: map[string]Expression{}, Pos: tok.pos}
+	target, err := p.expectIdent()
+	if err != nil {
+		return nil, err
+	}
+	call.Target = target.text
+	for p.isNext(".") {
+		_, _ = p.next()
+		name, err := p.expectIdent()
+		if err != nil {
+			return nil, err
+		}
+		call.Target += "." + name.text
+	}
+	if ok, err := p.accept("as"); err != nil {
+		return nil, err
+	} else if ok {
+		alias, err := p.expectIdent()
+		if err != nil {
+			return nil, err
+		}
+		call.Alias = alias.text
+	}
+	for p.isNext("after") {
+		_, _ = p.next()
+		after, err := p.expectIdent()
+		if err != nil {
+			return nil, err
+		}
+		call.After = append(call.After, after.text)
+	}
+	if !p.isNext("{") {
+		return call, nil
+	}
+	_, _ = p.next()
+	if ok, err := p.accept("input"); err != nil {
+		return nil, err
+	} else if ok {
+		if _, err := p.expect(":"); err != nil {
+			return nil, err
+		}
+	}
+	for !p.isNext("}") {
+		name, err := p.expectIdent()
+		if err != nil {
+			return nil, err
+		}
+		if _, exist := call.Inputs[name.text]; exist {
+			return nil, newSyntaxError(name.pos, "duplicate input %s of call %s", name.text, call.Name())
+		}
+		var expression Expression = &Identifier{Name: name.text}
+		if ok, err := p.accept("="); err != nil {
+			return nil, err
+		} else if ok {
+			if expression, err = p.parseExpression(); err != nil {
+				return nil, err
+			}
+		}
+		call.InputNames = append(call.InputNames, name.text)
+		call.Inputs[name.text] = expression
+		if ok, err := p.accept(","); err != nil {
+			return nil, err
+		} else if !ok {
+			break
+		}
+	}
+	_, err = p.expect("}")
+	return call, err
+}
+
+func (p *parser) parseScatter() (*Scatter, error) {
+	tok, _ := p.next()
+	if _, err := p.expect("("); err != nil {
+		return nil, err
+	}
+	variable, err := p.expectIdent()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect("in"); err != nil {
+		return nil, err
+	}
+	expression, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	body, err := p.parseWorkflowBody()
+	if err != nil {
+		return nil, err
+	}
+	return &Scatter{Variable: variable.text, Expression: expression, Body: body, Pos: tok.pos}, nil
+}
+
+func (p *parser) parseConditional() (*Conditional, error) {
+	tok, _ := p.next()
+	if _, err := p.expect("("); err != nil {
+		return nil, err
+	}
+	expression, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	body, err := p.parseWorkflowBody()
+	if err != nil {
+		return nil, err
+	}
+	return &Conditional{Expression: expression, Body: body, Pos: tok.pos}, nil
+}
+
+func (p *parser) parseDeclarationBlock() ([]*Declaration, error) {
+	if _, err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	decls := make([]*Declaration, 0)
+	for !p.isNext("}") {
+		decl, err := p.parseDeclaration(true)
+		if err != nil {
+			return nil, err
+		}
+		decls = append(decls, decl)
+	}
+	_, err := p.expect("}")
+	return decls, err
+}
+
+// parseWorkflowOutputs parses output section, which may contain references to call outputs in draft-2.
+func (p *parser) parseWorkflowOutputs() ([]*Declaration, error) {
+	if p.version != VersionDraft2 {
+		return p.parseDeclarationBlock()
+	}
+	if _, err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	outputs := make([]*Declaration, 0)
+	for !p.isNext("}") {
+		second, err := p.peekN(1)
+		if err != nil {
+			return nil, err
+		}
+		if second.kind == tokenPunct && (second.text == "." || second.text == "," || second.text == "}") {
+			output, err := p.parseOutputReference()
+			if err != nil {
+				return nil, err
+			}
+			outputs = append(outputs, output)
+		} else {
+			decl, err := p.parseDeclaration(true)
+			if err != nil {
+				return nil, err
+			}
+			outputs = append(outputs, decl)
+		}
+		if _, err := p.accept(","); err != nil {
+			return nil, err
+		}
+	}
+	_, err := p.expect("}")
+	return outputs, err
+}
+
+func (p *parser) parseOutputReference() (*Declaration, error) {
+	first, err := p.expectIdent()
+	if err != nil {
+		return nil, err
+	}
+	output := &Declaration{Name: first.text, Expression: &Identifier{Name: first.text}, Pos: first.pos}
+	for p.isNext(".") {
+		_, _ = p.next()
+		if ok, err := p.accept("*"); err != nil {
+			return nil, err
+		} else if ok {
+			output.Name += ".*"
+			return output, nil
+		}
+		member, err := p.expectIdent()
+		if err != nil {
+			return nil, err
+		}
+		output.Name += "." + member.text
+		output.Expression = &MemberAccess{Expression: output.Expression, Member: member.text}
+	}
+	return output, nil
+}
+
+func (p *parser) parseDeclaration(allowExpression bool) (*Declaration, error) {
+	tok, err := p.peek()
+	if err != nil {
+		return nil, err
+	}
+	typ, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.expectIdent()
+	if err != nil {
+		return nil, err
+	}
+	decl := &Declaration{Type: typ, Name: name.text, Pos: tok.pos}
+	if !allowExpression {
+		return decl, nil
+	}
+	if ok, err := p.accept("="); err != nil {
+		return nil, err
+	} else if ok {
+		if decl.Expression, err = p.parseExpression(); err != nil {
+			return nil, err
+		}
+	}
+	return decl, nil
+}
+
+func (p *parser) parseType() (*Type, error) {
+	name, err := p.expectIdent()
+	if err != nil {
+		return nil, err
+	}
+	typ := &Type{Name: name.text}
+	if p.isNext("[") {
+		_, _ = p.next()
+		for {
+			param, err := p.parseType()
+			if err != nil {
+				return nil, err
+			}
+			typ.Parameters = append(typ.Parameters, param)
+			if ok, err := p.accept(","); err != nil {
+				return nil, err
+			} else if !ok {
+				break
+			}
+		}
+		if _, err := p.expect("]"); err != nil {
+			return nil, err
+		}
+	}
+	if ok, err := p.accept("+"); err != nil {
+		return nil, err
+	} else if ok {
+		typ.NonEmpty = true
+	}
+	if ok, err := p.accept("?"); err != nil {
+		return nil, err
+	} else if ok {
+		typ.Optional = true
+	}
+	return typ, nil
+}
+
+// parseKeyValueBlock parses { key: value ... } of runtime and meta sections, entries may be separated by comma.
+func (p *parser) parseKeyValueBlock() ([]string, []Expression, error) {
+	if _, err := p.expect("{"); err != nil {
+		return nil, nil, err
+	}
+	keys := make([]string, 0)
+	values := make([]Expression, 0)
+	for !p.isNext("}") {
+		key, err := p.expectIdent()
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := p.expect(":"); err != nil {
+			return nil, nil, err
+		}
+		value, err := p.parseExpression()
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, key.text)
+		values = append(values, value)
+		if _, err := p.accept(","); err != nil {
+			return nil, nil, err
+		}
+	}
+	_, err := p.expect("}")
+	return keys, values, err
+}
@@ -0,0 +1,282 @@
+package wdl
+
+// binary operator precedences, higher binds tighter
+var binaryPrecedences = map[string]int{
+	"||": 1,
+	"&&": 2,
+	"==": 3, "!=": 3,
+	"<": 4, "<=": 4, ">": 4, ">=": 4,
+	"+": 5, "-": 5,
+	"*": 6, "/": 6, "%": 6,
+}
+
+func (p *parser) parseExpression() (Expression, error) {
+	return p.parseBinary(1)
+}
+
+func (p *parser) parseBinary(minPrecedence int) (Expression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok, err := p.peek()
+		if err != nil {
+			return nil, err
+		}
+		precedence, ok := binaryPrecedences[tok.text]
+		if tok.kind != tokenPunct || !ok || precedence < minPrecedence {
+			return left, nil
+		}
+		_, _ = p.next()
+		right, err := p.parseBinary(precedence + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &BinaryOperation{Operator: tok.text, Left: left, Right: right}
+	}
+}
+
+func (p *parser) parseUnary() (Expression, error) {
+	tok, err := p.peek()
+	if err != nil {
+		return nil, err
+	}
+	if tok.kind == tokenPunct && (tok.text == "!" || tok.text == "-" || tok.text == "+") {
+		_, _ = p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &UnaryOperation{Operator: tok.text, Expression: operand}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (Expression, error) {
+	expression, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.isNext("."):
+			_, _ = p.next()
+			member, err := p.expectIdent()
+			if err != nil {
+				return nil, err
+			}
+			expression = &MemberAccess{Expression: expression, Member: member.text}
+		case p.isNext("["):
+			_, _ = p.next()
+			index, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			expression = &IndexAccess{Expression: expression, Index: index}
+		default:
+			return expression, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (Expression, error) {
+	tok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch tok.kind {
+	case tokenInt, tokenFloat:
+		return &Literal{Value: tok.text}, nil
+	case tokenString:
+		return p.parseStringLiteral(tok)
+	case tokenIdent:
+		return p.parseIdentifierExpression(tok)
+	case tokenPunct:
+		switch tok.text {
+		case "(":
+			first, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if ok, err := p.accept(","); err != nil {
+				return nil, err
+			} else if ok {
+				second, err := p.parseExpression()
+				if err != nil {
+					return nil, err
+				}
+				if _, err := p.expect(")"); err != nil {
+					return nil, err
+				}
+				return &PairLiteral{Left: first, Right: second}, nil
+			}
+			if _, err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return first, nil
+		case "[":
+			items, err := p.parseExpressionList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &ArrayLiteral{Items: items}, nil
+		case "{":
+			return p.parseMapLiteral("")
+		}
+	}
+	return nil, newSyntaxError(tok.pos, "unexpected %s in expression", tok)
+}
+
+func (p *parser) parseIdentifierExpression(tok token) (Expression, error) {
+	switch tok.text {
+	case "true", "false", "None", "null":
+		return &Literal{Value: tok.text}, nil
+	case "if":
+		condition, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect("then"); err != nil {
+			return nil, err
+		}
+		then, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect("else"); err != nil {
+			return nil, err
+		}
+		otherwise, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		return &IfThenElse{Condition: condition, Then: then, Else: otherwise}, nil
+	case "object":
+		if p.isNext("{") {
+			_, _ = p.next()
+			return p.parseMapLiteral("object")
+		}
+	}
+	if p.isNext("(") {
+		_, _ = p.next()
+		arguments, err := p.parseExpressionList(")")
+		if err != nil {
+			return nil, err
+		}
+		return &Apply{Function: tok.text, Arguments: arguments}, nil
+	}
+	// struct literal
+	if p.version != VersionDraft2 && p.version != Version1_0 && p.isNext("{") {
+		_, _ = p.next()
+		return p.parseMapLiteral(tok.text)
+	}
+	return &Identifier{Name: tok.text}, nil
+}
+
+// parseExpressionList parses comma separated expressions until end, a trailing comma is allowed.
+func (p *parser) parseExpressionList(end string) ([]Expression, error) {
+	items := make([]Expression, 0)
+	for !p.isNext(end) {
+		item, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if ok, err := p.accept(","); err != nil {
+			return nil, err
+		} else if !ok {
+			break
+		}
+	}
+	_, err := p.expect(end)
+	return items, err
+}
+
+func (p *parser) parseMapLiteral(name string) (Expression, error) {
+	literal := &MapLiteral{Name: name}
+	for !p.isNext("}") {
+		key, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		literal.Keys = append(literal.Keys, key)
+		literal.Values = append(literal.Values, value)
+		if ok, err := p.accept(","); err != nil {
+			return nil, err
+		} else if !ok {
+			break
+		}
+	}
+	_, err := p.expect("}")
+	return literal, err
+}
+
+// parseStringLiteral parses expressions in placeholders of a string.
+func (p *parser) parseStringLiteral(tok token) (Expression, error) {
+	literal := &StringLiteral{Raw: tok.text}
+	raw := tok.text
+	for i := 1; i < len(raw)-1; i++ {
+		switch {
+		case raw[i] == '\\':
+			i++
+		case (raw[i] == '$' || raw[i] == '~') && raw[i+1] == '{':
+			sub := &lexer{src: raw, offset: i, line: 1, column: 1}
+			if err := sub.scanPlaceholder(); err != nil {
+				return nil, newSyntaxError(tok.pos, "invalid placeholder: %s", err)
+			}
+			content := raw[i+2 : sub.offset-1]
+			parts, err := parsePlaceholder(content, p.version)
+			if err != nil {
+				return nil, newSyntaxError(tok.pos, "invalid placeholder %q: %s", content, err)
+			}
+			literal.Parts = append(literal.Parts, parts)
+			i = sub.offset - 1
+		}
+	}
+	return literal, nil
+}
+
+// parsePlaceholder parses the content of a placeholder, which may start with options such as sep=", ".
+func parsePlaceholder(content, version string) (Expression, error) {
+	p := &parser{lexer: newLexer(content), version: version}
+	for {
+		first, err := p.peekN(0)
+		if err != nil {
+			return nil, err
+		}
+		second, err := p.peekN(1)
+		if err != nil {
+			return nil, err
+		}
+		if first.kind != tokenIdent || second.kind != tokenPunct || second.text != "=" {
+			break
+		}
+		// option=value
+		_, _ = p.next()
+		_, _ = p.next()
+		if _, err := p.parsePrimary(); err != nil {
+			return nil, err
+		}
+	}
+	expression, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if tok, err := p.peek(); err != nil {
+		return nil, err
+	} else if tok.kind != tokenEOF {
+		return nil, newSyntaxError(tok.pos, "unexpected %s", tok)
+	}
+	return expression, nil
+}
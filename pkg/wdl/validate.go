@@ -0,0 +1,271 @@
+package wdl
+
+// type names with their number of type parameters
+var builtinTypes = map[string]int{
+	"Boolean":   0,
+	"Int":       0,
+	"Float":     0,
+	"String":    0,
+	"File":      0,
+	"Directory": 0,
+	"Object":    0,
+	"Array":     1,
+	"Map":       2,
+	"Pair":      2,
+}
+
+func validateDocument(doc *Document) error {
+	for _, file := range doc.allDocuments() {
+		v := &validator{doc: file, structs: file.StructNames()}
+		if err := v.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Document) allDocuments() []*Document {
+	docs := make([]*Document, 0)
+	seen := make(map[*Document]bool)
+	var walk func(doc *Document)
+	walk = func(doc *Document) {
+		if seen[doc] {
+			return
+		}
+		seen[doc] = true
+		docs = append(docs, doc)
+		for _, imp := range doc.Imports {
+			walk(imp.Document)
+		}
+	}
+	walk(d)
+	return docs
+}
+
+type validator struct {
+	doc     *Document
+	structs map[string]bool
+}
+
+func (v *validator) validate() error {
+	namespaces := make(map[string]bool)
+	for _, imp := range v.doc.Imports {
+		if namespaces[imp.Namespace] {
+			return newValidationError(v.doc.Path, "duplicate import namespace %s", imp.Namespace)
+		}
+		namespaces[imp.Namespace] = true
+	}
+	for _, st := range v.doc.Structs {
+		if err := v.validateDeclarations(st.Members, map[string]bool{}); err != nil {
+			return err
+		}
+	}
+	tasks := make(map[string]bool)
+	for _, task := range v.doc.Tasks {
+		if tasks[task.Name] {
+			return newValidationError(v.doc.Path, "duplicate task %s", task.Name)
+		}
+		tasks[task.Name] = true
+		names := make(map[string]bool)
+		for _, decls := range [][]*Declaration{task.Inputs, task.Declarations, task.Outputs} {
+			if err := v.validateDeclarations(decls, names); err != nil {
+				return err
+			}
+		}
+	}
+	if v.doc.Workflow != nil {
+		if tasks[v.doc.Workflow.Name] {
+			return newValidationError(v.doc.Path, "workflow %s has the same name as a task", v.doc.Workflow.Name)
+		}
+		return v.validateWorkflow(v.doc.Workflow)
+	}
+	return nil
+}
+
+func (v *validator) validateDeclarations(decls []*Declaration, names map[string]bool) error {
+	for _, decl := range decls {
+		if names[decl.Name] {
+			return newValidationError(v.doc.Path, "%s: duplicate declaration %s", decl.Pos, decl.Name)
+		}
+		names[decl.Name] = true
+		if err := v.validateType(decl.Type); err != nil {
+			return newValidationError(v.doc.Path, "%s: %s", decl.Pos, err.Message)
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateType(typ *Type) *ValidationError {
+	if typ == nil {
+		return nil
+	}
+	paramNum, builtin := builtinTypes[typ.Name]
+	switch {
+	case builtin && paramNum != len(typ.Parameters):
+		return newValidationError(v.doc.Path, "type %s expects %d type parameters", typ.Name, paramNum)
+	case !builtin && !v.structs[typ.Name]:
+		return newValidationError(v.doc.Path, "unknown type %s", typ.Name)
+	case !builtin && len(typ.Parameters) > 0:
+		return newValidationError(v.doc.Path, "struct %s has no type parameters", typ.Name)
+	}
+	for _, param := range typ.Parameters {
+		if err := v.validateType(param); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateWorkflow(workflow *Workflow) error {
+	// names are unique in the whole workflow
+	names := make(map[string]bool)
+	if err := v.validateDeclarations(workflow.Inputs, names); err != nil {
+		return err
+	}
+	calls := make(map[string]bool)
+	var collect func(elements []WorkflowElement) error
+	collect = func(elements []WorkflowElement) error {
+		for _, element := range elements {
+			switch e := element.(type) {
+			case *Declaration:
+				if err := v.validateDeclarations([]*Declaration{e}, names); err != nil {
+					return err
+				}
+			case *Call:
+				if names[e.Name()] {
+					return newValidationError(v.doc.Path, "%s: duplicate name %s, use 'as' to alias the call", e.Pos, e.Name())
+				}
+				names[e.Name()] = true
+				calls[e.Name()] = true
+			case *Scatter:
+				if names[e.Variable] {
+					return newValidationError(v.doc.Path, "%s: duplicate name %s", e.Pos, e.Variable)
+				}
+				names[e.Variable] = true
+				if err := collect(e.Body); err != nil {
+					return err
+				}
+			case *Conditional:
+				if err := collect(e.Body); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := collect(workflow.Body); err != nil {
+		return err
+	}
+
+	checkReferences := func(expression Expression) error {
+		for _, name := range References(expression) {
+			if !names[name] {
+				return newValidationError(v.doc.Path, "unknown identifier %s in %s", name, expression)
+			}
+		}
+		return nil
+	}
+	for _, input := range workflow.Inputs {
+		if err := checkReferences(input.Expression); err != nil {
+			return err
+		}
+	}
+	var check func(elements []WorkflowElement) error
+	check = func(elements []WorkflowElement) error {
+		for _, element := range elements {
+			switch e := element.(type) {
+			case *Declaration:
+				if err := checkReferences(e.Expression); err != nil {
+					return err
+				}
+			case *Call:
+				if err := v.validateCall(e, calls); err != nil {
+					return err
+				}
+				for _, name := range e.InputNames {
+					if err := checkReferences(e.Inputs[name]); err != nil {
+						return err
+					}
+				}
+			case *Scatter:
+				if err := checkReferences(e.Expression); err != nil {
+					return err
+				}
+				if err := check(e.Body); err != nil {
+					return err
+				}
+			case *Conditional:
+				if err := checkReferences(e.Expression); err != nil {
+					return err
+				}
+				if err := check(e.Body); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := check(workflow.Body); err != nil {
+		return err
+	}
+
+	outputs := make(map[string]bool)
+	for _, output := range workflow.Outputs {
+		if output.Type == nil {
+			// draft-2 output reference
+			if root := References(output.Expression)[0]; !calls[root] {
+				return newValidationError(v.doc.Path, "%s: unknown call %s in output", output.Pos, root)
+			}
+			continue
+		}
+		if err := v.validateDeclarations([]*Declaration{output}, outputs); err != nil {
+			return err
+		}
+		if err := checkReferences(output.Expression); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateCall(call *Call, calls map[string]bool) error {
+	callable, err := v.doc.ResolveCall(call.Target)
+	if err != nil {
+		return newValidationError(v.doc.Path, "%s: call %s: %s", call.Pos, call.Target, err)
+	}
+	inputs := callable.inputNames()
+	for _, name := range call.InputNames {
+		if !inputs[name] {
+			return newValidationError(v.doc.Path, "%s: call %s has no input %s", call.Pos, call.Name(), name)
+		}
+	}
+	for _, after := range call.After {
+		if !calls[after] {
+			return newValidationError(v.doc.Path, "%s: call %s is after an unknown call %s", call.Pos, call.Name(), after)
+		}
+	}
+	return nil
+}
+
+func (c *Callable) inputNames() map[string]bool {
+	names := make(map[string]bool)
+	inputs := c.Workflow.inputsOrNil()
+	if c.Task != nil {
+		inputs = c.Task.Inputs
+		// private declarations can be supplied by call in draft-2
+		if c.Document.Version == VersionDraft2 {
+			inputs = append(append([]*Declaration{}, inputs...), c.Task.Declarations...)
+		}
+	}
+	for _, input := range inputs {
+		names[input.Name] = true
+	}
+	return names
+}
+
+func (w *Workflow) inputsOrNil() []*Declaration {
+	if w == nil {
+		return nil
+	}
+	return w.Inputs
+}
@@ -0,0 +1,379 @@
+package wdl
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		filePath := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+type param struct {
+	Name     string
+	Type     string
+	Optional bool
+	Default  string
+}
+
+func toParams(parameters []*Parameter) []param {
+	params := make([]param, 0, len(parameters))
+	for _, p := range parameters {
+		defaultValue, _ := p.DefaultValue()
+		params = append(params, param{Name: p.Name, Type: p.Type.String(), Optional: p.Optional, Default: defaultValue})
+	}
+	return params
+}
+
+func TestLoadVersion1(t *testing.T) {
+	g := gomega.NewWithT(t)
+	dir := writeFiles(t, map[string]string{
+		"main.wdl": `version 1.0
+
+import "tasks/align.wdl" as align
+import "sub.wdl"
+
+struct Sample {
+    String name
+    Array[File]+ reads
+}
+
+workflow main {
+    input {
+        Array[Sample] samples
+        String? prefix
+        Int threads = 4
+        String label = "run ~{threads}"
+    }
+
+    scatter (sample in samples) {
+        call align.bwa as bwa {
+            input: reads = sample.reads, threads = threads
+        }
+    }
+
+    Boolean has_prefix = defined(prefix)
+    if (has_prefix) {
+        call sub.report { input: bams = bwa.bam }
+    }
+
+    output {
+        Array[File] bams = bwa.bam
+        File? summary = report.summary
+    }
+
+    meta {
+        author: "bioos"
+        tags: ["a", "b"]
+    }
+}
+`,
+		"tasks/align.wdl": `version 1.0
+
+task bwa {
+    input {
+        Array[File] reads
+        Int threads
+        String docker = "bwa:latest"
+        Map[String, Int]? options
+    }
+    command <<<
+        bwa mem -t ~{threads} ~{sep=" " reads} | awk '{print $1}' > out.bam
+    >>>
+    output {
+        File bam = "out.bam"
+    }
+    runtime {
+        docker: docker
+        cpu: threads
+    }
+}
+`,
+		"sub.wdl": `version 1.0
+
+workflow report {
+    input {
+        Array[File] bams
+        Float min_quality = 0.5
+    }
+    call summarize { input: files = bams }
+    output {
+        File summary = summarize.out
+    }
+}
+
+task summarize {
+    input {
+        Array[File] files
+        Pair[Int, String] extra = (1, "x")
+    }
+    command {
+        cat ${sep=" " files} > summary.txt
+    }
+    output {
+        File out = "summary.txt"
+    }
+}
+`,
+	})
+
+	doc, err := Load(filepath.Join(dir, "main.wdl"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(doc.Version).To(gomega.Equal(Version1_0))
+	g.Expect(doc.Files()).To(gomega.Equal([]string{
+		filepath.Join(dir, "main.wdl"),
+		filepath.Join(dir, "tasks/align.wdl"),
+		filepath.Join(dir, "sub.wdl"),
+	}))
+	g.Expect(doc.Tasks).To(gomega.BeEmpty())
+	g.Expect(doc.Imports[0].Document.Tasks[0].Command).To(gomega.ContainSubstring(`awk '{print $1}'`))
+
+	inputs, err := doc.Inputs()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(toParams(inputs)).To(gomega.Equal([]param{
+		{Name: "main.bwa.docker", Type: "String", Optional: true, Default: "bwa:latest"},
+		{Name: "main.bwa.options", Type: "Map[String, Int]?", Optional: true},
+		{Name: "main.label", Type: "String", Optional: true, Default: "run ~{threads}"},
+		{Name: "main.prefix", Type: "String?", Optional: true},
+		{Name: "main.report.min_quality", Type: "Float", Optional: true, Default: "0.5"},
+		{Name: "main.report.summarize.extra", Type: "Pair[Int, String]", Optional: true, Default: `(1, "x")`},
+		{Name: "main.samples", Type: "Array[Sample]"},
+		{Name: "main.threads", Type: "Int", Optional: true, Default: "4"},
+	}))
+
+	outputs, err := doc.Outputs()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(toParams(outputs)).To(gomega.Equal([]param{
+		{Name: "main.bams", Type: "Array[File]"},
+		{Name: "main.summary", Type: "File?"},
+	}))
+
+	graph, err := doc.Graph()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(graph).To(gomega.Equal(`digraph main {
+  compound=true;
+  "CALL_bwa" -> "CALL_report";
+  subgraph cluster_0 {
+    label="scatter (sample in samples)";
+    "CALL_bwa" [label="call bwa"];
+  }
+  subgraph cluster_1 {
+    label="if (has_prefix)";
+    "CALL_report" [label="call report"];
+  }
+}
+`))
+}
+
+func TestLoadDraft2(t *testing.T) {
+	g := gomega.NewWithT(t)
+	dir := writeFiles(t, map[string]string{
+		"main.wdl": `import "tasks.wdl" as t
+
+workflow hello {
+    File input_file
+    Int lines = 10
+    String name = basename(input_file)
+
+    call t.count { input: file = input_file, n = lines }
+    scatter (i in range(count.total)) {
+        call t.echo { input: message = name + i }
+    }
+
+    output {
+        count.*
+        echo.out
+    }
+}
+`,
+		"tasks.wdl": `task count {
+    File file
+    Int n
+    Int? skip
+    String docker = "ubuntu"
+
+    command {
+        head -n ${n} ${file} | awk '{ total += 1 } END { print total }'
+    }
+    output {
+        Int total = read_int(stdout())
+        File log = "count.log"
+    }
+    runtime {
+        docker: "${docker}"
+    }
+}
+
+task echo {
+    String message
+    command <<<
+        echo ${message}
+    >>>
+    output {
+        String out = read_string(stdout())
+    }
+}
+`,
+	})
+
+	doc, err := Load(filepath.Join(dir, "main.wdl"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(doc.Version).To(gomega.Equal(VersionDraft2))
+
+	inputs, err := doc.Inputs()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(toParams(inputs)).To(gomega.Equal([]param{
+		{Name: "hello.count.docker", Type: "String", Optional: true, Default: "ubuntu"},
+		{Name: "hello.count.skip", Type: "Int?", Optional: true},
+		{Name: "hello.input_file", Type: "File"},
+		{Name: "hello.lines", Type: "Int", Optional: true, Default: "10"},
+	}))
+
+	outputs, err := doc.Outputs()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(toParams(outputs)).To(gomega.Equal([]param{
+		{Name: "hello.count.log", Type: "File"},
+		{Name: "hello.count.total", Type: "Int"},
+		{Name: "hello.echo.out", Type: "Array[String]"},
+	}))
+
+	graph, err := doc.Graph()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(graph).To(gomega.ContainSubstring(`"CALL_count" -> "CALL_echo";`))
+}
+
+func TestLoadVersion1_1(t *testing.T) {
+	g := gomega.NewWithT(t)
+	dir := writeFiles(t, map[string]string{
+		"main.wdl": `version 1.1
+
+struct Config {
+    Int size
+    String mode
+}
+
+workflow wf {
+    input {
+        Int size = 3
+    }
+    Config config = Config { size: size, mode: "fast" }
+    call run { size, mode = config.mode }
+    call run as rerun after run
+    output {
+        Int result = run.result + rerun.result
+    }
+}
+
+task run {
+    input {
+        Int size = 1
+        String mode = "slow"
+    }
+    command <<< echo ~{size} >>>
+    output {
+        Int result = size * 2
+    }
+    requirements {
+        container: "ubuntu"
+    }
+}
+`,
+	})
+
+	doc, err := Load(filepath.Join(dir, "main.wdl"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	inputs, err := doc.Inputs()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(toParams(inputs)).To(gomega.Equal([]param{
+		{Name: "wf.rerun.mode", Type: "String", Optional: true, Default: "slow"},
+		{Name: "wf.rerun.size", Type: "Int", Optional: true, Default: "1"},
+		{Name: "wf.size", Type: "Int", Optional: true, Default: "3"},
+	}))
+
+	graph, err := doc.Graph()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(graph).To(gomega.ContainSubstring(`"CALL_run" -> "CALL_rerun";`))
+}
+
+func TestLoadErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		files map[string]string
+		check func(g *gomega.WithT, err error)
+	}{
+		{
+			name:  "syntax error",
+			files: map[string]string{"main.wdl": "version 1.0\nworkflow wf {\n  call\n}\n"},
+			check: func(g *gomega.WithT, err error) {
+				var syntaxErr *SyntaxError
+				g.Expect(errors.As(err, &syntaxErr)).To(gomega.BeTrue())
+				g.Expect(syntaxErr.Pos.Line).To(gomega.Equal(4))
+			},
+		},
+		{
+			name:  "unsupported version",
+			files: map[string]string{"main.wdl": "version 2.0\n"},
+		},
+		{
+			name:  "unknown task",
+			files: map[string]string{"main.wdl": "version 1.0\nworkflow wf {\n  call missing\n}\n"},
+		},
+		{
+			name:  "unknown call input",
+			files: map[string]string{"main.wdl": "version 1.0\nworkflow wf {\n  call t { input: x = 1 }\n}\ntask t {\n  command {}\n}\n"},
+		},
+		{
+			name:  "unknown identifier",
+			files: map[string]string{"main.wdl": "version 1.0\nworkflow wf {\n  Int x = y\n}\n"},
+		},
+		{
+			name:  "unknown type",
+			files: map[string]string{"main.wdl": "version 1.0\nworkflow wf {\n  input {\n    Sample s\n  }\n}\n"},
+		},
+		{
+			name:  "duplicate call",
+			files: map[string]string{"main.wdl": "version 1.0\nworkflow wf {\n  call t\n  call t\n}\ntask t {\n  command {}\n}\n"},
+		},
+		{
+			name:  "version mismatch",
+			files: map[string]string{"main.wdl": "version 1.0\nimport \"a.wdl\"\n", "a.wdl": "task t {\n  command {}\n}\n"},
+		},
+		{
+			name:  "remote import",
+			files: map[string]string{"main.wdl": "version 1.0\nimport \"https://example.com/a.wdl\"\n"},
+			check: func(g *gomega.WithT, err error) {
+				g.Expect(errors.Is(err, ErrRemoteImport)).To(gomega.BeTrue())
+			},
+		},
+		{
+			name:  "cyclic import",
+			files: map[string]string{"main.wdl": "version 1.0\nimport \"a.wdl\"\n", "a.wdl": "version 1.0\nimport \"main.wdl\"\n"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			dir := writeFiles(t, c.files)
+			_, err := Load(filepath.Join(dir, "main.wdl"))
+			g.Expect(err).To(gomega.HaveOccurred())
+			if c.check != nil {
+				c.check(g, err)
+			}
+		})
+	}
+}